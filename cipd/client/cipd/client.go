@@ -43,6 +43,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -52,6 +53,7 @@ import (
 	"github.com/luci/luci-go/common/data/stringset"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/sync/parallel"
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 	"github.com/luci/luci-go/cipd/client/cipd/internal"
@@ -76,9 +78,17 @@ const (
 	TagAttachTimeout = 3 * time.Minute
 )
 
+// maxParallelFetches is how many packages EnsurePackages fetches at once.
+const maxParallelFetches = 4
+
+// maxOpenedFetches is how many fetched packages EnsurePackages keeps open at
+// once, including the ones being fetched and the one being deployed.
+const maxOpenedFetches = 2 * maxParallelFetches
+
 // Environment variable definitions
 const (
 	EnvCacheDir            = "CIPD_CACHE_DIR"
+	EnvCacheMaxSizeMB      = "CIPD_CACHE_MAX_SIZE_MB"
 	EnvHTTPUserAgentPrefix = "CIPD_HTTP_USER_AGENT_PREFIX"
 )
 
@@ -288,23 +298,39 @@ func (am ActionMap) Log(ctx context.Context) {
 				logging.Infof(ctx, "    %s", pin)
 			}
 		}
+		if len(actions.Broken) != 0 {
+			logging.Infof(ctx, "  broken:")
+			for _, broken := range actions.Broken {
+				logging.Infof(ctx, "    %s", broken.Pin)
+				for _, f := range broken.Added {
+					logging.Infof(ctx, "      added:    %s", f)
+				}
+				for _, f := range broken.Modified {
+					logging.Infof(ctx, "      modified: %s", f)
+				}
+				for _, f := range broken.Missing {
+					logging.Infof(ctx, "      missing:  %s", f)
+				}
+			}
+		}
 	}
 }
 
-// Actions is returned by EnsurePackages.
+// Actions is returned by EnsurePackages and CheckPackages.
 //
-// It lists pins that were attempted to be installed, updated or removed, as
-// well as all errors.
+// It lists pins that were attempted to be installed, updated or removed, pins
+// with broken files, as well as all errors.
 type Actions struct {
 	ToInstall common.PinSlice `json:"to_install,omitempty"` // pins to be installed
 	ToUpdate  []UpdatedPin    `json:"to_update,omitempty"`  // pins to be replaced
 	ToRemove  common.PinSlice `json:"to_remove,omitempty"`  // pins to be removed
+	Broken    []BrokenPin     `json:"broken,omitempty"`     // pins with broken files
 	Errors    []ActionError   `json:"errors,omitempty"`     // all individual errors
 }
 
 // Empty is true if there are no actions specified.
 func (a *Actions) Empty() bool {
-	return len(a.ToInstall) == 0 && len(a.ToUpdate) == 0 && len(a.ToRemove) == 0
+	return len(a.ToInstall) == 0 && len(a.ToUpdate) == 0 && len(a.ToRemove) == 0 && len(a.Broken) == 0
 }
 
 // UpdatedPin specifies a pair of pins: old and new version of a package.
@@ -313,6 +339,12 @@ type UpdatedPin struct {
 	To   common.Pin `json:"to"`
 }
 
+// BrokenPin is a deployed pin with files that don't match its manifest.
+type BrokenPin struct {
+	Pin common.Pin `json:"pin"`
+	local.FilesDrift
+}
+
 // ActionError holds an error that happened when installing or removing the pin.
 type ActionError struct {
 	Action string     `json:"action"`
@@ -452,9 +484,25 @@ type Client interface {
 	// If dryRun is true, will just check for changes and return them in Actions
 	// struct, but won't actually perform them.
 	//
+	// Packages are fetched in parallel, but deployed one at a time in the given
+	// order.
+	//
 	// If the update was only partially applied, returns both Actions and error.
 	EnsurePackages(ctx context.Context, pkgs common.PinSliceBySubdir, dryRun bool) (ActionMap, error)
 
+	// CheckPackages verifies that the site root matches the given packages.
+	//
+	// It reports packages that need to be installed, updated or removed, like
+	// EnsurePackages in dry run mode, and re-hashes files of packages deployed at
+	// the desired versions to find added, modified or missing files.
+	//
+	// If repair is true, modified and missing files are re-extracted from their
+	// instances, fetched through the instance cache. Nothing else is changed.
+	//
+	// If some package couldn't be checked or repaired, returns both Actions and
+	// error.
+	CheckPackages(ctx context.Context, pkgs common.PinSliceBySubdir, repair bool) (ActionMap, error)
+
 	// IncrementCounter adds delta to the counter's value and updates its last
 	// updated timestamp.
 	//
@@ -484,8 +532,18 @@ type ClientOptions struct {
 	//
 	// If empty, instances are not cached and tags are cached inside the site
 	// root. If both Root and CacheDir are empty, tag cache is disabled.
+	//
+	// The cache can be shared by multiple processes and site roots. If it is on
+	// the same file system as Root, deployed files are hardlinked from the cache
+	// instead of being copied.
 	CacheDir string
 
+	// CacheMaxBytes is the maximum total size of instances kept in CacheDir.
+	//
+	// When it is exceeded, least recently used instances are purged. If zero,
+	// only the number and the age of cached instances are limited.
+	CacheMaxBytes int64
+
 	// AnonymousClient is http.Client that doesn't attach authentication headers.
 	//
 	// Will be used when talking to the Google Storage. We use signed URLs that do
@@ -521,6 +579,15 @@ func (opts *ClientOptions) LoadFromEnv(getEnv func(string) string) error {
 			opts.CacheDir = v
 		}
 	}
+	if opts.CacheMaxBytes == 0 {
+		if v := getEnv(EnvCacheMaxSizeMB); v != "" {
+			mb, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || mb < 0 {
+				return fmt.Errorf("bad %s: not a non-negative integer - %s", EnvCacheMaxSizeMB, v)
+			}
+			opts.CacheMaxBytes = mb * 1024 * 1024
+		}
+	}
 	if opts.UserAgent == "" {
 		if v := getEnv(EnvHTTPUserAgentPrefix); v != "" {
 			opts.UserAgent = fmt.Sprintf("%s/%s", v, UserAgent)
@@ -655,7 +722,7 @@ func (client *clientImpl) getInstanceCache(ctx context.Context) *internal.Instan
 			return
 		}
 		path := filepath.Join(client.CacheDir, "instances")
-		client.instanceCache = internal.NewInstanceCache(local.NewFileSystem(path, ""))
+		client.instanceCache = internal.NewInstanceCache(local.NewFileSystem(path, ""), client.CacheMaxBytes)
 		logging.Infof(ctx, "cipd: using instance cache at %q", path)
 	})
 	return client.instanceCache
@@ -1262,14 +1329,27 @@ func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir str
 	if err := common.ValidateSubdir(subdir); err != nil {
 		return err
 	}
-	if err := common.ValidatePin(pin); err != nil {
+	instance, err := client.openInstance(ctx, pin)
+	if err != nil {
 		return err
 	}
+	return client.deployInstance(ctx, subdir, instance)
+}
+
+// openInstance fetches the package and opens it, ready to be deployed.
+//
+// If the instance cache is used, it also extracts the package into the cache,
+// so the returned instance can hardlink its files from there. It is safe to
+// call concurrently. The caller is responsible for closing the instance.
+func (client *clientImpl) openInstance(ctx context.Context, pin common.Pin) (local.PackageInstance, error) {
+	if err := common.ValidatePin(pin); err != nil {
+		return nil, err
+	}
 
 	// Fetch the package (verifying its hash) and obtain a pointer to its data.
 	instanceFile, err := client.FetchInstance(ctx, pin)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Make sure to close the file if paniced before OpenInstance call below.
@@ -1286,10 +1366,20 @@ func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir str
 	// the hash already, so skip verification.
 	instance, err := local.OpenInstance(ctx, instanceFile, pin.InstanceID, local.SkipHashVerification)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
 	owned = true // disarm the defer above, instance.Close closes instanceFile.
+
+	// Hardlink files from the instance cache, if possible. Close() of the wrapper
+	// closes the wrapped instance.
+	if cache := client.getInstanceCache(ctx); cache != nil {
+		instance = client.hardlinkFromCache(ctx, cache, instance)
+	}
+	return instance, nil
+}
+
+// deployInstance deploys an instance opened by openInstance and closes it.
+func (client *clientImpl) deployInstance(ctx context.Context, subdir string, instance local.PackageInstance) error {
 	defer func() {
 		if err := instance.Close(); err != nil {
 			logging.Warningf(ctx, "cipd: failed to close the instance - %s", err)
@@ -1299,10 +1389,35 @@ func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir str
 	}()
 
 	// Deploy it. 'defer' will take care of removing the temp file if needed.
-	_, err = client.deployer.DeployInstance(ctx, subdir, instance)
+	_, err := client.deployer.DeployInstance(ctx, subdir, instance)
 	return err
 }
 
+// hardlinkFromCache makes the instance hardlink its files from an extracted copy
+// kept in the instance cache, if the cache and the site root are on the same
+// file system.
+//
+// Returns the instance unchanged if hardlinking is impossible.
+func (client *clientImpl) hardlinkFromCache(ctx context.Context, cache *internal.InstanceCache, inst local.PackageInstance) local.PackageInstance {
+	if client.Root == "" || !local.SameFileSystem(client.Root, client.CacheDir) {
+		return inst
+	}
+	pin := inst.Pin()
+	dir, err := cache.GetExtracted(ctx, pin, clock.Now(ctx), func(dir string) (int64, error) {
+		logging.Infof(ctx, "cipd: extracting %s into the instance cache", pin)
+		size := int64(0)
+		for _, f := range inst.Files() {
+			size += int64(f.Size())
+		}
+		return size, local.ExtractInstance(ctx, inst, local.NewFileSystemDestination(dir, nil), nil)
+	})
+	if err != nil {
+		logging.Warningf(ctx, "cipd: failed to extract %s into the instance cache, copying files instead - %s", pin, err)
+		return inst
+	}
+	return local.NewHardlinkingInstance(inst, dir)
+}
+
 func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSliceBySubdir, dryRun bool) (aMap ActionMap, err error) {
 	if err = allPins.Validate(); err != nil {
 		return
@@ -1351,6 +1466,21 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 
 	// Install all new and updated stuff. Install in the order specified by
 	// 'pins'. Order matters if multiple packages install same file.
+	//
+	// Packages are fetched (and extracted into the instance cache, if it is used)
+	// in parallel, while already fetched ones are being deployed. Deployment
+	// itself is sequential, so a package that fails to be fetched never leaves
+	// the site root partially updated.
+	type installJob struct {
+		subdir  string
+		pin     common.Pin
+		actions *Actions
+
+		done     chan struct{} // closed when 'instance' or 'err' is set
+		instance local.PackageInstance
+		err      error
+	}
+	var jobs []*installJob
 	aMap.LoopOrdered(func(subdir string, actions *Actions) {
 		toDeploy := make(map[string]bool, len(actions.ToInstall)+len(actions.ToUpdate))
 		for _, p := range actions.ToInstall {
@@ -1360,33 +1490,193 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 			toDeploy[pair.To.PackageName] = true
 		}
 		for _, pin := range allPins[subdir] {
-			if !toDeploy[pin.PackageName] {
+			if toDeploy[pin.PackageName] {
+				jobs = append(jobs, &installJob{
+					subdir:  subdir,
+					pin:     pin,
+					actions: actions,
+					done:    make(chan struct{}),
+				})
+			}
+		}
+	})
+
+	// Don't fetch too far ahead of the deployment, since every fetched package
+	// stays open (and in the temp directory, if not using the instance cache)
+	// until it is deployed. Slots are taken in the deployment order, so the
+	// package the deployment waits for always has one.
+	opened := make(parallel.Semaphore, maxOpenedFetches)
+
+	progress := newEnsureProgress(ctx, len(jobs))
+	go parallel.Ignore(parallel.Run(maxParallelFetches, func(workC chan<- func() error) {
+		for _, job := range jobs {
+			job := job
+			opened.Lock()
+			workC <- func() error {
+				defer close(job.done)
+				fetchCtx := withDownloadProgress(ctx, progress.downloadStarted())
+				job.instance, job.err = client.openInstance(fetchCtx, job.pin)
+				progress.packageFetched()
+				return nil
+			}
+		}
+	}))
+
+	for _, job := range jobs {
+		<-job.done
+		if job.err == nil {
+			job.err = client.deployInstance(ctx, job.subdir, job.instance)
+		}
+		opened.Unlock()
+		progress.packageDeployed()
+		if job.err != nil {
+			logging.Errorf(ctx, "Failed to install %s - %s", job.pin, job.err)
+			hasErrors = true
+			job.actions.Errors = append(job.actions.Errors, ActionError{
+				Action: "install",
+				Pin:    job.pin,
+				Error:  JSONError{job.err},
+			})
+		}
+	}
+
+	// Opportunistically cleanup the trash left from previous installs.
+	client.doBatchAwareOp(ctx, batchAwareOpCleanupTrash)
+
+	if !hasErrors {
+		logging.Infof(ctx, "All changes applied.")
+	} else {
+		err = ErrEnsurePackagesFailed
+	}
+	return
+}
+
+func (client *clientImpl) CheckPackages(ctx context.Context, allPins common.PinSliceBySubdir, repair bool) (aMap ActionMap, err error) {
+	if err = allPins.Validate(); err != nil {
+		return
+	}
+
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	existing, err := client.deployer.FindDeployed(ctx)
+	if err != nil {
+		return
+	}
+
+	// Packages that are not deployed at the desired versions are reported as
+	// is, 'ensure' will take care of them. Check files of all others.
+	aMap = buildActionPlan(allPins, existing)
+	if aMap == nil {
+		aMap = ActionMap{}
+	}
+	deployed := existing.ToMap()
+	hasErrors := false
+
+	subdirs := make(sort.StringSlice, 0, len(allPins))
+	for subdir := range allPins {
+		subdirs = append(subdirs, subdir)
+	}
+	subdirs.Sort()
+	for _, subdir := range subdirs {
+		for _, pin := range allPins[subdir] {
+			if deployed[subdir][pin.PackageName] != pin.InstanceID {
+				continue
+			}
+			_, drift, err := client.deployer.CheckDeployedFiles(ctx, subdir, pin.PackageName)
+			if err == nil && drift.Empty() {
 				continue
 			}
-			err = client.FetchAndDeployInstance(ctx, subdir, pin)
+			actions := aMap[subdir]
+			if actions == nil {
+				actions = &Actions{}
+				aMap[subdir] = actions
+			}
 			if err != nil {
-				logging.Errorf(ctx, "Failed to install %s - %s", pin, err)
+				logging.Errorf(ctx, "Failed to check %s - %s", pin, err)
 				hasErrors = true
 				actions.Errors = append(actions.Errors, ActionError{
-					Action: "install",
+					Action: "check",
 					Pin:    pin,
 					Error:  JSONError{err},
 				})
+				continue
 			}
+			actions.Broken = append(actions.Broken, BrokenPin{Pin: pin, FilesDrift: drift})
 		}
-	})
+	}
 
-	// Opportunistically cleanup the trash left from previous installs.
-	client.doBatchAwareOp(ctx, batchAwareOpCleanupTrash)
+	if len(aMap) == 0 {
+		logging.Debugf(ctx, "Everything is up-to-date.")
+		return nil, nil
+	}
+	aMap.Log(ctx)
 
-	if !hasErrors {
-		logging.Infof(ctx, "All changes applied.")
-	} else {
+	if repair {
+		aMap.LoopOrdered(func(subdir string, actions *Actions) {
+			for _, broken := range actions.Broken {
+				if err := client.repairInstance(ctx, subdir, broken); err != nil {
+					logging.Errorf(ctx, "Failed to repair %s - %s", broken.Pin, err)
+					hasErrors = true
+					actions.Errors = append(actions.Errors, ActionError{
+						Action: "repair",
+						Pin:    broken.Pin,
+						Error:  JSONError{err},
+					})
+				}
+			}
+		})
+	}
+
+	if hasErrors {
 		err = ErrEnsurePackagesFailed
 	}
 	return
 }
 
+// repairInstance re-extracts modified and missing files of a deployed instance.
+//
+// Added files are left alone, they may be someone else's.
+func (client *clientImpl) repairInstance(ctx context.Context, subdir string, broken BrokenPin) error {
+	files := append(append([]string(nil), broken.Modified...), broken.Missing...)
+	if len(files) == 0 {
+		return nil
+	}
+
+	// If the package was deployed by hardlinking files from the instance cache,
+	// the modified files are the extracted copy's too. Get rid of it, so it is
+	// not hardlinked into other site roots.
+	if len(broken.Modified) != 0 {
+		if cache := client.getInstanceCache(ctx); cache != nil {
+			if err := cache.DeleteExtracted(ctx, broken.Pin, clock.Now(ctx)); err != nil {
+				return err
+			}
+		}
+	}
+
+	instanceFile, err := client.FetchInstance(ctx, broken.Pin)
+	if err != nil {
+		return err
+	}
+	// Don't hardlink from the instance cache here, the extracted files there
+	// may be the broken ones.
+	instance, err := local.OpenInstance(ctx, instanceFile, broken.Pin.InstanceID, local.SkipHashVerification)
+	if err != nil {
+		if err := instanceFile.Close(); err != nil {
+			logging.Warningf(ctx, "cipd: failed to close the package file - %s", err)
+		}
+		return err
+	}
+	defer func() {
+		if err := instance.Close(); err != nil {
+			logging.Warningf(ctx, "cipd: failed to close the instance - %s", err)
+		}
+		client.doBatchAwareOp(ctx, batchAwareOpCleanupTrash)
+	}()
+
+	return client.deployer.RepairDeployed(ctx, subdir, instance, files)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Private structs and interfaces.
 
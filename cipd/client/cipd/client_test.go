@@ -15,6 +15,8 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -819,6 +821,148 @@ func TestEnsurePackages(t *testing.T) {
 				"": PinSlice{a1.Pin(), b.Pin()},
 			}, shouldBeDeployed)
 		})
+
+		Convey("CheckPackages finds and repairs broken files", func(c C) {
+			a := buildInstanceInMemory(ctx, "pkg/a", []local.File{
+				local.NewTestFile("file a 1", "test data", false),
+				local.NewTestFile("file a 2", "test data", false),
+			})
+			defer a.Close()
+			b := buildInstanceInMemory(ctx, "pkg/b", []local.File{local.NewTestFile("file b", "test data", false)})
+			defer b.Close()
+
+			pins := PinSliceBySubdir{"": PinSlice{a.Pin()}}
+			_, err := mockClientForFetch(c, tempDir, []local.PackageInstance{a}).EnsurePackages(ctx, pins, false)
+			So(err, ShouldBeNil)
+
+			// Nothing is broken yet.
+			actions, err := mockClientForFetch(c, tempDir, nil).CheckPackages(ctx, pins, false)
+			So(err, ShouldBeNil)
+			So(actions, ShouldResemble, ActionMap(nil))
+
+			So(os.Remove(filepath.Join(tempDir, "file a 2")), ShouldBeNil)
+
+			// Packages to install are reported too.
+			pins[""] = append(pins[""], b.Pin())
+			actions, err = mockClientForFetch(c, tempDir, nil).CheckPackages(ctx, pins, false)
+			So(err, ShouldBeNil)
+			So(actions, ShouldResemble, ActionMap{
+				"": &Actions{
+					ToInstall: PinSlice{b.Pin()},
+					Broken: []BrokenPin{
+						{Pin: a.Pin(), FilesDrift: local.FilesDrift{Missing: []string{"file a 2"}}},
+					},
+				},
+			})
+			_, err = os.Stat(filepath.Join(tempDir, "file a 2"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			// Only the broken package is fetched to repair it.
+			actions, err = mockClientForFetch(c, tempDir, []local.PackageInstance{a}).CheckPackages(ctx, pins, true)
+			So(err, ShouldBeNil)
+			So(actions[""].Errors, ShouldHaveLength, 0)
+			So("file a 2", shouldHaveContent, "test data")
+			_, err = os.Stat(filepath.Join(tempDir, "file b"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("CheckPackages drops the extracted copy of a modified hardlinked package", func(c C) {
+			a := buildInstanceInMemory(ctx, "pkg/a", []local.File{local.NewTestFile("file a", "test data", false)})
+			defer a.Close()
+
+			cacheDir, err := ioutil.TempDir("", "cipd_cache_test")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(cacheDir)
+			extracted := filepath.Join(cacheDir, "instances", "extracted", a.Pin().InstanceID)
+
+			pins := PinSliceBySubdir{"": PinSlice{a.Pin()}}
+			client := mockClientForFetch(c, tempDir, []local.PackageInstance{a})
+			client.CacheDir = cacheDir
+			_, err = client.EnsurePackages(ctx, pins, false)
+			So(err, ShouldBeNil)
+
+			// The deployed file is hardlinked from the extracted copy, so modifying
+			// it modifies the extracted copy too.
+			path := filepath.Join(tempDir, "file a")
+			So(os.Chmod(path, 0666), ShouldBeNil)
+			So(ioutil.WriteFile(path, []byte("modified"), 0666), ShouldBeNil)
+			body, err := ioutil.ReadFile(filepath.Join(extracted, "file a"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, "modified")
+
+			// The instance itself is still in the cache, so nothing is fetched.
+			client = mockClientForFetch(c, tempDir, nil)
+			client.CacheDir = cacheDir
+			actions, err := client.CheckPackages(ctx, pins, true)
+			So(err, ShouldBeNil)
+			So(actions[""].Broken, ShouldResemble, []BrokenPin{
+				{Pin: a.Pin(), FilesDrift: local.FilesDrift{Modified: []string{"file a"}}},
+			})
+			So(actions[""].Errors, ShouldHaveLength, 0)
+			So("file a", shouldHaveContent, "test data")
+
+			_, err = os.Stat(extracted)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("EnsurePackages installs what it can if some fetches fail", func(c C) {
+			a := buildInstanceInMemory(ctx, "pkg/a", []local.File{local.NewTestFile("file a", "test data", false)})
+			defer a.Close()
+			b := buildInstanceInMemory(ctx, "pkg/b", []local.File{local.NewTestFile("file b", "test data", false)})
+			defer b.Close()
+			d := buildInstanceInMemory(ctx, "pkg/d", []local.File{local.NewTestFile("file d", "test data", false)})
+			defer d.Close()
+
+			// 'b' can't be downloaded.
+			client := mockClientForFetch(c, tempDir, []local.PackageInstance{a, b, d})
+			delete(client.storage.(*mockedStorage).data, "http://localhost/fetch/"+b.Pin().InstanceID)
+
+			actions, err := client.EnsurePackages(ctx, PinSliceBySubdir{
+				"":       PinSlice{a.Pin(), b.Pin()},
+				"subdir": PinSlice{d.Pin()},
+			}, false)
+			So(err, ShouldEqual, ErrEnsurePackagesFailed)
+			So(actions[""].Errors, ShouldHaveLength, 1)
+			So(actions[""].Errors[0].Pin, ShouldResemble, b.Pin())
+			So(actions["subdir"].Errors, ShouldHaveLength, 0)
+
+			So("file a", shouldHaveContent, "test data")
+			So("subdir/file d", shouldHaveContent, "test data")
+			_, err = os.Stat(filepath.Join(tempDir, "file b"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			deployed, err := local.NewDeployer(tempDir).FindDeployed(ctx)
+			So(err, ShouldBeNil)
+			So(deployed, ShouldResemble, PinSliceBySubdir{
+				"":       PinSlice{a.Pin()},
+				"subdir": PinSlice{d.Pin()},
+			})
+		})
+
+		Convey("EnsurePackages installs more packages than it keeps open at once", func(c C) {
+			var instances []local.PackageInstance
+			var pins PinSlice
+			for i := 0; i < maxOpenedFetches+2; i++ {
+				inst := buildInstanceInMemory(ctx, fmt.Sprintf("pkg/%d", i), []local.File{
+					local.NewTestFile(fmt.Sprintf("file %d", i), "test data", false),
+				})
+				defer inst.Close()
+				instances = append(instances, inst)
+				pins = append(pins, inst.Pin())
+			}
+
+			client := mockClientForFetch(c, tempDir, instances)
+			_, err := client.EnsurePackages(ctx, PinSliceBySubdir{"": pins}, false)
+			So(err, ShouldBeNil)
+
+			for i := range instances {
+				So(fmt.Sprintf("file %d", i), shouldHaveContent, "test data")
+			}
+
+			deployed, err := local.NewDeployer(tempDir).FindDeployed(ctx)
+			So(err, ShouldBeNil)
+			So(deployed, ShouldResemble, PinSliceBySubdir{"": pins})
+		})
 	})
 }
 
@@ -864,7 +1008,13 @@ func mockClientForFetch(c C, root string, instances []local.PackageInstance) *cl
 			}`, inst.Pin().InstanceID),
 		})
 	}
-	client := mockClient(c, root, calls)
+	// EnsurePackages fetches packages in parallel, so the calls may come in any
+	// order.
+	client := mockClientWithHandler(c, root, &expectedHTTPCallHandler{
+		c:        c,
+		calls:    calls,
+		anyOrder: true,
+	})
 
 	// Mock storage.
 	data := map[string][]byte{}
@@ -931,8 +1081,13 @@ func makeTestContext() context.Context {
 
 // mockClient returns Client with clock and HTTP calls mocked.
 func mockClient(c C, root string, expectations []expectedHTTPCall) *clientImpl {
+	return mockClientWithHandler(c, root, &expectedHTTPCallHandler{c: c, calls: expectations})
+}
+
+// mockClientWithHandler returns Client with clock mocked and HTTP calls served
+// by the given handler.
+func mockClientWithHandler(c C, root string, handler *expectedHTTPCallHandler) *clientImpl {
 	// Provide fake client instead.
-	handler := &expectedHTTPCallHandler{c, expectations, 0}
 	server := httptest.NewServer(handler)
 	Reset(func() {
 		server.Close()
@@ -963,15 +1118,33 @@ type expectedHTTPCallHandler struct {
 	c     C
 	calls []expectedHTTPCall
 	index int
+
+	// anyOrder allows the calls to come in any order, e.g. when they are made
+	// concurrently.
+	anyOrder bool
+	lock     sync.Mutex
 }
 
 func (s *expectedHTTPCallHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	// Unexpected call?
 	if s.index == len(s.calls) {
 		s.c.Printf("Unexpected call: %v\n", r)
 	}
 	s.c.So(s.index, ShouldBeLessThan, len(s.calls))
 
+	// Move the matching call (if any) to the front of the remaining ones.
+	if s.anyOrder {
+		for i := s.index; i < len(s.calls); i++ {
+			if s.calls[i].Path == r.URL.Path && reflect.DeepEqual(s.calls[i].Query, r.URL.Query()) {
+				s.calls[s.index], s.calls[i] = s.calls[i], s.calls[s.index]
+				break
+			}
+		}
+	}
+
 	// Fill in defaults.
 	exp := s.calls[s.index]
 	if exp.Method == "" {
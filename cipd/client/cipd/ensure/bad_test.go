@@ -98,6 +98,33 @@ var badEnsureFiles = []struct {
 		"$ServiceURL may only be set once per file",
 	},
 
+	{
+		"too many resolved versions files",
+		f(
+			"$ResolvedVersions versions.txt",
+			"$ResolvedVersions other_versions.txt",
+		),
+		"$ResolvedVersions may only be set once per file",
+	},
+
+	{
+		"empty resolved versions file",
+		"$ResolvedVersions",
+		"expecting '$ResolvedVersions <path>'",
+	},
+
+	{
+		"empty verified platform",
+		"$VerifiedPlatform",
+		"expecting '$VerifiedPlatform <os>-<arch> [<os>-<arch> ...]'",
+	},
+
+	{
+		"bad verified platform",
+		"$VerifiedPlatform linux-amd64 windows",
+		`bad platform "windows"`,
+	},
+
 	{
 		"bad setting",
 		"$nurbs thingy",
@@ -20,10 +20,18 @@
 //
 // Settings
 //
-// A setting looks like `$name value`. Settings are global and can only be set
-// once per file. The following settings are allowed:
+// A setting looks like `$name value`. Settings are global and, unless noted
+// otherwise, can only be set once per file. The following settings are
+// allowed:
 //   - ServiceURL is the url for the cipd service. It can be used in lieu of
 //     the -service-url command line parameter.
+//   - ResolvedVersions is the path to a resolved versions file, relative to
+//     the directory of the ensure file. See below.
+//   - VerifiedPlatform is a space-separated list of `<os>-<arch>` platforms
+//     (e.g. `linux-amd64 mac-amd64`) that the ensure file is expected to work
+//     on. It may be given several times. `cipd ensure-file-verify` checks
+//     that every package resolves on each of them, and
+//     `cipd ensure-file-resolve` pins the packages of each of them.
 //
 // Directives
 //
@@ -69,12 +77,36 @@
 //
 // That's all there is to it.
 //
+// Resolved Versions
+//
+// Versions like `latest` or `git_revision:...` are refs and tags, and are
+// resolved to instance IDs each time the file is used. To make this
+// reproducible, an ensure file can name a resolved versions file with the
+// $ResolvedVersions setting. This file is written by
+// `cipd ensure-file-resolve`, which expands the package templates for each
+// platform given to it and pins every resulting package version to an
+// instance ID. When the setting is present, `cipd ensure` takes the instance
+// IDs from the resolved versions file instead of asking the backend, and
+// fails if a package line is missing from it (i.e. the ensure file changed
+// without regenerating the resolved versions file).
+//
+// The resolved versions file holds a block for each package version, with the
+// package name, the version and the instance ID on consecutive lines, and
+// blocks separated by blank lines:
+//
+//   infra/tools/cipd/linux-amd64
+//     latest
+//     deadbeefdeadbeefdeadbeefdeadbeefdeadbeef
+//
 // Example
 //
 // Here is an example ensure file which demonstrates all the various features.
 //
 //   # This is an ensure file!
 //   $ServiceURL https://chrome-infra-packages.appspot.com/
+//   $ResolvedVersions cipd_versions.txt
+//   $VerifiedPlatform linux-amd64 mac-amd64
+//   $VerifiedPlatform windows-386
 //
 //   # This is the cipd client itself
 //   infra/tools/cipd/${os}-${arch}  latest
@@ -23,6 +23,16 @@ import (
 type File struct {
 	ServiceURL string
 
+	// ResolvedVersions is the path of the resolved versions file (see
+	// VersionsFile), as given by the $ResolvedVersions setting. It is relative
+	// to the directory of the ensure file.
+	ResolvedVersions string
+
+	// VerifyPlatforms are the platforms given by the $VerifiedPlatform setting,
+	// in the order they were declared. The ensure file is expected to resolve
+	// on each of them.
+	VerifyPlatforms []Platform
+
 	PackagesBySubdir map[string]PackageSlice
 }
 
@@ -188,6 +198,39 @@ func (f *File) ResolveWith(rslv VersionResolver, templateArgs map[string]string)
 	return ret, nil
 }
 
+// ResolveVersions expands all package templates for each of the given
+// platforms and resolves all of the resulting package versions with the
+// provided VersionResolver.
+//
+// The result pins every package line of the File, on each platform, and can be
+// written out as the File's resolved versions file.
+func (f *File) ResolveVersions(rslv VersionResolver, platforms []Platform) (VersionsFile, error) {
+	ret := VersionsFile{}
+	cachingRslv := func(pkg, vers string) (common.Pin, error) {
+		if iid, ok := ret[unresolvedVersion{pkg, vers}]; ok {
+			return common.Pin{PackageName: pkg, InstanceID: iid}, nil
+		}
+		pin, err := rslv(pkg, vers)
+		if err != nil {
+			return pin, err
+		}
+		if err := ret.AddVersion(pkg, vers, pin.InstanceID); err != nil {
+			return common.Pin{}, err
+		}
+		return pin, nil
+	}
+
+	for _, plat := range platforms {
+		if _, err := f.ResolveWith(cachingRslv, plat.TemplateArgs()); err != nil {
+			return nil, errors.Annotate(err).
+				Reason("resolving for platform %(platform)q").
+				D("platform", plat.String()).
+				Err()
+		}
+	}
+	return ret, nil
+}
+
 // Serialize writes the File to an io.Writer in canonical order.
 func (f *File) Serialize(w io.Writer) (int, error) {
 	return iotools.WriteTracker(w, func(w io.Writer) error {
@@ -202,6 +245,23 @@ func (f *File) Serialize(w io.Writer) (int, error) {
 		if f.ServiceURL != "" {
 			maybeAddNL()
 			fmt.Fprintf(w, "$ServiceURL %s", f.ServiceURL)
+			needsNLs = 1
+		}
+		if f.ResolvedVersions != "" {
+			maybeAddNL()
+			fmt.Fprintf(w, "$ResolvedVersions %s", f.ResolvedVersions)
+			needsNLs = 1
+		}
+		if len(f.VerifyPlatforms) > 0 {
+			maybeAddNL()
+			plats := make([]string, len(f.VerifyPlatforms))
+			for i, plat := range f.VerifyPlatforms {
+				plats[i] = plat.String()
+			}
+			fmt.Fprintf(w, "$VerifiedPlatform %s", strings.Join(plats, " "))
+			needsNLs = 1
+		}
+		if needsNLs > 0 {
 			needsNLs = 2
 		}
 
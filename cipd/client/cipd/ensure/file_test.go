@@ -24,15 +24,31 @@ var fileSerializationTests = []struct {
 
 	{
 		"ServiceURL",
-		&File{"https://something.example.com", nil},
+		&File{"https://something.example.com", "", nil, nil},
 		f(
 			"$ServiceURL https://something.example.com",
 		),
 	},
 
+	{
+		"ResolvedVersions",
+		&File{"", "path/to/versions.txt", nil, nil},
+		f(
+			"$ResolvedVersions path/to/versions.txt",
+		),
+	},
+
+	{
+		"VerifyPlatforms",
+		&File{"", "", []Platform{{"windows", "386"}}, nil},
+		f(
+			"$VerifiedPlatform windows-386",
+		),
+	},
+
 	{
 		"simple packages",
-		&File{"", map[string]PackageSlice{
+		&File{"", "", nil, map[string]PackageSlice{
 			"": {
 				PackageDef{"some/thing", "version", 0},
 				PackageDef{"some/other_thing", "latest", 0},
@@ -46,7 +62,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"full file",
-		&File{"https://some.example.com", map[string]PackageSlice{
+		&File{"https://some.example.com", "versions.txt", []Platform{{"linux", "amd64"}, {"mac", "amd64"}}, map[string]PackageSlice{
 			"": {
 				PackageDef{"some/thing", "version", 0},
 				PackageDef{"some/other_thing", "latest", 0},
@@ -57,6 +73,8 @@ var fileSerializationTests = []struct {
 		}},
 		f(
 			"$ServiceURL https://some.example.com",
+			"$ResolvedVersions versions.txt",
+			"$VerifiedPlatform linux-amd64 mac-amd64",
 			"",
 			"some/other_thing@latest",
 			"some/thing@version",
@@ -7,6 +7,7 @@ package ensure
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 )
@@ -40,10 +41,40 @@ func serviceURLParser(_ *itemParserState, f *File, val string) error {
 	return nil
 }
 
+func resolvedVersionsParser(_ *itemParserState, f *File, val string) error {
+	if f.ResolvedVersions != "" {
+		return fmt.Errorf("$ResolvedVersions may only be set once per file")
+	}
+	if val == "" {
+		return fmt.Errorf("expecting '$ResolvedVersions <path>'")
+	}
+	f.ResolvedVersions = val
+	return nil
+}
+
+func verifiedPlatformParser(_ *itemParserState, f *File, val string) error {
+	tokens := strings.Fields(val)
+	if len(tokens) == 0 {
+		return fmt.Errorf("expecting '$VerifiedPlatform <os>-<arch> [<os>-<arch> ...]'")
+	}
+	for _, tok := range tokens {
+		plat, err := ParsePlatform(tok)
+		if err != nil {
+			return err
+		}
+		if !f.verifiesPlatform(plat) {
+			f.VerifyPlatforms = append(f.VerifyPlatforms, plat)
+		}
+	}
+	return nil
+}
+
 // itemParsers is the main way that the ensure file format is extended. If you
 // need to add a new setting or directive, please add an appropriate function
 // above and then add it to this map.
 var itemParsers = map[string]itemParser{
-	"@subdir":     subdirParser,
-	"$serviceurl": serviceURLParser,
+	"@subdir":           subdirParser,
+	"$serviceurl":       serviceURLParser,
+	"$resolvedversions": resolvedVersionsParser,
+	"$verifiedplatform": verifiedPlatformParser,
 }
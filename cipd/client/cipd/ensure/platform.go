@@ -0,0 +1,60 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"strings"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/common/errors"
+)
+
+// Platform is an ${os} and ${arch} combination that package templates can be
+// expanded for.
+type Platform struct {
+	OS   string
+	Arch string
+}
+
+// HostPlatform returns the Platform of the running host.
+func HostPlatform() Platform {
+	args := common.TemplateArgs()
+	return Platform{args["os"], args["arch"]}
+}
+
+// ParsePlatform parses a Platform from its "<os>-<arch>" form, e.g.
+// "linux-amd64".
+func ParsePlatform(v string) (Platform, error) {
+	parts := strings.SplitN(v, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return Platform{}, errors.Reason("bad platform %(platform)q (expecting <os>-<arch>)").
+			D("platform", v).Err()
+	}
+	return Platform{parts[0], parts[1]}, nil
+}
+
+func (p Platform) String() string {
+	return p.OS + "-" + p.Arch
+}
+
+// TemplateArgs returns the template expansion arguments for this Platform. It
+// is the equivalent of common.TemplateArgs() on a host of this Platform.
+func (p Platform) TemplateArgs() map[string]string {
+	return map[string]string{
+		"os":       p.OS,
+		"arch":     p.Arch,
+		"platform": p.String(),
+	}
+}
+
+// verifiesPlatform returns true if plat is one of the File's VerifyPlatforms.
+func (f *File) verifiesPlatform(plat Platform) bool {
+	for _, p := range f.VerifyPlatforms {
+		if p == plat {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPlatform(t *testing.T) {
+	t.Parallel()
+
+	Convey("ParsePlatform", t, func() {
+		plat, err := ParsePlatform("linux-armv6l")
+		So(err, ShouldBeNil)
+		So(plat, ShouldResemble, Platform{"linux", "armv6l"})
+		So(plat.String(), ShouldEqual, "linux-armv6l")
+		So(plat.TemplateArgs(), ShouldResemble, map[string]string{
+			"os":       "linux",
+			"arch":     "armv6l",
+			"platform": "linux-armv6l",
+		})
+
+		_, err = ParsePlatform("linux")
+		So(err, ShouldErrLike, "bad platform")
+	})
+
+	Convey("$VerifiedPlatform", t, func() {
+		file, err := ParseFile(strings.NewReader(f(
+			"$VerifiedPlatform mac-amd64 linux-amd64",
+			"$VerifiedPlatform windows-386 mac-amd64",
+			"",
+			"some/tool/${platform} latest",
+		)))
+		So(err, ShouldBeNil)
+		So(file.VerifyPlatforms, ShouldResemble, []Platform{
+			{"mac", "amd64"},
+			{"linux", "amd64"},
+			{"windows", "386"},
+		})
+	})
+}
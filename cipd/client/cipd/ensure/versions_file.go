@@ -0,0 +1,154 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/iotools"
+)
+
+// versionsFileHeader is written at the top of each serialized VersionsFile.
+const versionsFileHeader = `# This file is generated by 'cipd ensure-file-resolve'.
+# Do not modify it manually; regenerate it after changing the ensure file.
+`
+
+// unresolvedVersion is a package name and a version of it, as they appear in
+// an ensure file after template expansion.
+type unresolvedVersion struct {
+	pkg     string
+	version string
+}
+
+// VersionsFile is an in-process representation of the 'resolved versions file'
+// format. It is a lock file that pins the package versions of an ensure file
+// to instance IDs, so that each 'ensure' installs the same instances.
+//
+// An ensure file names its resolved versions file with the $ResolvedVersions
+// setting, and 'cipd ensure-file-resolve' writes it. The file holds a block for
+// each package version, separated by blank lines:
+//
+//   # A comment.
+//
+//   path/to/package/linux-amd64
+//     latest
+//     deadbeefdeadbeefdeadbeefdeadbeefdeadbeef
+//
+// The first line is the package name, the second is the version, and the
+// third is the instance ID that the version resolved to.
+type VersionsFile map[unresolvedVersion]string
+
+// AddVersion pins a version of a package to an instance ID.
+func (v VersionsFile) AddVersion(pkg, version, instanceID string) error {
+	if err := common.ValidatePackageName(pkg); err != nil {
+		return err
+	}
+	if err := common.ValidateInstanceVersion(version); err != nil {
+		return err
+	}
+	if err := common.ValidateInstanceID(instanceID); err != nil {
+		return err
+	}
+	v[unresolvedVersion{pkg, version}] = instanceID
+	return nil
+}
+
+// ResolveVersion returns the Pin for a version of a package.
+//
+// It returns an error if the version isn't pinned. It can be used as a
+// VersionResolver.
+func (v VersionsFile) ResolveVersion(pkg, version string) (common.Pin, error) {
+	iid, ok := v[unresolvedVersion{pkg, version}]
+	if !ok {
+		return common.Pin{}, errors.Reason("%(pkg)s@%(version)s is not in the resolved versions file").
+			D("pkg", pkg).D("version", version).Err()
+	}
+	return common.Pin{PackageName: pkg, InstanceID: iid}, nil
+}
+
+// ParseVersionsFile parses a resolved versions file from the given reader.
+func ParseVersionsFile(r io.Reader) (VersionsFile, error) {
+	ret := VersionsFile{}
+
+	lineNo := 0
+	var block []string
+	addBlock := func() error {
+		defer func() { block = block[:0] }()
+		switch len(block) {
+		case 0:
+			return nil
+		case 3:
+			if err := ret.AddVersion(block[0], block[1], block[2]); err != nil {
+				return fmt.Errorf("failed to parse versions file (line %d): %s", lineNo, err)
+			}
+			return nil
+		default:
+			return fmt.Errorf("failed to parse versions file (line %d): expecting 3 lines per block, got %d",
+				lineNo, len(block))
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case len(line) == 0:
+			if err := addBlock(); err != nil {
+				return nil, err
+			}
+		case line[0] == '#':
+			// Skip comments.
+		default:
+			block = append(block, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := addBlock(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Serialize writes the VersionsFile to an io.Writer in canonical order.
+func (v VersionsFile) Serialize(w io.Writer) (int, error) {
+	keys := make([]unresolvedVersion, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Sort(unresolvedVersionSlice(keys))
+
+	return iotools.WriteTracker(w, func(w io.Writer) error {
+		if _, err := io.WriteString(w, versionsFileHeader); err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if _, err := fmt.Fprintf(w, "\n%s\n\t%s\n\t%s\n", k.pkg, k.version, v[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type unresolvedVersionSlice []unresolvedVersion
+
+func (s unresolvedVersionSlice) Len() int      { return len(s) }
+func (s unresolvedVersionSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s unresolvedVersionSlice) Less(i, j int) bool {
+	if s[i].pkg != s[j].pkg {
+		return s[i].pkg < s[j].pkg
+	}
+	return s[i].version < s[j].version
+}
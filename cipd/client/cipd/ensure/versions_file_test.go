@@ -0,0 +1,128 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVersionsFile(t *testing.T) {
+	t.Parallel()
+
+	iid := func(vers string) string {
+		h := sha1.Sum([]byte(vers))
+		return hex.EncodeToString(h[:])
+	}
+
+	Convey("VersionsFile", t, func() {
+		Convey("round-trips through Serialize and ParseVersionsFile", func() {
+			vf := VersionsFile{}
+			So(vf.AddVersion("pkg/b", "latest", iid("b")), ShouldBeNil)
+			So(vf.AddVersion("pkg/a", "version:1.2 (rc)", iid("a1")), ShouldBeNil)
+			So(vf.AddVersion("pkg/a", "latest", iid("a2")), ShouldBeNil)
+
+			buf := &bytes.Buffer{}
+			_, err := vf.Serialize(buf)
+			So(err, ShouldBeNil)
+			So(buf.String(), ShouldEqual, versionsFileHeader+f(
+				"",
+				"pkg/a",
+				"\tlatest",
+				"\t"+iid("a2"),
+				"",
+				"pkg/a",
+				"\tversion:1.2 (rc)",
+				"\t"+iid("a1"),
+				"",
+				"pkg/b",
+				"\tlatest",
+				"\t"+iid("b"),
+				"",
+			))
+
+			parsed, err := ParseVersionsFile(buf)
+			So(err, ShouldBeNil)
+			So(parsed, ShouldResemble, vf)
+
+			pin, err := parsed.ResolveVersion("pkg/a", "version:1.2 (rc)")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, common.Pin{PackageName: "pkg/a", InstanceID: iid("a1")})
+
+			_, err = parsed.ResolveVersion("pkg/a", "canary")
+			So(err, ShouldErrLike, "pkg/a@canary is not in the resolved versions file")
+		})
+
+		Convey("rejects bad entries", func() {
+			So(VersionsFile{}.AddVersion("pkg/a", "latest", "not an id"), ShouldErrLike, "not a valid package instance ID")
+
+			_, err := ParseVersionsFile(strings.NewReader(f(
+				"pkg/a",
+				"  latest",
+				"",
+			)))
+			So(err, ShouldErrLike, "expecting 3 lines per block, got 2")
+
+			_, err = ParseVersionsFile(strings.NewReader(f(
+				"pkg/a",
+				"  latest",
+				"  not an id",
+			)))
+			So(err, ShouldErrLike, "failed to parse versions file (line 3)")
+		})
+	})
+
+	Convey("File.ResolveVersions", t, func() {
+		file, err := ParseFile(strings.NewReader(f(
+			"$ResolvedVersions versions.txt",
+			"",
+			"some/tool/${platform} latest",
+			"",
+			"@Subdir win",
+			"some/win_only/${os=windows} latest",
+		)))
+		So(err, ShouldBeNil)
+		So(file.ResolvedVersions, ShouldEqual, "versions.txt")
+
+		calls := 0
+		rslv := func(pkg, vers string) (common.Pin, error) {
+			calls++
+			return common.Pin{PackageName: pkg, InstanceID: iid(pkg + "@" + vers)}, nil
+		}
+
+		vf, err := file.ResolveVersions(rslv, []Platform{
+			{"linux", "amd64"},
+			{"windows", "386"},
+			{"linux", "amd64"},
+		})
+		So(err, ShouldBeNil)
+		So(calls, ShouldEqual, 3)
+		So(vf, ShouldResemble, VersionsFile{
+			{"some/tool/linux-amd64", "latest"}: iid("some/tool/linux-amd64@latest"),
+			{"some/tool/windows-386", "latest"}: iid("some/tool/windows-386@latest"),
+			{"some/win_only/windows", "latest"}: iid("some/win_only/windows@latest"),
+		})
+
+		Convey("which can be used to resolve the File", func() {
+			rf, err := file.ResolveWith(vf.ResolveVersion, Platform{"windows", "386"}.TemplateArgs())
+			So(err, ShouldBeNil)
+			So(rf.PackagesBySubdir["win"], ShouldResemble, common.PinSlice{
+				{PackageName: "some/win_only/windows", InstanceID: iid("some/win_only/windows@latest")},
+			})
+
+			_, err = file.ResolveWith(vf.ResolveVersion, Platform{"mac", "amd64"}.TemplateArgs())
+			So(err, ShouldErrLike, "some/tool/mac-amd64@latest is not in the resolved versions file")
+		})
+	})
+
+}
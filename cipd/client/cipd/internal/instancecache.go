@@ -11,9 +11,11 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
+	"github.com/danjacques/gofslock/fslock"
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/common/clock"
@@ -44,15 +46,36 @@ const (
 
 	// instanceCacheStateFilename is a name of the file with InstanceCache proto.
 	instanceCacheStateFilename = "state.db"
+
+	// instanceCacheLockFilename is a name of the lock file that guards the state
+	// file against concurrent modifications by other processes.
+	instanceCacheLockFilename = "state.db.lock"
+
+	// instanceCacheLockDelay is how long to wait before retrying to grab the
+	// lock file held by another process.
+	instanceCacheLockDelay = 10 * time.Millisecond
+
+	// instanceCacheExtractedDir is a name of the directory with extracted copies
+	// of cached instances (see GetExtracted).
+	instanceCacheExtractedDir = "extracted"
 )
 
 // InstanceCache is a file-system-based, thread-safe, LRU cache of instances.
 //
+// The cache directory can be shared by multiple processes (e.g. by cipd clients
+// deploying packages into different site roots): modifications of the state
+// file are serialized with a lock file, and instance files are replaced
+// atomically.
+//
 // Does not validate instance hashes; it is caller's responsibility.
 type InstanceCache struct {
 	fs        local.FileSystem
 	stateLock sync.Mutex // synchronizes access to the state file.
 
+	// maxBytes is the total size of instances to keep in the cache. Zero means
+	// there's no size limit.
+	maxBytes int64
+
 	// Defaults to instanceCacheMaxSize, mocked in tests.
 	maxSize int
 	// Defaults to instanceCacheMaxAge, mocked in tests.
@@ -61,12 +84,14 @@ type InstanceCache struct {
 
 // NewInstanceCache initializes InstanceCache.
 //
-// fs will be the root of the cache.
-func NewInstanceCache(fs local.FileSystem) *InstanceCache {
+// fs will be the root of the cache. If maxBytes is positive, least recently
+// used instances are purged when the total size of the cache exceeds it.
+func NewInstanceCache(fs local.FileSystem, maxBytes int64) *InstanceCache {
 	return &InstanceCache{
-		fs:      fs,
-		maxSize: instanceCacheMaxSize,
-		maxAge:  instanceCacheMaxAge,
+		fs:       fs,
+		maxBytes: maxBytes,
+		maxSize:  instanceCacheMaxSize,
+		maxAge:   instanceCacheMaxAge,
 	}
 }
 
@@ -111,14 +136,90 @@ func (c *InstanceCache) Put(ctx context.Context, pin common.Pin, now time.Time,
 	if err := c.fs.EnsureFile(ctx, path, write); err != nil {
 		return err
 	}
+	size := fileSize(path)
 
 	c.withState(ctx, now, func(s *messages.InstanceCache) {
-		touch(s, pin.InstanceID, now)
+		touch(s, pin.InstanceID, now).Size = size
 		c.gc(ctx, s, now)
 	})
 	return nil
 }
 
+// GetExtracted returns a path to a directory with extracted files of a cached
+// instance.
+//
+// If the instance hasn't been extracted yet, calls extract to do it. extract
+// must create the directory atomically (e.g. by using local.ExtractInstance
+// with a file system destination) and return the total size of the extracted
+// files. The extracted copy is purged from the cache together with the
+// instance.
+//
+// Files in this directory are meant to be hardlinked into site roots. They are
+// read-only and must never be modified.
+func (c *InstanceCache) GetExtracted(ctx context.Context, pin common.Pin, now time.Time, extract func(dir string) (int64, error)) (string, error) {
+	if err := common.ValidatePin(pin); err != nil {
+		return "", err
+	}
+	dir, err := c.fs.RootRelToAbs(filepath.Join(instanceCacheExtractedDir, pin.InstanceID))
+	if err != nil {
+		return "", fmt.Errorf("invalid instance ID %q", pin.InstanceID)
+	}
+
+	extracted := int64(-1)
+	switch _, err := os.Stat(dir); {
+	case err == nil:
+		// Already extracted, probably by another process.
+	case os.IsNotExist(err):
+		if extracted, err = extract(dir); err != nil {
+			return "", err
+		}
+	default:
+		return "", err
+	}
+
+	c.withState(ctx, now, func(s *messages.InstanceCache) {
+		e := touch(s, pin.InstanceID, now)
+		if extracted >= 0 {
+			path, err := c.fs.RootRelToAbs(pin.InstanceID)
+			if err != nil {
+				panic("impossible")
+			}
+			e.Size = fileSize(path) + extracted
+			c.gc(ctx, s, now)
+		}
+	})
+	return dir, nil
+}
+
+// DeleteExtracted removes the extracted copy of a cached instance, if any.
+//
+// It is used when the extracted files are found to be modified (e.g. through
+// a hardlink in a site root), so that they are not hardlinked anywhere else.
+// The cached instance itself is kept, and is extracted again when needed.
+func (c *InstanceCache) DeleteExtracted(ctx context.Context, pin common.Pin, now time.Time) error {
+	if err := common.ValidatePin(pin); err != nil {
+		return err
+	}
+	dir, err := c.fs.RootRelToAbs(filepath.Join(instanceCacheExtractedDir, pin.InstanceID))
+	if err != nil {
+		return fmt.Errorf("invalid instance ID %q", pin.InstanceID)
+	}
+
+	c.withState(ctx, now, func(s *messages.InstanceCache) {
+		if err = c.fs.EnsureDirectoryGone(ctx, dir); err != nil {
+			return
+		}
+		if e, ok := s.Entries[pin.InstanceID]; ok {
+			path, err := c.fs.RootRelToAbs(pin.InstanceID)
+			if err != nil {
+				panic("impossible")
+			}
+			e.Size = fileSize(path)
+		}
+	})
+	return err
+}
+
 // GC opportunistically purges entries that haven't been touched for too long.
 func (c *InstanceCache) GC(ctx context.Context, now time.Time) {
 	c.withState(ctx, now, func(s *messages.InstanceCache) {
@@ -149,10 +250,13 @@ func (h *garbageHeap) Pop() interface{} {
 
 // gc cleans up the old instances.
 //
-// There are two cleanup polices acting at the same time:
+// There are three cleanup polices acting at the same time:
 //   1. Instances that haven't been touched for too long are removed.
 //   2. If the number of instances in the state is greater than maximum, oldest
 //      instances are removed.
+//   3. If the total size of instances is greater than maximum, oldest instances
+//      are removed. The most recently touched instance is always kept, even if
+//      it alone is bigger than the maximum.
 func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, now time.Time) {
 	// Kick out entries older than some threshold first.
 	garbage := stringset.New(0)
@@ -186,12 +290,39 @@ func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, n
 		}
 	}
 
+	// If the cache is still too big, kick out oldest entries until it fits.
+	if c.maxBytes > 0 {
+		total := int64(0)
+		garbageHeap := make(garbageHeap, 0, len(state.Entries)-garbage.Len())
+		for instanceID, e := range state.Entries {
+			if !garbage.Has(instanceID) {
+				total += e.Size
+				garbageHeap = append(garbageHeap, &garbageCandidate{
+					instanceID:     instanceID,
+					lastAccessTime: google.TimeFromProto(e.LastAccess),
+				})
+			}
+		}
+		if total > c.maxBytes {
+			logging.Infof(ctx, "cipd: instance cache is too big (%d > %d bytes)", total, c.maxBytes)
+			heap.Init(&garbageHeap)
+			for total > c.maxBytes && garbageHeap.Len() > 1 {
+				item := heap.Pop(&garbageHeap).(*garbageCandidate)
+				garbage.Add(item.instanceID)
+				total -= state.Entries[item.instanceID].Size
+				logging.Infof(ctx, "cipd: purging cached instance %s (age %s)", item.instanceID, now.Sub(item.lastAccessTime))
+			}
+		}
+	}
+
 	garbage.Iter(func(instanceID string) bool {
 		path, err := c.fs.RootRelToAbs(instanceID)
 		if err != nil {
 			panic("impossible")
 		}
-		// EnsureFileGone logs errors already.
+		// EnsureDirectoryGone and EnsureFileGone log errors already. Hardlinks to
+		// the extracted files in site roots are not affected.
+		c.fs.EnsureDirectoryGone(ctx, filepath.Join(c.fs.Root(), instanceCacheExtractedDir, instanceID))
 		if c.fs.EnsureFileGone(ctx, path) == nil {
 			delete(state.Entries, instanceID)
 		}
@@ -244,8 +375,10 @@ func (c *InstanceCache) readState(ctx context.Context, state *messages.InstanceC
 // syncState synchronizes the list of instances in the state file with instance
 // files.
 //
-// Preserves lastAccess of existing instances. Newly discovered files are
-// considered last accessed now.
+// Preserves lastAccess and size of existing instances. Newly discovered files
+// are considered last accessed now, and their extracted copies (if any) are not
+// accounted for in their size. Extracted copies of missing instances are
+// removed.
 func (c *InstanceCache) syncState(ctx context.Context, state *messages.InstanceCache, now time.Time) error {
 	root, err := os.Open(c.fs.Root())
 	switch {
@@ -274,6 +407,7 @@ func (c *InstanceCache) syncState(ctx context.Context, state *messages.InstanceC
 				}
 				state.Entries[id] = &messages.InstanceCache_Entry{
 					LastAccess: google.NewTimestamp(now),
+					Size:       fileSize(filepath.Join(c.fs.Root(), id)),
 				}
 			}
 		}
@@ -283,6 +417,15 @@ func (c *InstanceCache) syncState(ctx context.Context, state *messages.InstanceC
 				delete(state.Entries, id)
 			}
 		}
+
+		extractedDir := filepath.Join(c.fs.Root(), instanceCacheExtractedDir)
+		if extractedIDs, err := readDirNames(extractedDir); err == nil {
+			for _, id := range extractedIDs {
+				if common.ValidateInstanceID(id) == nil && !existingIDs.Has(id) {
+					c.fs.EnsureDirectoryGone(ctx, filepath.Join(extractedDir, id))
+				}
+			}
+		}
 	}
 
 	state.LastSynced = google.NewTimestamp(now)
@@ -323,10 +466,28 @@ func (c *InstanceCache) withState(ctx context.Context, now time.Time, f func(*me
 	c.stateLock.Lock()
 	defer c.stateLock.Unlock()
 
-	c.readState(ctx, state, now)
-	f(state)
-	if err := c.saveState(ctx, state); err != nil {
-		logging.Warningf(ctx, "cipd: could not save instance cache - %s", err)
+	// Other processes may be using the same cache directory.
+	if _, err := c.fs.EnsureDirectory(ctx, c.fs.Root()); err != nil {
+		logging.Warningf(ctx, "cipd: could not create instance cache directory - %s", err)
+		return
+	}
+	lockPath, err := c.fs.RootRelToAbs(instanceCacheLockFilename)
+	if err != nil {
+		panic("impossible")
+	}
+	blocker := func() error {
+		return clock.Sleep(ctx, instanceCacheLockDelay).Err
+	}
+	err = fslock.WithBlocking(lockPath, blocker, func() error {
+		c.readState(ctx, state, now)
+		f(state)
+		if err := c.saveState(ctx, state); err != nil {
+			logging.Warningf(ctx, "cipd: could not save instance cache - %s", err)
+		}
+		return nil
+	})
+	if err != nil {
+		logging.Warningf(ctx, "cipd: could not lock instance cache - %s", err)
 	}
 }
 
@@ -342,8 +503,8 @@ func (c *InstanceCache) getAccessTime(ctx context.Context, now time.Time, pin co
 	return
 }
 
-// touch updates/adds last access time for an instance.
-func touch(state *messages.InstanceCache, instanceID string, now time.Time) {
+// touch updates/adds last access time for an instance and returns its entry.
+func touch(state *messages.InstanceCache, instanceID string, now time.Time) *messages.InstanceCache_Entry {
 	entry := state.Entries[instanceID]
 	if entry == nil {
 		entry = &messages.InstanceCache_Entry{}
@@ -353,4 +514,23 @@ func touch(state *messages.InstanceCache, instanceID string, now time.Time) {
 		state.Entries[instanceID] = entry
 	}
 	entry.LastAccess = google.NewTimestamp(now)
+	return entry
+}
+
+// fileSize returns the size of a file, or 0 if it can't be stat'ed.
+func fileSize(path string) int64 {
+	if fi, err := os.Stat(path); err == nil {
+		return fi.Size()
+	}
+	return 0
+}
+
+// readDirNames returns names of all entries of a directory.
+func readDirNames(path string) ([]string, error) {
+	dir, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+	return dir.Readdirnames(0)
 }
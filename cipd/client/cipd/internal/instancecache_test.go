@@ -35,7 +35,7 @@ func TestInstanceCache(t *testing.T) {
 		now := time.Date(2016, 1, 2, 3, 4, 5, 6, time.UTC)
 
 		fs := local.NewFileSystem(tempDir, "")
-		cache := NewInstanceCache(fs)
+		cache := NewInstanceCache(fs, 0)
 		cache.maxSize = testInstanceCacheMaxSize
 
 		put := func(cache *InstanceCache, pin common.Pin, data string) {
@@ -57,7 +57,7 @@ func TestInstanceCache(t *testing.T) {
 		}
 
 		Convey("Works", func() {
-			cache2 := NewInstanceCache(fs)
+			cache2 := NewInstanceCache(fs, 0)
 			cache2.maxSize = testInstanceCacheMaxSize
 
 			pin := common.Pin{"pkg", "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}
@@ -95,7 +95,7 @@ func TestInstanceCache(t *testing.T) {
 
 			files, err := tempDirFile.Readdirnames(0)
 			So(err, ShouldBeNil)
-			So(files, ShouldHaveLength, testInstanceCacheMaxSize+1) // 1 for state.db
+			So(files, ShouldHaveLength, testInstanceCacheMaxSize+2) // 2 for state.db and its lock
 
 			// Try to get.
 			for i := 0; i < testInstanceCacheMaxSize*2; i++ {
@@ -134,6 +134,91 @@ func TestInstanceCache(t *testing.T) {
 			So(alive, ShouldResemble, []int{5, 6, 7})
 		})
 
+		Convey("GC respects MaxBytes", func() {
+			cache.maxBytes = 10
+			for i := 0; i < 5; i++ {
+				now = now.Add(time.Second)
+				put(cache, pini(i), "abcd")
+			}
+
+			// 4 bytes per instance => only 2 most recent instances fit.
+			alive := []int{}
+			for i := 0; i < 5; i++ {
+				r, _ := cache.Get(ctx, pini(i), now)
+				if r != nil {
+					r.Close()
+					alive = append(alive, i)
+				}
+			}
+			So(alive, ShouldResemble, []int{3, 4})
+
+			// The most recent instance is kept even if it alone is too big.
+			now = now.Add(time.Second)
+			put(cache, pini(5), "abcdefghijklmnop")
+			testHas(cache, pini(5), "abcdefghijklmnop")
+			_, ok := cache.getAccessTime(ctx, now, pini(4))
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("GetExtracted", func() {
+			pin := pini(0)
+			put(cache, pin, "blah")
+
+			calls := 0
+			extract := func(dir string) (int64, error) {
+				calls++
+				So(os.MkdirAll(dir, 0777), ShouldBeNil)
+				So(ioutil.WriteFile(filepath.Join(dir, "file"), []byte("extracted"), 0666), ShouldBeNil)
+				return 9, nil
+			}
+
+			dir, err := cache.GetExtracted(ctx, pin, now, extract)
+			So(err, ShouldBeNil)
+			So(dir, ShouldEqual, filepath.Join(tempDir, instanceCacheExtractedDir, pin.InstanceID))
+			buf, err := ioutil.ReadFile(filepath.Join(dir, "file"))
+			So(err, ShouldBeNil)
+			So(string(buf), ShouldEqual, "extracted")
+
+			// Extracted only once.
+			dir2, err := cache.GetExtracted(ctx, pin, now, extract)
+			So(err, ShouldBeNil)
+			So(dir2, ShouldEqual, dir)
+			So(calls, ShouldEqual, 1)
+
+			Convey("Extracted files are accounted for in the size", func() {
+				cache.maxBytes = 15
+				now = now.Add(time.Second)
+				put(cache, pini(1), "blah")
+
+				// 4+9 bytes for pin and 4 bytes for pini(1) don't fit.
+				_, ok := cache.getAccessTime(ctx, now, pin)
+				So(ok, ShouldBeFalse)
+				_, err := os.Stat(dir)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Extracted copies of missing instances are removed on sync", func() {
+				So(os.Remove(filepath.Join(tempDir, pin.InstanceID)), ShouldBeNil)
+				So(os.Remove(filepath.Join(tempDir, instanceCacheStateFilename)), ShouldBeNil)
+				cache.GC(ctx, now)
+
+				_, err := os.Stat(dir)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("DeleteExtracted removes only the extracted copy", func() {
+				So(cache.DeleteExtracted(ctx, pin, now), ShouldBeNil)
+				_, err := os.Stat(dir)
+				So(os.IsNotExist(err), ShouldBeTrue)
+				testHas(cache, pin, "blah")
+
+				// Extracted again when needed.
+				_, err = cache.GetExtracted(ctx, pin, now, extract)
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 2)
+			})
+		})
+
 		Convey("Sync", func() {
 			stateDbPath := filepath.Join(tempDir, instanceCacheStateFilename)
 			const count = 10
@@ -215,6 +215,9 @@ type InstanceCache_Entry struct {
 	// LastAccess is last time this instance was retrieved from or put to the
 	// cache.
 	LastAccess *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=last_access,json=lastAccess" json:"last_access,omitempty"`
+	// Size is the number of bytes the instance takes in the cache, including
+	// its extracted copy (if any).
+	Size int64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
 }
 
 func (m *InstanceCache_Entry) Reset()                    { *m = InstanceCache_Entry{} }
@@ -229,6 +232,13 @@ func (m *InstanceCache_Entry) GetLastAccess() *google_protobuf.Timestamp {
 	return nil
 }
 
+func (m *InstanceCache_Entry) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlobWithSHA1)(nil), "messages.BlobWithSHA1")
 	proto.RegisterType((*TagCache)(nil), "messages.TagCache")
@@ -243,33 +253,34 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0x26, 0xe9, 0xd6, 0x6d, 0xde, 0x54, 0x90, 0x39, 0x85, 0xa8, 0x6c, 0x29, 0x1e, 0x7a, 0x31,
-	0x61, 0xbb, 0x20, 0xa2, 0xa0, 0xac, 0x5f, 0xd8, 0x8b, 0x87, 0xec, 0x82, 0x78, 0x2a, 0x93, 0xe9,
-	0xdb, 0x64, 0xd8, 0xc9, 0xa4, 0x64, 0xa6, 0x0b, 0x3d, 0xfb, 0x0b, 0xfc, 0x21, 0xfe, 0x47, 0x99,
-	0x99, 0x4c, 0x5d, 0x83, 0x2c, 0x7b, 0x09, 0xcf, 0x3c, 0x79, 0xde, 0xcf, 0xe7, 0x85, 0x55, 0xc5,
-	0x75, 0xbd, 0x2f, 0x33, 0xd6, 0x36, 0xb9, 0xd8, 0x33, 0x6e, 0x3f, 0x2f, 0xab, 0x36, 0x67, 0x7c,
-	0xb7, 0xc9, 0x99, 0xe0, 0x28, 0xb5, 0xc3, 0x5c, 0x6a, 0xec, 0x24, 0x15, 0x79, 0x83, 0x4a, 0xd1,
-	0x0a, 0xd5, 0x11, 0x64, 0xbb, 0xae, 0xd5, 0x2d, 0x99, 0xf8, 0x77, 0x7a, 0x56, 0xb5, 0x6d, 0x25,
-	0x30, 0xb7, 0x7c, 0xb9, 0xdf, 0xe6, 0x9a, 0x37, 0xa8, 0x34, 0x6d, 0x76, 0x4e, 0x3a, 0x7f, 0x05,
-	0xd3, 0x0f, 0xa2, 0x2d, 0xbf, 0x73, 0x5d, 0x5f, 0x7d, 0xbd, 0x3c, 0x27, 0x04, 0x4e, 0x4a, 0xd1,
-	0x96, 0x49, 0x30, 0x0b, 0x16, 0xd3, 0xc2, 0x62, 0xc3, 0xa9, 0x9a, 0x9e, 0x27, 0xa1, 0xe3, 0x0c,
-	0x9e, 0xff, 0x1c, 0xc1, 0xe4, 0x9a, 0x56, 0x1f, 0x29, 0xab, 0x91, 0x2c, 0xe1, 0x14, 0xa5, 0xee,
-	0x38, 0xaa, 0x24, 0x98, 0x8d, 0x16, 0xf1, 0x32, 0xc9, 0x8e, 0x1d, 0x79, 0x51, 0xf6, 0x59, 0xea,
-	0xee, 0x50, 0x78, 0x21, 0x79, 0x0f, 0xd3, 0x2d, 0x17, 0xb8, 0xf6, 0x81, 0xa1, 0x0d, 0x7c, 0xf6,
-	0x9f, 0xc0, 0x2f, 0x5c, 0xa0, 0x0b, 0x8e, 0xb7, 0x3d, 0xe4, 0xa8, 0x52, 0x09, 0x63, 0xcb, 0x92,
-	0x04, 0x4e, 0x15, 0x76, 0xb7, 0x9c, 0x61, 0x72, 0x32, 0x0b, 0x16, 0x51, 0xe1, 0x9f, 0xe6, 0xcf,
-	0x8e, 0xb2, 0x1b, 0x5a, 0xa1, 0x9d, 0x27, 0x2a, 0xfc, 0x93, 0x3c, 0x81, 0x91, 0xa6, 0x95, 0x9d,
-	0x28, 0x2a, 0x0c, 0x24, 0x67, 0x10, 0x73, 0xa9, 0x34, 0x95, 0x0c, 0xd7, 0x7c, 0x93, 0x8c, 0xec,
-	0x1f, 0xf0, 0xd4, 0x6a, 0x93, 0xfe, 0x0a, 0x20, 0x3a, 0xb6, 0x72, 0xb7, 0xe8, 0xf8, 0xa1, 0x45,
-	0x07, 0x25, 0xc2, 0x61, 0x09, 0xf2, 0x14, 0x22, 0xbb, 0x13, 0x49, 0x1b, 0xec, 0x3b, 0x98, 0x18,
-	0xe2, 0x1b, 0x6d, 0xd0, 0xb8, 0x50, 0x53, 0x55, 0xf7, 0x33, 0x5a, 0x3c, 0xff, 0x1d, 0xc2, 0xe3,
-	0x55, 0x1f, 0xef, 0xac, 0x78, 0x37, 0xb4, 0xe2, 0xc5, 0xdf, 0x8d, 0xfe, 0xa3, 0xcc, 0xfa, 0x3d,
-	0x0e, 0x6c, 0x79, 0x0b, 0xb1, 0xa0, 0x4a, 0xaf, 0xd5, 0x41, 0x32, 0x74, 0x3d, 0xc6, 0xcb, 0x34,
-	0x73, 0x67, 0x94, 0xf9, 0x33, 0xca, 0xae, 0xfd, 0x19, 0x15, 0x60, 0xe4, 0x57, 0x56, 0x9d, 0x7e,
-	0xf2, 0x96, 0xf8, 0x2c, 0x94, 0x31, 0x54, 0xea, 0xa1, 0x59, 0x2e, 0xad, 0x3a, 0xfd, 0x01, 0xd3,
-	0xbb, 0xbd, 0x19, 0xaf, 0x6e, 0xf0, 0xd0, 0x2f, 0xd3, 0x40, 0x72, 0x01, 0xe3, 0x5b, 0x2a, 0xf6,
-	0xd8, 0x27, 0x7e, 0x7e, 0xdf, 0x88, 0x87, 0xc2, 0x69, 0xdf, 0x84, 0xaf, 0x83, 0xf2, 0x91, 0x2d,
-	0x7d, 0xf1, 0x27, 0x00, 0x00, 0xff, 0xff, 0x88, 0x1b, 0x5b, 0x96, 0x6c, 0x03, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x52, 0x51, 0x8b, 0xd3, 0x40,
+	0x10, 0x26, 0xc9, 0xd5, 0x6b, 0x26, 0x15, 0x64, 0x9f, 0x42, 0x54, 0xae, 0x14, 0x1f, 0xfa, 0x62,
+	0xc2, 0xf5, 0x40, 0x44, 0x41, 0x39, 0x45, 0xb1, 0x2f, 0x3e, 0xe4, 0x0e, 0xd4, 0xa7, 0xb2, 0xd9,
+	0x4e, 0x93, 0xe5, 0x36, 0x9b, 0x92, 0xdd, 0x1e, 0xd4, 0x57, 0x7f, 0x81, 0x3f, 0xc9, 0x7f, 0x26,
+	0xbb, 0x9b, 0xad, 0x67, 0x11, 0xb9, 0x97, 0xf0, 0xcd, 0xe4, 0x9b, 0x9d, 0x6f, 0xe6, 0x1b, 0x58,
+	0xd6, 0x5c, 0x37, 0xbb, 0x2a, 0x67, 0x5d, 0x5b, 0x88, 0x1d, 0xe3, 0xf6, 0xf3, 0xbc, 0xee, 0x0a,
+	0xc6, 0xb7, 0xeb, 0x82, 0x09, 0x8e, 0x52, 0x3b, 0xcc, 0xa5, 0xc6, 0x5e, 0x52, 0x51, 0xb4, 0xa8,
+	0x14, 0xad, 0x51, 0x1d, 0x40, 0xbe, 0xed, 0x3b, 0xdd, 0x91, 0xb1, 0x8f, 0xb3, 0xb3, 0xba, 0xeb,
+	0x6a, 0x81, 0x85, 0xcd, 0x57, 0xbb, 0x4d, 0xa1, 0x79, 0x8b, 0x4a, 0xd3, 0x76, 0xeb, 0xa8, 0xb3,
+	0x17, 0x30, 0x79, 0x27, 0xba, 0xea, 0x0b, 0xd7, 0xcd, 0xd5, 0xa7, 0xcb, 0x73, 0x42, 0xe0, 0xa4,
+	0x12, 0x5d, 0x95, 0x06, 0xd3, 0x60, 0x3e, 0x29, 0x2d, 0x36, 0x39, 0xd5, 0xd0, 0xf3, 0x34, 0x74,
+	0x39, 0x83, 0x67, 0x3f, 0x22, 0x18, 0x5f, 0xd3, 0xfa, 0x3d, 0x65, 0x0d, 0x92, 0x05, 0x9c, 0xa2,
+	0xd4, 0x3d, 0x47, 0x95, 0x06, 0xd3, 0x68, 0x9e, 0x2c, 0xd2, 0xfc, 0xa0, 0xc8, 0x93, 0xf2, 0x0f,
+	0x52, 0xf7, 0xfb, 0xd2, 0x13, 0xc9, 0x5b, 0x98, 0x6c, 0xb8, 0xc0, 0x95, 0x2f, 0x0c, 0x6d, 0xe1,
+	0x93, 0x7f, 0x14, 0x7e, 0xe4, 0x02, 0x5d, 0x71, 0xb2, 0x19, 0x20, 0x47, 0x95, 0x49, 0x18, 0xd9,
+	0x2c, 0x49, 0xe1, 0x54, 0x61, 0x7f, 0xcb, 0x19, 0xa6, 0x27, 0xd3, 0x60, 0x1e, 0x97, 0x3e, 0x34,
+	0x7f, 0xb6, 0x94, 0xdd, 0xd0, 0x1a, 0xed, 0x3c, 0x71, 0xe9, 0x43, 0xf2, 0x08, 0x22, 0x4d, 0x6b,
+	0x3b, 0x51, 0x5c, 0x1a, 0x48, 0xce, 0x20, 0xe1, 0x52, 0x69, 0x2a, 0x19, 0xae, 0xf8, 0x3a, 0x8d,
+	0xec, 0x1f, 0xf0, 0xa9, 0xe5, 0x3a, 0xfb, 0x19, 0x40, 0x7c, 0x90, 0x72, 0xb7, 0xe9, 0xe8, 0xbe,
+	0x4d, 0x8f, 0x5a, 0x84, 0xc7, 0x2d, 0xc8, 0x63, 0x88, 0xed, 0x4e, 0x24, 0x6d, 0x71, 0x50, 0x30,
+	0x36, 0x89, 0xcf, 0xb4, 0x45, 0xe3, 0x42, 0x43, 0x55, 0x33, 0xcc, 0x68, 0xf1, 0xec, 0x57, 0x08,
+	0x0f, 0x97, 0x43, 0xbd, 0xb3, 0xe2, 0xcd, 0xb1, 0x15, 0xcf, 0xfe, 0x6c, 0xf4, 0x2f, 0x66, 0x3e,
+	0xec, 0xf1, 0xc8, 0x96, 0xd7, 0x90, 0x08, 0xaa, 0xf4, 0x4a, 0xed, 0x25, 0x43, 0xa7, 0x31, 0x59,
+	0x64, 0xb9, 0x3b, 0xa3, 0xdc, 0x9f, 0x51, 0x7e, 0xed, 0xcf, 0xa8, 0x04, 0x43, 0xbf, 0xb2, 0xec,
+	0xec, 0xab, 0xb7, 0xc4, 0xbf, 0x42, 0x19, 0x43, 0xa5, 0xee, 0xfb, 0xca, 0xa5, 0x65, 0xdb, 0x73,
+	0xe3, 0xdf, 0xdd, 0x02, 0xa2, 0xd2, 0xe2, 0xec, 0x1b, 0x4c, 0xee, 0xea, 0x35, 0xfe, 0xdd, 0xe0,
+	0x7e, 0x58, 0xb0, 0x81, 0xe4, 0x02, 0x46, 0xb7, 0x54, 0xec, 0x70, 0x68, 0xf6, 0xf4, 0x7f, 0x63,
+	0xef, 0x4b, 0xc7, 0x7d, 0x15, 0xbe, 0x0c, 0xaa, 0x07, 0x56, 0xce, 0xc5, 0xef, 0x01, 0x00, 0x55,
+	0x0e, 0x46, 0x30, 0x80, 0x03, 0x00, 0x00,
 }
@@ -5,10 +5,14 @@
 package local
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime"
 	"sort"
@@ -22,6 +26,7 @@ import (
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 	"github.com/luci/luci-go/common/data/sortby"
+	"github.com/luci/luci-go/common/data/stringset"
 	"github.com/luci/luci-go/common/logging"
 )
 
@@ -79,6 +84,22 @@ type Deployer interface {
 	// FindDeployed returns a list of packages deployed to a site root.
 	FindDeployed(ctx context.Context) (out common.PinSliceBySubdir, err error)
 
+	// CheckDeployedFiles verifies files of a package deployed at the given
+	// subdir against the manifest recorded when it was deployed.
+	//
+	// Unlike CheckDeployed, it re-hashes all files of the package, so it is
+	// slow. It returns information about installed version and how its files
+	// differ from the manifest.
+	CheckDeployedFiles(ctx context.Context, subdir, packageName string) (common.Pin, FilesDrift, error)
+
+	// RepairDeployed re-extracts the given files of a deployed package from its
+	// instance, e.g. files reported as modified or missing by
+	// CheckDeployedFiles.
+	//
+	// inst must be the currently deployed instance of the package. Files are
+	// given as slash separated paths relative to the package root.
+	RepairDeployed(ctx context.Context, subdir string, inst PackageInstance, files []string) error
+
 	// RemoveDeployed deletes a package from a subdir given its name.
 	RemoveDeployed(ctx context.Context, subdir, packageName string) error
 
@@ -93,6 +114,25 @@ type Deployer interface {
 	CleanupTrash(ctx context.Context) error
 }
 
+// FilesDrift lists files of a deployed package that differ from its manifest.
+//
+// All paths are slash separated and relative to the subdir the package is
+// deployed to.
+type FilesDrift struct {
+	// Added are files that are not part of any package deployed to the subdir,
+	// found in directories that have files of this package.
+	Added []string `json:"added,omitempty"`
+	// Modified are files with a size or a hash that doesn't match the manifest.
+	Modified []string `json:"modified,omitempty"`
+	// Missing are files from the manifest that are gone.
+	Missing []string `json:"missing,omitempty"`
+}
+
+// Empty is true if the deployed files match the manifest.
+func (d *FilesDrift) Empty() bool {
+	return len(d.Added) == 0 && len(d.Modified) == 0 && len(d.Missing) == 0
+}
+
 // NewDeployer return default Deployer implementation.
 func NewDeployer(root string) Deployer {
 	var err error
@@ -105,7 +145,7 @@ func NewDeployer(root string) Deployer {
 		return errDeployer{err}
 	}
 	trashDir := filepath.Join(root, SiteServiceDir, "trash")
-	return &deployerImpl{NewFileSystem(root, trashDir)}
+	return &deployerImpl{fs: NewFileSystem(root, trashDir)}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -124,6 +164,14 @@ func (d errDeployer) CheckDeployed(context.Context, string, string) (common.Pin,
 func (d errDeployer) FindDeployed(context.Context) (out common.PinSliceBySubdir, err error) {
 	return nil, d.err
 }
+func (d errDeployer) CheckDeployedFiles(context.Context, string, string) (common.Pin, FilesDrift, error) {
+	return common.Pin{}, FilesDrift{}, d.err
+}
+
+func (d errDeployer) RepairDeployed(context.Context, string, PackageInstance, []string) error {
+	return d.err
+}
+
 func (d errDeployer) RemoveDeployed(context.Context, string, string) error { return d.err }
 func (d errDeployer) TempFile(context.Context, string) (*os.File, error)   { return nil, d.err }
 func (d errDeployer) CleanupTrash(context.Context) error                   { return d.err }
@@ -145,6 +193,11 @@ const currentTxt = "_current.txt"
 // deployerImpl implements Deployer interface.
 type deployerImpl struct {
 	fs FileSystem
+
+	// lock serializes modifications of the site root done by this process, so
+	// concurrent DeployInstance and RemoveDeployed calls don't step on each
+	// other when allocating and updating .cipd/pkgs/*.
+	lock sync.Mutex
 }
 
 func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst PackageInstance) (common.Pin, error) {
@@ -152,6 +205,9 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst P
 		return common.Pin{}, err
 	}
 
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
 	pin := inst.Pin()
 	logging.Infof(ctx, "Deploying %s into %s(/%s)", pin, d.fs.Root(), subdir)
 
@@ -330,11 +386,154 @@ func (d *deployerImpl) FindDeployed(ctx context.Context) (common.PinSliceBySubdi
 	return found.ToSlice(), nil
 }
 
+func (d *deployerImpl) CheckDeployedFiles(ctx context.Context, subdir, pkg string) (common.Pin, FilesDrift, error) {
+	pin, err := d.CheckDeployed(ctx, subdir, pkg)
+	if err != nil {
+		return common.Pin{}, FilesDrift{}, err
+	}
+	manifest, err := d.deployedManifest(ctx, subdir, pin)
+	if err != nil {
+		return common.Pin{}, FilesDrift{}, err
+	}
+	subdirAbs, err := d.fs.RootRelToAbs(filepath.FromSlash(subdir))
+	if err != nil {
+		return common.Pin{}, FilesDrift{}, err
+	}
+
+	logging.Infof(ctx, "Checking files of %s in %s(/%s)", pin, d.fs.Root(), subdir)
+
+	drift := FilesDrift{}
+	dirs := stringset.New(0) // directories with files of the package
+	for _, f := range manifest.Files {
+		dirs.Add(path.Dir(f.Name))
+		switch ok, err := checkDeployedFile(filepath.Join(subdirAbs, filepath.FromSlash(f.Name)), f); {
+		case os.IsNotExist(err):
+			drift.Missing = append(drift.Missing, f.Name)
+		case err != nil:
+			return common.Pin{}, FilesDrift{}, err
+		case !ok:
+			drift.Modified = append(drift.Modified, f.Name)
+		}
+	}
+
+	// Look for unknown files next to the files of the package. Files of other
+	// packages deployed to the same subdir are fine.
+	owned, err := d.subdirFiles(ctx, subdir)
+	if err != nil {
+		return common.Pin{}, FilesDrift{}, err
+	}
+	err = nil
+	dirs.Iter(func(dir string) bool {
+		var infos []os.FileInfo
+		infos, err = ioutil.ReadDir(filepath.Join(subdirAbs, filepath.FromSlash(dir)))
+		if os.IsNotExist(err) {
+			err = nil
+			return true
+		}
+		if err != nil {
+			return false
+		}
+		for _, info := range infos {
+			name := path.Join(dir, info.Name())
+			if !info.IsDir() && !owned.Has(name) {
+				drift.Added = append(drift.Added, name)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return common.Pin{}, FilesDrift{}, err
+	}
+
+	sort.Strings(drift.Added)
+	sort.Strings(drift.Modified)
+	sort.Strings(drift.Missing)
+	return pin, drift, nil
+}
+
+func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, inst PackageInstance, files []string) error {
+	if err := common.ValidateSubdir(subdir); err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	pin := inst.Pin()
+	logging.Infof(ctx, "Repairing %d files of %s in %s(/%s)", len(files), pin, d.fs.Root(), subdir)
+
+	pkgPath, err := d.packagePath(ctx, subdir, pin.PackageName, false)
+	if err != nil {
+		return err
+	}
+	if pkgPath == "" {
+		return fmt.Errorf("package %s is not installed", pin.PackageName)
+	}
+	switch current, err := d.getCurrentInstanceID(pkgPath); {
+	case err != nil:
+		return err
+	case current != pin.InstanceID:
+		return fmt.Errorf("instance %s is not the deployed one (%s is)", pin, current)
+	}
+	destPath := filepath.Join(pkgPath, pin.InstanceID)
+	manifest, err := d.readManifest(ctx, destPath)
+	if err != nil {
+		return err
+	}
+
+	toRepair := stringset.NewFromSlice(files...)
+	infos := make([]FileInfo, 0, len(files))
+	for _, f := range manifest.Files {
+		if toRepair.Has(f.Name) {
+			infos = append(infos, f)
+		}
+	}
+	if len(infos) != toRepair.Len() {
+		return fmt.Errorf("some of the files are not in the package %s", pin)
+	}
+
+	// Extract the files into a temp directory first. ExtractInstance needs the
+	// manifest file, it is ignored below.
+	tmp, err := d.TempDir(ctx, "repair_", 0700)
+	if err != nil {
+		return err
+	}
+	defer d.fs.EnsureDirectoryGone(ctx, tmp)
+	srcPath := filepath.Join(tmp, "pkg")
+	err = ExtractInstance(ctx, inst, NewFileSystemDestination(srcPath, d.fs), func(f File) bool {
+		return f.Name() != manifestName && !toRepair.Has(f.Name())
+	})
+	if err != nil {
+		return err
+	}
+
+	// In "symlink" mode the files live in the instance directory, and the site
+	// root has only symlinks to them (that may be broken too). In "copy" mode
+	// addToSiteRoot moves the files from srcPath to the site root.
+	if resolveInstallMode(manifest.InstallMode) == InstallModeSymlink {
+		for _, f := range infos {
+			rel := filepath.FromSlash(f.Name)
+			if err := d.fs.Replace(ctx, filepath.Join(srcPath, rel), filepath.Join(destPath, rel)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := d.addToSiteRoot(ctx, subdir, infos, manifest.InstallMode, pkgPath, srcPath); err != nil {
+		return err
+	}
+
+	logging.Infof(ctx, "Successfully repaired %s", pin)
+	return nil
+}
+
 func (d *deployerImpl) RemoveDeployed(ctx context.Context, subdir, packageName string) error {
 	if err := common.ValidateSubdir(subdir); err != nil {
 		return err
 	}
 
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
 	logging.Infof(ctx, "Removing %s from %s(/%s)", packageName, d.fs.Root(), subdir)
 	if err := common.ValidatePackageName(packageName); err != nil {
 		return err
@@ -717,15 +916,54 @@ func (d *deployerImpl) readManifest(ctx context.Context, instanceDir string) (Ma
 	return manifest, nil
 }
 
-// addToSiteRoot moves or symlinks files into the site root directory (depending
-// on passed installMode).
-func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files []FileInfo, installMode InstallMode, pkgDir, srcDir string) error {
+// deployedManifest reads the manifest of a deployed instance of a package.
+func (d *deployerImpl) deployedManifest(ctx context.Context, subdir string, pin common.Pin) (Manifest, error) {
+	pkgPath, err := d.packagePath(ctx, subdir, pin.PackageName, false)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if pkgPath == "" {
+		return Manifest{}, fmt.Errorf("package %s is not installed", pin.PackageName)
+	}
+	return d.readManifest(ctx, filepath.Join(pkgPath, pin.InstanceID))
+}
+
+// subdirFiles returns names of all files of all packages deployed to a subdir.
+func (d *deployerImpl) subdirFiles(ctx context.Context, subdir string) (stringset.Set, error) {
+	deployed, err := d.FindDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files := stringset.New(0)
+	for _, pin := range deployed[subdir] {
+		manifest, err := d.deployedManifest(ctx, subdir, pin)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range manifest.Files {
+			files.Add(f.Name)
+		}
+	}
+	return files, nil
+}
+
+// resolveInstallMode returns the install mode actually used for a package
+// with the given install mode in its manifest.
+func resolveInstallMode(installMode InstallMode) InstallMode {
 	// On Windows only InstallModeCopy is supported.
 	if runtime.GOOS == "windows" {
-		installMode = InstallModeCopy
-	} else if installMode == "" {
-		installMode = InstallModeSymlink // default on non-Windows
+		return InstallModeCopy
 	}
+	if installMode == "" {
+		return InstallModeSymlink // default on non-Windows
+	}
+	return installMode
+}
+
+// addToSiteRoot moves or symlinks files into the site root directory (depending
+// on passed installMode).
+func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files []FileInfo, installMode InstallMode, pkgDir, srcDir string) error {
+	installMode = resolveInstallMode(installMode)
 	if err := ValidateInstallMode(installMode); err != nil {
 		return err
 	}
@@ -802,6 +1040,44 @@ func (d *deployerImpl) removeEmptyDirs(ctx context.Context, root string) {
 ////////////////////////////////////////////////////////////////////////////////
 // Utility functions.
 
+// checkDeployedFile returns true if a deployed file matches its FileInfo from
+// the manifest. Returns an os.IsNotExist error if the file is missing.
+func checkDeployedFile(path string, f FileInfo) (bool, error) {
+	if f.Symlink != "" {
+		_, err := os.Lstat(path)
+		return err == nil, err
+	}
+	// Follows the symlinks to the files in "symlink" install mode.
+	info, err := os.Stat(path)
+	switch {
+	case err != nil:
+		return false, err
+	case !info.Mode().IsRegular() || uint64(info.Size()) != f.Size:
+		return false, nil
+	case f.Hash == "":
+		return true, nil // older manifests have no hashes, trust the size
+	}
+	hash, err := hashFile(path)
+	if err != nil {
+		return false, err
+	}
+	return hash == f.Hash, nil
+}
+
+// hashFile returns a hex encoded SHA1 digest of a file body.
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha1.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // scanPackageDir finds a set of regular files (and symlinks) in a package
 // instance directory and returns them as FileInfo structs (with slash-separated
 // paths relative to dir directory). Skips package service directories (.cipdpkg
@@ -1324,6 +1324,82 @@ func TestRemoveDeployedWindows(t *testing.T) {
 	})
 }
 
+func TestCheckAndRepairDeployedFiles(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+		mode := mode
+
+		Convey(fmt.Sprintf("Given a package deployed in %s mode", mode), t, func() {
+			tempDir := mkTempDir()
+			d := NewDeployer(tempDir)
+
+			inst := makeTestInstance("test/package", []File{
+				NewTestFile("some/file/path", "data a", false),
+				NewTestFile("some/executable", "data b", true),
+				NewTestFile("another/file", "data c", false),
+				NewTestSymlink("some/symlink", "executable"),
+			}, mode)
+			_, err := d.DeployInstance(ctx, "subdir", inst)
+			So(err, ShouldBeNil)
+
+			// Files of other packages are not reported as added.
+			other := makeTestInstance("test/other", []File{
+				NewTestFile("some/other", "data d", false),
+			}, mode)
+			_, err = d.DeployInstance(ctx, "subdir", other)
+			So(err, ShouldBeNil)
+
+			abs := func(rel string) string {
+				return filepath.Join(tempDir, "subdir", filepath.FromSlash(rel))
+			}
+
+			Convey("CheckDeployedFiles accepts intact files", func() {
+				pin, drift, err := d.CheckDeployedFiles(ctx, "subdir", "test/package")
+				So(err, ShouldBeNil)
+				So(pin, ShouldResemble, inst.Pin())
+				So(drift.Empty(), ShouldBeTrue)
+			})
+
+			Convey("CheckDeployedFiles finds damaged files, RepairDeployed fixes them", func() {
+				So(os.Remove(abs("some/file/path")), ShouldBeNil)
+				So(os.Remove(abs("some/executable")), ShouldBeNil)
+				So(ioutil.WriteFile(abs("some/executable"), []byte("data B"), 0666), ShouldBeNil)
+				So(ioutil.WriteFile(abs("some/junk"), []byte("junk"), 0666), ShouldBeNil)
+
+				_, drift, err := d.CheckDeployedFiles(ctx, "subdir", "test/package")
+				So(err, ShouldBeNil)
+				So(drift, ShouldResemble, FilesDrift{
+					Added:    []string{"some/junk"},
+					Modified: []string{"some/executable"},
+					Missing:  []string{"some/file/path"},
+				})
+
+				err = d.RepairDeployed(ctx, "subdir", inst, append(drift.Modified, drift.Missing...))
+				So(err, ShouldBeNil)
+				So(readFile(tempDir, "subdir/some/file/path"), ShouldEqual, "data a")
+				So(readFile(tempDir, "subdir/some/executable"), ShouldEqual, "data b")
+
+				// Added files are left alone.
+				_, drift, err = d.CheckDeployedFiles(ctx, "subdir", "test/package")
+				So(err, ShouldBeNil)
+				So(drift, ShouldResemble, FilesDrift{
+					Added: []string{"some/junk"},
+				})
+			})
+
+			Convey("RepairDeployed refuses files not in the package", func() {
+				err := d.RepairDeployed(ctx, "subdir", inst, []string{"some/junk"})
+				So(err, ShouldErrLike, "some of the files are not in the package")
+			})
+		})
+	}
+}
+
 func TestUpgradeOldPkgDir(t *testing.T) {
 	ctx := context.Background()
 
@@ -480,6 +480,15 @@ func (d *fileSystemDestination) CreateSymlink(ctx context.Context, name string,
 	return d.fs.EnsureSymlink(ctx, path, target)
 }
 
+// CreateHardlink implements hardlinkDestination.
+func (d *fileSystemDestination) CreateHardlink(ctx context.Context, name string, src string) error {
+	path, err := d.prepareFilePath(ctx, name)
+	if err != nil {
+		return err
+	}
+	return os.Link(src, path)
+}
+
 func (d *fileSystemDestination) End(ctx context.Context, success bool) error {
 	if d.tempDir == "" {
 		return fmt.Errorf("destination is not open")
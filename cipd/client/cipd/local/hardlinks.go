@@ -0,0 +1,58 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"path/filepath"
+
+	"golang.org/x/net/context"
+)
+
+// hardlinkDestination is implemented by Destinations that can hardlink existing
+// files instead of writing their contents.
+type hardlinkDestination interface {
+	// CreateHardlink creates a hardlink to an existing file at native path src.
+	//
+	// 'name' must be a slash separated path relative to the destination root.
+	CreateHardlink(ctx context.Context, name string, src string) error
+}
+
+// NewHardlinkingInstance wraps a PackageInstance so that ExtractInstance
+// hardlinks its regular files from dir instead of copying them out of the
+// package, if the destination supports it.
+//
+// dir must contain an extracted copy of the same instance, for example one
+// kept in a shared instance cache. Files that can't be hardlinked (e.g. because
+// dir is on another file system) are copied as usual.
+//
+// Hardlinked files share their contents and attributes with the files in dir,
+// and thus must be treated as read-only.
+func NewHardlinkingInstance(inst PackageInstance, dir string) PackageInstance {
+	files := inst.Files()
+	linked := make([]File, len(files))
+	for i, f := range files {
+		if f.Symlink() || f.Name() == manifestName {
+			linked[i] = f
+		} else {
+			linked[i] = &hardlinkedFile{f, filepath.Join(dir, filepath.FromSlash(f.Name()))}
+		}
+	}
+	return &hardlinkingInstance{inst, linked}
+}
+
+type hardlinkingInstance struct {
+	PackageInstance
+
+	files []File
+}
+
+func (inst *hardlinkingInstance) Files() []File { return inst.files }
+
+// hardlinkedFile is a File that has an identical copy at a native path src.
+type hardlinkedFile struct {
+	File
+
+	src string
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHardlinkingInstance(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a package instance", t, func() {
+		tempDir, err := ioutil.TempDir("", "cipd_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		out := bytes.Buffer{}
+		err = BuildInstance(ctx, BuildInstanceOptions{
+			Input: []File{
+				NewTestFile("testing/qwerty", "12345", false),
+				NewTestFile("abc", "duh", true),
+				NewTestSymlink("rel_symlink", "abc"),
+			},
+			Output:      &out,
+			PackageName: "testing",
+		})
+		So(err, ShouldBeNil)
+
+		inst, err := OpenInstance(ctx, bytes.NewReader(out.Bytes()), "", VerifyHash)
+		So(err, ShouldBeNil)
+		defer inst.Close()
+
+		extract := func(inst PackageInstance, dir string) {
+			So(ExtractInstance(ctx, inst, NewFileSystemDestination(dir, nil), nil), ShouldBeNil)
+		}
+		sameFile := func(a, b string) bool {
+			fa, err := os.Stat(a)
+			So(err, ShouldBeNil)
+			fb, err := os.Stat(b)
+			So(err, ShouldBeNil)
+			return os.SameFile(fa, fb)
+		}
+
+		cached := filepath.Join(tempDir, "cached")
+		extract(inst, cached)
+
+		Convey("Hardlinks regular files from an extracted copy", func() {
+			deployed := filepath.Join(tempDir, "deployed")
+			extract(NewHardlinkingInstance(inst, cached), deployed)
+
+			for _, name := range []string{"testing/qwerty", "abc"} {
+				So(sameFile(filepath.Join(cached, name), filepath.Join(deployed, name)), ShouldBeTrue)
+			}
+			So(sameFile(filepath.Join(cached, manifestName), filepath.Join(deployed, manifestName)), ShouldBeFalse)
+
+			target, err := os.Readlink(filepath.Join(deployed, "rel_symlink"))
+			So(err, ShouldBeNil)
+			So(target, ShouldEqual, "abc")
+		})
+
+		Convey("Copies files if they can't be hardlinked", func() {
+			deployed := filepath.Join(tempDir, "deployed")
+			extract(NewHardlinkingInstance(inst, filepath.Join(tempDir, "missing")), deployed)
+
+			buf, err := ioutil.ReadFile(filepath.Join(deployed, "testing/qwerty"))
+			So(err, ShouldBeNil)
+			So(string(buf), ShouldEqual, "12345")
+			So(sameFile(filepath.Join(cached, "abc"), filepath.Join(deployed, "abc")), ShouldBeFalse)
+		})
+
+		Convey("Copies files whose extracted copy was modified", func() {
+			So(os.Chmod(filepath.Join(cached, "abc"), 0777), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(cached, "abc"), []byte("modified"), 0777), ShouldBeNil)
+
+			deployed := filepath.Join(tempDir, "deployed")
+			extract(NewHardlinkingInstance(inst, cached), deployed)
+
+			buf, err := ioutil.ReadFile(filepath.Join(deployed, "abc"))
+			So(err, ShouldBeNil)
+			So(string(buf), ShouldEqual, "duh")
+			So(sameFile(filepath.Join(cached, "abc"), filepath.Join(deployed, "abc")), ShouldBeFalse)
+			So(sameFile(filepath.Join(cached, "testing/qwerty"), filepath.Join(deployed, "testing/qwerty")), ShouldBeTrue)
+
+			// The manifest has the hash of the package's content.
+			f, err := os.Open(filepath.Join(deployed, manifestName))
+			So(err, ShouldBeNil)
+			defer f.Close()
+			manifest, err := readManifest(f)
+			So(err, ShouldBeNil)
+			hashes := map[string]string{}
+			for _, fi := range manifest.Files {
+				hashes[fi.Name] = fi.Hash
+			}
+			want, err := hashFile(filepath.Join(deployed, "abc"))
+			So(err, ShouldBeNil)
+			So(hashes["abc"], ShouldEqual, want)
+		})
+
+		Convey("SameFileSystem works", func() {
+			So(SameFileSystem(tempDir, cached), ShouldBeTrue)
+			So(SameFileSystem(tempDir, filepath.Join(tempDir, "missing")), ShouldBeFalse)
+		})
+	})
+}
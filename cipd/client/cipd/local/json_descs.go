@@ -74,6 +74,13 @@ type FileInfo struct {
 
 	// Symlink is a path the symlink points to or "" if the file is not a symlink.
 	Symlink string `json:"symlink,omitempty"`
+
+	// Hash is a hex encoded SHA1 digest of the file body. Used to detect
+	// modifications of deployed files.
+	//
+	// Present only in deployed manifests and only for regular files. Manifests
+	// written by older clients don't have it.
+	Hash string `json:"hash,omitempty"`
 }
 
 // VersionFile describes JSON file with package version information that's
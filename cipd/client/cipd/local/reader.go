@@ -7,6 +7,8 @@ package local
 import (
 	"archive/zip"
 	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash"
@@ -125,6 +127,9 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 
 	progress := newProgressReporter(ctx, files)
 
+	// SHA1 digests of extracted regular files, to put into the manifest.
+	hashes := make(map[string]string, len(files))
+
 	extractManifestFile := func(f File) (err error) {
 		defer progress.advance(f)
 		manifest, err := readManifestFile(f)
@@ -144,6 +149,7 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 				Size:       file.Size(),
 				Executable: file.Executable(),
 				WinAttrs:   file.WinAttrs().String(),
+				Hash:       hashes[file.Name()],
 			}
 			if file.Symlink() {
 				target, err := file.SymlinkTarget()
@@ -175,8 +181,34 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 		return dest.CreateSymlink(ctx, f.Name(), target)
 	}
 
+	// Hardlink files when possible, see NewHardlinkingInstance. Stop trying after
+	// the first failure, since it is likely to happen for all files.
+	linkDest, _ := dest.(hardlinkDestination)
 	extractRegularFile := func(f File) (err error) {
 		defer progress.advance(f)
+		if lf, ok := f.(*hardlinkedFile); ok && linkDest != nil {
+			// The copy at lf.src is shared by every hardlink to it, and may have been
+			// modified through any of them. Link it only if it still matches the
+			// package.
+			want, err := hashPackageFile(f)
+			if err != nil {
+				return err
+			}
+			switch got, err := hashFile(lf.src); {
+			case err != nil:
+				logging.Warningf(ctx, "cipd: failed to hash %q, copying it instead - %s", lf.src, err)
+			case got != want:
+				logging.Warningf(ctx, "cipd: %q was modified, copying %q instead", lf.src, f.Name())
+			default:
+				if err := linkDest.CreateHardlink(ctx, f.Name(), lf.src); err != nil {
+					logging.Warningf(ctx, "cipd: failed to hardlink %q, copying files instead - %s", f.Name(), err)
+					linkDest = nil
+					break
+				}
+				hashes[f.Name()] = want
+				return nil
+			}
+		}
 		out, err := dest.CreateFile(ctx, f.Name(), f.Executable(), f.WinAttrs())
 		if err != nil {
 			return err
@@ -191,8 +223,12 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 			return err
 		}
 		defer in.Close()
-		_, err = io.Copy(out, in)
-		return err
+		h := sha1.New()
+		if _, err = io.Copy(io.MultiWriter(out, h), in); err != nil {
+			return err
+		}
+		hashes[f.Name()] = hex.EncodeToString(h.Sum(nil))
+		return nil
 	}
 
 	var manifest File
@@ -462,6 +498,21 @@ func readManifestFile(f File) (Manifest, error) {
 	return readManifest(r)
 }
 
+// hashPackageFile returns the hex SHA1 digest of the content of f, as stored in
+// the package.
+func hashPackageFile(f File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	h := sha1.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // makeVersionFile returns File representing a JSON blob with info about package
 // version. It's what's deployed at path specified in 'version_file' stanza in
 // package definition YAML.
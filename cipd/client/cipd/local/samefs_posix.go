@@ -0,0 +1,35 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build !windows
+
+package local
+
+import (
+	"os"
+	"syscall"
+)
+
+// SameFileSystem returns true if both existing paths are on the same file
+// system, i.e. files can be hardlinked between them.
+func SameFileSystem(a, b string) bool {
+	devA, ok := deviceOf(a)
+	if !ok {
+		return false
+	}
+	devB, ok := deviceOf(b)
+	return ok && devA == devB
+}
+
+func deviceOf(path string) (uint64, bool) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0, false
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+	return uint64(st.Dev), true
+}
@@ -0,0 +1,26 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build windows
+
+package local
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// SameFileSystem returns true if both existing paths are on the same file
+// system, i.e. files can be hardlinked between them.
+//
+// On Windows it compares volume names, which doesn't account for mounted
+// folders.
+func SameFileSystem(a, b string) bool {
+	a, errA := filepath.Abs(a)
+	b, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return strings.EqualFold(filepath.VolumeName(a), filepath.VolumeName(b))
+}
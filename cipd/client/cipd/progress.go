@@ -0,0 +1,111 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cipd
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+)
+
+var downloadProgressKey = "cipd.downloadProgress"
+
+// ensureProgress tracks the progress of all packages installed by a single
+// EnsurePackages call and periodically logs it as one line, instead of
+// a "fetching - N%" line per concurrent download.
+type ensureProgress struct {
+	ctx   context.Context
+	total int // number of packages to install
+
+	lock       sync.Mutex
+	fetched    int // number of packages fetched (or failed to be fetched)
+	deployed   int // number of packages deployed (or failed to be deployed)
+	downloads  []*downloadProgress
+	lastReport time.Time
+}
+
+// downloadProgress is the progress of a single download.
+type downloadProgress struct {
+	read  int64
+	total int64
+}
+
+func newEnsureProgress(ctx context.Context, total int) *ensureProgress {
+	return &ensureProgress{ctx: ctx, total: total}
+}
+
+// withDownloadProgress returns a context that makes downloads report how many
+// bytes of them were read so far to the supplied callback, instead of logging
+// it.
+//
+// All downloads done under the returned context report to the same callback,
+// so it should be used for a single fetch (including its retries).
+func withDownloadProgress(ctx context.Context, report func(read, total int64)) context.Context {
+	return context.WithValue(ctx, &downloadProgressKey, report)
+}
+
+// getDownloadProgress returns the callback installed in the context by
+// withDownloadProgress, or nil if there's none.
+func getDownloadProgress(ctx context.Context) func(read, total int64) {
+	report, _ := ctx.Value(&downloadProgressKey).(func(read, total int64))
+	return report
+}
+
+// downloadStarted registers a new package fetch and returns a callback that
+// its download should use to report how many bytes of it were read so far.
+//
+// Retries of the fetch should report to the same callback.
+func (p *ensureProgress) downloadStarted() func(read, total int64) {
+	d := &downloadProgress{}
+
+	p.lock.Lock()
+	p.downloads = append(p.downloads, d)
+	p.lock.Unlock()
+
+	return func(read, total int64) {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		d.read, d.total = read, total
+		p.reportLocked()
+	}
+}
+
+// packageFetched is called when a package is fetched, or fails to be fetched.
+func (p *ensureProgress) packageFetched() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.fetched++
+	p.reportLocked()
+}
+
+// packageDeployed is called when a package is deployed, or fails to be
+// deployed.
+func (p *ensureProgress) packageDeployed() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.deployed++
+	p.reportLocked()
+}
+
+// reportLocked logs the progress, throttling the reports rate.
+func (p *ensureProgress) reportLocked() {
+	now := clock.Now(p.ctx)
+	if now.Sub(p.lastReport) < downloadReportInterval && p.deployed != p.total {
+		return
+	}
+	p.lastReport = now
+
+	read, total := int64(0), int64(0)
+	for _, d := range p.downloads {
+		read += d.read
+		total += d.total
+	}
+	logging.Infof(p.ctx, "cipd: %d of %d packages fetched, %d deployed; downloaded %.1f of %.1f Mb",
+		p.fetched, p.total, p.deployed, float32(read)/1024.0/1024.0, float32(total)/1024.0/1024.0)
+}
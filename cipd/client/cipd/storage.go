@@ -208,6 +208,11 @@ func (s *storageImpl) download(ctx context.Context, url string, output io.WriteS
 			prevProgress = progress
 		}
 	}
+	// When fetching multiple packages at once, report the combined progress
+	// instead.
+	if report := getDownloadProgress(ctx); report != nil {
+		reportProgress = report
+	}
 
 	// download is a separate function to be able to use deferred close.
 	download := func(out io.Writer, src io.ReadCloser, totalLen int64) error {
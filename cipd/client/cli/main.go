@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
@@ -15,6 +16,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -246,9 +248,10 @@ func makeCLIError(msg string, args ...interface{}) error {
 // clientOptions defines command line arguments related to CIPD client creation.
 // Subcommands that need a CIPD client embed it.
 type clientOptions struct {
-	authFlags  authcli.Flags
-	serviceURL string
-	cacheDir   string
+	authFlags      authcli.Flags
+	serviceURL     string
+	cacheDir       string
+	cacheMaxSizeMB int64
 }
 
 func (opts *clientOptions) registerFlags(f *flag.FlagSet, params Parameters) {
@@ -256,6 +259,9 @@ func (opts *clientOptions) registerFlags(f *flag.FlagSet, params Parameters) {
 		"Backend URL. If provided via an 'ensure file', the URL in the file takes precedence.")
 	f.StringVar(&opts.cacheDir, "cache-dir", "",
 		fmt.Sprintf("Directory for shared cache (can also be set by %s env var).", cipd.EnvCacheDir))
+	f.Int64Var(&opts.cacheMaxSizeMB, "cache-max-size-mb", 0,
+		fmt.Sprintf("Maximum total size of instances in the shared cache, in MiB (can also be set by %s env var).",
+			cipd.EnvCacheMaxSizeMB))
 	opts.authFlags.Register(f, params.DefaultAuthOptions)
 }
 
@@ -273,6 +279,7 @@ func (opts *clientOptions) makeCipdClient(ctx context.Context, root string) (cip
 		ServiceURL:          opts.serviceURL,
 		Root:                root,
 		CacheDir:            opts.cacheDir,
+		CacheMaxBytes:       opts.cacheMaxSizeMB * 1024 * 1024,
 		AuthenticatedClient: client,
 		AnonymousClient:     http.DefaultClient,
 	}
@@ -715,7 +722,11 @@ func cmdEnsure(params Parameters) *subcommands.Command {
 		LongDesc: "Installs, removes and updates packages in one go.\n\n" +
 			"Supposed to be used from scripts and automation. Alternative to 'init', " +
 			"'install' and 'remove'. As such, it doesn't try to discover site root " +
-			"directory on its own.",
+			"directory on its own.\n\n" +
+			"With -check it doesn't install anything, but verifies that the site root " +
+			"matches the ensure file, re-hashing files of deployed packages to find " +
+			"added, modified and missing ones. Add -repair to re-extract modified and " +
+			"missing files.",
 		CommandRun: func() subcommands.CommandRun {
 			c := &ensureRun{}
 			c.registerBaseFlags()
@@ -726,6 +737,10 @@ func cmdEnsure(params Parameters) *subcommands.Command {
 				(`An "ensure" file. See syntax described here: ` +
 					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
 					` Providing '-' will read from stdin.`))
+			c.Flags.BoolVar(&c.check, "check", false,
+				"Verify that the site root matches the ensure file instead of updating it.")
+			c.Flags.BoolVar(&c.repair, "repair", false,
+				"With -check, re-extract modified and missing files of deployed packages.")
 			return c
 		},
 	}
@@ -737,32 +752,141 @@ type ensureRun struct {
 
 	rootDir    string
 	ensureFile string
+	check      bool
+	repair     bool
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	if !c.checkArgs(args, 0, 0) {
 		return 1
 	}
+	if c.repair && !c.check {
+		c.printError(makeCLIError("-repair can only be used with -check"))
+		return 1
+	}
 	ctx := cli.GetContext(a, c, env)
+	if c.check {
+		_, actions, err := checkPackages(ctx, c.rootDir, c.ensureFile, c.repair, c.clientOptions)
+		if err == nil {
+			err = siteRootDrift(actions, c.repair)
+		}
+		return c.done(actions, err)
+	}
 	currentPins, _, err := ensurePackages(ctx, c.rootDir, c.ensureFile, false, c.clientOptions)
 	return c.done(currentPins, err)
 }
 
 func ensurePackages(ctx context.Context, root string, desiredStateFile string, dryRun bool, clientOpts clientOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
+	return withEnsureFilePins(ctx, root, desiredStateFile, clientOpts, func(client cipd.Client, pins common.PinSliceBySubdir) (cipd.ActionMap, error) {
+		return client.EnsurePackages(ctx, pins, dryRun)
+	})
+}
+
+func checkPackages(ctx context.Context, root string, desiredStateFile string, repair bool, clientOpts clientOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
+	return withEnsureFilePins(ctx, root, desiredStateFile, clientOpts, func(client cipd.Client, pins common.PinSliceBySubdir) (cipd.ActionMap, error) {
+		return client.CheckPackages(ctx, pins, repair)
+	})
+}
+
+// siteRootDrift returns an error describing how the site root differs from the
+// ensure file, based on actions returned by CheckPackages, or nil if it
+// doesn't.
+func siteRootDrift(actions cipd.ActionMap, repaired bool) error {
+	outdated, added, broken := false, false, false
+	for _, a := range actions {
+		if len(a.ToInstall) != 0 || len(a.ToUpdate) != 0 || len(a.ToRemove) != 0 {
+			outdated = true
+		}
+		for _, b := range a.Broken {
+			added = added || len(b.Added) != 0
+			broken = broken || len(b.Modified) != 0 || len(b.Missing) != 0
+		}
+	}
+
+	var problems []string
+	if outdated {
+		problems = append(problems, "some packages need to be installed, updated or removed, run 'cipd ensure'")
+	}
+	if broken && !repaired {
+		problems = append(problems, "some files are modified or missing, run 'cipd ensure -check -repair'")
+	}
+	if added {
+		problems = append(problems, "some unknown files were added next to package files")
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the site root doesn't match the ensure file: %s", strings.Join(problems, "; "))
+}
+
+// withEnsureFilePins resolves the packages of an ensure file and calls 'cb'
+// with them and a client for the given site root.
+func withEnsureFilePins(ctx context.Context, root string, desiredStateFile string, clientOpts clientOptions, cb func(cipd.Client, common.PinSliceBySubdir) (cipd.ActionMap, error)) (common.PinSliceBySubdir, cipd.ActionMap, error) {
+	ensureFile, err := parseEnsureFile(ctx, desiredStateFile, &clientOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := clientOpts.makeCipdClient(ctx, root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	var resolver ensure.VersionResolver
+	if ensureFile.ResolvedVersions != "" {
+		// Versions are pinned by the resolved versions file, don't ask the backend.
+		path := resolvedVersionsPath(desiredStateFile, ensureFile.ResolvedVersions)
+		versions, err := readVersionsFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		resolver = func(pkg, vers string) (common.Pin, error) {
+			pin, err := versions.ResolveVersion(pkg, vers)
+			if err != nil {
+				return pin, fmt.Errorf("%s; is %q stale? Regenerate it with 'cipd ensure-file-resolve'", err, path)
+			}
+			return pin, nil
+		}
+	} else {
+		resolver = func(pkg, vers string) (common.Pin, error) {
+			return client.ResolveVersion(ctx, pkg, vers)
+		}
+	}
+
+	resolved, err := ensureFile.Resolve(resolver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, err := cb(client, resolved.PackagesBySubdir)
+	if err != nil {
+		return nil, actions, err
+	}
+
+	return resolved.PackagesBySubdir, actions, nil
+}
+
+// parseEnsureFile reads and parses an ensure file ('-' for stdin).
+//
+// If the file sets $ServiceURL, it overrides the one in clientOpts.
+func parseEnsureFile(ctx context.Context, path string, clientOpts *clientOptions) (*ensure.File, error) {
 	var err error
 	var f io.ReadCloser
-	if desiredStateFile == "-" {
+	if path == "-" {
 		f = os.Stdin
 	} else {
-		if f, err = os.Open(desiredStateFile); err != nil {
-			return nil, nil, err
+		if f, err = os.Open(path); err != nil {
+			return nil, err
 		}
 	}
 	defer f.Close()
 
 	ensureFile, err := ensure.ParseFile(f)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Prefer the ServiceURL from the file (if set), and log a warning if the user
@@ -775,27 +899,292 @@ func ensurePackages(ctx context.Context, root string, desiredStateFile string, d
 		clientOpts.serviceURL = ensureFile.ServiceURL
 	}
 
-	client, err := clientOpts.makeCipdClient(ctx, root)
+	return ensureFile, nil
+}
+
+// resolvedVersionsPath returns the path of the resolved versions file named by
+// the $ResolvedVersions setting of the given ensure file.
+//
+// Relative paths are relative to the directory of the ensure file, or to the
+// current directory if the ensure file is read from stdin.
+func resolvedVersionsPath(ensureFilePath, resolvedVersions string) string {
+	if filepath.IsAbs(resolvedVersions) || ensureFilePath == "-" {
+		return resolvedVersions
+	}
+	return filepath.Join(filepath.Dir(ensureFilePath), resolvedVersions)
+}
+
+func readVersionsFile(path string) (ensure.VersionsFile, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	defer f.Close()
+	return ensure.ParseVersionsFile(f)
+}
 
-	client.BeginBatch(ctx)
-	defer client.EndBatch(ctx)
+////////////////////////////////////////////////////////////////////////////////
+// 'ensure-file-resolve' subcommand.
+
+// platformList holds an array of '-platform' command line options.
+type platformList []ensure.Platform
+
+func (pl *platformList) String() string {
+	// String() for empty vars used in -help output.
+	if len(*pl) == 0 {
+		return "os-arch"
+	}
+	strs := make([]string, len(*pl))
+	for i, p := range *pl {
+		strs[i] = p.String()
+	}
+	return strings.Join(strs, " ")
+}
+
+// Set is called by 'flag' package when parsing command line options.
+func (pl *platformList) Set(value string) error {
+	p, err := ensure.ParsePlatform(value)
+	if err != nil {
+		return commandLineError{err}
+	}
+	*pl = append(*pl, p)
+	return nil
+}
+
+func cmdEnsureFileResolve(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "ensure-file-resolve [options]",
+		ShortDesc: "writes the resolved versions file of an ensure file",
+		LongDesc: "Writes the resolved versions file of an ensure file.\n\n" +
+			"Expands the package templates of the ensure file for each given " +
+			"platform, resolves all package versions to instance IDs and writes " +
+			"them to the file named by the ensure file's $ResolvedVersions " +
+			"setting. 'ensure' then installs exactly these instances.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &ensureFileResolveRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
+				(`An "ensure" file. See syntax described here: ` +
+					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
+					` Providing '-' will read from stdin.`))
+			c.Flags.Var(&c.platforms, "platform",
+				"A platform (e.g. linux-amd64) to resolve the ensure file for (can be used multiple times). "+
+					"Defaults to the ensure file's $VerifiedPlatform platforms, or to the current platform.")
+			return c
+		},
+	}
+}
+
+type ensureFileResolveRun struct {
+	cipdSubcommand
+	clientOptions
+
+	ensureFile string
+	platforms  platformList
+}
+
+func (c *ensureFileResolveRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(resolveEnsureFile(ctx, c.ensureFile, c.platforms, c.clientOptions))
+}
+
+// ensureFilePlatforms returns the platforms to check an ensure file on.
+//
+// These are the platforms given on the command line if any, else the platforms
+// of the ensure file's $VerifiedPlatform settings if any, else the current
+// platform.
+func ensureFilePlatforms(ensureFile *ensure.File, platforms platformList) []ensure.Platform {
+	switch {
+	case len(platforms) > 0:
+		return platforms
+	case len(ensureFile.VerifyPlatforms) > 0:
+		return ensureFile.VerifyPlatforms
+	default:
+		return []ensure.Platform{ensure.HostPlatform()}
+	}
+}
 
-	resolved, err := ensureFile.Resolve(func(pkg, vers string) (common.Pin, error) {
+func resolveEnsureFile(ctx context.Context, ensureFilePath string, platformFlags platformList, clientOpts clientOptions) (common.PinSlice, error) {
+	ensureFile, err := parseEnsureFile(ctx, ensureFilePath, &clientOpts)
+	if err != nil {
+		return nil, err
+	}
+	platforms := ensureFilePlatforms(ensureFile, platformFlags)
+	if ensureFile.ResolvedVersions == "" {
+		return nil, fmt.Errorf("the ensure file has no $ResolvedVersions setting")
+	}
+
+	client, err := clientOpts.makeCipdClient(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := ensureFile.ResolveVersions(func(pkg, vers string) (common.Pin, error) {
 		return client.ResolveVersion(ctx, pkg, vers)
-	})
+	}, platforms)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	actions, err := client.EnsurePackages(ctx, resolved.PackagesBySubdir, dryRun)
+	buf := bytes.Buffer{}
+	if _, err := versions.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	path := resolvedVersionsPath(ensureFilePath, ensureFile.ResolvedVersions)
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0666); err != nil {
+		return nil, err
+	}
+
+	pins := make(common.PinSlice, 0, len(versions))
+	seen := make(map[common.Pin]struct{}, len(versions))
+	for _, plat := range platforms {
+		resolved, err := ensureFile.ResolveWith(versions.ResolveVersion, plat.TemplateArgs())
+		if err != nil {
+			return nil, err
+		}
+		for _, subdirPins := range resolved.PackagesBySubdir {
+			for _, pin := range subdirPins {
+				if _, ok := seen[pin]; !ok {
+					seen[pin] = struct{}{}
+					pins = append(pins, pin)
+				}
+			}
+		}
+	}
+	fmt.Printf("Pinned %d package version(s) for %d platform(s) in %s.\n", len(versions), len(platforms), path)
+	return pins, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'ensure-file-verify' subcommand.
+
+func cmdEnsureFileVerify(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "ensure-file-verify [options]",
+		ShortDesc: "verifies that an ensure file resolves on all its platforms",
+		LongDesc: "Verifies that an ensure file resolves on all its platforms.\n\n" +
+			"Expands the package templates of the ensure file for each platform of " +
+			"its $VerifiedPlatform settings (or each -platform given) and checks " +
+			"that every resulting package and version exists on the backend. " +
+			"Doesn't install anything.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &ensureFileVerifyRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
+				(`An "ensure" file. See syntax described here: ` +
+					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
+					` Providing '-' will read from stdin.`))
+			c.Flags.Var(&c.platforms, "platform",
+				"A platform (e.g. linux-amd64) to verify the ensure file for (can be used multiple times). "+
+					"Defaults to the ensure file's $VerifiedPlatform platforms, or to the current platform.")
+			return c
+		},
+	}
+}
+
+type ensureFileVerifyRun struct {
+	cipdSubcommand
+	clientOptions
+
+	ensureFile string
+	platforms  platformList
+}
+
+func (c *ensureFileVerifyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	results, err := verifyEnsureFile(ctx, c.ensureFile, c.platforms, c.clientOptions)
+	printVerifyResults(results)
+	ret := c.done(results, err)
+	if hasErrors(results) && ret == 0 {
+		return 1
+	}
+	return ret
+}
+
+// printVerifyResults prints the platform->pinInfo map produced by
+// verifyEnsureFile.
+func printVerifyResults(results map[string][]pinInfo) {
+	plats := make(sort.StringSlice, 0, len(results))
+	for plat := range results {
+		plats = append(plats, plat)
+	}
+	plats.Sort()
+
+	for _, plat := range plats {
+		fmt.Printf("Platform %s:\n", plat)
+		for _, p := range results[plat] {
+			if p.Err != "" {
+				fmt.Printf("  %s: %s\n", p.Pkg, p.Err)
+			} else {
+				fmt.Printf("  %s\n", p.Pin)
+			}
+		}
+	}
+	if hasErrors(results) {
+		fmt.Fprintln(os.Stderr, "Some packages can't be resolved, see above.")
+	}
+}
+
+func verifyEnsureFile(ctx context.Context, ensureFilePath string, platformFlags platformList, clientOpts clientOptions) (map[string][]pinInfo, error) {
+	ensureFile, err := parseEnsureFile(ctx, ensureFilePath, &clientOpts)
 	if err != nil {
-		return nil, actions, err
+		return nil, err
 	}
 
-	return resolved.PackagesBySubdir, actions, nil
+	client, err := clientOpts.makeCipdClient(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Each package version is checked once, even if several platforms use it.
+	checked := map[string]pinInfo{}
+	check := func(pkg, vers string) pinInfo {
+		key := pkg + "@" + vers
+		if res, ok := checked[key]; ok {
+			return res
+		}
+		pin, err := client.ResolveVersion(ctx, pkg, vers)
+		if err == nil && pin.InstanceID == vers {
+			// ResolveVersion doesn't ask the backend about instance IDs.
+			_, err = client.FetchInstanceInfo(ctx, pin)
+		}
+		res := pinInfo{Pkg: pkg}
+		if err != nil {
+			res.Err = fmt.Sprintf("version %q: %s", vers, err)
+		} else {
+			res.Pin = &pin
+		}
+		checked[key] = res
+		return res
+	}
+
+	// Results are keyed by platform. Errors about missing packages or versions
+	// are reported in them, so that all of them are found in one go.
+	results := map[string][]pinInfo{}
+	for _, plat := range ensureFilePlatforms(ensureFile, platformFlags) {
+		var pins []pinInfo
+		_, err := ensureFile.ResolveWith(func(pkg, vers string) (common.Pin, error) {
+			res := check(pkg, vers)
+			pins = append(pins, res)
+			return common.Pin{PackageName: pkg, InstanceID: vers}, nil
+		}, plat.TemplateArgs())
+		if err != nil {
+			return results, errors.Annotate(err).
+				Reason("verifying for platform %(platform)q").
+				D("platform", plat.String()).
+				Err()
+		}
+		results[plat.String()] = pins
+	}
+	return results, nil
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -2157,6 +2546,10 @@ func GetApplication(params Parameters) *cli.Application {
 				ShortDesc: "Directory with shared instance and tags cache " +
 					"(-cache-dir, if given, takes precedence).",
 			},
+			cipd.EnvCacheMaxSizeMB: {
+				ShortDesc: "Maximum total size of instances in the shared cache, in MiB " +
+					"(-cache-max-size-mb, if given, takes precedence).",
+			},
 		},
 
 		Commands: []*subcommands.Command{
@@ -2179,6 +2572,8 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdSearch(params),
 			cmdCreate(params),
 			cmdEnsure(params),
+			cmdEnsureFileResolve(params),
+			cmdEnsureFileVerify(params),
 			cmdResolve(params),
 			cmdDescribe(params),
 			cmdSetRef(params),
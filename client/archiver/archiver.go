@@ -128,7 +128,10 @@ func New(ctx context.Context, c *isolatedclient.Client, out io.Writer) *Archiver
 		stage2HashChan:        make(chan *Item),
 		stage3LookupChan:      make(chan *Item),
 		stage4UploadChan:      make(chan *Item),
+		chunker:               newChunker(minChunkSize, avgChunkSize, maxChunkSize),
+		chunksPushed:          map[isolated.HexDigest]bool{},
 	}
+	a.chunkSem = make(chan struct{}, a.maxConcurrentHash)
 	tracer.NewPID(a, "archiver")
 
 	a.wg.Add(1)
@@ -181,6 +184,7 @@ type Item struct {
 	err        error                                    // Item specific error
 	digestItem isolateservice.HandlersEndpointsV1Digest // Mutated by hashLoop(), used by doContains()
 	linked     []*Item                                  // Deduplicated item.
+	chunks     []isolated.Chunk                         // Set by PushFileChunked.
 
 	// Mutable but not accessible externally.
 	source isolatedclient.Source     // Source of data
@@ -223,6 +227,14 @@ func (i *Item) Digest() isolated.HexDigest {
 	return isolated.HexDigest(i.digestItem.Digest)
 }
 
+// Chunks returns the chunks of an item pushed with PushFileChunked once
+// hashed, nil otherwise.
+func (i *Item) Chunks() []isolated.Chunk {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return i.chunks
+}
+
 func (i *Item) isFile() bool {
 	return len(i.path) != 0
 }
@@ -318,10 +330,15 @@ type Archiver struct {
 	wg                    sync.WaitGroup
 	canceler              common.Canceler
 	progress              progress.Progress
+	chunker               *chunker
+	chunkSem              chan struct{} // Limits concurrent PushFileChunked splits.
+	chunkThreshold        int64         // Set by EnableChunking.
 
 	// Mutable.
-	statsLock sync.Mutex
-	stats     Stats
+	statsLock    sync.Mutex
+	stats        Stats
+	chunksLock   sync.Mutex
+	chunksPushed map[isolated.HexDigest]bool
 }
 
 // Close waits for all pending files to be done. If an error occured during
@@ -380,6 +397,95 @@ func (a *Archiver) PushFile(displayName, path string, priority int64) *Item {
 	return a.push(newItem(a, displayName, path, source, priority))
 }
 
+// EnableChunking makes PushDirectory push files of at least threshold bytes
+// with PushFileChunked instead of PushFile. 0 disables chunking.
+//
+// It must be called before any item is pushed.
+func (a *Archiver) EnableChunking(threshold int64) {
+	a.chunkThreshold = threshold
+}
+
+// ShouldChunk returns true if a file of the given size should be pushed with
+// PushFileChunked, as configured with EnableChunking.
+func (a *Archiver) ShouldChunk(size int64) bool {
+	return a.chunkThreshold > 0 && size >= a.chunkThreshold
+}
+
+// PushFileChunked schedules upload of the file at path as content-defined
+// chunks. Each chunk is pushed as an independent item so only the chunks
+// missing on the server are uploaded. The file content itself is not pushed.
+// Smaller priority value means earlier processing.
+//
+// The returned Item is signaled once the file is split into chunks. Its
+// Digest() is then the hash of the whole file and Chunks() lists the chunks
+// to record in an isolated.Chunked file.
+func (a *Archiver) PushFileChunked(displayName, path string, priority int64) *Item {
+	s := &Item{DisplayName: displayName, path: path, priority: priority}
+	s.wgHashed.Add(1)
+	go func() {
+		defer s.wgHashed.Done()
+		if err := a.CancelationReason(); err != nil {
+			s.SetErr(err)
+			return
+		}
+		a.chunkSem <- struct{}{}
+		chunks, d, err := a.splitFile(displayName, path)
+		<-a.chunkSem
+		if err != nil {
+			a.Cancel(err)
+			s.SetErr(err)
+			return
+		}
+		offset := int64(0)
+		for index, c := range chunks {
+			if a.markChunkPushed(c.Digest) {
+				name := fmt.Sprintf("%s#%d", displayName, index)
+				if a.Push(name, newSectionSource(path, offset, c.Size), priority) == nil {
+					s.SetErr(errors.New("archiver was closed"))
+					return
+				}
+			}
+			offset += c.Size
+		}
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.digestItem = d
+		s.chunks = chunks
+	}()
+	return s
+}
+
+// splitFile splits the file at path into chunks.
+func (a *Archiver) splitFile(displayName, path string) ([]isolated.Chunk, isolateservice.HandlersEndpointsV1Digest, error) {
+	end := tracer.Span(a, "split", tracer.Args{"name": displayName})
+	f, err := os.Open(path)
+	if err != nil {
+		end(tracer.Args{"err": err})
+		return nil, isolateservice.HandlersEndpointsV1Digest{}, fmt.Errorf("open(%s) failed: %s", displayName, err)
+	}
+	defer f.Close()
+	chunks, digest, size, err := a.chunker.split(f)
+	if err != nil {
+		end(tracer.Args{"err": err})
+		return nil, isolateservice.HandlersEndpointsV1Digest{}, fmt.Errorf("read(%s) failed: %s", displayName, err)
+	}
+	end(tracer.Args{"size": float64(size), "chunks": len(chunks)})
+	logging.Debugf(a.ctx, "Split %s in %d chunks", displayName, len(chunks))
+	return chunks, isolateservice.HandlersEndpointsV1Digest{Digest: string(digest), Size: size}, nil
+}
+
+// markChunkPushed returns true if the chunk wasn't pushed yet by this
+// Archiver.
+func (a *Archiver) markChunkPushed(d isolated.HexDigest) bool {
+	a.chunksLock.Lock()
+	defer a.chunksLock.Unlock()
+	if a.chunksPushed[d] {
+		return false
+	}
+	a.chunksPushed[d] = true
+	return true
+}
+
 // Stats returns a copy of the statistics.
 func (a *Archiver) Stats() *Stats {
 	a.statsLock.Lock()
@@ -89,6 +89,53 @@ func TestArchiverFile(t *testing.T) {
 	})
 }
 
+func TestArchiverFileChunked(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`An archiver should only push the chunks of a chunked file.`, t, func() {
+		server := isolatedfake.New()
+		ts := httptest.NewServer(server)
+		defer ts.Close()
+		a := New(ctx, isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil), nil)
+		a.chunker = newChunker(256, 1024, 4096)
+
+		tmpDir, err := ioutil.TempDir("", "archiver")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+
+		data := randomBytes(1, 64*1024)
+		fileName := filepath.Join(tmpDir, "big")
+		So(ioutil.WriteFile(fileName, data, 0600), ShouldBeNil)
+		// Same content, already seen: no additional chunk is pushed.
+		otherName := filepath.Join(tmpDir, "other")
+		So(ioutil.WriteFile(otherName, data, 0600), ShouldBeNil)
+
+		item := a.PushFileChunked("big", fileName, 0)
+		item.WaitForHashed()
+		So(item.Error(), ShouldBeNil)
+		other := a.PushFileChunked("other", otherName, 0)
+		other.WaitForHashed()
+		So(other.Error(), ShouldBeNil)
+		So(a.Close(), ShouldBeNil)
+
+		So(item.Digest(), ShouldResemble, isolated.HashBytes(data))
+		chunks := item.Chunks()
+		So(other.Chunks(), ShouldResemble, chunks)
+		contents := server.Contents()
+		// The whole file is not pushed, only its chunks.
+		_, ok := contents[isolated.HashBytes(data)]
+		So(ok, ShouldBeFalse)
+		var reassembled []byte
+		for _, c := range chunks {
+			reassembled = append(reassembled, contents[c.Digest]...)
+		}
+		So(reassembled, ShouldResemble, data)
+		So(a.Stats().TotalBytesPushed(), ShouldEqual, units.Size(len(data)))
+		So(server.Error(), ShouldBeNil)
+	})
+}
+
 func TestArchiverFileHit(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
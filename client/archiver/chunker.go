@@ -0,0 +1,125 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archiver
+
+import (
+	"io"
+	"os"
+
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+)
+
+const (
+	// Default chunking parameters. The average chunk size is roughly
+	// minChunkSize + avgChunkSize.
+	minChunkSize = 256 * 1024
+	avgChunkSize = 1024 * 1024
+	maxChunkSize = 4 * 1024 * 1024
+)
+
+// gear is the table used by the rolling hash. It must never change, otherwise
+// chunk boundaries, and thus chunk digests, change for every file.
+var gear [256]uint64
+
+func init() {
+	// splitmix64 with a fixed seed.
+	x := uint64(0x6c75636963686e6b)
+	for i := range gear {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// chunker splits content into content-defined chunks.
+//
+// It uses a gear based rolling hash: a chunk boundary is declared after a byte
+// when the top bits of the hash are all zero. The hash only depends on the last
+// 64 bytes so inserting or removing data in a file only moves the boundaries
+// around the modified region, and the other chunks keep the same digest.
+type chunker struct {
+	minSize int64
+	maxSize int64
+	mask    uint64
+}
+
+// newChunker returns a chunker. avgSize must be a power of two.
+func newChunker(minSize, avgSize, maxSize int64) *chunker {
+	bits := uint(0)
+	for s := avgSize; s > 1; s >>= 1 {
+		bits++
+	}
+	return &chunker{
+		minSize: minSize,
+		maxSize: maxSize,
+		mask:    ^uint64(0) << (64 - bits),
+	}
+}
+
+// split reads r until EOF and returns the chunks, along with the digest and
+// the size of the whole content.
+func (c *chunker) split(r io.Reader) ([]isolated.Chunk, isolated.HexDigest, int64, error) {
+	var chunks []isolated.Chunk
+	whole := isolated.GetHash()
+	h := isolated.GetHash()
+	total := int64(0)
+	size := int64(0)
+	roll := uint64(0)
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := r.Read(buf)
+		data := buf[:n]
+		_, _ = whole.Write(data)
+		total += int64(n)
+		start := 0
+		for i, b := range data {
+			roll = (roll << 1) + gear[b]
+			size++
+			if size >= c.maxSize || (size >= c.minSize && roll&c.mask == 0) {
+				_, _ = h.Write(data[start : i+1])
+				chunks = append(chunks, isolated.Chunk{Digest: isolated.Sum(h), Size: size})
+				h.Reset()
+				size = 0
+				start = i + 1
+			}
+		}
+		_, _ = h.Write(data[start:])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+	if size != 0 || len(chunks) == 0 {
+		chunks = append(chunks, isolated.Chunk{Digest: isolated.Sum(h), Size: size})
+	}
+	return chunks, isolated.Sum(whole), total, nil
+}
+
+// sectionReadCloser reads a section of a file.
+type sectionReadCloser struct {
+	*io.SectionReader
+	f *os.File
+}
+
+func (s *sectionReadCloser) Close() error {
+	return s.f.Close()
+}
+
+// newSectionSource returns an isolatedclient.Source reading size bytes from
+// path starting at offset.
+func newSectionSource(path string, offset, size int64) isolatedclient.Source {
+	return func() (io.ReadCloser, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		return &sectionReadCloser{io.NewSectionReader(f, offset, size), f}, nil
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archiver
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/luci/luci-go/common/isolated"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func randomBytes(seed int64, size int) []byte {
+	out := make([]byte, size)
+	r := rand.New(rand.NewSource(seed))
+	for i := range out {
+		out[i] = byte(r.Intn(256))
+	}
+	return out
+}
+
+func chunkSet(chunks []isolated.Chunk) map[isolated.HexDigest]bool {
+	out := map[isolated.HexDigest]bool{}
+	for _, c := range chunks {
+		out[c.Digest] = true
+	}
+	return out
+}
+
+func TestChunker(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A chunker should split content along content-defined boundaries.`, t, func() {
+		c := newChunker(256, 1024, 4096)
+		data := randomBytes(0, 256*1024)
+
+		chunks, digest, size, err := c.split(bytes.NewReader(data))
+		So(err, ShouldBeNil)
+		So(size, ShouldEqual, len(data))
+		So(digest, ShouldEqual, isolated.HashBytes(data))
+		So(len(chunks), ShouldBeGreaterThan, 1)
+
+		// The chunks concatenate back to the content.
+		offset := int64(0)
+		for i, chunk := range chunks {
+			So(chunk.Size, ShouldBeLessThanOrEqualTo, 4096)
+			if i != len(chunks)-1 {
+				So(chunk.Size, ShouldBeGreaterThanOrEqualTo, 256)
+			}
+			So(chunk.Digest, ShouldEqual, isolated.HashBytes(data[offset:offset+chunk.Size]))
+			offset += chunk.Size
+		}
+		So(offset, ShouldEqual, len(data))
+
+		Convey(`Splitting is deterministic.`, func() {
+			again, _, _, err := c.split(bytes.NewReader(data))
+			So(err, ShouldBeNil)
+			So(again, ShouldResemble, chunks)
+		})
+
+		Convey(`An insertion only changes nearby chunks.`, func() {
+			modified := append(append(append([]byte{}, data[:100000]...), []byte("inserted")...), data[100000:]...)
+			other, _, _, err := c.split(bytes.NewReader(modified))
+			So(err, ShouldBeNil)
+			orig := chunkSet(chunks)
+			changed := 0
+			for _, chunk := range other {
+				if !orig[chunk.Digest] {
+					changed++
+				}
+			}
+			So(changed, ShouldBeBetweenOrEqual, 1, 3)
+		})
+	})
+
+	Convey(`Empty content is a single empty chunk.`, t, func() {
+		chunks, digest, size, err := newChunker(256, 1024, 4096).split(bytes.NewReader(nil))
+		So(err, ShouldBeNil)
+		So(size, ShouldEqual, 0)
+		So(chunks, ShouldResemble, []isolated.Chunk{{Digest: digest, Size: 0}})
+	})
+}
@@ -156,7 +156,11 @@ func PushDirectory(a *Archiver, root string, relDir string, blacklist []string)
 			i.Files[item.relPath] = isolated.SymLink(l)
 		} else {
 			i.Files[item.relPath] = isolated.BasicFile("", int(mode.Perm()), item.info.Size())
-			items = append(items, a.PushFile(item.relPath, item.fullPath, -item.info.Size()))
+			if a.ShouldChunk(item.info.Size()) {
+				items = append(items, a.PushFileChunked(item.relPath, item.fullPath, -item.info.Size()))
+			} else {
+				items = append(items, a.PushFile(item.relPath, item.fullPath, -item.info.Size()))
+			}
 		}
 	}
 	if s.Error() != nil {
@@ -177,6 +181,10 @@ func PushDirectory(a *Archiver, root string, relDir string, blacklist []string)
 			name := item.DisplayName
 			d := i.Files[name]
 			d.Digest = item.Digest()
+			if chunks := item.Chunks(); chunks != nil {
+				d.Type = isolated.Chunked
+				d.Chunks = chunks
+			}
 			i.Files[name] = d
 		}
 		if err == nil {
@@ -7,9 +7,19 @@ package main
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
-	"github.com/luci/luci-go/common/auth"
 	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/client/downloader"
+	"github.com/luci/luci-go/client/internal/common"
+	"github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/data/caching/cache"
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
 )
 
 func cmdDownload(authOpts auth.Options) *subcommands.Command {
@@ -22,6 +32,12 @@ Files are referenced by their hash`,
 		CommandRun: func() subcommands.CommandRun {
 			c := downloadRun{}
 			c.commonFlags.Init(authOpts)
+			c.Flags.StringVar(&c.isolated, "isolated", "", "Hash of the .isolated file to download")
+			c.Flags.StringVar(&c.outputDir, "output-dir", "", "Directory to put the files in")
+			c.Flags.StringVar(&c.cacheDir, "cache-dir", "", "Directory to use as a local cache, if any")
+			c.Flags.Int64Var(&c.maxCacheSize, "max-cache-size", 20*1024*1024*1024, "Trim the cache if it gets larger than this value, in bytes")
+			c.Flags.IntVar(&c.maxCacheItems, "max-cache-items", 100000, "Maximum number of items to keep in the cache")
+			c.Flags.IntVar(&c.jobs, "jobs", 8, "Maximum number of concurrent downloads")
 			return &c
 		},
 	}
@@ -29,6 +45,12 @@ Files are referenced by their hash`,
 
 type downloadRun struct {
 	commonFlags
+	isolated      string
+	outputDir     string
+	cacheDir      string
+	maxCacheSize  int64
+	maxCacheItems int
+	jobs          int
 }
 
 func (c *downloadRun) Parse(a subcommands.Application, args []string) error {
@@ -38,11 +60,65 @@ func (c *downloadRun) Parse(a subcommands.Application, args []string) error {
 	if len(args) != 0 {
 		return errors.New("position arguments not expected")
 	}
+	if !isolated.HexDigest(c.isolated).Validate() {
+		return errors.New("-isolated must be a valid hash")
+	}
+	if c.outputDir == "" {
+		return errors.New("-output-dir must be specified")
+	}
+	if c.jobs <= 0 {
+		return errors.New("-jobs must be positive")
+	}
+	if c.cacheDir != "" {
+		var err error
+		if c.cacheDir, err = filepath.Abs(c.cacheDir); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (c *downloadRun) main(a subcommands.Application, args []string) error {
-	return errors.New("TODO")
+	start := time.Now()
+	authClient, err := c.createAuthClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := c.defaultFlags.MakeLoggingContext(os.Stderr)
+	var diskCache cache.Cache
+	if c.cacheDir != "" {
+		if err := os.MkdirAll(c.cacheDir, 0777); err != nil {
+			return err
+		}
+		policies := cache.Policies{MaxSize: units.Size(c.maxCacheSize), MaxItems: c.maxCacheItems}
+		// An error loading the previous cache state is not fatal, the cache is
+		// still usable.
+		if diskCache, err = cache.NewDisk(policies, c.cacheDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load the cache state: %s\n", err)
+		}
+		defer func() {
+			if err := diskCache.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to save the cache state: %s\n", err)
+			}
+		}()
+	}
+
+	client := isolatedclient.New(nil, authClient, c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace, nil, nil)
+	d := downloader.New(ctx, client, diskCache, c.jobs)
+	common.CancelOnCtrlC(d)
+	_, err = d.FetchIsolated(isolated.HexDigest(c.isolated), c.outputDir)
+	if err2 := d.Close(); err == nil {
+		err = err2
+	}
+	if !c.defaultFlags.Quiet {
+		duration := time.Since(start)
+		stats := d.Stats()
+		fmt.Fprintf(os.Stderr, "Hits    : %5d (%s)\n", stats.TotalHits(), stats.TotalBytesHits())
+		fmt.Fprintf(os.Stderr, "Misses  : %5d (%s)\n", stats.TotalMisses(), stats.TotalBytesDownloaded())
+		fmt.Fprintf(os.Stderr, "Duration: %s\n", units.Round(duration, time.Millisecond))
+	}
+	return err
 }
 
 func (c *downloadRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
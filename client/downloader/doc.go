@@ -0,0 +1,7 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package downloader implements the logic to efficiently fetch a .isolated
+// tree from an isolated server to the local disk.
+package downloader
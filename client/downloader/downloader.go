@@ -0,0 +1,488 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/internal/common"
+	"github.com/luci/luci-go/common/api/isolate/isolateservice/v1"
+	"github.com/luci/luci-go/common/data/caching/cache"
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/runtime/tracer"
+)
+
+// Stats is statistics from the Downloader.
+type Stats struct {
+	Hits       []units.Size // Bytes; each item is immutable.
+	Downloaded []units.Size // Misses; each item is immutable.
+}
+
+// TotalHits is the number of files retrieved from the local cache.
+func (s *Stats) TotalHits() int {
+	return len(s.Hits)
+}
+
+// TotalBytesHits is the number of bytes not downloaded due to local cache
+// hits.
+func (s *Stats) TotalBytesHits() units.Size {
+	out := units.Size(0)
+	for _, i := range s.Hits {
+		out += i
+	}
+	return out
+}
+
+// TotalMisses returns the number of files fetched from the server.
+func (s *Stats) TotalMisses() int {
+	return len(s.Downloaded)
+}
+
+// TotalBytesDownloaded returns the sum of bytes fetched from the server.
+func (s *Stats) TotalBytesDownloaded() units.Size {
+	out := units.Size(0)
+	for _, i := range s.Downloaded {
+		out += i
+	}
+	return out
+}
+
+func (s *Stats) deepCopy() *Stats {
+	// Only need to copy the slice, not the items themselves.
+	return &Stats{s.Hits, s.Downloaded}
+}
+
+// Downloader is an high level interface to fetch a .isolated tree with an
+// isolatedclient.Client.
+//
+// The .isolated files are fetched first, then all the files they reference
+// are fetched concurrently.
+type Downloader struct {
+	// Immutable.
+	ctx               context.Context
+	c                 *isolatedclient.Client
+	cache             cache.Cache
+	maxConcurrentJobs int
+	canceler          common.Canceler
+
+	// Mutable.
+	statsLock sync.Mutex
+	stats     Stats
+}
+
+// New returns a Downloader instance.
+//
+// If not nil, files are retrieved from cache when possible and added to it
+// once fetched. The Downloader does not close cache.
+//
+// ctx will be used for logging.
+func New(ctx context.Context, c *isolatedclient.Client, cache cache.Cache, maxConcurrentJobs int) *Downloader {
+	if maxConcurrentJobs <= 0 {
+		maxConcurrentJobs = 8
+	}
+	d := &Downloader{
+		ctx:               ctx,
+		c:                 c,
+		cache:             cache,
+		maxConcurrentJobs: maxConcurrentJobs,
+		canceler:          common.NewCanceler(),
+	}
+	tracer.NewPID(d, "downloader")
+	return d
+}
+
+// Close releases the resources held by the Downloader. If an error occured
+// during processing, it is returned.
+func (d *Downloader) Close() error {
+	_ = d.canceler.Close()
+	return d.CancelationReason()
+}
+
+// Cancel implements common.Canceler
+func (d *Downloader) Cancel(reason error) {
+	tracer.Instant(d, "cancel", tracer.Thread, tracer.Args{"reason": reason})
+	d.canceler.Cancel(reason)
+}
+
+// CancelationReason implements common.Canceler
+func (d *Downloader) CancelationReason() error {
+	return d.canceler.CancelationReason()
+}
+
+// Channel implements common.Canceler
+func (d *Downloader) Channel() <-chan error {
+	return d.canceler.Channel()
+}
+
+// Stats returns a copy of the statistics.
+func (d *Downloader) Stats() *Stats {
+	d.statsLock.Lock()
+	defer d.statsLock.Unlock()
+	return d.stats.deepCopy()
+}
+
+// FetchIsolated fetches the .isolated file root, all the .isolated files it
+// includes and all the files they reference into outputDir.
+//
+// When a file is listed in more than one .isolated file, the one closest to
+// root wins, in the order of the includes.
+//
+// It returns the root .isolated, as read from the server.
+func (d *Downloader) FetchIsolated(root isolated.HexDigest, outputDir string) (*isolated.Isolated, error) {
+	end := tracer.Span(d, "FetchIsolated", tracer.Args{"root": root})
+	files := map[string]isolated.File{}
+	rootIsolated, err := d.fetchTree(root, files)
+	end(tracer.Args{"files": len(files), "err": err})
+	if err != nil {
+		return nil, err
+	}
+	readOnly := rootIsolated.ReadOnly != nil && *rootIsolated.ReadOnly != isolated.Writeable
+
+	if err := os.MkdirAll(outputDir, 0777); err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	pool := common.NewGoroutinePool(d.maxConcurrentJobs, d.canceler)
+	for _, p := range paths {
+		relPath, f := p, files[p]
+		pool.Schedule(func() {
+			if err := d.fetchFile(outputDir, relPath, f, readOnly); err != nil {
+				d.Cancel(fmt.Errorf("%s: %s", relPath, err))
+			}
+		}, nil)
+	}
+	if err := pool.Wait(); err != nil {
+		return nil, err
+	}
+	return rootIsolated, nil
+}
+
+// fetchTree fetches the .isolated file digest and recursively its includes.
+//
+// The files referenced are added to files unless already present.
+func (d *Downloader) fetchTree(digest isolated.HexDigest, files map[string]isolated.File) (*isolated.Isolated, error) {
+	seen := map[isolated.HexDigest]bool{}
+	var walk func(digest isolated.HexDigest) (*isolated.Isolated, error)
+	walk = func(digest isolated.HexDigest) (*isolated.Isolated, error) {
+		if seen[digest] {
+			return nil, fmt.Errorf("%s is included more than once", digest)
+		}
+		seen[digest] = true
+		if err := d.CancelationReason(); err != nil {
+			return nil, err
+		}
+		i, err := d.fetchIsolated(digest)
+		if err != nil {
+			return nil, err
+		}
+		for p, f := range i.Files {
+			if _, ok := files[p]; !ok {
+				files[p] = f
+			}
+		}
+		for _, include := range i.Includes {
+			if _, err := walk(include); err != nil {
+				return nil, err
+			}
+		}
+		return i, nil
+	}
+	return walk(digest)
+}
+
+// fetchIsolated fetches and decodes a single .isolated file.
+func (d *Downloader) fetchIsolated(digest isolated.HexDigest) (*isolated.Isolated, error) {
+	if !digest.Validate() {
+		return nil, fmt.Errorf("invalid digest %q", digest)
+	}
+	buf := &memWriteSeeker{}
+	if err := d.fetch(digest, buf); err != nil {
+		return nil, err
+	}
+	if h := isolated.HashBytes(buf.data); h != digest {
+		return nil, fmt.Errorf("invalid hash for %s: got %s", digest, h)
+	}
+	i := &isolated.Isolated{}
+	if err := json.Unmarshal(buf.data, i); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %s", digest, err)
+	}
+	if i.Algo != isolated.Algorithm {
+		return nil, fmt.Errorf("%s: unsupported algo %q", digest, i.Algo)
+	}
+	logging.Debugf(d.ctx, "Fetched %s: %d files, %d includes", digest, len(i.Files), len(i.Includes))
+	return i, nil
+}
+
+// fetchFile materializes a single isolated.File at relPath in outputDir.
+func (d *Downloader) fetchFile(outputDir, relPath string, f isolated.File, readOnly bool) error {
+	if rel := filepath.Clean(relPath); filepath.IsAbs(rel) || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.New("path escapes the output directory")
+	}
+	dest := filepath.Join(outputDir, relPath)
+	if err := os.MkdirAll(filepath.Dir(dest), 0777); err != nil {
+		return err
+	}
+	if err := os.Remove(dest); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if f.Link != nil {
+		return os.Symlink(*f.Link, dest)
+	}
+	if f.Type != "" && f.Type != isolated.Basic && f.Type != isolated.Chunked {
+		return fmt.Errorf("unsupported file type %q", f.Type)
+	}
+	if !f.Digest.Validate() {
+		return fmt.Errorf("invalid digest %q", f.Digest)
+	}
+
+	mode := os.FileMode(0666)
+	if f.Mode != nil {
+		mode = os.FileMode(*f.Mode).Perm()
+	}
+	if readOnly {
+		mode &^= 0222
+	}
+
+	if d.cache != nil && d.cache.Touch(f.Digest) {
+		switch err := d.fetchFromCache(f.Digest, dest, mode); err {
+		case nil:
+			d.statsLock.Lock()
+			d.stats.Hits = append(d.stats.Hits, sizeOf(f))
+			d.statsLock.Unlock()
+			return nil
+		case errCorruptedCacheEntry:
+			// The entry was evicted, fetch the file again.
+			logging.Warningf(d.ctx, "Cached %s is corrupted, fetching it again", f.Digest)
+		default:
+			return err
+		}
+	}
+
+	if err := d.fetchToFile(f, dest); err != nil {
+		_ = os.Remove(dest)
+		return err
+	}
+	d.statsLock.Lock()
+	d.stats.Downloaded = append(d.stats.Downloaded, sizeOf(f))
+	d.statsLock.Unlock()
+	return os.Chmod(dest, mode)
+}
+
+// errCorruptedCacheEntry is returned by fetchFromCache when the content of the
+// cache entry doesn't match its digest.
+var errCorruptedCacheEntry = errors.New("corrupted cache entry")
+
+// fetchFromCache materializes the cached digest at dest with the given mode.
+//
+// The disk cache hardlinks its entries, and a hardlink shares its content and
+// mode with the cache entry and with every other output linked to it. So read
+// only outputs are linked if the entry already has the requested mode, and
+// every other output gets a copy of the content.
+//
+// The content is verified against digest either way. If it doesn't match, the
+// cache entry is evicted and errCorruptedCacheEntry is returned.
+func (d *Downloader) fetchFromCache(digest isolated.HexDigest, dest string, mode os.FileMode) error {
+	if mode&0222 == 0 {
+		if err := d.cache.Hardlink(digest, dest, mode); err != nil {
+			return err
+		}
+		fi, err := os.Lstat(dest)
+		if err != nil {
+			return err
+		}
+		if fi.Mode().Perm() == mode {
+			return d.verifyCached(digest, dest)
+		}
+		if err := os.Remove(dest); err != nil {
+			return err
+		}
+	}
+
+	src, err := d.cache.Read(digest)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	h := isolated.GetHash()
+	_, err = io.Copy(out, io.TeeReader(src, h))
+	if err2 := out.Close(); err == nil {
+		err = err2
+	}
+	if err == nil && isolated.Sum(h) != digest {
+		d.cache.Evict(digest)
+		err = errCorruptedCacheEntry
+	}
+	if err != nil {
+		_ = os.Remove(dest)
+		return err
+	}
+	// The umask may have trimmed the mode, dest is not shared with the cache.
+	return os.Chmod(dest, mode)
+}
+
+// verifyCached verifies that dest, linked to the cache entry for digest, has
+// the expected content.
+//
+// If it doesn't, dest is removed, the cache entry is evicted and
+// errCorruptedCacheEntry is returned.
+func (d *Downloader) verifyCached(digest isolated.HexDigest, dest string) error {
+	f, err := os.Open(dest)
+	if err != nil {
+		return err
+	}
+	h, err := isolated.Hash(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if h == digest {
+		return nil
+	}
+	if err := os.Remove(dest); err != nil {
+		return err
+	}
+	d.cache.Evict(digest)
+	return errCorruptedCacheEntry
+}
+
+// fetchToFile fetches the content of f into the file dest and verifies its
+// hash. Chunked files are reassembled from their chunks.
+//
+// The content is then added to the cache if there is one.
+func (d *Downloader) fetchToFile(f isolated.File, dest string) error {
+	digest := f.Digest
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if f.Type == isolated.Chunked {
+		err = d.fetchChunks(f.Chunks, out)
+	} else {
+		err = d.fetch(digest, out)
+	}
+	if err2 := out.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(dest)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	h, err := isolated.Hash(src)
+	if err != nil {
+		return err
+	}
+	if h != digest {
+		return fmt.Errorf("invalid hash: got %s", h)
+	}
+	if d.cache != nil {
+		// Failing to populate the cache is not fatal, the file is already in
+		// place.
+		if _, err := src.Seek(0, os.SEEK_SET); err != nil {
+			return err
+		}
+		if err := d.cache.Add(digest, src); err != nil {
+			logging.Warningf(d.ctx, "Failed to add %s to the cache: %s", digest, err)
+		}
+	}
+	return nil
+}
+
+// fetchChunks fetches chunks in order and appends them to out.
+//
+// Each chunk is verified before being written.
+func (d *Downloader) fetchChunks(chunks []isolated.Chunk, out io.Writer) error {
+	for _, c := range chunks {
+		if !c.Digest.Validate() {
+			return fmt.Errorf("invalid chunk digest %q", c.Digest)
+		}
+		buf := &memWriteSeeker{}
+		if err := d.fetch(c.Digest, buf); err != nil {
+			return err
+		}
+		if h := isolated.HashBytes(buf.data); h != c.Digest || int64(len(buf.data)) != c.Size {
+			return fmt.Errorf("invalid chunk %s: got %s (%d bytes)", c.Digest, h, len(buf.data))
+		}
+		if _, err := out.Write(buf.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Downloader) fetch(digest isolated.HexDigest, dest io.WriteSeeker) (err error) {
+	end := tracer.Span(d, "fetch", tracer.Args{"digest": digest})
+	defer func() { end(tracer.Args{"err": err}) }()
+	item := &isolateservice.HandlersEndpointsV1Digest{Digest: string(digest)}
+	return d.c.Fetch(d.ctx, item, dest)
+}
+
+func sizeOf(f isolated.File) units.Size {
+	if f.Size == nil {
+		return 0
+	}
+	return units.Size(*f.Size)
+}
+
+// memWriteSeeker is an in-memory io.WriteSeeker.
+type memWriteSeeker struct {
+	data []byte
+	pos  int
+}
+
+func (m *memWriteSeeker) Write(p []byte) (int, error) {
+	if end := m.pos + len(p); end > len(m.data) {
+		m.data = append(m.data, make([]byte, end-len(m.data))...)
+	}
+	copy(m.data[m.pos:], p)
+	m.pos += len(p)
+	return len(p), nil
+}
+
+func (m *memWriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case os.SEEK_SET:
+		pos = offset
+	case os.SEEK_CUR:
+		pos = int64(m.pos) + offset
+	case os.SEEK_END:
+		pos = int64(len(m.data)) + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if pos < 0 {
+		return 0, errors.New("negative position")
+	}
+	m.pos = int(pos)
+	return pos, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/data/caching/cache"
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+	"github.com/luci/luci-go/common/isolatedclient/isolatedfake"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func init() {
+	log.SetOutput(ioutil.Discard)
+}
+
+func inject(server isolatedfake.IsolatedFake, i *isolated.Isolated) isolated.HexDigest {
+	raw, err := json.Marshal(i)
+	if err != nil {
+		panic(err)
+	}
+	server.Inject(raw)
+	return isolated.HashBytes(raw)
+}
+
+func TestDownloaderFetchIsolated(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`A downloader should fetch a .isolated tree.`, t, func() {
+		server := isolatedfake.New()
+		ts := httptest.NewServer(server)
+		defer ts.Close()
+		client := isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil)
+
+		td, err := ioutil.TempDir("", "downloader")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(td)
+		out := filepath.Join(td, "out")
+
+		foo := []byte("foo")
+		bar := []byte("bar")
+		server.Inject(foo)
+		server.Inject(bar)
+		included := isolated.New()
+		included.Files["a/bar"] = isolated.BasicFile(isolated.HashBytes(bar), 0600, 3)
+		included.Files["foo"] = isolated.BasicFile(isolated.HashBytes(bar), 0600, 3)
+		includedDigest := inject(server, included)
+		root := isolated.New()
+		root.Files["foo"] = isolated.BasicFile(isolated.HashBytes(foo), 0700, 3)
+		root.Files["link"] = isolated.SymLink("a/bar")
+		root.Includes = isolated.HexDigests{includedDigest}
+		rootDigest := inject(server, root)
+
+		Convey(`Without a cache.`, func() {
+			d := New(ctx, client, nil, 2)
+			i, err := d.FetchIsolated(rootDigest, out)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			So(i.Includes, ShouldResemble, isolated.HexDigests{includedDigest})
+
+			// The root .isolated wins over its includes.
+			content, err := ioutil.ReadFile(filepath.Join(out, "foo"))
+			So(err, ShouldBeNil)
+			So(content, ShouldResemble, foo)
+			content, err = ioutil.ReadFile(filepath.Join(out, "a", "bar"))
+			So(err, ShouldBeNil)
+			So(content, ShouldResemble, bar)
+			if runtime.GOOS != "windows" {
+				fi, err := os.Stat(filepath.Join(out, "foo"))
+				So(err, ShouldBeNil)
+				So(fi.Mode().Perm(), ShouldEqual, os.FileMode(0700))
+				l, err := os.Readlink(filepath.Join(out, "link"))
+				So(err, ShouldBeNil)
+				So(l, ShouldEqual, "a/bar")
+			}
+
+			stats := d.Stats()
+			So(stats.TotalHits(), ShouldEqual, 0)
+			So(stats.TotalMisses(), ShouldEqual, 2)
+			So(stats.TotalBytesDownloaded(), ShouldEqual, units.Size(6))
+			So(server.Error(), ShouldBeNil)
+		})
+
+		Convey(`With a cache.`, func() {
+			c := cache.NewMemory(cache.Policies{MaxSize: 1024, MaxItems: 10})
+			defer c.Close()
+
+			d := New(ctx, client, c, 2)
+			_, err := d.FetchIsolated(rootDigest, out)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			So(d.Stats().TotalMisses(), ShouldEqual, 2)
+			So(len(c.Keys()), ShouldEqual, 2)
+
+			// The second time, everything comes from the cache.
+			d = New(ctx, client, c, 2)
+			_, err = d.FetchIsolated(rootDigest, filepath.Join(td, "out2"))
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			stats := d.Stats()
+			So(stats.TotalHits(), ShouldEqual, 2)
+			So(stats.TotalBytesHits(), ShouldEqual, units.Size(6))
+			So(stats.TotalMisses(), ShouldEqual, 0)
+			content, err := ioutil.ReadFile(filepath.Join(td, "out2", "foo"))
+			So(err, ShouldBeNil)
+			So(content, ShouldResemble, foo)
+		})
+
+		Convey(`With a disk cache.`, func() {
+			cacheDir := filepath.Join(td, "cache")
+			So(os.Mkdir(cacheDir, 0700), ShouldBeNil)
+			c, err := cache.NewDisk(cache.Policies{MaxSize: 1024, MaxItems: 10}, cacheDir)
+			So(err, ShouldBeNil)
+			defer c.Close()
+
+			d := New(ctx, client, c, 2)
+			_, err = d.FetchIsolated(rootDigest, out)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			So(d.Stats().TotalMisses(), ShouldEqual, 2)
+
+			cached, err := os.Stat(filepath.Join(cacheDir, string(isolated.HashBytes(foo))))
+			So(err, ShouldBeNil)
+
+			// Fetch the same files read only; this must not change the mode of the
+			// cache entries nor of the files fetched before.
+			readOnly := isolated.FilesReadOnly
+			root.ReadOnly = &readOnly
+			rootDigest = inject(server, root)
+			out2 := filepath.Join(td, "out2")
+			d = New(ctx, client, c, 2)
+			_, err = d.FetchIsolated(rootDigest, out2)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			So(d.Stats().TotalHits(), ShouldEqual, 2)
+
+			content, err := ioutil.ReadFile(filepath.Join(out2, "foo"))
+			So(err, ShouldBeNil)
+			So(content, ShouldResemble, foo)
+			if runtime.GOOS != "windows" {
+				fi, err := os.Stat(filepath.Join(out, "foo"))
+				So(err, ShouldBeNil)
+				So(fi.Mode().Perm(), ShouldEqual, os.FileMode(0700))
+				fi, err = os.Stat(filepath.Join(out2, "foo"))
+				So(err, ShouldBeNil)
+				So(fi.Mode().Perm(), ShouldEqual, os.FileMode(0500))
+				fi, err = os.Stat(filepath.Join(out2, "a", "bar"))
+				So(err, ShouldBeNil)
+				So(fi.Mode().Perm(), ShouldEqual, os.FileMode(0400))
+				fi, err = os.Stat(filepath.Join(cacheDir, string(isolated.HashBytes(foo))))
+				So(err, ShouldBeNil)
+				So(fi.Mode().Perm(), ShouldEqual, cached.Mode().Perm())
+			}
+		})
+
+		Convey(`With a disk cache, outputs don't alias the cache.`, func() {
+			cacheDir := filepath.Join(td, "cache")
+			So(os.Mkdir(cacheDir, 0700), ShouldBeNil)
+			c, err := cache.NewDisk(cache.Policies{MaxSize: 1024, MaxItems: 10}, cacheDir)
+			So(err, ShouldBeNil)
+			defer c.Close()
+
+			d := New(ctx, client, c, 2)
+			_, err = d.FetchIsolated(rootDigest, out)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+
+			// Fetch foo again writable, with the mode of its cache entry.
+			cachedPath := filepath.Join(cacheDir, string(isolated.HashBytes(foo)))
+			cached, err := os.Stat(cachedPath)
+			So(err, ShouldBeNil)
+			root.Files["foo"] = isolated.BasicFile(isolated.HashBytes(foo), int(cached.Mode().Perm()), 3)
+			rootDigest = inject(server, root)
+
+			Convey(`Writing to a fetched file doesn't change the cache entry.`, func() {
+				out2 := filepath.Join(td, "out2")
+				d = New(ctx, client, c, 2)
+				_, err = d.FetchIsolated(rootDigest, out2)
+				So(err, ShouldBeNil)
+				So(d.Close(), ShouldBeNil)
+				So(d.Stats().TotalHits(), ShouldEqual, 2)
+
+				So(ioutil.WriteFile(filepath.Join(out2, "foo"), []byte("modified"), 0600), ShouldBeNil)
+				content, err := ioutil.ReadFile(cachedPath)
+				So(err, ShouldBeNil)
+				So(content, ShouldResemble, foo)
+			})
+
+			Convey(`A corrupted cache entry is fetched again.`, func() {
+				So(os.Chmod(cachedPath, 0600), ShouldBeNil)
+				So(ioutil.WriteFile(cachedPath, []byte("bad"), 0600), ShouldBeNil)
+
+				out2 := filepath.Join(td, "out2")
+				d = New(ctx, client, c, 2)
+				_, err = d.FetchIsolated(rootDigest, out2)
+				So(err, ShouldBeNil)
+				So(d.Close(), ShouldBeNil)
+				So(d.Stats().TotalHits(), ShouldEqual, 1)
+				So(d.Stats().TotalMisses(), ShouldEqual, 1)
+
+				content, err := ioutil.ReadFile(filepath.Join(out2, "foo"))
+				So(err, ShouldBeNil)
+				So(content, ShouldResemble, foo)
+				content, err = ioutil.ReadFile(cachedPath)
+				So(err, ShouldBeNil)
+				So(content, ShouldResemble, foo)
+			})
+		})
+
+		Convey(`Read only.`, func() {
+			readOnly := isolated.FilesReadOnly
+			root.ReadOnly = &readOnly
+			rootDigest = inject(server, root)
+
+			d := New(ctx, client, nil, 2)
+			_, err := d.FetchIsolated(rootDigest, out)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			if runtime.GOOS != "windows" {
+				fi, err := os.Stat(filepath.Join(out, "foo"))
+				So(err, ShouldBeNil)
+				So(fi.Mode().Perm(), ShouldEqual, os.FileMode(0500))
+			}
+		})
+
+		Convey(`Chunked file.`, func() {
+			content := []byte("foobarbaz")
+			server.Inject([]byte("baz"))
+			root.Files["chunked"] = isolated.ChunkedFile(isolated.HashBytes(content), 0600, 9, []isolated.Chunk{
+				{Digest: isolated.HashBytes(foo), Size: 3},
+				{Digest: isolated.HashBytes(bar), Size: 3},
+				{Digest: isolated.HashBytes([]byte("baz")), Size: 3},
+			})
+			rootDigest = inject(server, root)
+
+			d := New(ctx, client, nil, 2)
+			_, err := d.FetchIsolated(rootDigest, out)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			actual, err := ioutil.ReadFile(filepath.Join(out, "chunked"))
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, content)
+		})
+
+		Convey(`Chunked file with a bad digest.`, func() {
+			root.Files["chunked"] = isolated.ChunkedFile(isolated.HashBytes(bar), 0600, 3, []isolated.Chunk{
+				{Digest: isolated.HashBytes(foo), Size: 3},
+			})
+			rootDigest = inject(server, root)
+
+			d := New(ctx, client, nil, 2)
+			_, err := d.FetchIsolated(rootDigest, out)
+			So(err, ShouldNotBeNil)
+			So(d.Close(), ShouldNotBeNil)
+		})
+
+		Convey(`Path escaping the output directory.`, func() {
+			root.Files["../evil"] = isolated.BasicFile(isolated.HashBytes(foo), 0600, 3)
+			rootDigest = inject(server, root)
+
+			d := New(ctx, client, nil, 2)
+			_, err := d.FetchIsolated(rootDigest, out)
+			So(err, ShouldNotBeNil)
+			So(d.Close(), ShouldNotBeNil)
+			_, err = os.Stat(filepath.Join(td, "evil"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
@@ -286,7 +286,11 @@ func archive(arch *archiver.Archiver, opts *ArchiveOptions, displayName string)
 				i.Files[relPath] = isolated.SymLink(l)
 			} else {
 				i.Files[relPath] = isolated.BasicFile("", int(mode.Perm()), info.Size())
-				fileItems = append(fileItems, arch.PushFile(relPath, dep, -info.Size()))
+				if arch.ShouldChunk(info.Size()) {
+					fileItems = append(fileItems, arch.PushFileChunked(relPath, dep, -info.Size()))
+				} else {
+					fileItems = append(fileItems, arch.PushFile(relPath, dep, -info.Size()))
+				}
 			}
 		}
 	}
@@ -298,6 +302,10 @@ func archive(arch *archiver.Archiver, opts *ArchiveOptions, displayName string)
 		}
 		f := i.Files[item.DisplayName]
 		f.Digest = item.Digest()
+		if chunks := item.Chunks(); chunks != nil {
+			f.Type = isolated.Chunked
+			f.Chunks = chunks
+		}
 		i.Files[item.DisplayName] = f
 	}
 	// Avoid duplicated entries in includes.
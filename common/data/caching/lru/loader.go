@@ -0,0 +1,161 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Loader generates the value for a key missing from the cache.
+//
+// If it returns a nil value and no error, nothing is cached and GetOrCreate
+// returns nil.
+type Loader func(c context.Context) (interface{}, error)
+
+// load is an in-flight or failed invocation of a Loader.
+type load struct {
+	// done is closed once the loader has returned.
+	done chan struct{}
+	// cancel cancels the context passed to the loader.
+	cancel context.CancelFunc
+	// waiters is the number of GetOrCreate calls waiting for this load.
+	waiters int
+
+	// value and err are the loader's results. They are set before done is
+	// closed.
+	value interface{}
+	err   error
+
+	// errExpiry, if not zero, is the time until which err is returned to
+	// GetOrCreate callers without calling the loader again.
+	errExpiry time.Time
+}
+
+// GetOrCreate returns the value associated with key. If there is none, it calls
+// loader to generate it and caches the result.
+//
+// Concurrent GetOrCreate calls for the same key share a single loader call.
+// The loader runs with a context that carries the values of the first
+// caller's context, but that is only canceled once all callers waiting on it
+// have had their context canceled. A caller whose context is canceled returns
+// its context's error right away.
+//
+// If the loader returns an error and the cache's ErrorExpiration is > 0, the
+// error is returned to GetOrCreate callers for this key until it expires,
+// without calling the loader again. Errors caused by the cancellation of the
+// loader's context are never cached.
+//
+// GetOrCreate uses the cache Locker's read/write lock, but not while loader
+// runs, so loader may use the cache.
+func (c *Cache) GetOrCreate(ctx context.Context, key interface{}, loader Loader) (interface{}, error) {
+	if v := c.Get(key); v != nil {
+		return v, nil
+	}
+
+	c.loadsLock.Lock()
+	// The value could have been put while we weren't holding loadsLock.
+	if v := c.peekLive(key); v != nil {
+		c.loadsLock.Unlock()
+		return v, nil
+	}
+
+	l := c.loads[key]
+	if l != nil && !l.errExpiry.IsZero() {
+		if c.config.Clock.Now().Before(l.errExpiry) {
+			c.loadsLock.Unlock()
+			return nil, l.err
+		}
+		delete(c.loads, key)
+		l = nil
+	}
+	if l == nil {
+		l = c.startLoadLocked(ctx, key, loader)
+	}
+	l.waiters++
+	c.loadsLock.Unlock()
+
+	select {
+	case <-l.done:
+		return l.value, l.err
+
+	case <-ctx.Done():
+		c.loadsLock.Lock()
+		defer c.loadsLock.Unlock()
+
+		l.waiters--
+		if l.waiters == 0 {
+			// We were the last one waiting, no one cares about the value anymore.
+			l.cancel()
+			if c.loads[key] == l {
+				delete(c.loads, key)
+			}
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// startLoadLocked registers a new load for key and runs loader in a goroutine.
+// loadsLock must be held by the caller.
+func (c *Cache) startLoadLocked(ctx context.Context, key interface{}, loader Loader) *load {
+	lctx, cancel := context.WithCancel(detachedContext{ctx})
+	l := &load{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+	c.loads[key] = l
+
+	go func() {
+		defer close(l.done)
+		defer cancel()
+
+		v, err := loader(lctx)
+		if err == nil && v != nil {
+			// Put the value before forgetting about the load, so that GetOrCreate
+			// callers always find one or the other.
+			c.Put(key, v)
+		}
+
+		c.loadsLock.Lock()
+		defer c.loadsLock.Unlock()
+
+		l.value, l.err = v, err
+		if c.loads[key] != l {
+			// All waiters have left.
+			return
+		}
+		if err != nil && c.config.ErrorExpiration > 0 && lctx.Err() == nil {
+			l.errExpiry = c.config.Clock.Now().Add(c.config.ErrorExpiration)
+		} else {
+			delete(c.loads, key)
+		}
+	}()
+	return l
+}
+
+// pruneLoads removes expired errors cached by GetOrCreate. If all is true, it
+// removes all of them.
+func (c *Cache) pruneLoads(all bool) {
+	c.loadsLock.Lock()
+	defer c.loadsLock.Unlock()
+
+	now := c.config.Clock.Now()
+	for k, l := range c.loads {
+		if !l.errExpiry.IsZero() && (all || !now.Before(l.errExpiry)) {
+			delete(c.loads, k)
+		}
+	}
+}
+
+// detachedContext is a context.Context that has the values of the wrapped
+// context, but is never canceled and has no deadline.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
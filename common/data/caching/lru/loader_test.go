@@ -0,0 +1,182 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetOrCreate(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A cache with error expiration`, t, func() {
+		c := context.Background()
+		tc := testclock.New(testclock.TestTimeUTC)
+		cache := Config{
+			Locker:          &sync.RWMutex{},
+			Clock:           tc,
+			ErrorExpiration: time.Minute,
+		}.New()
+
+		waiters := func(key interface{}) int {
+			cache.loadsLock.Lock()
+			defer cache.loadsLock.Unlock()
+			if l := cache.loads[key]; l != nil {
+				return l.waiters
+			}
+			return 0
+		}
+		waitForWaiters := func(key interface{}, n int) {
+			for waiters(key) != n {
+				time.Sleep(time.Millisecond)
+			}
+		}
+
+		Convey(`Returns cached values without calling the loader.`, func() {
+			cache.Put("a", "av")
+			v, err := cache.GetOrCreate(c, "a", func(context.Context) (interface{}, error) {
+				panic("must not be called")
+			})
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "av")
+		})
+
+		Convey(`Coalesces concurrent loads.`, func() {
+			calls := int32(0)
+			release := make(chan struct{})
+			loader := func(context.Context) (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return "av", nil
+			}
+
+			const n = 10
+			results := make(chan interface{}, n)
+			for i := 0; i < n; i++ {
+				go func() {
+					v, _ := cache.GetOrCreate(c, "a", loader)
+					results <- v
+				}()
+			}
+			waitForWaiters("a", n)
+			close(release)
+
+			for i := 0; i < n; i++ {
+				So(<-results, ShouldEqual, "av")
+			}
+			So(calls, ShouldEqual, 1)
+			So(cache.Get("a"), ShouldEqual, "av")
+			So(waiters("a"), ShouldEqual, 0)
+		})
+
+		Convey(`Caches errors.`, func() {
+			calls := 0
+			loader := func(context.Context) (interface{}, error) {
+				calls++
+				return nil, errors.New("boom")
+			}
+
+			_, err := cache.GetOrCreate(c, "a", loader)
+			So(err, ShouldErrLike, "boom")
+			_, err = cache.GetOrCreate(c, "a", loader)
+			So(err, ShouldErrLike, "boom")
+			So(calls, ShouldEqual, 1)
+
+			Convey(`Until they expire.`, func() {
+				tc.Add(time.Minute)
+				_, err = cache.GetOrCreate(c, "a", loader)
+				So(err, ShouldErrLike, "boom")
+				So(calls, ShouldEqual, 2)
+			})
+
+			Convey(`Until they are pruned.`, func() {
+				tc.Add(time.Minute)
+				cache.Prune()
+				So(cache.loads, ShouldBeEmpty)
+			})
+
+			Convey(`Until the cache is purged.`, func() {
+				cache.Purge()
+				_, err = cache.GetOrCreate(c, "a", loader)
+				So(calls, ShouldEqual, 2)
+			})
+		})
+
+		Convey(`Does not cache errors without error expiration.`, func() {
+			cache.config.ErrorExpiration = 0
+			calls := 0
+			loader := func(context.Context) (interface{}, error) {
+				calls++
+				return nil, errors.New("boom")
+			}
+
+			cache.GetOrCreate(c, "a", loader)
+			cache.GetOrCreate(c, "a", loader)
+			So(calls, ShouldEqual, 2)
+		})
+
+		Convey(`Passes context values to the loader.`, func() {
+			c = context.WithValue(c, "key", "value")
+			v, err := cache.GetOrCreate(c, "a", func(c context.Context) (interface{}, error) {
+				return c.Value("key"), nil
+			})
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "value")
+		})
+
+		Convey(`Cancels the loader when the last waiter leaves.`, func() {
+			started := make(chan struct{})
+			loaderCtx := make(chan context.Context, 1)
+			loader := func(c context.Context) (interface{}, error) {
+				loaderCtx <- c
+				close(started)
+				<-c.Done()
+				return nil, c.Err()
+			}
+
+			c1, cancel1 := context.WithCancel(c)
+			c2, cancel2 := context.WithCancel(c)
+			errs := make(chan error, 2)
+			go func() {
+				_, err := cache.GetOrCreate(c1, "a", loader)
+				errs <- err
+			}()
+			<-started
+			lctx := <-loaderCtx
+			go func() {
+				_, err := cache.GetOrCreate(c2, "a", loader)
+				errs <- err
+			}()
+			waitForWaiters("a", 2)
+
+			cancel1()
+			So(<-errs, ShouldEqual, context.Canceled)
+			So(lctx.Err(), ShouldBeNil)
+
+			cancel2()
+			So(<-errs, ShouldEqual, context.Canceled)
+			<-lctx.Done()
+
+			Convey(`And doesn't cache the cancellation.`, func() {
+				v, err := cache.GetOrCreate(c, "a", func(context.Context) (interface{}, error) {
+					return "av", nil
+				})
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, "av")
+			})
+		})
+	})
+}
@@ -7,7 +7,12 @@ package lru
 
 import (
 	"container/list"
+	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
 )
 
 // snapshot is a snapshot of the contents of the Cache.
@@ -15,6 +20,12 @@ type snapshot map[interface{}]interface{}
 
 type pair struct {
 	k, v interface{}
+
+	// weight is the weight of this entry, as returned by the Weigher.
+	weight int64
+	// expiry is the time after which this entry is no longer valid. If zero,
+	// the entry never expires.
+	expiry time.Time
 }
 
 // Heuristic is a callback function that is run after every LRU mutation to
@@ -35,6 +46,52 @@ func sizeHeuristic(maxElements int) Heuristic {
 	}
 }
 
+// Weigher returns the weight of a cache entry, e.g. its size in bytes. It must
+// return the same value every time it is called for the same entry.
+//
+// Weigher is called while the cache holds its write lock, meaning that no
+// locking cache methods may be called during this callback.
+type Weigher func(key, value interface{}) int64
+
+// EvictReason describes why an entry left the cache.
+type EvictReason int
+
+const (
+	// Evicted means the entry was dropped to satisfy the cache's Heuristic or
+	// Capacity.
+	Evicted EvictReason = iota
+	// Expired means the entry outlived its expiration.
+	Expired
+	// Removed means the entry was explicitly removed through Remove, Purge or a
+	// Mutate generator returning nil.
+	Removed
+	// Replaced means the entry's value was overwritten by a different value.
+	Replaced
+)
+
+// String implements fmt.Stringer.
+func (r EvictReason) String() string {
+	switch r {
+	case Evicted:
+		return "evicted"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	case Replaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
+
+// OnEvict is a callback function that is invoked every time a value leaves
+// the cache, e.g. to release resources held by the value.
+//
+// Unlike Heuristic and Weigher, OnEvict is called after the cache has released
+// its lock, so it may use the cache.
+type OnEvict func(key, value interface{}, reason EvictReason)
+
 // Config is a configuration structure for the cache.
 type Config struct {
 	// Locker is the read/write Locker implementation to use for this cache. If
@@ -44,8 +101,31 @@ type Config struct {
 
 	// Heuristic is the LRU heuristic to use.
 	//
-	// If nil, the cache will never prune elements.
+	// If nil, the cache will never prune elements based on a heuristic.
 	Heuristic Heuristic
+
+	// Capacity, if > 0, is the maximum total weight of all entries in the cache.
+	// Least-recently-used entries are evicted until the total weight is within
+	// Capacity. An entry heavier than Capacity is evicted right away.
+	Capacity int64
+
+	// Weigher returns the weight of an entry. If nil, every entry weighs 1.
+	Weigher Weigher
+
+	// Expiration, if > 0, is the default lifetime of an entry added to the
+	// cache. It can be overridden per entry using PutWithExpiration.
+	Expiration time.Duration
+
+	// Clock is the clock used to expire entries. If nil, the system clock is
+	// used.
+	Clock clock.Clock
+
+	// OnEvict, if not nil, is called for each value leaving the cache.
+	OnEvict OnEvict
+
+	// ErrorExpiration, if > 0, is how long an error returned by a GetOrCreate
+	// loader is cached. If 0, errors are not cached.
+	ErrorExpiration time.Duration
 }
 
 // New instantiates a new cache from the current configuration.
@@ -53,10 +133,26 @@ func (cfg Config) New() *Cache {
 	if cfg.Locker == nil {
 		cfg.Locker = nopLocker{}
 	}
+	if cfg.Clock == nil {
+		cfg.Clock = clock.GetSystemClock()
+	}
 
 	return newCache(&cfg)
 }
 
+// Stats is a snapshot of a Cache's counters.
+type Stats struct {
+	// Hits is the number of lookups that found a value.
+	Hits int64
+	// Misses is the number of lookups that didn't find a value.
+	Misses int64
+	// Evictions is the number of entries dropped to satisfy the Heuristic or
+	// the Capacity.
+	Evictions int64
+	// Expirations is the number of entries dropped because they expired.
+	Expirations int64
+}
+
 // Cache is a goroutine-safe least-recently-used (LRU) cache implementation. The
 // cache stores key-value mapping entries up to a size limit. If more items are
 // added past that limit, the entries that have have been referenced least
@@ -66,11 +162,30 @@ func (cfg Config) New() *Cache {
 // non-mutating readers (Peek), but only one mutating reader/writer (Get, Put,
 // Mutate).
 type Cache struct {
+	// stats holds the cache's counters. It is updated atomically, since Peek
+	// only holds the read lock. It is first to be 64-bit aligned.
+	stats Stats
+
 	// config is the installed configuration.
 	config *Config
 
-	cache map[interface{}]*list.Element // Map of elements.
-	lru   list.List                     // List of least-recently-used elements.
+	cache  map[interface{}]*list.Element // Map of elements.
+	lru    list.List                     // List of least-recently-used elements.
+	weight int64                         // Total weight of all elements.
+
+	// dropped is the list of entries that left the cache during the current
+	// write operation. They are passed to OnEvict once the lock is released.
+	dropped []droppedEntry
+
+	// loadsLock protects loads. It is always taken before the Locker.
+	loadsLock sync.Mutex
+	// loads are the in-flight and failed GetOrCreate loads, by key.
+	loads map[interface{}]*load
+}
+
+type droppedEntry struct {
+	*pair
+	reason EvictReason
 }
 
 // New creates a new goroutine-safe Cache instance retains a maximum number of
@@ -97,6 +212,7 @@ func newCache(cfg *Config) *Cache {
 	c := Cache{
 		config: cfg,
 		cache:  make(map[interface{}]*list.Element),
+		loads:  make(map[interface{}]*load),
 	}
 	c.lru.Init()
 	return &c
@@ -105,13 +221,26 @@ func newCache(cfg *Config) *Cache {
 // Peek fetches the element associated with the supplied key without updating
 // the element's recently-used standing.
 //
-// Peek uses the cache Locker's read lock.
+// Peek uses the cache Locker's read lock. An expired element is not returned,
+// but it stays in the cache until it is looked up with Get or pruned.
 func (c *Cache) Peek(key interface{}) interface{} {
+	if v := c.peekLive(key); v != nil {
+		atomic.AddInt64(&c.stats.Hits, 1)
+		return v
+	}
+	atomic.AddInt64(&c.stats.Misses, 1)
+	return nil
+}
+
+// peekLive is Peek without counting hits and misses.
+func (c *Cache) peekLive(key interface{}) interface{} {
 	c.config.Locker.RLock()
 	defer c.config.Locker.RUnlock()
 
 	if e := c.cache[key]; e != nil {
-		return e.Value.(*pair).v
+		if p := e.Value.(*pair); !c.isExpired(p, c.config.Clock.Now()) {
+			return p.v
+		}
 	}
 	return nil
 }
@@ -120,15 +249,19 @@ func (c *Cache) Peek(key interface{}) interface{} {
 // recently-used standing.
 //
 // Get uses the cache Locker's read/write lock.
-func (c *Cache) Get(key interface{}) interface{} {
-	c.config.Locker.Lock()
-	defer c.config.Locker.Unlock()
-
-	if e := c.cache[key]; e != nil {
-		c.lru.MoveToFront(e)
-		return e.Value.(*pair).v
+func (c *Cache) Get(key interface{}) (value interface{}) {
+	c.write(func() {
+		if e := c.liveLocked(key); e != nil {
+			c.lru.MoveToFront(e)
+			value = e.Value.(*pair).v
+		}
+	})
+	if value != nil {
+		atomic.AddInt64(&c.stats.Hits, 1)
+	} else {
+		atomic.AddInt64(&c.stats.Misses, 1)
 	}
-	return nil
+	return
 }
 
 // Put adds a new value to the cache. The value in the cache will be replaced
@@ -140,7 +273,13 @@ func (c *Cache) Get(key interface{}) interface{} {
 //
 // The new item will be considered most recently used.
 func (c *Cache) Put(key, value interface{}) (existed bool) {
-	c.Mutate(key, func(current interface{}) interface{} {
+	return c.PutWithExpiration(key, value, c.config.Expiration)
+}
+
+// PutWithExpiration is like Put, but the entry expires after exp instead of
+// the cache's default Expiration. If exp is <= 0, the entry never expires.
+func (c *Cache) PutWithExpiration(key, value interface{}, exp time.Duration) (existed bool) {
+	c.mutate(key, exp, false, func(current interface{}) interface{} {
 		existed = (current != nil)
 		return value
 	})
@@ -162,35 +301,59 @@ func (c *Cache) Put(key, value interface{}) (existed bool) {
 // by the generator.
 //
 // The key will be considered most recently used regardless of whether it was
-// put.
+// put. An existing entry keeps its expiration; a new entry gets the cache's
+// default Expiration.
 func (c *Cache) Mutate(key interface{}, gen func(interface{}) interface{}) (value interface{}) {
-	c.config.Locker.Lock()
-	defer c.config.Locker.Unlock()
+	return c.mutate(key, c.config.Expiration, true, gen)
+}
 
-	e := c.cache[key]
-	if e != nil {
-		value = e.Value.(*pair).v
-	}
-	value = gen(value)
-	if value == nil {
+// mutate implements Mutate and PutWithExpiration.
+//
+// The new entry expires after exp, unless keepExpiry is true and there is a
+// current entry, in which case the entry keeps its current expiration.
+func (c *Cache) mutate(key interface{}, exp time.Duration, keepExpiry bool, gen func(interface{}) interface{}) (value interface{}) {
+	c.write(func() {
+		e := c.liveLocked(key)
 		if e != nil {
-			delete(c.cache, key)
-			c.lru.Remove(e)
+			value = e.Value.(*pair).v
+		}
+		value = gen(value)
+		if value == nil {
+			if e != nil {
+				c.removeLocked(e, Removed)
+			}
+			return
+		}
+
+		p := &pair{k: key, v: value, weight: 1}
+		if c.config.Weigher != nil {
+			p.weight = c.config.Weigher(key, value)
+		}
+		switch {
+		case keepExpiry && e != nil:
+			p.expiry = e.Value.(*pair).expiry
+		case exp > 0:
+			p.expiry = c.config.Clock.Now().Add(exp)
 		}
-		return
-	}
 
-	if e == nil {
-		// The key doesn't currently exist. Create a new one and place it at the
-		// front.
-		e = c.lru.PushFront(&pair{key, value})
-		c.cache[key] = e
+		if e == nil {
+			// The key doesn't currently exist. Create a new one and place it at the
+			// front.
+			e = c.lru.PushFront(p)
+			c.cache[key] = e
+		} else {
+			// The element already exists. Visit it.
+			old := e.Value.(*pair)
+			if c.config.OnEvict != nil && !sameValue(old.v, value) {
+				c.dropped = append(c.dropped, droppedEntry{old, Replaced})
+			}
+			c.weight -= old.weight
+			c.lru.MoveToFront(e)
+			e.Value = p
+		}
+		c.weight += p.weight
 		c.pruneLocked()
-	} else {
-		// The element already exists. Visit it.
-		c.lru.MoveToFront(e)
-		e.Value.(*pair).v = value
-	}
+	})
 	return
 }
 
@@ -198,27 +361,54 @@ func (c *Cache) Mutate(key interface{}, gen func(interface{}) interface{}) (valu
 // value will be returned; otherwise, nil will be returned.
 //
 // Remove uses the cache Locker's read/write lock.
-func (c *Cache) Remove(key interface{}) interface{} {
-	c.config.Locker.Lock()
-	defer c.config.Locker.Unlock()
-
-	if e, ok := c.cache[key]; ok {
-		delete(c.cache, key)
-		c.lru.Remove(e)
-		return e.Value.(*pair).v
-	}
-	return nil
+func (c *Cache) Remove(key interface{}) (value interface{}) {
+	c.write(func() {
+		if e := c.liveLocked(key); e != nil {
+			value = e.Value.(*pair).v
+			c.removeLocked(e, Removed)
+		}
+	})
+	return
 }
 
-// Purge clears the full contents of the cache.
+// Purge clears the full contents of the cache, including the errors cached by
+// GetOrCreate.
 //
 // Purge uses the cache Locker's read/write lock.
 func (c *Cache) Purge() {
-	c.config.Locker.Lock()
-	defer c.config.Locker.Unlock()
+	c.pruneLoads(true)
+	c.write(func() {
+		if c.config.OnEvict != nil {
+			for e := c.lru.Back(); e != nil; e = e.Prev() {
+				c.dropped = append(c.dropped, droppedEntry{e.Value.(*pair), Removed})
+			}
+		}
+		c.cache = make(map[interface{}]*list.Element)
+		c.lru.Init()
+		c.weight = 0
+	})
+}
 
-	c.cache = make(map[interface{}]*list.Element)
-	c.lru.Init()
+// Prune removes all expired entries and expired GetOrCreate errors from the
+// cache.
+//
+// Expired entries are otherwise only dropped when they are looked up with Get
+// or Mutate, or when they are evicted to make room for new entries. Prune can
+// be called periodically to release them earlier.
+//
+// Prune uses the cache Locker's read/write lock.
+func (c *Cache) Prune() {
+	c.pruneLoads(false)
+	c.write(func() {
+		now := c.config.Clock.Now()
+		for e := c.lru.Back(); e != nil; {
+			prev := e.Prev()
+			if c.isExpired(e.Value.(*pair), now) {
+				c.removeLocked(e, Expired)
+			}
+			e = prev
+		}
+	})
 }
 
 // Len returns the number of entries in the cache.
@@ -230,6 +420,25 @@ func (c *Cache) Len() int {
 	return len(c.cache)
 }
 
+// Weight returns the total weight of the entries in the cache.
+//
+// Weight uses the cache Locker's read lock.
+func (c *Cache) Weight() int64 {
+	c.config.Locker.RLock()
+	defer c.config.Locker.RUnlock()
+	return c.weight
+}
+
+// Stats returns a snapshot of the cache's counters.
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:        atomic.LoadInt64(&c.stats.Hits),
+		Misses:      atomic.LoadInt64(&c.stats.Misses),
+		Evictions:   atomic.LoadInt64(&c.stats.Evictions),
+		Expirations: atomic.LoadInt64(&c.stats.Expirations),
+	}
+}
+
 // keys returns a list of keys in the cache.
 func (c *Cache) keys() []interface{} {
 	c.config.Locker.RLock()
@@ -259,21 +468,84 @@ func (c *Cache) snapshot() (ss snapshot) {
 	return
 }
 
-// pruneLocked prunes LRU elements until its heuristic is satisfied. Its write
-// lock must be held by the caller.
+// write runs fn while holding the write lock. Once the lock is released, the
+// entries dropped by fn are passed to the OnEvict callback.
+func (c *Cache) write(fn func()) {
+	var dropped []droppedEntry
+	func() {
+		c.config.Locker.Lock()
+		defer c.config.Locker.Unlock()
+
+		fn()
+		dropped, c.dropped = c.dropped, nil
+	}()
+
+	if cb := c.config.OnEvict; cb != nil {
+		for _, d := range dropped {
+			cb(d.k, d.v, d.reason)
+		}
+	}
+}
+
+// liveLocked returns the element for key, or nil if there is none. If the
+// element has expired, it is removed and nil is returned. Its write lock must
+// be held by the caller.
+func (c *Cache) liveLocked(key interface{}) *list.Element {
+	e := c.cache[key]
+	if e != nil && c.isExpired(e.Value.(*pair), c.config.Clock.Now()) {
+		c.removeLocked(e, Expired)
+		return nil
+	}
+	return e
+}
+
+// removeLocked removes e from the cache, recording it as dropped for reason.
+// Its write lock must be held by the caller.
+func (c *Cache) removeLocked(e *list.Element, reason EvictReason) {
+	p := e.Value.(*pair)
+	delete(c.cache, p.k)
+	c.lru.Remove(e)
+	c.weight -= p.weight
+
+	switch reason {
+	case Evicted:
+		atomic.AddInt64(&c.stats.Evictions, 1)
+	case Expired:
+		atomic.AddInt64(&c.stats.Expirations, 1)
+	}
+	if c.config.OnEvict != nil {
+		c.dropped = append(c.dropped, droppedEntry{p, reason})
+	}
+}
+
+// pruneLocked prunes LRU elements until its heuristic and capacity are
+// satisfied. Its write lock must be held by the caller.
 func (c *Cache) pruneLocked() {
 	h := c.config.Heuristic
-	if h == nil {
+	capacity := c.config.Capacity
+	if h == nil && capacity <= 0 {
 		return
 	}
 
 	for e := c.lru.Back(); e != nil; e = c.lru.Back() {
 		pair := e.Value.(*pair)
-		if h(len(c.cache), pair.v) {
+		if (h == nil || h(len(c.cache), pair.v)) && (capacity <= 0 || c.weight <= capacity) {
 			break
 		}
+		c.removeLocked(e, Evicted)
+	}
+}
+
+func (c *Cache) isExpired(p *pair, now time.Time) bool {
+	return !p.expiry.IsZero() && !now.Before(p.expiry)
+}
 
-		delete(c.cache, pair.k)
-		c.lru.Remove(e)
+// sameValue returns true if a and b are the same value. Values that are not
+// comparable are never the same.
+func sameValue(a, b interface{}) bool {
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) || !ta.Comparable() {
+		return false
 	}
+	return a == b
 }
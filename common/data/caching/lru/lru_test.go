@@ -5,7 +5,11 @@
 package lru
 
 import (
+	"fmt"
 	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -104,3 +108,139 @@ func TestCache(t *testing.T) {
 		})
 	})
 }
+
+func TestCacheConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A cache with a weigher, expiration and eviction callback`, t, func() {
+		tc := testclock.New(testclock.TestTimeUTC)
+
+		var evicted []string
+		cache := Config{
+			Capacity: 10,
+			Weigher: func(k, v interface{}) int64 {
+				return int64(len(v.(string)))
+			},
+			Expiration: time.Minute,
+			Clock:      tc,
+			OnEvict: func(k, v interface{}, reason EvictReason) {
+				evicted = append(evicted, fmt.Sprintf("%s=%s %s", k, v, reason))
+			},
+		}.New()
+
+		cache.Put("a", "aaaa")
+		cache.Put("b", "bbbb")
+		So(cache.Weight(), ShouldEqual, 8)
+
+		Convey(`Evicts least-recently-used entries when over capacity.`, func() {
+			So(cache.Get("a"), ShouldEqual, "aaaa")
+			cache.Put("c", "cccc")
+			So(cache.Len(), ShouldEqual, 2)
+			So(cache.Weight(), ShouldEqual, 8)
+			So(cache.Peek("b"), ShouldBeNil)
+			So(evicted, ShouldResemble, []string{"b=bbbb evicted"})
+		})
+
+		Convey(`Evicts an entry heavier than the capacity right away.`, func() {
+			cache.Put("c", "ccccccccccc")
+			So(cache.Len(), ShouldEqual, 0)
+			So(cache.Weight(), ShouldEqual, 0)
+			So(evicted, ShouldResemble, []string{
+				"a=aaaa evicted",
+				"b=bbbb evicted",
+				"c=ccccccccccc evicted",
+			})
+		})
+
+		Convey(`Reweighs replaced entries.`, func() {
+			cache.Put("a", "a")
+			So(cache.Weight(), ShouldEqual, 5)
+			So(evicted, ShouldResemble, []string{"a=aaaa replaced"})
+
+			Convey(`Does not report a value replaced by itself.`, func() {
+				cache.Mutate("a", func(cur interface{}) interface{} { return cur })
+				So(evicted, ShouldResemble, []string{"a=aaaa replaced"})
+			})
+		})
+
+		Convey(`Expires entries.`, func() {
+			tc.Add(30 * time.Second)
+			cache.PutWithExpiration("b", "bb", 2*time.Minute)
+
+			tc.Add(30 * time.Second)
+			So(cache.Peek("a"), ShouldBeNil)
+			So(cache.Len(), ShouldEqual, 2)
+			So(cache.Get("a"), ShouldBeNil)
+			So(cache.Len(), ShouldEqual, 1)
+			So(cache.Get("b"), ShouldEqual, "bb")
+
+			tc.Add(2 * time.Minute)
+			cache.Prune()
+			So(cache.Len(), ShouldEqual, 0)
+			So(cache.Weight(), ShouldEqual, 0)
+			So(evicted, ShouldResemble, []string{
+				"b=bbbb replaced",
+				"a=aaaa expired",
+				"b=bb expired",
+			})
+		})
+
+		Convey(`Mutate keeps the expiration of an existing entry.`, func() {
+			cache.PutWithExpiration("b", "bb", 2*time.Minute)
+			tc.Add(90 * time.Second)
+			cache.Mutate("b", func(cur interface{}) interface{} { return cur.(string) + "b" })
+			cache.Mutate("c", func(cur interface{}) interface{} { return "c" })
+
+			tc.Add(30 * time.Second)
+			So(cache.Get("b"), ShouldBeNil)
+			So(cache.Get("c"), ShouldEqual, "c")
+
+			tc.Add(30 * time.Second)
+			So(cache.Get("c"), ShouldBeNil)
+		})
+
+		Convey(`Mutate does not see expired entries.`, func() {
+			tc.Add(time.Minute)
+			cache.Mutate("a", func(cur interface{}) interface{} {
+				So(cur, ShouldBeNil)
+				return "new"
+			})
+			So(cache.Get("a"), ShouldEqual, "new")
+		})
+
+		Convey(`Reports removed entries.`, func() {
+			So(cache.Remove("a"), ShouldEqual, "aaaa")
+			cache.Put("c", "c")
+			cache.Purge()
+			So(cache.Weight(), ShouldEqual, 0)
+			So(evicted, ShouldResemble, []string{
+				"a=aaaa removed",
+				"b=bbbb removed",
+				"c=c removed",
+			})
+		})
+
+		Convey(`OnEvict can use the cache.`, func() {
+			cache.config.OnEvict = func(k, v interface{}, reason EvictReason) {
+				cache.Put("evicted", k)
+			}
+			cache.Remove("a")
+			So(cache.Get("evicted"), ShouldEqual, "a")
+		})
+
+		Convey(`Counts hits, misses, evictions and expirations.`, func() {
+			cache.Get("a")
+			cache.Peek("b")
+			cache.Get("c")
+			cache.Put("c", "cccc")
+			tc.Add(time.Minute)
+			cache.Prune()
+			So(cache.Stats(), ShouldResemble, Stats{
+				Hits:        2,
+				Misses:      1,
+				Evictions:   1,
+				Expirations: 2,
+			})
+		})
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/metric"
+)
+
+var (
+	cacheLookups = metric.NewCounter(
+		"luci/lru/lookups",
+		"Number of cache lookups, by whether they found a value.",
+		nil,
+		field.String("name"), // Name passed to Cache.ReportMetrics.
+		field.Bool("hit"))
+
+	cacheDrops = metric.NewCounter(
+		"luci/lru/drops",
+		"Number of entries dropped from the cache by the cache itself.",
+		nil,
+		field.String("name"),   // Name passed to Cache.ReportMetrics.
+		field.String("reason")) // "evicted" or "expired".
+
+	cacheEntries = metric.NewInt(
+		"luci/lru/entries",
+		"Number of entries in the cache.",
+		nil,
+		field.String("name")) // Name passed to Cache.ReportMetrics.
+
+	cacheWeight = metric.NewInt(
+		"luci/lru/weight",
+		"Total weight of the entries in the cache.",
+		nil,
+		field.String("name")) // Name passed to Cache.ReportMetrics.
+)
+
+// ReportMetrics registers a tsmon callback that reports the cache's counters,
+// size and weight under the given name at metric collection time.
+//
+// The callback is never unregistered, so this should only be used for caches
+// that live as long as the process.
+func (c *Cache) ReportMetrics(ctx context.Context, name string) {
+	tsmon.RegisterCallbackIn(ctx, func(ctx context.Context) {
+		s := c.Stats()
+		cacheLookups.Set(ctx, s.Hits, name, true)
+		cacheLookups.Set(ctx, s.Misses, name, false)
+		cacheDrops.Set(ctx, s.Evictions, name, Evicted.String())
+		cacheDrops.Set(ctx, s.Expirations, name, Expired.String())
+
+		c.config.Locker.RLock()
+		defer c.config.Locker.RUnlock()
+		cacheEntries.Set(ctx, int64(len(c.cache)), name)
+		cacheWeight.Set(ctx, c.weight, name)
+	})
+}
@@ -10,4 +10,8 @@
 //
 // The frame protocol does not handle data integrity; that is left to the
 // outer protocol or medium which uses the frame.
+//
+// A stream can optionally end with an index footer, written by an
+// IndexedWriter, which allows a SeekableReader to jump to a given frame
+// without reading the whole stream.
 package recordio
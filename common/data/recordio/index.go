@@ -0,0 +1,169 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package recordio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	// DefaultIndexInterval is the index interval used by NewIndexedWriter when
+	// none is specified.
+	DefaultIndexInterval = 64
+
+	// indexMagic prefixes the data of the index frame.
+	indexMagic = "RIOX"
+	// trailerMagic ends the data of the trailer frame.
+	trailerMagic = "RIOI"
+
+	// trailerDataSize is the size of the trailer frame's data: the offset of the
+	// index frame followed by trailerMagic.
+	trailerDataSize = 8 + 4
+	// trailerSize is the size of the trailer frame, including its header.
+	trailerSize = 1 + trailerDataSize
+)
+
+// IndexedWriter is a Writer that keeps track of the offsets of the frames that
+// it writes, and can write them as an index footer at the end of the stream.
+//
+// The index footer is made of two regular frames, so an indexed stream is a
+// valid RecordIO stream. However, sequential Readers will return them as the
+// last two frames of the stream. SeekableReader recognizes and skips them.
+//
+// The first footer frame holds the index: a sparse list of the offsets of
+// every Nth frame, N being the index interval, and the total number of frames.
+// The second one is a fixed-size trailer holding the offset of the index frame,
+// so that it can be located from the end of the stream.
+type IndexedWriter interface {
+	Writer
+
+	// WriteIndex writes the index footer. It must be called after the last frame
+	// has been flushed, and no frame may be written after it.
+	WriteIndex() error
+}
+
+// indexedWriter implements IndexedWriter on top of writer.
+type indexedWriter struct {
+	writer
+
+	interval int64
+	offset   int64   // Number of bytes written to inner.
+	count    int64   // Number of frames written.
+	marks    []int64 // Offsets of every interval-th frame.
+}
+
+// NewIndexedWriter creates a new IndexedWriter that writes frames to an
+// underlying io.Writer, indexing every interval-th frame.
+//
+// Offsets are relative to the position of w when it is attached to the
+// IndexedWriter. If interval is <= 0, DefaultIndexInterval will be used.
+func NewIndexedWriter(w io.Writer, interval int) IndexedWriter {
+	if interval <= 0 {
+		interval = DefaultIndexInterval
+	}
+	return &indexedWriter{
+		writer:   writer{inner: w},
+		interval: int64(interval),
+	}
+}
+
+func (w *indexedWriter) Flush() error {
+	n, err := WriteFrame(w.inner, w.buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if w.count%w.interval == 0 {
+		w.marks = append(w.marks, w.offset)
+	}
+	w.offset += int64(n)
+	w.count++
+	w.buf.Reset()
+	return nil
+}
+
+func (w *indexedWriter) Reset(inner io.Writer) {
+	w.writer.Reset(inner)
+	w.offset, w.count, w.marks = 0, 0, nil
+}
+
+func (w *indexedWriter) WriteIndex() error {
+	indexOffset := w.offset
+	n, err := WriteFrame(w.inner, encodeIndex(w.interval, w.count, w.marks))
+	if err != nil {
+		return err
+	}
+	w.offset += int64(n)
+
+	var trailer [trailerDataSize]byte
+	binary.BigEndian.PutUint64(trailer[:], uint64(indexOffset))
+	copy(trailer[8:], trailerMagic)
+	n, err = WriteFrame(w.inner, trailer[:])
+	w.offset += int64(n)
+	return err
+}
+
+// encodeIndex returns the data of an index frame.
+//
+// It is made of indexMagic followed by Uvarints: the index interval, the
+// number of frames, the number of marks and the marks, each of them as the
+// difference with the previous one.
+func encodeIndex(interval, count int64, marks []int64) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteString(indexMagic)
+
+	varint := make([]byte, binary.MaxVarintLen64)
+	put := func(v int64) {
+		buf.Write(varint[:binary.PutUvarint(varint, uint64(v))])
+	}
+	put(interval)
+	put(count)
+	put(int64(len(marks)))
+	prev := int64(0)
+	for _, m := range marks {
+		put(m - prev)
+		prev = m
+	}
+	return buf.Bytes()
+}
+
+// decodeIndex parses the data of an index frame.
+func decodeIndex(data []byte) (interval, count int64, marks []int64, err error) {
+	if !bytes.HasPrefix(data, []byte(indexMagic)) {
+		return 0, 0, nil, fmt.Errorf("recordio: bad index magic")
+	}
+	br := bytes.NewReader(data[len(indexMagic):])
+	get := func() int64 {
+		if err != nil {
+			return 0
+		}
+		var v uint64
+		v, err = binary.ReadUvarint(br)
+		return int64(v)
+	}
+
+	interval, count = get(), get()
+	n := get()
+	if err == nil && (interval <= 0 || count < 0 || n > int64(len(data)) || n != (count+interval-1)/interval) {
+		err = fmt.Errorf("recordio: inconsistent index (interval %d, %d frames, %d marks)", interval, count, n)
+	}
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	marks = make([]int64, n)
+	prev := int64(0)
+	for i := range marks {
+		prev += get()
+		marks[i] = prev
+	}
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return
+}
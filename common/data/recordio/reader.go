@@ -86,7 +86,7 @@ func (r *reader) ReadFrameAll() ([]byte, error) {
 	}
 
 	data := make([]byte, count)
-	if _, err := fr.Read(data); err != nil {
+	if _, err := io.ReadFull(fr, data); err != nil {
 		return nil, err
 	}
 	return data, nil
@@ -100,8 +100,17 @@ type simpleByteReader struct {
 }
 
 func (r *simpleByteReader) ReadByte() (byte, error) {
-	_, err := r.Read(r.buf[:])
-	return r.buf[0], err
+	// An io.Reader may return no data and no error, so keep reading until we get
+	// a byte or an error.
+	for {
+		n, err := r.Read(r.buf[:])
+		switch {
+		case n > 0:
+			return r.buf[0], nil
+		case err != nil:
+			return 0, err
+		}
+	}
 }
 
 // Split splits the supplied buffer into its component records.
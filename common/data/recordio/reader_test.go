@@ -12,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"testing"
+	"testing/iotest"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -90,6 +91,16 @@ func TestReader(t *testing.T) {
 			So(f, ShouldResemble, data)
 		})
 
+		Convey(`Can successfully read a frame from a Reader that returns short reads.`, func() {
+			data := []byte{0x13, 0x37, 0xd0, 0x65}
+			tr.loadFrames(data)
+			r := NewReader(iotest.OneByteReader(&tr), maxSize)
+
+			f, err := r.ReadFrameAll()
+			So(err, ShouldBeNil)
+			So(f, ShouldResemble, data)
+		})
+
 		Convey(`Can successfully read two frames.`, func() {
 			data := [][]byte{
 				{0x13, 0x37, 0xd0, 0x65},
@@ -0,0 +1,266 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package recordio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"sort"
+)
+
+// SeekableReader is a Reader that reads frames from an io.ReaderAt and that can
+// be repositioned on any frame of the stream.
+//
+// If the stream ends with an index footer (see IndexedWriter), it is used to
+// jump close to the requested frame. Otherwise, the SeekableReader builds a
+// similar index as it reads through the stream, so that only the part of the
+// stream that it has never read is scanned.
+//
+// A SeekableReader is not goroutine-safe.
+type SeekableReader struct {
+	r       io.ReaderAt
+	end     int64 // Offset of the end of the frames, excluding any footer.
+	maxSize int64
+
+	indexed  bool    // True if the stream has an index footer.
+	interval int64   // Number of frames between marks.
+	marks    []int64 // Offsets of frames 0, interval, 2*interval...
+	complete bool    // True if count is known.
+	count    int64   // Number of frames in the stream, if complete.
+
+	frame  int64 // Index of the next frame.
+	offset int64 // Offset of the next frame.
+}
+
+var _ Reader = (*SeekableReader)(nil)
+
+// NewSeekableReader creates a new SeekableReader which reads frames of up to
+// maxSize bytes from the first size bytes of r.
+//
+// If the stream has an index footer, it is loaded. Streams without a footer,
+// or with a damaged one, are read as plain streams.
+func NewSeekableReader(r io.ReaderAt, size, maxSize int64) (*SeekableReader, error) {
+	sr := &SeekableReader{
+		r:        r,
+		end:      size,
+		maxSize:  maxSize,
+		interval: DefaultIndexInterval,
+		marks:    []int64{0},
+	}
+	if err := sr.loadIndex(size); err != nil {
+		return nil, err
+	}
+	return sr, nil
+}
+
+// loadIndex loads the stream's index footer, if it has one.
+func (r *SeekableReader) loadIndex(size int64) error {
+	if size < trailerSize {
+		return nil
+	}
+	var trailer [trailerSize]byte
+	if err := r.readAt(trailer[:], size-trailerSize); err != nil {
+		return err
+	}
+	if trailer[0] != trailerDataSize || !bytes.HasSuffix(trailer[:], []byte(trailerMagic)) {
+		return nil
+	}
+
+	indexOffset := int64(binary.BigEndian.Uint64(trailer[1:]))
+	indexEnd := size - trailerSize
+	if indexOffset < 0 || indexOffset >= indexEnd {
+		return nil
+	}
+	hdr := make([]byte, binary.MaxVarintLen64)
+	n, err := r.r.ReadAt(hdr[:min64(int64(len(hdr)), indexEnd-indexOffset)], indexOffset)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	dataSize, l := binary.Uvarint(hdr[:n])
+	if l <= 0 || dataSize > uint64(indexEnd) || indexOffset+int64(l)+int64(dataSize) != indexEnd {
+		return nil
+	}
+	data := make([]byte, dataSize)
+	if err := r.readAt(data, indexOffset+int64(l)); err != nil {
+		return err
+	}
+
+	interval, count, marks, err := decodeIndex(data)
+	if err != nil {
+		return nil
+	}
+	r.end = indexOffset
+	r.indexed = true
+	r.interval = interval
+	r.marks = marks
+	r.complete = true
+	r.count = count
+	return nil
+}
+
+// Indexed returns true if the stream has an index footer.
+func (r *SeekableReader) Indexed() bool { return r.indexed }
+
+// Frame returns the index of the next frame that will be read.
+func (r *SeekableReader) Frame() int64 { return r.frame }
+
+// Offset returns the offset of the next frame that will be read.
+func (r *SeekableReader) Offset() int64 { return r.offset }
+
+// Count returns the number of frames in the stream.
+//
+// If the stream has no index footer, the part of the stream that hasn't been
+// read yet is scanned the first time Count is called.
+func (r *SeekableReader) Count() (int64, error) {
+	if !r.complete {
+		frame, offset := r.frame, r.offset
+		if err := r.SeekFrame(math.MaxInt64); err != nil && err != io.EOF {
+			return 0, err
+		}
+		r.frame, r.offset = frame, offset
+	}
+	return r.count, nil
+}
+
+// SeekFrame positions the reader so that the next frame read is frame n, the
+// first frame being frame 0.
+//
+// If n is past the last frame, the reader is positioned at the end of the
+// stream and io.EOF is returned.
+func (r *SeekableReader) SeekFrame(n int64) error {
+	if n < 0 {
+		return errors.New("recordio: negative frame index")
+	}
+	r.seekMark(int(min64(n/r.interval, int64(len(r.marks)-1))))
+	for r.frame < n {
+		if _, _, err := r.next(); err != nil {
+			return err
+		}
+	}
+	if r.offset >= r.end {
+		return io.EOF
+	}
+	return nil
+}
+
+// SeekOffset positions the reader on the first frame that starts at or after
+// the byte offset off.
+//
+// If there is no such frame, the reader is positioned at the end of the stream
+// and io.EOF is returned.
+func (r *SeekableReader) SeekOffset(off int64) error {
+	i := sort.Search(len(r.marks), func(i int) bool { return r.marks[i] > off }) - 1
+	if i < 0 {
+		i = 0
+	}
+	r.seekMark(i)
+	for r.offset < off {
+		if _, _, err := r.next(); err != nil {
+			return err
+		}
+	}
+	if r.offset >= r.end {
+		return io.EOF
+	}
+	return nil
+}
+
+// ReadFrame implements Reader.
+//
+// Unlike other Readers, the returned io.Reader stays valid after the next
+// call.
+func (r *SeekableReader) ReadFrame() (int64, io.Reader, error) {
+	dataOffset, size, err := r.next()
+	if err != nil {
+		return 0, nil, err
+	}
+	return size, io.NewSectionReader(r.r, dataOffset, size), nil
+}
+
+// ReadFrameAll implements Reader.
+func (r *SeekableReader) ReadFrameAll() ([]byte, error) {
+	dataOffset, size, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, nil
+	}
+
+	data := make([]byte, size)
+	if err := r.readAt(data, dataOffset); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// seekMark positions the reader on the frame of the i-th mark.
+func (r *SeekableReader) seekMark(i int) {
+	if len(r.marks) == 0 {
+		// An indexed empty stream.
+		r.frame, r.offset = 0, r.end
+		return
+	}
+	r.frame, r.offset = int64(i)*r.interval, r.marks[i]
+}
+
+// next reads the header of the next frame, and moves past the frame. It
+// returns the offset of the frame data and its size.
+func (r *SeekableReader) next() (dataOffset, size int64, err error) {
+	if r.offset >= r.end {
+		if !r.complete {
+			r.complete = true
+			r.count = r.frame
+		}
+		return 0, 0, io.EOF
+	}
+
+	hdr := make([]byte, binary.MaxVarintLen64)
+	n, err := r.r.ReadAt(hdr[:min64(int64(len(hdr)), r.end-r.offset)], r.offset)
+	if err != nil && err != io.EOF {
+		return 0, 0, err
+	}
+	v, l := binary.Uvarint(hdr[:n])
+	switch {
+	case l <= 0:
+		return 0, 0, io.ErrUnexpectedEOF
+	case v > uint64(r.maxSize):
+		return 0, 0, ErrFrameTooLarge
+	}
+	dataOffset, size = r.offset+int64(l), int64(v)
+	if size > r.end-dataOffset {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+
+	if !r.complete && r.frame == int64(len(r.marks))*r.interval {
+		r.marks = append(r.marks, r.offset)
+	}
+	r.frame++
+	r.offset = dataOffset + size
+	return
+}
+
+// readAt fills buf from offset off.
+func (r *SeekableReader) readAt(buf []byte, off int64) error {
+	// ReadAt may return io.EOF along with a full buffer.
+	n, err := r.r.ReadAt(buf, off)
+	if n == len(buf) {
+		return nil
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,201 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package recordio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSeekableReader(t *testing.T) {
+	t.Parallel()
+
+	// frameData returns the data of the i-th test frame. Frames have varying
+	// sizes, including empty ones.
+	frameData := func(i int) []byte {
+		return bytes.Repeat([]byte{byte(i)}, (i*37)%300)
+	}
+
+	const frames = 100
+	var offsets []int64
+	build := func(indexed bool) []byte {
+		buf := bytes.Buffer{}
+		var w Writer
+		if indexed {
+			w = NewIndexedWriter(&buf, 8)
+		} else {
+			w = NewWriter(&buf)
+		}
+		offsets = nil
+		for i := 0; i < frames; i++ {
+			offsets = append(offsets, int64(buf.Len()))
+			_, err := w.Write(frameData(i))
+			So(err, ShouldBeNil)
+			So(w.Flush(), ShouldBeNil)
+		}
+		if indexed {
+			So(w.(IndexedWriter).WriteIndex(), ShouldBeNil)
+		}
+		return buf.Bytes()
+	}
+
+	for _, indexed := range []bool{true, false} {
+		indexed := indexed
+
+		Convey(fmt.Sprintf(`A SeekableReader on a stream of %d frames (indexed: %v)`, frames, indexed), t, func() {
+			data := build(indexed)
+			r, err := NewSeekableReader(bytes.NewReader(data), int64(len(data)), 1024)
+			So(err, ShouldBeNil)
+			So(r.Indexed(), ShouldEqual, indexed)
+
+			Convey(`Reads all frames sequentially.`, func() {
+				for i := 0; i < frames; i++ {
+					f, err := r.ReadFrameAll()
+					So(err, ShouldBeNil)
+					So(len(f), ShouldEqual, len(frameData(i)))
+				}
+				_, err := r.ReadFrameAll()
+				So(err, ShouldEqual, io.EOF)
+			})
+
+			Convey(`Counts frames.`, func() {
+				So(r.SeekFrame(10), ShouldBeNil)
+				count, err := r.Count()
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, frames)
+				So(r.Frame(), ShouldEqual, 10)
+			})
+
+			Convey(`Can seek to any frame.`, func() {
+				for _, i := range []int{57, 3, 99, 0, 8, 16, 15, 64} {
+					So(r.SeekFrame(int64(i)), ShouldBeNil)
+					So(r.Frame(), ShouldEqual, i)
+					So(r.Offset(), ShouldEqual, offsets[i])
+
+					size, fr, err := r.ReadFrame()
+					So(err, ShouldBeNil)
+					So(size, ShouldEqual, len(frameData(i)))
+					d, err := ioutil.ReadAll(fr)
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, frameData(i))
+				}
+			})
+
+			Convey(`Returns io.EOF when seeking past the last frame.`, func() {
+				So(r.SeekFrame(frames), ShouldEqual, io.EOF)
+				So(r.SeekFrame(frames+20), ShouldEqual, io.EOF)
+				_, err := r.ReadFrameAll()
+				So(err, ShouldEqual, io.EOF)
+			})
+
+			Convey(`Can seek to a byte offset.`, func() {
+				So(r.SeekOffset(offsets[42]), ShouldBeNil)
+				So(r.Frame(), ShouldEqual, 42)
+
+				So(r.SeekOffset(offsets[42]+1), ShouldBeNil)
+				So(r.Frame(), ShouldEqual, 43)
+				f, err := r.ReadFrameAll()
+				So(err, ShouldBeNil)
+				So(len(f), ShouldEqual, len(frameData(43)))
+
+				So(r.SeekOffset(offsets[frames-1]+1), ShouldEqual, io.EOF)
+			})
+		})
+	}
+
+	Convey(`A SeekableReader`, t, func() {
+		Convey(`Handles an empty indexed stream.`, func() {
+			buf := bytes.Buffer{}
+			So(NewIndexedWriter(&buf, 0).WriteIndex(), ShouldBeNil)
+
+			r, err := NewSeekableReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 1024)
+			So(err, ShouldBeNil)
+			So(r.Indexed(), ShouldBeTrue)
+			count, err := r.Count()
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 0)
+			So(r.SeekFrame(0), ShouldEqual, io.EOF)
+			_, err = r.ReadFrameAll()
+			So(err, ShouldEqual, io.EOF)
+		})
+
+		Convey(`Handles an empty plain stream.`, func() {
+			r, err := NewSeekableReader(bytes.NewReader(nil), 0, 1024)
+			So(err, ShouldBeNil)
+			So(r.Indexed(), ShouldBeFalse)
+			count, err := r.Count()
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 0)
+		})
+
+		Convey(`Reads a stream with a damaged index as a plain stream.`, func() {
+			buf := bytes.Buffer{}
+			w := NewIndexedWriter(&buf, 2)
+			for i := 0; i < 5; i++ {
+				w.Write([]byte("frame"))
+				So(w.Flush(), ShouldBeNil)
+			}
+			So(w.WriteIndex(), ShouldBeNil)
+			data := buf.Bytes()
+			// Corrupt the index magic, right after the index frame header.
+			data[5*6+1] = 'X'
+
+			r, err := NewSeekableReader(bytes.NewReader(data), int64(len(data)), 1024)
+			So(err, ShouldBeNil)
+			So(r.Indexed(), ShouldBeFalse)
+			count, err := r.Count()
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 7)
+		})
+
+		Convey(`Rejects frames larger than the maximum size.`, func() {
+			buf := bytes.Buffer{}
+			WriteFrame(&buf, make([]byte, 20))
+			r, err := NewSeekableReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 10)
+			So(err, ShouldBeNil)
+			_, err = r.ReadFrameAll()
+			So(err, ShouldEqual, ErrFrameTooLarge)
+		})
+
+		Convey(`Detects truncated streams.`, func() {
+			buf := bytes.Buffer{}
+			WriteFrame(&buf, make([]byte, 20))
+			r, err := NewSeekableReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()-1), 1024)
+			So(err, ShouldBeNil)
+			_, err = r.ReadFrameAll()
+			So(err, ShouldEqual, io.ErrUnexpectedEOF)
+		})
+	})
+}
+
+func TestIndexedWriter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An IndexedWriter produces a valid recordio stream.`, t, func() {
+		buf := bytes.Buffer{}
+		w := NewIndexedWriter(&buf, 2)
+		for _, s := range []string{"a", "bb", "ccc"} {
+			w.Write([]byte(s))
+			So(w.Flush(), ShouldBeNil)
+		}
+		So(w.WriteIndex(), ShouldBeNil)
+
+		records, err := Split(buf.Bytes())
+		So(err, ShouldBeNil)
+		So(len(records), ShouldEqual, 5)
+		So(btos(records[:3]...), ShouldResemble, []string{"a", "bb", "ccc"})
+
+		interval, count, marks, err := decodeIndex(records[3])
+		So(err, ShouldBeNil)
+		So(interval, ShouldEqual, 2)
+		So(count, ShouldEqual, 3)
+		So(marks, ShouldResemble, []int64{0, 5})
+	})
+}
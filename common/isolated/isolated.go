@@ -40,14 +40,27 @@ const (
 
 	// TarArchive represents a tar archive containing a large number of small files.
 	TarArchive FileType = "tar"
+
+	// Chunked represents a file stored as a list of content-defined chunks. The
+	// file content is the concatenation of the chunks, in order.
+	Chunked FileType = "chunked"
 )
 
+// Chunk describes a single piece of a Chunked file.
+type Chunk struct {
+	Digest HexDigest `json:"h"`
+	Size   int64     `json:"s"`
+}
+
 // File describes a single file referenced by content in a .isolated file.
 //
 // For regular files, the Digest, Mode, and Size fields should be set, and the
 // Type field should be set for non-basic files.
+// For chunked files, Chunks is also set; Digest is the hash of the whole
+// content but the content itself is only stored as chunks.
 // For symbolic links, only the Link field should be set.
 type File struct {
+	Chunks []Chunk   `json:"c,omitempty"`
 	Digest HexDigest `json:"h,omitempty"`
 	Link   *string   `json:"l,omitempty"`
 	Mode   *int      `json:"m,omitempty"`
@@ -81,6 +94,17 @@ func TarFile(d HexDigest, mode int, size int64) File {
 	}
 }
 
+// ChunkedFile returns a File populated for a file stored as chunks.
+func ChunkedFile(d HexDigest, mode int, size int64, chunks []Chunk) File {
+	return File{
+		Chunks: chunks,
+		Digest: d,
+		Mode:   &mode,
+		Size:   &size,
+		Type:   Chunked,
+	}
+}
+
 // Isolated is the data from a JSON serialized .isolated file.
 type Isolated struct {
 	Algo        string          `json:"algo"` // Must be "sha-1"
@@ -77,6 +77,7 @@ func New() IsolatedFake {
 	server.handleJSON("/api/isolateservice/v1/preupload", server.preupload)
 	server.handleJSON("/api/isolateservice/v1/finalize_gs_upload", server.finalizeGSUpload)
 	server.handleJSON("/api/isolateservice/v1/store_inline", server.storeInline)
+	server.handleJSON("/api/isolateservice/v1/retrieve", server.retrieve)
 	server.mux.HandleFunc("/fake/cloudstorage", server.fakeCloudStorage)
 
 	// Fail on anything else.
@@ -279,3 +280,39 @@ func (server *isolatedFake) storeInline(r *http.Request) interface{} {
 	//log.Printf("  storing %s = %d bytes", digest, len(raw))
 	return map[string]string{"ok": "true"}
 }
+
+func (server *isolatedFake) retrieve(r *http.Request) interface{} {
+	data := &isolateservice.HandlersEndpointsV1RetrieveRequest{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+	if data.Namespace == nil || data.Namespace.Namespace != "default-gzip" {
+		server.Fail(fmt.Errorf("unexpected namespace %#v", data.Namespace))
+		return map[string]string{"err": "unexpected namespace"}
+	}
+
+	server.lock.Lock()
+	raw, ok := server.contents[isolated.HexDigest(data.Digest)]
+	server.lock.Unlock()
+	if !ok {
+		err := fmt.Errorf("unknown digest %#v", data.Digest)
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+
+	// Always return the content inline, even for large items.
+	buf := bytes.Buffer{}
+	compressor := isolated.GetCompressor(&buf)
+	if _, err := compressor.Write(raw); err != nil {
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+	if err := compressor.Close(); err != nil {
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+	return &isolateservice.HandlersEndpointsV1RetrievedContent{
+		Content: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}
+}
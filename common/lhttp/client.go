@@ -50,7 +50,9 @@ type RequestGen func() (*http.Request, error)
 // example if a TCP connection is terminated while receiving the content.
 //
 // If rFn is nil, NewRequest will use a default exponential backoff strategy
-// only for transient errors.
+// only for transient errors. rFn can be wrapped with retry.WithBreaker or a
+// retry.Budget to protect the remote host from excessive requests. If the
+// circuit breaker refuses the request, retry.ErrCircuitOpen is returned.
 //
 // If errorHandler is nil, the default error handler will drain and close the
 // response body.
@@ -108,7 +110,7 @@ func NewRequest(ctx context.Context, c *http.Client, rFn retry.Factory, rgen Req
 
 			return errorHandler(resp, err)
 		}, nil)
-		if err != nil {
+		if err != nil && err != retry.ErrCircuitOpen {
 			err = fmt.Errorf("%v (attempts: %d)", err, attempts)
 		}
 		return status, err
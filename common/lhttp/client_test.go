@@ -439,3 +439,31 @@ func handlerJSON(t *testing.T, handler jsonAPI) http.Handler {
 		})
 	})
 }
+
+func TestNewRequestCircuitBreaker(t *testing.T) {
+	Convey(`HTTP requests should stop once the circuit breaker opens.`, t, func(c C) {
+		ctx := context.Background()
+
+		serverCalls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			serverCalls++
+			w.WriteHeader(500)
+		}))
+		defer ts.Close()
+
+		breaker := retry.NewCircuitBreaker(retry.BreakerOptions{Failures: 2})
+		clientReq := NewRequest(ctx, http.DefaultClient, breaker.Wrap(fast), httpReqGen("GET", ts.URL, nil),
+			func(resp *http.Response) error {
+				c.So("must not be called", ShouldBeNil)
+				return nil
+			}, nil)
+
+		_, err := clientReq()
+		So(err, ShouldEqual, retry.ErrCircuitOpen)
+		So(serverCalls, ShouldEqual, 2)
+
+		_, err = clientReq()
+		So(err, ShouldEqual, retry.ErrCircuitOpen)
+		So(serverCalls, ShouldEqual, 2)
+	})
+}
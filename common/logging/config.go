@@ -6,6 +6,12 @@ package logging
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
 
 	"golang.org/x/net/context"
 )
@@ -13,16 +19,92 @@ import (
 // Config is a logging configuration structure.
 type Config struct {
 	Level Level
+
+	// Format, if not empty, is the name of the output format to install in the
+	// context by Set. It must have been registered with RegisterFormat.
+	Format string
 }
 
 // AddFlags adds common flags to a supplied FlagSet.
 func (c *Config) AddFlags(fs *flag.FlagSet) {
 	fs.Var(&c.Level, "log-level",
 		"The logging level. Valid options are: debug, info, warning, error.")
+	fs.Var(&formatFlag{&c.Format}, "log-format",
+		fmt.Sprintf("The logging output format. Valid options are: %s. If empty, the "+
+			"default logger is used.", strings.Join(FormatNames(), ", ")))
 }
 
 // Set returns a new context configured to use logging level passed via the
-// command-line level flag.
+// command-line level flag, and the logging format passed via the command-line
+// format flag, if any.
 func (c *Config) Set(ctx context.Context) context.Context {
+	if c.Format != "" {
+		if f := getFormat(c.Format); f != nil {
+			ctx = SetFactory(ctx, f(os.Stderr))
+		}
+	}
 	return SetLevel(ctx, c.Level)
 }
+
+// FormatFactory returns a Factory producing Loggers that write to w in a
+// given output format.
+type FormatFactory func(w io.Writer) Factory
+
+var formats = struct {
+	sync.RWMutex
+	m map[string]FormatFactory
+}{m: map[string]FormatFactory{}}
+
+// RegisterFormat registers a named output format, making it selectable with
+// Config's Format.
+//
+// It is meant to be called by Logger implementations when they are
+// initialized. It panics if the format is already registered.
+func RegisterFormat(name string, f FormatFactory) {
+	formats.Lock()
+	defer formats.Unlock()
+
+	if _, ok := formats.m[name]; ok {
+		panic(fmt.Errorf("logging format %q is already registered", name))
+	}
+	formats.m[name] = f
+}
+
+// FormatNames returns the sorted names of the registered output formats.
+func FormatNames() []string {
+	formats.RLock()
+	defer formats.RUnlock()
+
+	names := make([]string, 0, len(formats.m))
+	for name := range formats.m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getFormat(name string) FormatFactory {
+	formats.RLock()
+	defer formats.RUnlock()
+	return formats.m[name]
+}
+
+// formatFlag is a flag.Value that accepts the name of a registered format.
+type formatFlag struct {
+	v *string
+}
+
+func (f *formatFlag) Set(v string) error {
+	if v != "" && getFormat(v) == nil {
+		return fmt.Errorf("unknown log format %q", v)
+	}
+	*f.v = v
+	return nil
+}
+
+func (f *formatFlag) String() string {
+	if f.v == nil {
+		return ""
+	}
+	return *f.v
+}
@@ -44,6 +44,12 @@ func PickStdFormat(w io.Writer) string {
 	return StdFormat
 }
 
+func init() {
+	logging.RegisterFormat("text", func(w io.Writer) logging.Factory {
+		return (&LoggerConfig{Out: w}).NewLogger
+	})
+}
+
 // StdConfig defines default logger configuration.
 //
 // It logs to Stderr using default logging format compatible with luci-py, see
@@ -0,0 +1,143 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package structlogger implements luci-go/common/logging Loggers that emit
+// machine-readable records, one per line, as JSON objects or in logfmt.
+//
+// Each record holds the time of the message (taken from the context's clock),
+// its level, its call site, the message itself and all of the context's
+// logging fields. Error values are rendered along with their annotated stack,
+// as returned by errors.RenderStack.
+//
+// Importing this package registers the "json" and "logfmt" formats, which can
+// then be selected with logging.Config's "-log-format" flag:
+//
+//   import (
+//     _ "github.com/luci/luci-go/common/logging/structlogger"
+//   )
+//
+// They can also be used directly:
+//
+//   logCfg := structlogger.LoggerConfig{Out: os.Stderr, Format: structlogger.JSON}
+//   ctx = logCfg.Use(ctx)
+package structlogger
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+)
+
+// Format is the output format of a structured logger.
+type Format string
+
+const (
+	// JSON emits each record as a JSON object.
+	JSON Format = "json"
+	// Logfmt emits each record as a list of key=value pairs.
+	Logfmt Format = "logfmt"
+)
+
+// Keys of the standard entries of a record. Fields whose keys collide with
+// them are prefixed with "field.".
+const (
+	TimeKey    = "time"
+	LevelKey   = "level"
+	CallerKey  = "caller"
+	MessageKey = "msg"
+)
+
+func init() {
+	for _, f := range []Format{JSON, Logfmt} {
+		f := f
+		logging.RegisterFormat(string(f), func(w io.Writer) logging.Factory {
+			return (&LoggerConfig{Out: w, Format: f}).NewLogger
+		})
+	}
+}
+
+// LoggerConfig writes structured log records to an io.Writer.
+//
+// If you are using os.File as Out, you are responsible for closing it when you
+// are done with logging.
+type LoggerConfig struct {
+	Out    io.Writer // where to write the log to, required
+	Format Format    // how to format the records, default is JSON
+
+	lock sync.Mutex // Serializes writes to Out.
+}
+
+// NewLogger returns new structured logger bound to the given context.
+//
+// It will use logging level, fields and clock specified in the context. Pass
+// 'nil' as a context to completely disable context-related checks; records
+// then use the system time.
+//
+// lc.NewLogger is in fact logging.Factory and can be used in SetFactory.
+func (lc *LoggerConfig) NewLogger(c context.Context) logging.Logger {
+	return &loggerImpl{lc, c}
+}
+
+// Use registers the structured logger as default logger of the context.
+func (lc *LoggerConfig) Use(c context.Context) context.Context {
+	return logging.SetFactory(c, lc.NewLogger)
+}
+
+// loggerImpl implements logging.Logger. It binds a LoggerConfig to a Context.
+type loggerImpl struct {
+	lc *LoggerConfig
+	c  context.Context // Bound context; may be nil if there is no bound context.
+}
+
+func (li *loggerImpl) Debugf(format string, args ...interface{}) {
+	li.LogCall(logging.Debug, 1, format, args)
+}
+func (li *loggerImpl) Infof(format string, args ...interface{}) {
+	li.LogCall(logging.Info, 1, format, args)
+}
+func (li *loggerImpl) Warningf(format string, args ...interface{}) {
+	li.LogCall(logging.Warning, 1, format, args)
+}
+func (li *loggerImpl) Errorf(format string, args ...interface{}) {
+	li.LogCall(logging.Error, 1, format, args)
+}
+
+func (li *loggerImpl) LogCall(l logging.Level, calldepth int, format string, args []interface{}) {
+	r := record{level: l}
+	if li.c != nil {
+		if !logging.IsLogging(li.c, l) {
+			return
+		}
+		r.time = clock.Now(li.c)
+		r.fields = logging.GetFields(li.c)
+	} else {
+		r.time = time.Now()
+	}
+	if _, file, line, ok := runtime.Caller(calldepth + 1); ok {
+		r.caller = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+	r.message = fmt.Sprintf(format, args...)
+
+	buf := bytes.Buffer{}
+	switch li.lc.Format {
+	case Logfmt:
+		r.writeLogfmt(&buf)
+	default:
+		r.writeJSON(&buf)
+	}
+	buf.WriteByte('\n')
+
+	li.lc.lock.Lock()
+	defer li.lc.lock.Unlock()
+	li.lc.Out.Write(buf.Bytes())
+}
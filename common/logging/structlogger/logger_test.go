@@ -0,0 +1,126 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package structlogger
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A structured logger`, t, func() {
+		c, _ := testclock.UseTime(context.Background(), time.Date(2017, 1, 2, 3, 4, 5, 6000, time.UTC))
+		c = logging.SetLevel(c, logging.Info)
+		buf := bytes.Buffer{}
+		cfg := LoggerConfig{Out: &buf}
+
+		Convey(`Writes JSON records.`, func() {
+			c = cfg.Use(c)
+			logging.Debugf(c, "not logged")
+			logging.Fields{
+				"count": 42,
+				"msg":   "a field",
+				"dur":   time.Second,
+			}.Infof(c, "Hello %s", "world")
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			So(lines, ShouldHaveLength, 1)
+			So(lines[0], ShouldStartWith, `{"time":"2017-01-02T03:04:05.000006Z","level":"info",`)
+
+			var rec map[string]interface{}
+			So(json.Unmarshal([]byte(lines[0]), &rec), ShouldBeNil)
+			So(rec, ShouldResemble, map[string]interface{}{
+				"time":      "2017-01-02T03:04:05.000006Z",
+				"level":     "info",
+				"caller":    rec["caller"],
+				"msg":       "Hello world",
+				"count":     42.0,
+				"dur":       "1s",
+				"field.msg": "a field",
+			})
+			So(rec["caller"], ShouldStartWith, "logger_test.go:")
+		})
+
+		Convey(`Writes logfmt records.`, func() {
+			cfg.Format = Logfmt
+			l := cfg.NewLogger(logging.SetField(c, "path", "a b=c"))
+			l.Warningf("Hi!")
+
+			So(buf.String(), ShouldStartWith, `time=2017-01-02T03:04:05.000006Z level=warning caller=logger_test.go:`)
+			So(buf.String(), ShouldEndWith, ` msg=Hi! path="a b=c"`+"\n")
+		})
+
+		Convey(`Renders errors with their stack.`, func() {
+			err := errors.New("boom")
+			err = errors.Annotate(err).Reason("while testing").Err()
+
+			Convey(`As JSON.`, func() {
+				cfg.NewLogger(logging.SetError(c, err)).Errorf("Failed.")
+
+				var rec struct {
+					Error errorValue `json:"error"`
+				}
+				So(json.Unmarshal(buf.Bytes(), &rec), ShouldBeNil)
+				So(rec.Error.Message, ShouldEqual, "while testing: boom")
+				So(rec.Error.Stack, ShouldNotBeEmpty)
+				So(rec.Error.Stack[0].Frames, ShouldNotBeEmpty)
+
+				f := rec.Error.Stack[0].Frames[0]
+				So(f.File, ShouldEqual, "logger_test.go")
+				So(f.Annotations, ShouldResemble, []string{`reason: "while testing"`})
+			})
+
+			Convey(`As logfmt.`, func() {
+				cfg.Format = Logfmt
+				cfg.NewLogger(logging.SetError(c, err)).Errorf("Failed.")
+
+				So(buf.String(), ShouldContainSubstring, ` error="while testing: boom" error.stack.0=`)
+				So(buf.String(), ShouldContainSubstring, `logger_test.go:`)
+			})
+		})
+
+		Convey(`Logs without a context.`, func() {
+			cfg.NewLogger(nil).Debugf("Debug")
+			So(buf.String(), ShouldContainSubstring, `"level":"debug"`)
+		})
+	})
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey(`The formats are selectable with logging.Config.`, t, func() {
+		So(logging.FormatNames(), ShouldContain, "json")
+		So(logging.FormatNames(), ShouldContain, "logfmt")
+
+		cfg := logging.Config{Level: logging.Info}
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(ioutil.Discard)
+		cfg.AddFlags(fs)
+		So(fs.Parse([]string{"-log-format", "logfmt"}), ShouldBeNil)
+		So(cfg.Format, ShouldEqual, "logfmt")
+
+		l, ok := logging.Get(cfg.Set(context.Background())).(*loggerImpl)
+		So(ok, ShouldBeTrue)
+		So(l.lc.Format, ShouldEqual, Logfmt)
+
+		So(fs.Parse([]string{"-log-format", "unknown"}), ShouldNotBeNil)
+	})
+}
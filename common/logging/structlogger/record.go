@@ -0,0 +1,217 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package structlogger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+)
+
+// record is a single log record.
+type record struct {
+	time    time.Time
+	level   logging.Level
+	caller  string
+	message string
+	fields  logging.Fields
+}
+
+// entry is a key/value pair of a rendered record.
+type entry struct {
+	key   string
+	value interface{}
+}
+
+// entries returns the entries of the record, in order: the standard entries,
+// then the fields with the error first, as sorted by Fields.SortedEntries.
+//
+// Error values are replaced with their errorValue.
+func (r *record) entries() []entry {
+	entries := make([]entry, 0, 4+len(r.fields))
+	entries = append(entries,
+		entry{TimeKey, r.time.UTC().Format(time.RFC3339Nano)},
+		entry{LevelKey, r.level.String()},
+		entry{CallerKey, r.caller},
+		entry{MessageKey, r.message})
+
+	for _, e := range r.fields.SortedEntries() {
+		key := e.Key
+		switch key {
+		case TimeKey, LevelKey, CallerKey, MessageKey:
+			key = "field." + key
+		}
+		value := e.Value
+		if err, ok := value.(error); ok {
+			value = renderError(err)
+		}
+		entries = append(entries, entry{key, value})
+	}
+	return entries
+}
+
+// errorValue is the rendering of an error.
+type errorValue struct {
+	Message string       `json:"message"`
+	Stack   []stackValue `json:"stack,omitempty"`
+}
+
+// stackValue is the rendering of an errors.RenderedStack.
+type stackValue struct {
+	Goroutine uint64       `json:"goroutine"`
+	Frames    []frameValue `json:"frames"`
+}
+
+// frameValue is the rendering of an errors.RenderedFrame. Each annotation and
+// wrapper is rendered as a single, possibly multi-line, string.
+type frameValue struct {
+	Pkg         string   `json:"pkg"`
+	File        string   `json:"file"`
+	Line        int      `json:"line"`
+	Func        string   `json:"func"`
+	Annotations []string `json:"annotations,omitempty"`
+	Wrappers    []string `json:"wrappers,omitempty"`
+}
+
+func (f *frameValue) String() string {
+	s := fmt.Sprintf("%s/%s:%d - %s()", f.Pkg, f.File, f.Line, f.Func)
+	for _, a := range f.Annotations {
+		s += "\n" + a
+	}
+	return s
+}
+
+func renderError(err error) *errorValue {
+	ev := &errorValue{Message: err.Error()}
+	for _, s := range errors.RenderStack(err).Stacks {
+		sv := stackValue{
+			Goroutine: uint64(s.GoID),
+			Frames:    make([]frameValue, len(s.Frames)),
+		}
+		for i, f := range s.Frames {
+			sv.Frames[i] = frameValue{
+				Pkg:         f.Pkg,
+				File:        f.File,
+				Line:        f.LineNum,
+				Func:        f.FuncName,
+				Annotations: joinLines(f.Annotations),
+				Wrappers:    joinLines(f.Wrappers),
+			}
+		}
+		ev.Stack = append(ev.Stack, sv)
+	}
+	return ev
+}
+
+func joinLines(l []errors.Lines) []string {
+	if len(l) == 0 {
+		return nil
+	}
+	s := make([]string, len(l))
+	for i, lines := range l {
+		s[i] = strings.Join(lines, "\n")
+	}
+	return s
+}
+
+// writeJSON writes the record as a JSON object.
+//
+// Values implementing fmt.Stringer, but not json.Marshaler, are written as
+// their String. Values that can't be marshalled are written as formatted by
+// fmt.
+func (r *record) writeJSON(buf *bytes.Buffer) {
+	buf.WriteByte('{')
+	for i, e := range r.entries() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		value := e.value
+		if _, ok := value.(json.Marshaler); !ok {
+			if s, ok := value.(fmt.Stringer); ok {
+				value = s.String()
+			}
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			data, _ = json.Marshal(fmt.Sprint(e.value))
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+}
+
+// writeLogfmt writes the record as a list of key=value pairs.
+//
+// Errors are written as their message, followed by one "<key>.stack.<i>" pair
+// per stack frame, numbered across goroutines.
+func (r *record) writeLogfmt(buf *bytes.Buffer) {
+	first := true
+	write := func(key, value string) {
+		if !first {
+			buf.WriteByte(' ')
+		}
+		first = false
+		buf.WriteString(logfmtKey(key))
+		buf.WriteByte('=')
+		buf.WriteString(logfmtValue(value))
+	}
+
+	for _, e := range r.entries() {
+		switch v := e.value.(type) {
+		case *errorValue:
+			write(e.key, v.Message)
+			i := 0
+			for _, s := range v.Stack {
+				for _, f := range s.Frames {
+					write(fmt.Sprintf("%s.stack.%d", e.key, i), f.String())
+					i++
+				}
+			}
+		case string:
+			write(e.key, v)
+		case fmt.Stringer:
+			write(e.key, v.String())
+		default:
+			write(e.key, fmt.Sprint(v))
+		}
+	}
+}
+
+// logfmtKey replaces the characters that can't appear in a logfmt key with
+// underscores.
+func logfmtKey(k string) string {
+	if k == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if needsQuoting(r) {
+			return '_'
+		}
+		return r
+	}, k)
+}
+
+// logfmtValue quotes v if needed.
+func logfmtValue(v string) string {
+	if v == "" || strings.IndexFunc(v, needsQuoting) >= 0 {
+		return strconv.Quote(v)
+	}
+	return v
+}
+
+func needsQuoting(r rune) bool {
+	return r <= ' ' || r == '=' || r == '"' || r == unicode.ReplacementChar || !unicode.IsPrint(r)
+}
@@ -0,0 +1,290 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package throttlelogger implements a logging.Logger that wraps another one to
+// limit the volume of messages it receives.
+//
+// Throttling is configured through the context with SetConfig, the same way
+// logging levels are configured with logging.SetLevel:
+//
+//   ctx = throttlelogger.Use(gologger.StdConfig.Use(ctx))
+//   ctx = throttlelogger.SetConfig(ctx, throttlelogger.Config{
+//     DedupWindow: 10 * time.Second,
+//     RateLimits: map[logging.Level]throttlelogger.Limit{
+//       logging.Warning: {Rate: 10, Burst: 100},
+//     },
+//   })
+//
+// A context without configuration is not throttled.
+package throttlelogger
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/data/rand/mathrand"
+	"github.com/luci/luci-go/common/logging"
+)
+
+// Config is the throttling configuration of a context.
+//
+// The zero value disables all throttling.
+type Config struct {
+	// DedupWindow, if positive, suppresses the repetitions of a message, at the
+	// same level and with the same fields, for that long after it was first
+	// logged.
+	//
+	// Once the window has expired, a "(repeated N times)" summary is logged for
+	// messages that have been suppressed. Summaries are logged lazily, by the
+	// next call to the logger.
+	DedupWindow time.Duration
+
+	// RateLimits are the rate limits of each level. Levels without a limit are
+	// not rate limited.
+	//
+	// When a message gets through after others were dropped, it is preceded by
+	// a message counting the dropped messages.
+	RateLimits map[logging.Level]Limit
+
+	// DebugSampling, if in ]0, 1[, is the fraction of Debug messages that are
+	// randomly picked to be logged. The others are dropped.
+	DebugSampling float64
+}
+
+// Limit is a token-bucket rate limit.
+type Limit struct {
+	// Rate is the number of messages per second that can be logged in the long
+	// run. If it is not positive, there is no limit.
+	Rate float64
+	// Burst is the number of messages that can be logged at once. If it is less
+	// than 1, 1 will be used.
+	Burst int
+}
+
+type key int
+
+const configKey key = 0
+
+// SetConfig sets the throttling configuration for this context.
+//
+// It can be retrieved with GetConfig(context).
+func SetConfig(c context.Context, cfg Config) context.Context {
+	return context.WithValue(c, configKey, &cfg)
+}
+
+// GetConfig returns the throttling configuration for this context. It returns
+// a zero Config, which doesn't throttle anything, if none is defined.
+func GetConfig(c context.Context) Config {
+	if cfg, ok := c.Value(configKey).(*Config); ok {
+		return *cfg
+	}
+	return Config{}
+}
+
+// Use adds a throttling logger to the context, wrapping the logger factory in
+// the context.
+//
+// All of the loggers produced by the new factory share the same deduplication
+// windows and token buckets, but each of them uses the configuration of the
+// context it is bound to.
+func Use(c context.Context) context.Context {
+	base := logging.GetFactory(c)
+	if base == nil {
+		return c
+	}
+	st := &state{
+		dedup:   map[dedupKey]*dedupEntry{},
+		buckets: map[logging.Level]*bucket{},
+	}
+	return logging.SetFactory(c, func(ic context.Context) logging.Logger {
+		return &throttleImpl{ic, base(ic), st}
+	})
+}
+
+// state is the throttling state shared by the loggers of a factory.
+type state struct {
+	sync.Mutex
+
+	dedup   map[dedupKey]*dedupEntry
+	windows dedupQueue // The entries of dedup, by expiration.
+	buckets map[logging.Level]*bucket
+}
+
+type dedupKey struct {
+	level   logging.Level
+	message string // The rendered message and fields.
+}
+
+type dedupEntry struct {
+	key     dedupKey
+	l       logging.Logger // The logger of the first occurrence.
+	message string         // The rendered message.
+	expires time.Time
+	count   int // Number of suppressed repetitions.
+}
+
+// dedupQueue is a heap of deduplication windows, the earliest to expire
+// first.
+type dedupQueue []*dedupEntry
+
+var _ heap.Interface = (*dedupQueue)(nil)
+
+func (q dedupQueue) Len() int           { return len(q) }
+func (q dedupQueue) Less(i, j int) bool { return q[i].expires.Before(q[j].expires) }
+func (q dedupQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *dedupQueue) Push(x interface{}) {
+	*q = append(*q, x.(*dedupEntry))
+}
+
+func (q *dedupQueue) Pop() interface{} {
+	old := *q
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return e
+}
+
+// bucket is the token bucket of a level.
+type bucket struct {
+	tokens  float64
+	last    time.Time
+	dropped int // Number of messages dropped since the last one logged.
+}
+
+// take takes a token from the bucket if there is one.
+func (b *bucket) take(now time.Time, lim Limit) bool {
+	burst := float64(lim.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	if b.last.IsZero() {
+		b.tokens = burst
+	} else if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * lim.Rate
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		b.dropped++
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// logCall is a message to log once the throttling decision has been made.
+type logCall struct {
+	l       logging.Logger
+	level   logging.Level
+	format  string
+	args    []interface{}
+	message bool // If true, this is the message being throttled itself.
+}
+
+type throttleImpl struct {
+	c  context.Context
+	l  logging.Logger
+	st *state
+}
+
+func (t *throttleImpl) Debugf(fmt string, args ...interface{}) {
+	t.LogCall(logging.Debug, 1, fmt, args)
+}
+
+func (t *throttleImpl) Infof(fmt string, args ...interface{}) {
+	t.LogCall(logging.Info, 1, fmt, args)
+}
+
+func (t *throttleImpl) Warningf(fmt string, args ...interface{}) {
+	t.LogCall(logging.Warning, 1, fmt, args)
+}
+
+func (t *throttleImpl) Errorf(fmt string, args ...interface{}) {
+	t.LogCall(logging.Error, 1, fmt, args)
+}
+
+func (t *throttleImpl) LogCall(level logging.Level, calldepth int, f string, args []interface{}) {
+	if !logging.IsLogging(t.c, level) {
+		return
+	}
+	cfg := GetConfig(t.c)
+	if level == logging.Debug && cfg.DebugSampling > 0 && cfg.DebugSampling < 1 &&
+		mathrand.Float64(t.c) >= cfg.DebugSampling {
+		return
+	}
+
+	out := t.throttle(&cfg, clock.Now(t.c), level, f, args)
+	for _, s := range out {
+		if s.message {
+			t.l.LogCall(level, calldepth+1, f, args)
+		} else {
+			s.l.LogCall(s.level, calldepth+1, s.format, s.args)
+		}
+	}
+}
+
+// throttle updates the throttling state with a new message, and returns what
+// should be logged, in order.
+func (t *throttleImpl) throttle(cfg *Config, now time.Time, level logging.Level, f string, args []interface{}) (out []logCall) {
+	t.st.Lock()
+	defer t.st.Unlock()
+
+	// Summarize and forget the expired deduplication windows. They are popped
+	// in expiration order, so only the expired ones are visited.
+	for len(t.st.windows) > 0 && !now.Before(t.st.windows[0].expires) {
+		e := heap.Pop(&t.st.windows).(*dedupEntry)
+		if e.count > 0 {
+			out = append(out, logCall{l: e.l, level: e.key.level, format: "%s (repeated %d times)",
+				args: []interface{}{e.message, e.count}})
+		}
+		delete(t.st.dedup, e.key)
+	}
+
+	var k *dedupKey
+	var message string
+	if cfg.DedupWindow > 0 {
+		message = fmt.Sprintf(f, args...)
+		k = &dedupKey{level, message}
+		if fields := logging.GetFields(t.c); len(fields) > 0 {
+			k.message += "\x00" + fields.String()
+		}
+		if e := t.st.dedup[*k]; e != nil {
+			e.count++
+			return
+		}
+	}
+
+	if lim, ok := cfg.RateLimits[level]; ok && lim.Rate > 0 {
+		b := t.st.buckets[level]
+		if b == nil {
+			b = &bucket{}
+			t.st.buckets[level] = b
+		}
+		if !b.take(now, lim) {
+			return
+		}
+		if b.dropped > 0 {
+			out = append(out, logCall{l: t.l, level: level, format: "(%d %s messages dropped by the rate limit)",
+				args: []interface{}{b.dropped, level}})
+			b.dropped = 0
+		}
+	}
+
+	// Only start a deduplication window for messages that are logged.
+	if k != nil {
+		e := &dedupEntry{key: *k, l: t.l, message: message, expires: now.Add(cfg.DedupWindow)}
+		t.st.dedup[*k] = e
+		heap.Push(&t.st.windows, e)
+	}
+	return append(out, logCall{message: true})
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package throttlelogger
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/data/rand/mathrand"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/logging/memlogger"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestThrottleLogger(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A throttling logger`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		c = logging.SetLevel(c, logging.Debug)
+		c = memlogger.Use(c)
+		ml := logging.Get(c).(*memlogger.MemLogger)
+		c = Use(c)
+
+		messages := func() (s []string) {
+			for _, e := range ml.Messages() {
+				s = append(s, e.Level.String()+": "+e.Msg)
+			}
+			ml.Reset()
+			return
+		}
+
+		Convey(`Doesn't throttle without a configuration.`, func() {
+			for i := 0; i < 3; i++ {
+				logging.Infof(c, "Hello")
+			}
+			So(messages(), ShouldResemble, []string{"info: Hello", "info: Hello", "info: Hello"})
+		})
+
+		Convey(`Deduplicates repeated messages.`, func() {
+			c = SetConfig(c, Config{DedupWindow: time.Minute})
+
+			for i := 0; i < 5; i++ {
+				logging.Warningf(c, "Disk %s is full", "A")
+				tc.Add(time.Second)
+			}
+			logging.Warningf(c, "Disk %s is full", "B")
+			logging.Fields{"id": 1}.Warningf(c, "Disk %s is full", "A")
+			logging.Errorf(c, "Disk %s is full", "A")
+			So(messages(), ShouldResemble, []string{
+				"warning: Disk A is full",
+				"warning: Disk B is full",
+				"warning: Disk A is full",
+				"error: Disk A is full",
+			})
+
+			tc.Add(time.Minute)
+			logging.Infof(c, "Something else")
+			So(messages(), ShouldContain, "warning: Disk A is full (repeated 4 times)")
+
+			// The window has been reset.
+			logging.Warningf(c, "Disk %s is full", "A")
+			So(messages(), ShouldResemble, []string{"warning: Disk A is full"})
+		})
+
+		Convey(`Summarizes each window when it expires.`, func() {
+			long := SetConfig(c, Config{DedupWindow: 2 * time.Minute})
+			short := SetConfig(c, Config{DedupWindow: time.Minute})
+
+			for i := 0; i < 3; i++ {
+				logging.Warningf(long, "Disk A is full")
+				logging.Warningf(short, "Disk B is full")
+			}
+			So(messages(), ShouldResemble, []string{
+				"warning: Disk A is full",
+				"warning: Disk B is full",
+			})
+
+			tc.Add(time.Minute)
+			logging.Infof(c, "Something else")
+			So(messages(), ShouldResemble, []string{
+				"warning: Disk B is full (repeated 2 times)",
+				"info: Something else",
+			})
+
+			tc.Add(time.Minute)
+			logging.Infof(c, "Something else")
+			So(messages(), ShouldResemble, []string{
+				"warning: Disk A is full (repeated 2 times)",
+				"info: Something else",
+			})
+		})
+
+		Convey(`Rate limits levels.`, func() {
+			c = SetConfig(c, Config{
+				RateLimits: map[logging.Level]Limit{
+					logging.Info: {Rate: 1, Burst: 2},
+				},
+			})
+
+			for i := 0; i < 5; i++ {
+				logging.Infof(c, "Info #%d", i)
+				logging.Errorf(c, "Error #%d", i)
+			}
+			So(messages(), ShouldResemble, []string{
+				"info: Info #0", "error: Error #0",
+				"info: Info #1", "error: Error #1",
+				"error: Error #2",
+				"error: Error #3",
+				"error: Error #4",
+			})
+
+			tc.Add(time.Second)
+			logging.Infof(c, "Info #5")
+			logging.Infof(c, "Info #6")
+			So(messages(), ShouldResemble, []string{
+				"info: (3 info messages dropped by the rate limit)",
+				"info: Info #5",
+			})
+		})
+
+		Convey(`Samples debug messages.`, func() {
+			c = mathrand.Set(c, rand.New(rand.NewSource(0)))
+			c = SetConfig(c, Config{DebugSampling: 0.1})
+
+			for i := 0; i < 1000; i++ {
+				logging.Debugf(c, "Debug")
+				logging.Infof(c, "Info")
+			}
+			debug, info := 0, 0
+			for _, e := range ml.Messages() {
+				switch e.Level {
+				case logging.Debug:
+					debug++
+				case logging.Info:
+					info++
+				}
+			}
+			So(info, ShouldEqual, 1000)
+			So(debug, ShouldBeBetween, 50, 150)
+		})
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"golang.org/x/net/context"
+)
+
+// ErrCircuitOpen is returned by Retry when a circuit breaker refuses an
+// attempt.
+var ErrCircuitOpen = errors.New("retry: circuit breaker is open")
+
+// BreakerState is the state of a CircuitBreaker.
+type BreakerState int
+
+const (
+	// Closed is the normal state, where all attempts are allowed.
+	Closed BreakerState = iota
+	// Open is the state where all attempts are refused.
+	Open
+	// HalfOpen is the state where a single trial attempt is allowed to find out
+	// whether the circuit can be closed again.
+	HalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerOptions configures a CircuitBreaker.
+type BreakerOptions struct {
+	// Failures is the number of consecutive failed attempts after which the
+	// circuit opens. If <= 0, a default of 5 will be used.
+	Failures int
+
+	// OpenTimeout is how long the circuit stays open before a trial attempt is
+	// allowed. If <= 0, a default of 30 seconds will be used.
+	OpenTimeout time.Duration
+
+	// IsFailure returns true if an error returned by an attempt means that the
+	// backend is unhealthy. If nil, errors.IsTransient is used. Errors for which
+	// it returns false count as successful attempts.
+	IsFailure func(error) bool
+}
+
+// CircuitBreaker stops attempts made through it after repeated failures, so a
+// fleet of clients doesn't keep hammering a dead backend.
+//
+// It starts Closed. After Failures consecutive failed attempts it opens and
+// all attempts fail with ErrCircuitOpen. After OpenTimeout it becomes HalfOpen
+// and allows a single trial attempt: if it succeeds the circuit is closed
+// again, otherwise it is opened again.
+//
+// A CircuitBreaker is meant to be shared by all calls to the same backend, see
+// BreakerRegistry.
+type CircuitBreaker struct {
+	opts BreakerOptions
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int       // Number of consecutive failures while Closed.
+	openedAt time.Time // When the circuit was last opened.
+	probing  bool      // True if a trial attempt is in flight while HalfOpen.
+}
+
+// NewCircuitBreaker creates a new, closed, CircuitBreaker.
+func NewCircuitBreaker(o BreakerOptions) *CircuitBreaker {
+	if o.Failures <= 0 {
+		o.Failures = 5
+	}
+	if o.OpenTimeout <= 0 {
+		o.OpenTimeout = 30 * time.Second
+	}
+	if o.IsFailure == nil {
+		o.IsFailure = errors.IsTransient
+	}
+	return &CircuitBreaker{opts: o}
+}
+
+// State returns the current state of the breaker.
+func (b *CircuitBreaker) State(ctx context.Context) BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.updateLocked(clock.Now(ctx))
+	return b.state
+}
+
+// Wrap returns a Factory whose Iterators are gated by this breaker.
+//
+// Returns nil if f is nil.
+func (b *CircuitBreaker) Wrap(f Factory) Factory {
+	if f == nil {
+		return nil
+	}
+	return wrap(f, func(it Iterator) Iterator {
+		return &breakerIterator{Iterator: it, b: b}
+	})
+}
+
+// updateLocked moves from Open to HalfOpen once the open timeout has elapsed.
+func (b *CircuitBreaker) updateLocked(now time.Time) {
+	if b.state == Open && !now.Before(b.openedAt.Add(b.opts.OpenTimeout)) {
+		b.state = HalfOpen
+		b.probing = false
+	}
+}
+
+// admit returns nil if an attempt may be made. probe is true if the attempt is
+// a HalfOpen trial.
+func (b *CircuitBreaker) admit(now time.Time) (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.updateLocked(now)
+	switch b.state {
+	case Open:
+		return false, ErrCircuitOpen
+	case HalfOpen:
+		if b.probing {
+			return false, ErrCircuitOpen
+		}
+		b.probing = true
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// release gives back an admission that didn't lead to an attempt, e.g. because
+// another Gate refused it.
+func (b *CircuitBreaker) release(probe bool) {
+	if probe {
+		b.mu.Lock()
+		b.probing = false
+		b.mu.Unlock()
+	}
+}
+
+// report records the outcome of an admitted attempt.
+func (b *CircuitBreaker) report(now time.Time, probe bool, err error) {
+	failed := err != nil && b.opts.IsFailure(err)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case probe:
+		b.probing = false
+		if failed {
+			b.openLocked(now)
+		} else {
+			b.state = Closed
+			b.failures = 0
+		}
+
+	case b.state != Closed:
+		// An attempt admitted before the circuit opened. The circuit is already
+		// dealing with the failure.
+
+	case failed:
+		b.failures++
+		if b.failures >= b.opts.Failures {
+			b.openLocked(now)
+		}
+
+	default:
+		b.failures = 0
+	}
+}
+
+func (b *CircuitBreaker) openLocked(now time.Time) {
+	b.state = Open
+	b.openedAt = now
+	b.failures = 0
+}
+
+// breakerIterator is an Iterator gated by a CircuitBreaker.
+type breakerIterator struct {
+	Iterator // The wrapped Iterator.
+
+	b     *CircuitBreaker
+	probe bool // True if the current attempt is a trial attempt.
+}
+
+func (i *breakerIterator) Admit(ctx context.Context) (err error) {
+	if i.probe, err = i.b.admit(clock.Now(ctx)); err != nil {
+		return
+	}
+	if err = admit(ctx, i.Iterator); err != nil {
+		// No attempt will be made, so let someone else make the trial.
+		i.b.release(i.probe)
+		i.probe = false
+	}
+	return
+}
+
+func (i *breakerIterator) Report(ctx context.Context, err error) {
+	i.b.report(clock.Now(ctx), i.probe, err)
+	report(ctx, i.Iterator, err)
+}
+
+// BreakerRegistry holds circuit breakers by name, typically the name of the
+// backend they protect, so they can be shared by all calls to that backend.
+type BreakerRegistry struct {
+	// Options is used to create new circuit breakers.
+	Options BreakerOptions
+
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// Get returns the circuit breaker with the given name, creating it if needed.
+func (r *BreakerRegistry) Get(name string) *CircuitBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := r.breakers[name]
+	if b == nil {
+		if r.breakers == nil {
+			r.breakers = make(map[string]*CircuitBreaker)
+		}
+		b = NewCircuitBreaker(r.Options)
+		r.breakers[name] = b
+	}
+	return b
+}
+
+// Breakers is the process-wide BreakerRegistry used by WithBreaker.
+var Breakers BreakerRegistry
+
+// WithBreaker returns a Factory whose Iterators are gated by the circuit
+// breaker registered under name in Breakers.
+//
+// Returns nil if f is nil.
+func WithBreaker(name string, f Factory) Factory {
+	return Breakers.Get(name).Wrap(f)
+}
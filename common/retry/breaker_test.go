@@ -0,0 +1,169 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	lucierrors "github.com/luci/luci-go/common/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A CircuitBreaker with 2 failures and a 1m timeout`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		b := NewCircuitBreaker(BreakerOptions{
+			Failures:    2,
+			OpenTimeout: time.Minute,
+		})
+
+		transient := lucierrors.WrapTransient(errors.New("transient"))
+		fatal := errors.New("fatal")
+
+		calls := 0
+		call := func(err error) error {
+			return Retry(ctx, b.Wrap(None), func() error {
+				calls++
+				return err
+			}, nil)
+		}
+
+		Convey(`Opens after consecutive transient failures.`, func() {
+			So(call(transient), ShouldResemble, transient)
+			So(b.State(ctx), ShouldEqual, Closed)
+			So(call(transient), ShouldResemble, transient)
+			So(b.State(ctx), ShouldEqual, Open)
+
+			So(call(nil), ShouldEqual, ErrCircuitOpen)
+			So(calls, ShouldEqual, 2)
+
+			Convey(`Allows a single trial after the timeout.`, func() {
+				tc.Add(time.Minute)
+				So(b.State(ctx), ShouldEqual, HalfOpen)
+
+				it := b.Wrap(None)().(Gate)
+				So(it.Admit(ctx), ShouldBeNil)
+				So(call(nil), ShouldEqual, ErrCircuitOpen)
+
+				Convey(`Closes if the trial succeeds.`, func() {
+					it.Report(ctx, nil)
+					So(b.State(ctx), ShouldEqual, Closed)
+					So(call(nil), ShouldBeNil)
+				})
+
+				Convey(`Opens again if the trial fails.`, func() {
+					it.Report(ctx, transient)
+					So(b.State(ctx), ShouldEqual, Open)
+					So(call(nil), ShouldEqual, ErrCircuitOpen)
+				})
+			})
+		})
+
+		Convey(`Successes reset the failure count.`, func() {
+			So(call(transient), ShouldResemble, transient)
+			So(call(nil), ShouldBeNil)
+			So(call(transient), ShouldResemble, transient)
+			So(b.State(ctx), ShouldEqual, Closed)
+		})
+
+		Convey(`Non-transient errors do not count as failures.`, func() {
+			So(call(fatal), ShouldEqual, fatal)
+			So(call(fatal), ShouldEqual, fatal)
+			So(b.State(ctx), ShouldEqual, Closed)
+		})
+
+		Convey(`Stops retries once open.`, func() {
+			err := Retry(ctx, b.Wrap(func() Iterator { return &Limited{Retries: 5} }), func() error {
+				calls++
+				return transient
+			}, nil)
+			So(err, ShouldEqual, ErrCircuitOpen)
+			So(calls, ShouldEqual, 2)
+		})
+
+		Convey(`Is reached through TransientOnly.`, func() {
+			err := Retry(ctx, TransientOnly(b.Wrap(func() Iterator { return &Limited{Retries: 5} })), func() error {
+				calls++
+				return transient
+			}, nil)
+			So(err, ShouldEqual, ErrCircuitOpen)
+			So(calls, ShouldEqual, 2)
+		})
+	})
+
+	Convey(`A half-open CircuitBreaker`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		b := NewCircuitBreaker(BreakerOptions{
+			Failures:    1,
+			OpenTimeout: time.Minute,
+		})
+		transient := lucierrors.WrapTransient(errors.New("transient"))
+		So(Retry(ctx, b.Wrap(None), func() error { return transient }, nil), ShouldResemble, transient)
+		tc.Add(time.Minute)
+		So(b.State(ctx), ShouldEqual, HalfOpen)
+
+		Convey(`Gives the trial back if an inner gate refuses it.`, func() {
+			inner := NewCircuitBreaker(BreakerOptions{Failures: 1, OpenTimeout: time.Hour})
+			So(Retry(ctx, inner.Wrap(None), func() error { return transient }, nil), ShouldResemble, transient)
+
+			calls := 0
+			err := Retry(ctx, b.Wrap(inner.Wrap(None)), func() error {
+				calls++
+				return nil
+			}, nil)
+			So(err, ShouldEqual, ErrCircuitOpen)
+			So(calls, ShouldEqual, 0)
+			So(Retry(ctx, b.Wrap(None), func() error { return nil }, nil), ShouldBeNil)
+			So(b.State(ctx), ShouldEqual, Closed)
+		})
+
+		Convey(`Reopens if the trial fails with an exhausted budget.`, func() {
+			budget := Budget{Ratio: 0, MaxBalance: 1}
+			f := func() Iterator { return &Limited{Retries: 5} }
+			So(Retry(ctx, budget.Wrap(f), func() error { return transient }, nil), ShouldResemble, transient)
+			So(budget.Balance(), ShouldEqual, 0)
+
+			calls := 0
+			err := Retry(ctx, b.Wrap(budget.Wrap(f)), func() error {
+				calls++
+				return transient
+			}, nil)
+			So(err, ShouldResemble, transient)
+			So(calls, ShouldEqual, 1)
+			So(b.State(ctx), ShouldEqual, Open)
+
+			tc.Add(time.Minute)
+			So(Retry(ctx, b.Wrap(budget.Wrap(f)), func() error { return nil }, nil), ShouldBeNil)
+			So(b.State(ctx), ShouldEqual, Closed)
+		})
+
+		Convey(`Reopens if the trial panics.`, func() {
+			So(func() {
+				Retry(ctx, b.Wrap(None), func() error { panic("boom") }, nil)
+			}, ShouldPanicWith, "boom")
+			So(b.State(ctx), ShouldEqual, Open)
+
+			tc.Add(time.Minute)
+			So(Retry(ctx, b.Wrap(None), func() error { return nil }, nil), ShouldBeNil)
+			So(b.State(ctx), ShouldEqual, Closed)
+		})
+	})
+
+	Convey(`A BreakerRegistry`, t, func() {
+		r := BreakerRegistry{Options: BreakerOptions{Failures: 3}}
+
+		Convey(`Shares breakers by name.`, func() {
+			So(r.Get("a"), ShouldEqual, r.Get("a"))
+			So(r.Get("a"), ShouldNotEqual, r.Get("b"))
+			So(r.Get("a").opts.Failures, ShouldEqual, 3)
+		})
+	})
+}
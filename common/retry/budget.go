@@ -0,0 +1,116 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retry
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Budget caps the number of retries made through it to a fraction of the
+// number of calls made through it, so that retries can't multiply the load on
+// a struggling backend.
+//
+// Every call, i.e. every Iterator created by a Factory wrapped with Wrap,
+// deposits Ratio into the budget, and every retry withdraws 1 from it. A retry
+// is only made if there is at least 1 left. The balance starts at, and is
+// capped by, MaxBalance, which allows bursts of retries after a quiet period.
+//
+// A Budget must not be copied after first use.
+type Budget struct {
+	// Ratio is the maximum number of retries per call, e.g. 0.1 to allow
+	// retries for 10% of the calls.
+	Ratio float64
+
+	// MaxBalance is the initial and maximum balance of the budget. If <= 0,
+	// a default of 10 will be used.
+	MaxBalance float64
+
+	mu          sync.Mutex
+	initialized bool
+	balance     float64
+}
+
+// Wrap returns a Factory whose Iterators' retries are subject to the budget.
+//
+// Returns nil if f is nil.
+func (b *Budget) Wrap(f Factory) Factory {
+	if f == nil {
+		return nil
+	}
+	return wrap(f, func(it Iterator) Iterator {
+		b.deposit()
+		return &budgetIterator{Iterator: it, b: b}
+	})
+}
+
+// Balance returns the number of retries currently allowed by the budget.
+func (b *Budget) Balance() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.initLocked()
+	return b.balance
+}
+
+func (b *Budget) initLocked() {
+	if !b.initialized {
+		b.balance = b.maxBalance()
+		b.initialized = true
+	}
+}
+
+func (b *Budget) maxBalance() float64 {
+	if b.MaxBalance <= 0 {
+		return 10
+	}
+	return b.MaxBalance
+}
+
+func (b *Budget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.initLocked()
+	if b.balance += b.Ratio; b.balance > b.maxBalance() {
+		b.balance = b.maxBalance()
+	}
+}
+
+func (b *Budget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.initLocked()
+	if b.balance < 1 {
+		return false
+	}
+	b.balance--
+	return true
+}
+
+// budgetIterator is an Iterator whose retries are subject to a Budget.
+type budgetIterator struct {
+	Iterator // The wrapped Iterator.
+
+	b *Budget
+}
+
+func (i *budgetIterator) Next(ctx context.Context, err error) time.Duration {
+	delay := i.Iterator.Next(ctx, err)
+	if delay == Stop || !i.b.withdraw() {
+		return Stop
+	}
+	return delay
+}
+
+func (i *budgetIterator) Admit(ctx context.Context) error {
+	return admit(ctx, i.Iterator)
+}
+
+func (i *budgetIterator) Report(ctx context.Context, err error) {
+	report(ctx, i.Iterator, err)
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Budget allowing 50% retries with a balance of 2`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		tc.SetTimerCallback(func(d time.Duration, _ clock.Timer) { tc.Add(d) })
+
+		b := Budget{Ratio: 0.5, MaxBalance: 2}
+		f := b.Wrap(func() Iterator { return &Limited{Delay: time.Second, Retries: 10} })
+
+		failure := errors.New("failure")
+		attempts := 0
+		call := func() {
+			Retry(ctx, f, func() error {
+				attempts++
+				return failure
+			}, nil)
+		}
+
+		Convey(`Starts full.`, func() {
+			So(b.Balance(), ShouldEqual, 2)
+		})
+
+		Convey(`Stops retrying once the budget is spent.`, func() {
+			call()
+			So(attempts, ShouldEqual, 3)
+			So(b.Balance(), ShouldEqual, 0)
+
+			attempts = 0
+			call()
+			So(attempts, ShouldEqual, 1)
+			So(b.Balance(), ShouldEqual, 0.5)
+
+			attempts = 0
+			call()
+			So(attempts, ShouldEqual, 2)
+			So(b.Balance(), ShouldEqual, 0)
+		})
+
+		Convey(`Refills with successful calls.`, func() {
+			call()
+			call()
+			for i := 0; i < 4; i++ {
+				So(Retry(ctx, f, func() error { return nil }, nil), ShouldBeNil)
+			}
+			So(b.Balance(), ShouldEqual, 2)
+		})
+
+		Convey(`Returns nil for a nil Factory.`, func() {
+			So(b.Wrap(nil), ShouldBeNil)
+		})
+	})
+}
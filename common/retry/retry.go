@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 	"golang.org/x/net/context"
 )
@@ -38,6 +39,20 @@ type Iterator interface {
 	Next(context.Context, error) time.Duration
 }
 
+// Gate is an optional interface that an Iterator can implement to control
+// whether attempts are made at all and to observe their outcome, e.g. to
+// implement a circuit breaker.
+//
+// Iterators that wrap another Iterator should forward these calls to it if it
+// implements Gate.
+type Gate interface {
+	// Admit is called before each attempt, including the first one. If it
+	// returns an error, the attempt is not made and Retry returns that error.
+	Admit(context.Context) error
+	// Report is called after each admitted attempt with its outcome.
+	Report(context.Context, error)
+}
+
 // Factory is a function that produces an independent Iterator instance.
 //
 // Since each Iterator is mutated as it is iterated through, this is used to
@@ -59,11 +74,15 @@ type Factory func() Iterator
 //
 // If 'callback' is not nil, it will be invoked if an error occurs (prior to
 // sleeping).
+//
+// If the Iterator implements Gate, it is consulted before each attempt and is
+// told about the outcome of each attempt.
 func Retry(ctx context.Context, f Factory, fn func() error, callback Callback) (err error) {
 	var it Iterator
 	if f != nil {
 		it = f()
 	}
+	gate, _ := it.(Gate)
 
 	timer := clock.NewTimer(ctx)
 	defer timer.Stop()
@@ -79,7 +98,14 @@ func Retry(ctx context.Context, f Factory, fn func() error, callback Callback) (
 		}
 
 		// Execute the function.
-		err = fn()
+		if gate != nil {
+			if err = gate.Admit(ctx); err != nil {
+				return
+			}
+			err = gatedCall(ctx, gate, fn)
+		} else {
+			err = fn()
+		}
 		if err == nil || it == nil {
 			return
 		}
@@ -103,3 +129,22 @@ func Retry(ctx context.Context, f Factory, fn func() error, callback Callback) (
 		}
 	}
 }
+
+// errPanicked is reported to a Gate when the attempt panicked.
+var errPanicked = errors.WrapTransient(errors.New("retry: attempt panicked"))
+
+// gatedCall calls fn and reports its outcome to gate. If fn panics, the
+// attempt is reported as failed before the panic is propagated, so the gate
+// isn't left waiting for an outcome.
+func gatedCall(ctx context.Context, gate Gate, fn func() error) (err error) {
+	reported := false
+	defer func() {
+		if !reported {
+			gate.Report(ctx, errPanicked)
+		}
+	}()
+	err = fn()
+	reported = true
+	gate.Report(ctx, err)
+	return
+}
@@ -26,6 +26,14 @@ func (i *transientOnlyIterator) Next(ctx context.Context, err error) time.Durati
 	return i.Iterator.Next(ctx, err)
 }
 
+func (i *transientOnlyIterator) Admit(ctx context.Context) error {
+	return admit(ctx, i.Iterator)
+}
+
+func (i *transientOnlyIterator) Report(ctx context.Context, err error) {
+	report(ctx, i.Iterator, err)
+}
+
 // TransientOnly returns an Iterator that wraps another Iterator. It will fall
 // through to the wrapped Iterator if a transient error is encountered;
 // otherwise, it will not retry.
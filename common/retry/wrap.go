@@ -4,6 +4,10 @@
 
 package retry
 
+import (
+	"golang.org/x/net/context"
+)
+
 // wrap wraps a Factory, applying a modification function to each Iterator
 // that it produces.
 func wrap(g Factory, mod func(it Iterator) Iterator) Factory {
@@ -15,3 +19,18 @@ func wrap(g Factory, mod func(it Iterator) Iterator) Factory {
 		return mod(next)
 	}
 }
+
+// admit calls it.Admit if it implements Gate.
+func admit(ctx context.Context, it Iterator) error {
+	if g, ok := it.(Gate); ok {
+		return g.Admit(ctx)
+	}
+	return nil
+}
+
+// report calls it.Report if it implements Gate.
+func report(ctx context.Context, it Iterator, err error) {
+	if g, ok := it.(Gate); ok {
+		g.Report(ctx, err)
+	}
+}
@@ -5,14 +5,23 @@
 package parallel
 
 import (
-	"container/list"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // A Buffer embeds a Runner, overriding its RunOne method to buffer tasks
 // indefinitely without blocking.
+//
+// Buffered tasks are dispatched in decreasing order of their WorkItem's
+// Priority. Tasks of the same priority are dispatched in a round-robin manner
+// between their WorkItem's Key, and in FIFO or LIFO order within a key (see
+// SetFIFO).
 type Buffer struct {
+	// queued is the number of tasks that have been received but not yet sent to
+	// the Runner. It is updated atomically, and is first to be 64-bit aligned.
+	queued int64
+
 	Runner
 
 	// lifo, if non-zero-indicates a LIFO task dispatch or, if zero, a FIFO task
@@ -51,7 +60,7 @@ func (b *Buffer) process() {
 
 	// This is our work buffer. If we have unsent work, any additional work will
 	// be written to this buffer.
-	var buf list.List
+	var buf workQueue
 
 	// Our main processing loop.
 	inC := b.workC
@@ -60,7 +69,7 @@ func (b *Buffer) process() {
 		case work, ok := <-inC:
 			if !ok {
 				// Our work channel has been closed. We aren't accepting any new tasks.
-				if outC == nil && buf.Len() == 0 {
+				if outC == nil && buf.len() == 0 {
 					// We have no buffered work; exit immediately.
 					return
 				}
@@ -69,6 +78,11 @@ func (b *Buffer) process() {
 				inC = nil
 				break
 			}
+			if work.queued.IsZero() {
+				work.queued = time.Now()
+			}
+			atomic.AddInt64(&b.queued, 1)
+			work.started = b.dequeued
 
 			// If we have no immediate work, send "work" directly; otherwise, buffer
 			// work for future sending.
@@ -76,22 +90,15 @@ func (b *Buffer) process() {
 				cur = work
 				outC = b.Runner.WorkC()
 			} else {
-				buf.PushBack(&work)
+				buf.push(&work)
 			}
 
 		case outC <- cur:
 			// "cur" has been sent. Dequeue the next work item, or set outC to nil if
 			// there are no more items.
 			switch {
-			case buf.Len() > 0:
-				var e *list.Element
-				if b.isFIFO() {
-					e = buf.Front()
-				} else {
-					e = buf.Back()
-				}
-
-				cur = *(buf.Remove(e).(*WorkItem))
+			case buf.len() > 0:
+				cur = *buf.pop(b.isFIFO())
 			case inC == nil:
 				//  There's no more immediate work, no buffered work, and we're closed,
 				//  so we're finished.
@@ -104,6 +111,13 @@ func (b *Buffer) process() {
 	}
 }
 
+// dequeued is called by the Runner when it starts executing a work item that
+// was buffered, so that the item stops being counted as queued before its
+// completion is signalled.
+func (b *Buffer) dequeued() {
+	atomic.AddInt64(&b.queued, -1)
+}
+
 // Run implements the same semantics as Runner's Run. However, if the
 // dispatch pipeline is full, Run will buffer the work and return immediately
 // rather than block.
@@ -156,11 +170,19 @@ func (b *Buffer) Close() {
 	b.Runner.Close()
 }
 
+// Stats returns a snapshot of the Buffer's statistics, including its
+// underlying Runner's.
+func (b *Buffer) Stats() Stats {
+	s := b.Runner.Stats()
+	s.Queued = atomic.LoadInt64(&b.queued)
+	return s
+}
+
 // SetFIFO sets the Buffer's task dispatch order to FIFO (true) or LIFO (false).
-// This determines the order in which buffered tasks will be dispatched. In
-// FIFO (first in, first out) mode, the first tasks to be buffered will be
-// dispatchd first. In LIFO (last in, last out) mode, the last tasks to be
-// buffered will be dispatched first.
+// This determines the order in which buffered tasks of the same priority and
+// key will be dispatched. In FIFO (first in, first out) mode, the first tasks
+// to be buffered will be dispatchd first. In LIFO (last in, last out) mode, the
+// last tasks to be buffered will be dispatched first.
 func (b *Buffer) SetFIFO(fifo bool) {
 	if fifo {
 		atomic.StoreInt32(&b.lifo, 0)
@@ -7,6 +7,7 @@ package parallel
 import (
 	"sync/atomic"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -138,6 +139,76 @@ func TestBuffer(t *testing.T) {
 			})
 		})
 
+		Convey(`Dispatches buffered tasks by priority, then round-robin by key.`, func() {
+			// With a Maximum of 1, the first task blocks the Runner, and the second
+			// one is held by the Buffer, ready to be dispatched. Everything after
+			// that is buffered.
+			b.Maximum = 1
+			unblockC := make(chan struct{})
+			b.WorkC() <- WorkItem{F: func() error {
+				<-unblockC
+				return nil
+			}}
+			b.WorkC() <- WorkItem{F: func() error { return nil }}
+
+			var order []string
+			doneC := make(chan struct{})
+			push := func(name string, priority int, key string) {
+				b.WorkC() <- WorkItem{
+					F:        func() error { return nil },
+					After:    func() { order = append(order, name); doneC <- struct{}{} },
+					Priority: priority,
+					Key:      key,
+				}
+			}
+			push("a1", 0, "a")
+			push("a2", 0, "a")
+			push("a3", 0, "a")
+			push("b1", 0, "b")
+			push("c1", 0, "c")
+			push("b2", 0, "b")
+			push("hi", 10, "a")
+			push("lo", -1, "")
+
+			So(b.Stats().Queued, ShouldEqual, 9)
+			close(unblockC)
+			for i := 0; i < 8; i++ {
+				<-doneC
+			}
+			So(order, ShouldResemble, []string{"hi", "a1", "b1", "c1", "a2", "b2", "a3", "lo"})
+		})
+
+		Convey(`Reports statistics.`, func() {
+			b.Maximum = 2
+			unblockC := make(chan struct{})
+			errC := b.Run(func(taskC chan<- func() error) {
+				for i := 0; i < 5; i++ {
+					taskC <- func() error {
+						<-unblockC
+						return nil
+					}
+				}
+			})
+
+			// Two tasks are in flight, and the others are queued.
+			for {
+				if s := b.Stats(); s.InFlight == 2 && s.Queued == 3 {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			close(unblockC)
+			for range errC {
+			}
+			s := b.Stats()
+			So(s.Queued, ShouldEqual, 0)
+			So(s.InFlight, ShouldEqual, 0)
+			So(s.Completed, ShouldEqual, 5)
+			So(s.Waited, ShouldEqual, 5)
+			So(s.WaitTime, ShouldBeGreaterThan, 0)
+		})
+
 		Convey(`Will finish tasks if closed while some are pending.`, func() {
 			const iters = 1000
 			b.Maximum = 10
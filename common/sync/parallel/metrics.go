@@ -0,0 +1,61 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/metric"
+	"github.com/luci/luci-go/common/tsmon/types"
+)
+
+var (
+	workQueued = metric.NewInt(
+		"luci/parallel/queued",
+		"Number of work items buffered, waiting to be dispatched.",
+		nil,
+		field.String("name")) // Name passed to ReportMetrics.
+
+	workInFlight = metric.NewInt(
+		"luci/parallel/in_flight",
+		"Number of work items being executed.",
+		nil,
+		field.String("name")) // Name passed to ReportMetrics.
+
+	workCompleted = metric.NewCounter(
+		"luci/parallel/completed",
+		"Number of work items that have finished executing.",
+		nil,
+		field.String("name")) // Name passed to ReportMetrics.
+
+	workWaitTime = metric.NewFloatCounter(
+		"luci/parallel/wait_time",
+		"Total time that work items waited for a worker.",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("name")) // Name passed to ReportMetrics.
+)
+
+// StatsReporter is implemented by Runner and Buffer.
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// ReportMetrics registers a tsmon callback that reports the statistics of a
+// Runner or of a Buffer under the given name at metric collection time.
+//
+// There is no way to stop reporting, and the callback keeps r alive, so it is
+// meant for the long-lived, shared Runners and Buffers of a service rather than
+// for the ones created for the duration of a request.
+func ReportMetrics(ctx context.Context, name string, r StatsReporter) {
+	tsmon.RegisterCallbackIn(ctx, func(ctx context.Context) {
+		s := r.Stats()
+		workQueued.Set(ctx, s.Queued, name)
+		workInFlight.Set(ctx, s.InFlight, name)
+		workCompleted.Set(ctx, s.Completed, name)
+		workWaitTime.Set(ctx, s.WaitTime.Seconds(), name)
+	})
+}
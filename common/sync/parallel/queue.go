@@ -0,0 +1,86 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package parallel
+
+import (
+	"container/list"
+	"sort"
+)
+
+// workQueue is a queue of work items.
+//
+// Items are dequeued in decreasing order of priority. Within a priority, keys
+// are served in a round-robin manner, and the items of a key are dequeued in
+// FIFO or LIFO order.
+//
+// workQueue is not goroutine-safe.
+type workQueue struct {
+	levels []*priorityLevel // Sorted by decreasing priority.
+	size   int
+}
+
+// priorityLevel holds the items of a given priority.
+type priorityLevel struct {
+	priority int
+	keys     list.List                // Of *keyQueue, in round-robin order.
+	byKey    map[string]*list.Element // Elements of keys, by key.
+}
+
+// keyQueue holds the items of a given priority and key.
+type keyQueue struct {
+	key   string
+	items list.List // Of *WorkItem.
+}
+
+func (q *workQueue) len() int { return q.size }
+
+func (q *workQueue) push(wi *WorkItem) {
+	i := sort.Search(len(q.levels), func(i int) bool { return q.levels[i].priority <= wi.Priority })
+	if i == len(q.levels) || q.levels[i].priority != wi.Priority {
+		q.levels = append(q.levels, nil)
+		copy(q.levels[i+1:], q.levels[i:])
+		q.levels[i] = &priorityLevel{
+			priority: wi.Priority,
+			byKey:    make(map[string]*list.Element),
+		}
+	}
+	l := q.levels[i]
+
+	e := l.byKey[wi.Key]
+	if e == nil {
+		e = l.keys.PushBack(&keyQueue{key: wi.Key})
+		l.byKey[wi.Key] = e
+	}
+	e.Value.(*keyQueue).items.PushBack(wi)
+	q.size++
+}
+
+// pop removes and returns the next work item. The queue must not be empty.
+func (q *workQueue) pop(fifo bool) *WorkItem {
+	l := q.levels[0]
+	e := l.keys.Front()
+	kq := e.Value.(*keyQueue)
+
+	var ie *list.Element
+	if fifo {
+		ie = kq.items.Front()
+	} else {
+		ie = kq.items.Back()
+	}
+	wi := kq.items.Remove(ie).(*WorkItem)
+	q.size--
+
+	// Move on to the next key, and forget about empty keys and levels.
+	if kq.items.Len() == 0 {
+		l.keys.Remove(e)
+		delete(l.byKey, kq.key)
+		if l.keys.Len() == 0 {
+			q.levels = q.levels[1:]
+		}
+	} else {
+		l.keys.MoveToBack(e)
+	}
+	return wi
+}
@@ -7,6 +7,7 @@ package parallel
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // WorkItem is a single item of work that a Runner will execute. The supplied
@@ -31,14 +32,46 @@ type WorkItem struct {
 	// If F panics, After will still be called, and can be used to recover from
 	// the panic.
 	After func()
+
+	// Priority is the priority of this work item. Items buffered by a Buffer are
+	// dispatched in decreasing order of priority. Items of the same priority are
+	// dispatched according to their Key.
+	//
+	// Runner, which doesn't buffer work, ignores it.
+	Priority int
+	// Key identifies the caller or the class of this work item. Buffer
+	// dispatches items of the same priority but different keys in a round-robin
+	// manner, so that callers with a lot of queued items don't starve the
+	// others.
+	//
+	// Runner, which doesn't buffer work, ignores it.
+	Key string
+
+	// queued is the time at which this work item was submitted. It is used to
+	// compute the time that it waited for a worker.
+	queued time.Time
+	// started, if not nil, is called when the Runner starts executing this work
+	// item.
+	started func()
 }
 
-func (wi *WorkItem) execute() {
+// execute executes the work item. finished is called when F returns or panics,
+// before its result is sent to ErrC and before After is called.
+func (wi *WorkItem) execute(finished func()) {
 	if wi.After != nil {
 		defer wi.After()
 	}
 
+	returned := false
+	defer func() {
+		if !returned {
+			finished()
+		}
+	}()
 	err := wi.F()
+	returned = true
+	finished()
+
 	if wi.ErrC != nil {
 		wi.ErrC <- err
 	}
@@ -55,6 +88,10 @@ func (wi *WorkItem) execute() {
 // and consuming resources (namely, its dispatch goroutine) until its Close
 // method is called.
 type Runner struct {
+	// stats are the Runner's statistics, updated atomically. It is first to be
+	// 64-bit aligned.
+	stats runnerStats
+
 	// Sustained is the number of sustained goroutines to use in this Runner.
 	// Sustained goroutines are spawned on demand, but continue running to
 	// dispatch future work until the Runner is closed.
@@ -82,6 +119,61 @@ type Runner struct {
 	dispatchFinishedC chan struct{}
 }
 
+// runnerStats are the counters behind Stats.
+type runnerStats struct {
+	inFlight  int64
+	completed int64
+	waited    int64 // Number of work items that contributed to waitTime.
+	waitTime  int64 // In nanoseconds.
+}
+
+// Stats is a snapshot of the statistics of a Runner or a Buffer.
+type Stats struct {
+	// Queued is the number of work items that are buffered, waiting to be
+	// dispatched. It is always 0 for a Runner, which doesn't buffer work.
+	Queued int64
+	// InFlight is the number of work items being executed.
+	InFlight int64
+	// Completed is the number of work items that have finished executing.
+	Completed int64
+
+	// WaitTime is the total time that the work items that have been executed
+	// waited for a worker, from their submission to the start of their
+	// execution. Items written directly to a Runner's WorkC are not counted.
+	WaitTime time.Duration
+	// Waited is the number of work items that contributed to WaitTime.
+	Waited int64
+}
+
+// Stats returns a snapshot of the Runner's statistics.
+func (r *Runner) Stats() Stats {
+	return Stats{
+		InFlight:  atomic.LoadInt64(&r.stats.inFlight),
+		Completed: atomic.LoadInt64(&r.stats.completed),
+		WaitTime:  time.Duration(atomic.LoadInt64(&r.stats.waitTime)),
+		Waited:    atomic.LoadInt64(&r.stats.waited),
+	}
+}
+
+// execute executes a work item, keeping track of its statistics.
+func (r *Runner) execute(wi *WorkItem) {
+	if wi.started != nil {
+		wi.started()
+	}
+	if !wi.queued.IsZero() {
+		atomic.AddInt64(&r.stats.waitTime, int64(time.Since(wi.queued)))
+		atomic.AddInt64(&r.stats.waited, 1)
+	}
+	atomic.AddInt64(&r.stats.inFlight, 1)
+
+	// Update the statistics before the completion is signalled, so that callers
+	// waiting on ErrC or After see them.
+	wi.execute(func() {
+		atomic.AddInt64(&r.stats.inFlight, -1)
+		atomic.AddInt64(&r.stats.completed, 1)
+	})
+}
+
 // init initializes the starting state of the Runner. It must be called at the
 // beginning of all exported methods.
 func (r *Runner) init() {
@@ -132,12 +224,12 @@ func (r *Runner) dispatchLoopBody(sustained int, before, after func()) {
 			defer after()
 
 			// Execute the work that the outer loop pulled
-			work.execute()
+			r.execute(&work)
 
 			// Sustained execution loop.
 			if isSustained {
 				for work := range r.workC {
-					work.execute()
+					r.execute(&work)
 				}
 			}
 		}()
@@ -202,9 +294,10 @@ func (r *Runner) RunOne(f func() error) <-chan error {
 
 	errC := make(chan error)
 	r.workC <- WorkItem{
-		F:     f,
-		ErrC:  errC,
-		After: func() { close(errC) },
+		F:      f,
+		ErrC:   errC,
+		After:  func() { close(errC) },
+		queued: time.Now(),
 	}
 	return errC
 }
@@ -251,9 +344,10 @@ func runImpl(gen func(chan<- func() error), workC chan<- WorkItem, then func())
 		for task := range taskC {
 			atomic.AddInt32(&count, 1)
 			workC <- WorkItem{
-				F:     task,
-				ErrC:  errC,
-				After: finish,
+				F:      task,
+				ErrC:   errC,
+				After:  finish,
+				queued: time.Now(),
 			}
 		}
 	}()
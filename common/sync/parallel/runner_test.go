@@ -126,6 +126,22 @@ func TestRunner(t *testing.T) {
 			So(err, ShouldEqual, testErr)
 		})
 
+		Convey(`Keeps track of executed tasks.`, func() {
+			for i := 0; i < 10; i++ {
+				<-r.RunOne(func() error { return nil })
+			}
+			r.WorkC() <- WorkItem{F: func() error { return nil }}
+
+			r.Close()
+			s := r.Stats()
+			r = nil // So we don't close it in a defer.
+
+			So(s.Queued, ShouldEqual, 0)
+			So(s.InFlight, ShouldEqual, 0)
+			So(s.Completed, ShouldEqual, 11)
+			So(s.Waited, ShouldEqual, 10) // WorkC items have no submission time.
+		})
+
 		Convey("Ignore consumes the errors and blocks", func() {
 			count := new(int32)
 			Ignore(r.Run(func(ch chan<- func() error) {
@@ -299,6 +299,10 @@ func (c *Client) CallRaw(ctx context.Context, serviceName, methodName string, in
 	// We have to unwrap gRPC errors because
 	// grpc.Code and grpc.ErrorDesc functions do not work with error wrappers.
 	// https://github.com/grpc/grpc-go/issues/494
+	if err == retry.ErrCircuitOpen {
+		logging.Warningf(ctx, "RPC not sent: circuit breaker is open")
+		return nil, grpcutil.Errf(codes.Unavailable, "%s", err)
+	}
 	if err != nil {
 		logging.WithError(err).Warningf(ctx, "RPC failed permanently: %s", err)
 		return nil, errors.Unwrap(err)
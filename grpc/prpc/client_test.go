@@ -275,6 +275,28 @@ func TestClient(t *testing.T) {
 				)
 			})
 
+			Convey("HTTP 500 with a circuit breaker", func(c C) {
+				hits := 0
+				h := transientErrors(10, true, sayHello(c))
+				client, server := setUp(func(w http.ResponseWriter, r *http.Request) {
+					hits++
+					h(w, r)
+				})
+				defer server.Close()
+
+				breaker := retry.NewCircuitBreaker(retry.BreakerOptions{Failures: 2})
+				client.Options.Retry = breaker.Wrap(client.Options.Retry)
+
+				err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+				So(grpc.Code(err), ShouldEqual, codes.Unavailable)
+				So(hits, ShouldEqual, 2)
+
+				err = client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+				So(grpc.Code(err), ShouldEqual, codes.Unavailable)
+				So(hits, ShouldEqual, 2)
+				So(breaker.State(ctx), ShouldEqual, retry.Open)
+			})
+
 			Convey("HTTP 500 without gRPC header", func(c C) {
 				client, server := setUp(transientErrors(10, false, sayHello(c)))
 				defer server.Close()
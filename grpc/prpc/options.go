@@ -16,7 +16,12 @@ import (
 
 // Options controls how RPC requests are sent.
 type Options struct {
-	Retry retry.Factory // RPC retrial.
+	// Retry is the RPC retrial strategy. Only transient errors are retried.
+	//
+	// It can be wrapped with retry.WithBreaker or a retry.Budget to protect the
+	// server from excessive requests. RPCs refused by an open circuit breaker
+	// fail with codes.Unavailable.
+	Retry retry.Factory
 
 	// UserAgent is the value of User-Agent HTTP header.
 	// If empty, DefaultUserAgent is used.
@@ -39,6 +39,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ArchiveCompression is the compression codec applied to the archived log
+// stream and data files.
+type ArchiveCompression int32
+
+const (
+	// Use the default codec: the service's codec for a project, and no
+	// compression for the service.
+	ArchiveCompression_DEFAULT ArchiveCompression = 0
+	// No compression.
+	ArchiveCompression_NONE ArchiveCompression = 1
+	// gzip compression.
+	ArchiveCompression_GZIP ArchiveCompression = 2
+	// Snappy compression.
+	ArchiveCompression_SNAPPY ArchiveCompression = 3
+)
+
+var ArchiveCompression_name = map[int32]string{
+	0: "DEFAULT",
+	1: "NONE",
+	2: "GZIP",
+	3: "SNAPPY",
+}
+var ArchiveCompression_value = map[string]int32{
+	"DEFAULT": 0,
+	"NONE":    1,
+	"GZIP":    2,
+	"SNAPPY":  3,
+}
+
+func (x ArchiveCompression) String() string {
+	return proto.EnumName(ArchiveCompression_name, int32(x))
+}
+func (ArchiveCompression) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 // ArchiveIndexConfig specifies how archive indexes should be generated.
 //
 // By default, each log entry will be present in the index. This is generally
@@ -81,6 +115,7 @@ func (m *ArchiveIndexConfig) GetByteRange() int32 {
 
 func init() {
 	proto.RegisterType((*ArchiveIndexConfig)(nil), "svcconfig.ArchiveIndexConfig")
+	proto.RegisterEnum("svcconfig.ArchiveCompression", ArchiveCompression_name, ArchiveCompression_value)
 }
 
 func init() {
@@ -88,16 +123,19 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x72, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29, 0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9,
-	0xfa, 0x39, 0xf9, 0xe9, 0x29, 0xf9, 0xe9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x79, 0x69,
-	0x99, 0xe9, 0xfa, 0xc5, 0x65, 0xc9, 0x50, 0x56, 0x62, 0x51, 0x72, 0x46, 0x66, 0x59, 0x62, 0x8e,
-	0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x27, 0x5c, 0x46, 0xa9, 0x92, 0x4b, 0xc8, 0x11, 0x2c,
-	0x99, 0xea, 0x99, 0x97, 0x92, 0x5a, 0xe1, 0x0c, 0x16, 0x15, 0x52, 0xe4, 0xe2, 0x29, 0x2e, 0x29,
-	0x4a, 0x4d, 0xcc, 0x8d, 0x2f, 0x4a, 0xcc, 0x4b, 0x4f, 0x95, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0d,
-	0xe2, 0x86, 0x88, 0x05, 0x81, 0x84, 0x40, 0x4a, 0x0a, 0x8a, 0x52, 0xd3, 0x32, 0x2b, 0xa0, 0x4a,
-	0x98, 0x20, 0x4a, 0x20, 0x62, 0x10, 0x25, 0xb2, 0x5c, 0x5c, 0x49, 0x95, 0x25, 0xa9, 0x50, 0x05,
-	0xcc, 0x60, 0x05, 0x9c, 0x20, 0x11, 0xb0, 0x74, 0x12, 0x1b, 0xd8, 0x31, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x06, 0x73, 0xbd, 0x23, 0xd2, 0x00, 0x00, 0x00,
+	// 224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x3c, 0x8f, 0x41, 0x4b, 0x03, 0x31,
+	0x10, 0x46, 0xdd, 0x56, 0xab, 0x9d, 0x7a, 0x58, 0x72, 0xf2, 0x22, 0xa8, 0x27, 0x11, 0x6c, 0x0e,
+	0xfe, 0x82, 0x58, 0xab, 0x14, 0x64, 0x5d, 0xaa, 0x1e, 0xf4, 0x22, 0xd9, 0x34, 0x4d, 0x03, 0xbb,
+	0x99, 0x90, 0xa4, 0xa5, 0xfd, 0xf7, 0x92, 0x64, 0xe9, 0x25, 0x7c, 0xbc, 0xf7, 0x20, 0x0c, 0x3c,
+	0x2b, 0x1d, 0x36, 0xdb, 0x66, 0x2a, 0xb0, 0xa3, 0xed, 0x56, 0xe8, 0xf4, 0x3c, 0x2a, 0xa4, 0x2d,
+	0xaa, 0x15, 0x2a, 0xca, 0xad, 0xa6, 0x02, 0xcd, 0x5a, 0x2b, 0xea, 0x77, 0xa2, 0x5f, 0xdc, 0x89,
+	0x8d, 0xde, 0xf1, 0x76, 0x6a, 0x1d, 0x06, 0x24, 0xe3, 0xa3, 0xb9, 0x3b, 0x00, 0x61, 0x49, 0xca,
+	0x85, 0x59, 0xc9, 0xfd, 0x2c, 0x51, 0x72, 0x0b, 0x97, 0x3e, 0x38, 0xc9, 0xbb, 0x3f, 0xc7, 0x8d,
+	0x92, 0x57, 0xc5, 0x4d, 0x71, 0x7f, 0xb6, 0x9c, 0x64, 0xb6, 0x8c, 0x28, 0x26, 0xd6, 0xc9, 0xb5,
+	0xde, 0xf7, 0xc9, 0x20, 0x27, 0x99, 0xe5, 0xe4, 0x1a, 0xa0, 0x39, 0x04, 0xd9, 0x07, 0xc3, 0x14,
+	0x8c, 0x23, 0x49, 0xfa, 0x81, 0x1d, 0xbf, 0x9e, 0x61, 0x67, 0x9d, 0xf4, 0x5e, 0xa3, 0x21, 0x13,
+	0x38, 0x7f, 0x99, 0xbf, 0xb2, 0xef, 0xf7, 0xaf, 0xf2, 0x84, 0x5c, 0xc0, 0x69, 0xf5, 0x51, 0xcd,
+	0xcb, 0x22, 0xae, 0xb7, 0xdf, 0x45, 0x5d, 0x0e, 0x08, 0xc0, 0xe8, 0xb3, 0x62, 0x75, 0xfd, 0x53,
+	0x0e, 0x9b, 0x51, 0xba, 0xe7, 0xe9, 0x7f, 0x00, 0x93, 0x1e, 0xb9, 0x73, 0x15, 0x01, 0x00, 0x00,
 }
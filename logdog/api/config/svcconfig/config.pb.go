@@ -237,6 +237,9 @@ type Archivist struct {
 	// Service-wide index configuration. This is used if per-project configuration
 	// is not specified.
 	ArchiveIndexConfig *ArchiveIndexConfig `protobuf:"bytes,10,opt,name=archive_index_config,json=archiveIndexConfig" json:"archive_index_config,omitempty"`
+	// Service-wide compression codec of the archived log stream and data files.
+	// This is used if per-project configuration is not specified.
+	ArchiveCompression ArchiveCompression `protobuf:"varint,11,opt,name=archive_compression,json=archiveCompression,enum=svcconfig.ArchiveCompression" json:"archive_compression,omitempty"`
 	// If true, always render the log entries as a binary file during archival,
 	// regardless of whether a specific stream has a binary file extension.
 	//
@@ -258,6 +261,10 @@ type Archivist struct {
 	// Streams without an explicit binary file extension will default to ".bin" if
 	// this is enabled.
 	RenderAllStreams bool `protobuf:"varint,13,opt,name=render_all_streams,json=renderAllStreams" json:"render_all_streams,omitempty"`
+	// If true, also archive an HTML rendering of each text stream, in which ANSI
+	// color codes are rendered as styled spans and each line is anchored by its
+	// stream index. It is stored next to the other archive files as "data.html".
+	RenderHtml bool `protobuf:"varint,14,opt,name=render_html,json=renderHtml" json:"render_html,omitempty"`
 }
 
 func (m *Archivist) Reset()                    { *m = Archivist{} }
@@ -293,6 +300,13 @@ func (m *Archivist) GetArchiveIndexConfig() *ArchiveIndexConfig {
 	return nil
 }
 
+func (m *Archivist) GetArchiveCompression() ArchiveCompression {
+	if m != nil {
+		return m.ArchiveCompression
+	}
+	return ArchiveCompression_DEFAULT
+}
+
 func (m *Archivist) GetRenderAllStreams() bool {
 	if m != nil {
 		return m.RenderAllStreams
@@ -300,6 +314,13 @@ func (m *Archivist) GetRenderAllStreams() bool {
 	return false
 }
 
+func (m *Archivist) GetRenderHtml() bool {
+	if m != nil {
+		return m.RenderHtml
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "svcconfig.Config")
 	proto.RegisterType((*Coordinator)(nil), "svcconfig.Coordinator")
@@ -312,47 +333,49 @@ func init() {
 }
 
 var fileDescriptor1 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0x4b, 0x6b, 0xdb, 0x30,
-	0x1c, 0x27, 0xed, 0xda, 0x2d, 0x4a, 0x1f, 0x89, 0x96, 0x76, 0x5e, 0x61, 0x5d, 0xc8, 0x2e, 0x61,
-	0x74, 0x0e, 0x74, 0x30, 0x76, 0x5c, 0x9a, 0x76, 0x63, 0x8c, 0x52, 0x70, 0x0a, 0x3b, 0x0a, 0x45,
-	0x56, 0x14, 0x51, 0xdb, 0x32, 0x92, 0xdc, 0x7a, 0xfd, 0x0c, 0x3b, 0xed, 0x83, 0x8e, 0x7d, 0x84,
-	0xa1, 0x87, 0x1f, 0xd0, 0x43, 0xa1, 0x97, 0x24, 0xfe, 0xbd, 0xf2, 0x97, 0x7e, 0xb2, 0xc0, 0x17,
-	0xc6, 0xf5, 0xba, 0x58, 0x86, 0x44, 0xa4, 0xd3, 0xa4, 0x20, 0xdc, 0x7e, 0x7c, 0x60, 0x62, 0x9a,
-	0x08, 0x16, 0x0b, 0x36, 0xc5, 0x39, 0x9f, 0x12, 0x91, 0xad, 0x38, 0x9b, 0xaa, 0x5b, 0xe2, 0x7f,
-	0xb9, 0xaf, 0x30, 0x97, 0x42, 0x0b, 0xd8, 0xad, 0xf1, 0xa3, 0xb3, 0xa7, 0x84, 0x61, 0x49, 0xd6,
-	0xfc, 0x16, 0x27, 0x2e, 0xee, 0x68, 0xf6, 0x94, 0x0c, 0xa5, 0x85, 0xc4, 0x8c, 0xfa, 0x88, 0xf9,
-	0x53, 0x22, 0xb4, 0xc4, 0x99, 0xca, 0x85, 0xd4, 0x3e, 0xe4, 0x98, 0x09, 0xc1, 0x12, 0x3a, 0xb5,
-	0x4f, 0xcb, 0x62, 0x35, 0x8d, 0x0b, 0x89, 0x35, 0x17, 0x99, 0xe3, 0xc7, 0xbf, 0x37, 0xc0, 0xf6,
-	0xdc, 0x5a, 0xe1, 0x29, 0xe8, 0xd6, 0xee, 0x00, 0x8c, 0x3a, 0x93, 0xde, 0xe9, 0x30, 0xac, 0x93,
-	0xc3, 0xeb, 0x8a, 0x8b, 0x1a, 0x19, 0x3c, 0x01, 0xcf, 0xfd, 0xd0, 0x41, 0xcf, 0x3a, 0x60, 0xcb,
-	0xb1, 0x70, 0x4c, 0x54, 0x49, 0xe0, 0x67, 0xd0, 0x23, 0x42, 0xc8, 0x98, 0x67, 0x58, 0x0b, 0x19,
-	0x0c, 0xad, 0xe3, 0xb0, 0xe5, 0x98, 0x37, 0x6c, 0xd4, 0x96, 0x9a, 0xd9, 0x88, 0x48, 0x12, 0x4a,
-	0x8c, 0xef, 0xe0, 0xc1, 0x6c, 0xf3, 0x8a, 0x8b, 0x1a, 0x99, 0xf1, 0xb8, 0x52, 0xb8, 0xd2, 0xc1,
-	0xe1, 0x03, 0xcf, 0xac, 0xe2, 0xa2, 0x46, 0x36, 0xfe, 0xb3, 0x09, 0x7a, 0xad, 0x21, 0xe0, 0x04,
-	0xf4, 0x71, 0x9c, 0xf2, 0x0c, 0xe1, 0x42, 0xaf, 0x11, 0x93, 0xa2, 0xc8, 0xed, 0xd6, 0x74, 0xa3,
-	0x3d, 0x8b, 0xcf, 0x0a, 0xbd, 0xfe, 0x66, 0x50, 0x78, 0x02, 0xa0, 0xa2, 0xf2, 0x96, 0x13, 0xda,
-	0xd6, 0xf6, 0xac, 0xb6, 0xef, 0x99, 0x46, 0xfd, 0x1e, 0x0c, 0x64, 0x4e, 0x10, 0x4e, 0x12, 0x71,
-	0x87, 0x84, 0xe4, 0x8c, 0x67, 0x2a, 0x18, 0x8e, 0x36, 0x27, 0xdd, 0x68, 0x5f, 0xe6, 0x64, 0x66,
-	0xf0, 0x2b, 0x07, 0xc3, 0xaf, 0x60, 0x90, 0x4b, 0xba, 0xe2, 0x25, 0xa2, 0x65, 0xce, 0x5d, 0x7b,
-	0x7e, 0x0f, 0x5e, 0x87, 0xae, 0xde, 0xb0, 0xaa, 0x37, 0x3c, 0xf7, 0xf5, 0x46, 0x7d, 0xe7, 0xb9,
-	0xa8, 0x2d, 0xf0, 0x1d, 0xd8, 0x75, 0x0b, 0xa5, 0x48, 0x8b, 0x9c, 0x93, 0xe0, 0xd8, 0x0e, 0xb7,
-	0xe3, 0xc1, 0x6b, 0x83, 0xc1, 0x1f, 0x60, 0x58, 0x89, 0x14, 0xd5, 0x3a, 0xa1, 0x28, 0xa6, 0x09,
-	0xfe, 0x15, 0xbc, 0x7d, 0xec, 0xff, 0xa0, 0xb7, 0x2d, 0xac, 0xeb, 0xdc, 0x98, 0xe0, 0x05, 0x18,
-	0x54, 0x61, 0x36, 0x05, 0xa5, 0xb8, 0x0c, 0x46, 0x8f, 0x25, 0xed, 0x7b, 0x8f, 0xcd, 0xb8, 0xc4,
-	0xe5, 0xf8, 0x6f, 0x07, 0x74, 0xeb, 0x86, 0xe1, 0x27, 0xf0, 0x2a, 0xc5, 0x25, 0x22, 0x22, 0x23,
-	0x85, 0x94, 0x34, 0xd3, 0x28, 0xa5, 0x4a, 0x61, 0x46, 0x55, 0xd0, 0x19, 0x75, 0x26, 0x5b, 0xd1,
-	0x41, 0x8a, 0xcb, 0x79, 0xcd, 0x5e, 0x7a, 0x12, 0x86, 0xe0, 0xa5, 0xf1, 0x79, 0x31, 0xba, 0x13,
-	0xf2, 0x86, 0x4a, 0x15, 0x6c, 0x58, 0xcf, 0x20, 0xc5, 0xa5, 0x57, 0xfe, 0x74, 0x84, 0xa9, 0x5e,
-	0x69, 0xac, 0x29, 0x22, 0x98, 0xac, 0x29, 0x52, 0xfc, 0x9e, 0x06, 0x9b, 0x56, 0xbc, 0x67, 0xf1,
-	0xb9, 0x81, 0x17, 0xfc, 0x9e, 0xc2, 0x2b, 0x70, 0xd8, 0x56, 0xb6, 0x5a, 0x7a, 0xf6, 0xd8, 0x5a,
-	0x87, 0x4d, 0x54, 0xd3, 0xd4, 0xf8, 0x5f, 0x07, 0x74, 0xeb, 0xe3, 0x09, 0xc7, 0x60, 0x47, 0x15,
-	0x4b, 0x45, 0x24, 0xcf, 0x6d, 0x68, 0xc7, 0xd5, 0xd6, 0xc6, 0xe0, 0x10, 0x6c, 0x69, 0xac, 0x6e,
-	0xaa, 0xe5, 0xb8, 0x07, 0x73, 0xca, 0x98, 0x42, 0x4a, 0x63, 0xc6, 0x33, 0x86, 0x96, 0x05, 0xb9,
-	0xa1, 0xda, 0xae, 0xa1, 0x1b, 0xed, 0x33, 0xb5, 0x70, 0xf8, 0x99, 0x85, 0xe1, 0x55, 0x53, 0x3c,
-	0xcf, 0x62, 0x6a, 0x37, 0x78, 0xc5, 0x99, 0xbf, 0x08, 0xde, 0x3c, 0x78, 0x71, 0xe8, 0x77, 0xa3,
-	0x72, 0x57, 0x47, 0x5d, 0x7e, 0x0b, 0x33, 0x2f, 0x84, 0xa4, 0x59, 0x4c, 0xa5, 0x39, 0xe5, 0x48,
-	0x69, 0x49, 0x71, 0xaa, 0x82, 0xdd, 0x51, 0x67, 0xf2, 0x22, 0xea, 0x3b, 0x66, 0x96, 0x24, 0x0b,
-	0x87, 0x2f, 0xb7, 0xed, 0xde, 0x7c, 0xfc, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x11, 0xee, 0x77,
-	0xc9, 0x05, 0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0xdb, 0x4e, 0x1b, 0x39,
+	0x18, 0x56, 0x60, 0x61, 0x37, 0x0e, 0x84, 0xc4, 0x04, 0x76, 0x16, 0x69, 0x21, 0x4a, 0x6f, 0xa2,
+	0x8a, 0x4e, 0x24, 0x2a, 0x55, 0xbd, 0x6c, 0x08, 0xf4, 0xa0, 0x8a, 0x22, 0x4d, 0x90, 0x7a, 0x69,
+	0x39, 0x8e, 0xe3, 0x58, 0xcc, 0x8c, 0x47, 0xb6, 0x07, 0xa6, 0x3c, 0x43, 0xaf, 0xfa, 0x86, 0x7d,
+	0x81, 0x3e, 0x43, 0xe5, 0xc3, 0x1c, 0x24, 0x2a, 0x21, 0x71, 0x93, 0x64, 0xbe, 0x53, 0x7e, 0xfb,
+	0xb3, 0x07, 0xbc, 0x63, 0x5c, 0xaf, 0xf3, 0x45, 0x48, 0x44, 0x32, 0x89, 0x73, 0xc2, 0xed, 0xc7,
+	0x2b, 0x26, 0x26, 0xb1, 0x60, 0x4b, 0xc1, 0x26, 0x38, 0xe3, 0x13, 0x22, 0xd2, 0x15, 0x67, 0x13,
+	0x75, 0x47, 0xfc, 0x2f, 0xf7, 0x15, 0x66, 0x52, 0x68, 0x01, 0xdb, 0x15, 0x7e, 0x74, 0xfe, 0x9c,
+	0x30, 0x2c, 0xc9, 0x9a, 0xdf, 0xe1, 0xd8, 0xc5, 0x1d, 0x4d, 0x9f, 0x93, 0xa1, 0xb4, 0x90, 0x98,
+	0x51, 0x1f, 0x31, 0x7b, 0x4e, 0x84, 0x96, 0x38, 0x55, 0x99, 0x90, 0xda, 0x87, 0x1c, 0x33, 0x21,
+	0x58, 0x4c, 0x27, 0xf6, 0x69, 0x91, 0xaf, 0x26, 0xcb, 0x5c, 0x62, 0xcd, 0x45, 0xea, 0xf8, 0xd1,
+	0xf7, 0x0d, 0xb0, 0x3d, 0xb3, 0x56, 0x78, 0x06, 0xda, 0x95, 0x3b, 0x00, 0xc3, 0xd6, 0xb8, 0x73,
+	0x36, 0x08, 0xab, 0xe4, 0xf0, 0xa6, 0xe4, 0xa2, 0x5a, 0x06, 0x4f, 0xc1, 0xdf, 0x7e, 0xe8, 0xa0,
+	0x63, 0x1d, 0xb0, 0xe1, 0x98, 0x3b, 0x26, 0x2a, 0x25, 0xf0, 0x2d, 0xe8, 0x10, 0x21, 0xe4, 0x92,
+	0xa7, 0x58, 0x0b, 0x19, 0x0c, 0xac, 0xe3, 0xb0, 0xe1, 0x98, 0xd5, 0x6c, 0xd4, 0x94, 0x9a, 0xd9,
+	0x88, 0x88, 0x63, 0x4a, 0x8c, 0xef, 0xe0, 0xd1, 0x6c, 0xb3, 0x92, 0x8b, 0x6a, 0x99, 0xf1, 0xb8,
+	0x52, 0xb8, 0xd2, 0xc1, 0xe1, 0x23, 0xcf, 0xb4, 0xe4, 0xa2, 0x5a, 0x36, 0xfa, 0xb1, 0x09, 0x3a,
+	0x8d, 0x21, 0xe0, 0x18, 0xf4, 0xf0, 0x32, 0xe1, 0x29, 0xc2, 0xb9, 0x5e, 0x23, 0x26, 0x45, 0x9e,
+	0xd9, 0xad, 0x69, 0x47, 0x5d, 0x8b, 0x4f, 0x73, 0xbd, 0xfe, 0x60, 0x50, 0x78, 0x0a, 0xa0, 0xa2,
+	0xf2, 0x8e, 0x13, 0xda, 0xd4, 0x76, 0xac, 0xb6, 0xe7, 0x99, 0x5a, 0xfd, 0x12, 0xf4, 0x65, 0x46,
+	0x10, 0x8e, 0x63, 0x71, 0x8f, 0x84, 0xe4, 0x8c, 0xa7, 0x2a, 0x18, 0x0c, 0x37, 0xc7, 0xed, 0x68,
+	0x4f, 0x66, 0x64, 0x6a, 0xf0, 0x6b, 0x07, 0xc3, 0xf7, 0xa0, 0x9f, 0x49, 0xba, 0xe2, 0x05, 0xa2,
+	0x45, 0xc6, 0x5d, 0x7b, 0x7e, 0x0f, 0xfe, 0x0b, 0x5d, 0xbd, 0x61, 0x59, 0x6f, 0x78, 0xe1, 0xeb,
+	0x8d, 0x7a, 0xce, 0x73, 0x59, 0x59, 0xe0, 0x0b, 0xb0, 0xeb, 0x16, 0x4a, 0x91, 0x16, 0x19, 0x27,
+	0xc1, 0xb1, 0x1d, 0x6e, 0xc7, 0x83, 0x37, 0x06, 0x83, 0x9f, 0xc1, 0xa0, 0x14, 0x29, 0xaa, 0x75,
+	0x4c, 0xd1, 0x92, 0xc6, 0xf8, 0x5b, 0x70, 0xf2, 0xd4, 0xff, 0x41, 0x6f, 0x9b, 0x5b, 0xd7, 0x85,
+	0x31, 0xc1, 0x4b, 0xd0, 0x2f, 0xc3, 0x6c, 0x0a, 0x4a, 0x70, 0x11, 0x0c, 0x9f, 0x4a, 0xda, 0xf3,
+	0x1e, 0x9b, 0x71, 0x85, 0x8b, 0xd1, 0xaf, 0x16, 0x68, 0x57, 0x0d, 0xc3, 0x37, 0xe0, 0xdf, 0x04,
+	0x17, 0x88, 0x88, 0x94, 0xe4, 0x52, 0xd2, 0x54, 0xa3, 0x84, 0x2a, 0x85, 0x19, 0x55, 0x41, 0x6b,
+	0xd8, 0x1a, 0x6f, 0x45, 0x07, 0x09, 0x2e, 0x66, 0x15, 0x7b, 0xe5, 0x49, 0x18, 0x82, 0x7d, 0xe3,
+	0xf3, 0x62, 0x74, 0x2f, 0xe4, 0x2d, 0x95, 0x2a, 0xd8, 0xb0, 0x9e, 0x7e, 0x82, 0x0b, 0xaf, 0xfc,
+	0xea, 0x08, 0x53, 0xbd, 0xd2, 0x58, 0x53, 0x44, 0x30, 0x59, 0x53, 0xa4, 0xf8, 0x03, 0x0d, 0x36,
+	0xad, 0xb8, 0x6b, 0xf1, 0x99, 0x81, 0xe7, 0xfc, 0x81, 0xc2, 0x6b, 0x70, 0xd8, 0x54, 0x36, 0x5a,
+	0xfa, 0xeb, 0xa9, 0xb5, 0x0e, 0xea, 0xa8, 0xba, 0xa9, 0xd1, 0xcf, 0x0d, 0xd0, 0xae, 0x8e, 0x27,
+	0x1c, 0x81, 0x1d, 0x95, 0x2f, 0x14, 0x91, 0x3c, 0xb3, 0xa1, 0x2d, 0x57, 0x5b, 0x13, 0x83, 0x03,
+	0xb0, 0xa5, 0xb1, 0xba, 0x2d, 0x97, 0xe3, 0x1e, 0xcc, 0x29, 0x63, 0x0a, 0x29, 0x8d, 0x19, 0x4f,
+	0x19, 0x5a, 0xe4, 0xe4, 0x96, 0x6a, 0xbb, 0x86, 0x76, 0xb4, 0xc7, 0xd4, 0xdc, 0xe1, 0xe7, 0x16,
+	0x86, 0xd7, 0x75, 0xf1, 0x3c, 0x5d, 0x52, 0xbb, 0xc1, 0x2b, 0xce, 0xfc, 0x8b, 0xe0, 0xff, 0x47,
+	0x17, 0x87, 0x7e, 0x32, 0x2a, 0xf7, 0xea, 0xa8, 0xca, 0x6f, 0x60, 0xe6, 0x42, 0x48, 0x9a, 0x2e,
+	0xa9, 0x34, 0xa7, 0x1c, 0x29, 0x2d, 0x29, 0x4e, 0x54, 0xb0, 0x3b, 0x6c, 0x8d, 0xff, 0x89, 0x7a,
+	0x8e, 0x99, 0xc6, 0xf1, 0xdc, 0xe1, 0xf0, 0x04, 0x74, 0xbc, 0x7a, 0xad, 0x93, 0x38, 0xe8, 0x5a,
+	0x19, 0x70, 0xd0, 0x47, 0x9d, 0xc4, 0xf0, 0x0b, 0xd8, 0x2f, 0xe7, 0x23, 0x22, 0xc9, 0x24, 0x55,
+	0xca, 0x6c, 0x86, 0xb9, 0x60, 0xdd, 0x3f, 0x8d, 0x37, 0xab, 0x45, 0xd5, 0x78, 0x0d, 0x6c, 0xb1,
+	0x6d, 0xcb, 0x78, 0xfd, 0x7b, 0x00, 0x46, 0x35, 0x6a, 0xa0, 0x3a, 0x06, 0x00, 0x00,
 }
@@ -63,6 +63,21 @@ type ProjectConfig struct {
 	// Any unspecified index configuration will default to the service archival
 	// config.
 	ArchiveIndexConfig *ArchiveIndexConfig `protobuf:"bytes,12,opt,name=archive_index_config,json=archiveIndexConfig" json:"archive_index_config,omitempty"`
+	// Project-specific compression codec of the archived log stream and data
+	// files.
+	//
+	// If not specified, the service archival codec is used.
+	ArchiveCompression ArchiveCompression `protobuf:"varint,15,opt,name=archive_compression,json=archiveCompression,enum=svcconfig.ArchiveCompression" json:"archive_compression,omitempty"`
+	// The amount of time after which a log stream is purged.
+	//
+	// Log streams whose creation time is older than this are periodically purged
+	// by the Coordinator's backend: their log data is deleted, leaving only a
+	// tombstone. If this is not set, log streams are retained indefinitely.
+	LogRetention *google_protobuf.Duration `protobuf:"bytes,13,opt,name=log_retention,json=logRetention" json:"log_retention,omitempty"`
+	// If true, also archive an HTML rendering of each text stream.
+	//
+	// See Archivist's "render_html" for more information.
+	RenderHtml bool `protobuf:"varint,14,opt,name=render_html,json=renderHtml" json:"render_html,omitempty"`
 }
 
 func (m *ProjectConfig) Reset()                    { *m = ProjectConfig{} }
@@ -119,6 +134,27 @@ func (m *ProjectConfig) GetArchiveIndexConfig() *ArchiveIndexConfig {
 	return nil
 }
 
+func (m *ProjectConfig) GetArchiveCompression() ArchiveCompression {
+	if m != nil {
+		return m.ArchiveCompression
+	}
+	return ArchiveCompression_DEFAULT
+}
+
+func (m *ProjectConfig) GetLogRetention() *google_protobuf.Duration {
+	if m != nil {
+		return m.LogRetention
+	}
+	return nil
+}
+
+func (m *ProjectConfig) GetRenderHtml() bool {
+	if m != nil {
+		return m.RenderHtml
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ProjectConfig)(nil), "svcconfig.ProjectConfig")
 }
@@ -128,27 +164,31 @@ func init() {
 }
 
 var fileDescriptor2 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4a, 0xfb, 0x40,
-	0x14, 0xc5, 0xe9, 0xbf, 0x7f, 0xc5, 0x4e, 0xab, 0xb6, 0xc1, 0x45, 0x2c, 0x28, 0xc1, 0x55, 0x10,
-	0x4d, 0x40, 0x1f, 0x40, 0x52, 0x3f, 0x8a, 0x2b, 0x25, 0x3e, 0xc0, 0x30, 0x49, 0xa6, 0x93, 0xd1,
-	0x49, 0x26, 0xcc, 0x47, 0xcd, 0xdb, 0xf8, 0xaa, 0x92, 0xb9, 0x69, 0x11, 0x5d, 0x08, 0x6e, 0x42,
-	0x72, 0xcf, 0xef, 0x1e, 0x4e, 0xce, 0x45, 0x09, 0xe3, 0xa6, 0xb4, 0x59, 0x94, 0xcb, 0x2a, 0x16,
-	0x36, 0xe7, 0xee, 0x71, 0xc9, 0x64, 0x2c, 0x24, 0x2b, 0x24, 0x8b, 0x49, 0xc3, 0xe3, 0x5c, 0xd6,
-	0x2b, 0xce, 0x62, 0xbd, 0xce, 0xfb, 0xb7, 0x46, 0xc9, 0x57, 0x9a, 0x9b, 0xa8, 0x51, 0xd2, 0x48,
-	0x6f, 0xb4, 0x15, 0xe6, 0x8b, 0xbf, 0xb8, 0x11, 0x95, 0x97, 0x7c, 0x4d, 0x04, 0xd8, 0xcd, 0x4f,
-	0x99, 0x94, 0x4c, 0xd0, 0xd8, 0x7d, 0x65, 0x76, 0x15, 0x17, 0x56, 0x11, 0xc3, 0x65, 0x0d, 0xfa,
-	0xd9, 0xc7, 0x10, 0xed, 0x3f, 0x43, 0x80, 0x5b, 0x67, 0xe0, 0x5d, 0x20, 0x4f, 0x51, 0x52, 0x50,
-	0x85, 0x89, 0x35, 0x25, 0x66, 0x4a, 0xda, 0x46, 0xfb, 0xff, 0x82, 0x61, 0x38, 0x4a, 0xa7, 0xa0,
-	0x24, 0xd6, 0x94, 0x4b, 0x37, 0xef, 0xe8, 0x77, 0xc5, 0xcd, 0x37, 0x7a, 0x08, 0x34, 0x28, 0x5f,
-	0xe8, 0x1b, 0x74, 0x50, 0x91, 0x16, 0x6b, 0xa3, 0x28, 0xa9, 0x30, 0x61, 0xd4, 0xff, 0x1f, 0x0c,
-	0xc2, 0xf1, 0xd5, 0x71, 0x04, 0x31, 0xa3, 0x4d, 0xcc, 0xe8, 0xae, 0x8f, 0x99, 0x4e, 0x2a, 0xd2,
-	0xbe, 0x38, 0x3e, 0x61, 0xd4, 0x7b, 0x40, 0xb3, 0x46, 0xd1, 0x15, 0x6f, 0x31, 0x6d, 0x1b, 0x0e,
-	0x88, 0xbf, 0xf3, 0x9b, 0xc7, 0x14, 0x76, 0xee, 0xb7, 0x2b, 0xde, 0x39, 0x9a, 0x41, 0x51, 0x14,
-	0x33, 0x8d, 0x33, 0x9b, 0xbf, 0x51, 0xe3, 0xa3, 0x60, 0x10, 0x8e, 0xd2, 0xc3, 0x5e, 0x58, 0xea,
-	0x85, 0x1b, 0x43, 0x21, 0xb5, 0x2b, 0x44, 0x88, 0x3e, 0xbb, 0xf6, 0xc7, 0xc1, 0x20, 0xdc, 0xeb,
-	0x0a, 0xe9, 0x94, 0x44, 0x08, 0xc8, 0xa8, 0xbd, 0x27, 0x74, 0xb4, 0x71, 0xe6, 0x75, 0x41, 0x5b,
-	0x0c, 0x77, 0xf1, 0x27, 0x2e, 0xe4, 0x49, 0xb4, 0xbd, 0x54, 0x94, 0x00, 0xf6, 0xd8, 0x51, 0xd0,
-	0x7d, 0xea, 0x91, 0x1f, 0xb3, 0x6c, 0xd7, 0xfd, 0xcf, 0xf5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x58, 0x02, 0x29, 0xb9, 0x5c, 0x02, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x91, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x15, 0x36, 0x10, 0x75, 0xd7, 0xae, 0x33, 0x5c, 0x84, 0x49, 0x40, 0xc4, 0x55, 0x84,
+	0x20, 0x91, 0xe0, 0x1e, 0x94, 0x0d, 0x18, 0xdc, 0x00, 0x0a, 0x0f, 0x60, 0x39, 0x8e, 0xeb, 0x18,
+	0x9c, 0x38, 0xf2, 0x9f, 0x92, 0xc7, 0xe4, 0x91, 0x50, 0x7d, 0x92, 0x50, 0x01, 0x52, 0xa5, 0xdd,
+	0x54, 0xcd, 0xf9, 0x7e, 0x3e, 0xf9, 0xe5, 0x33, 0x2a, 0x84, 0x74, 0x8d, 0xaf, 0x32, 0xa6, 0xdb,
+	0x5c, 0x79, 0x26, 0xc3, 0xcf, 0x4b, 0xa1, 0x73, 0xa5, 0x45, 0xad, 0x45, 0x4e, 0x7b, 0x99, 0x33,
+	0xdd, 0x6d, 0xa5, 0xc8, 0xed, 0x8e, 0x8d, 0xff, 0x7a, 0xa3, 0xbf, 0x73, 0xe6, 0xb2, 0xde, 0x68,
+	0xa7, 0xf1, 0x62, 0x0e, 0x2e, 0xaf, 0x6e, 0xb3, 0x8d, 0x1a, 0xd6, 0xc8, 0x1d, 0x55, 0xb0, 0xee,
+	0xf2, 0x89, 0xd0, 0x5a, 0x28, 0x9e, 0x87, 0xa7, 0xca, 0x6f, 0xf3, 0xda, 0x1b, 0xea, 0xa4, 0xee,
+	0x20, 0x7f, 0xf6, 0xeb, 0x14, 0xad, 0xbe, 0x82, 0xc0, 0x75, 0x58, 0x80, 0x5f, 0x20, 0x6c, 0x38,
+	0xad, 0xb9, 0x21, 0xd4, 0xbb, 0x86, 0x08, 0xa3, 0x7d, 0x6f, 0xe3, 0x3b, 0xc9, 0x49, 0xba, 0x28,
+	0x37, 0x90, 0x14, 0xde, 0x35, 0x37, 0x61, 0xbe, 0xa7, 0x7f, 0x1a, 0xe9, 0xfe, 0xa2, 0x4f, 0x80,
+	0x86, 0xe4, 0x80, 0x7e, 0x8b, 0xd6, 0x2d, 0x1d, 0x88, 0x75, 0x86, 0xd3, 0x96, 0x50, 0xc1, 0xe3,
+	0xd3, 0x24, 0x4a, 0x97, 0xaf, 0x1e, 0x65, 0xa0, 0x99, 0x4d, 0x9a, 0xd9, 0xbb, 0x51, 0xb3, 0x3c,
+	0x6b, 0xe9, 0xf0, 0x2d, 0xf0, 0x85, 0xe0, 0xf8, 0x03, 0xba, 0xe8, 0x0d, 0xdf, 0xca, 0x81, 0xf0,
+	0xa1, 0x97, 0x80, 0xc4, 0x77, 0x8f, 0xed, 0xd8, 0xc0, 0x99, 0xf7, 0xf3, 0x11, 0xfc, 0x1c, 0x5d,
+	0x40, 0x51, 0x9c, 0x08, 0x4b, 0x2a, 0xcf, 0x7e, 0x70, 0x17, 0xa3, 0x24, 0x4a, 0x17, 0xe5, 0xf9,
+	0x18, 0xdc, 0xd8, 0xab, 0x30, 0x86, 0x42, 0xba, 0x50, 0x88, 0x52, 0xa3, 0xbb, 0x8d, 0x97, 0x49,
+	0x94, 0xde, 0x2f, 0x37, 0x90, 0x14, 0x4a, 0x81, 0xa3, 0xc5, 0x5f, 0xd0, 0xc3, 0x69, 0xb3, 0xec,
+	0x6a, 0x3e, 0x10, 0xb8, 0x97, 0xf8, 0x2c, 0x48, 0x3e, 0xce, 0xe6, 0x9b, 0xca, 0x0a, 0xc0, 0x3e,
+	0xed, 0x29, 0xe8, 0xbe, 0xc4, 0xf4, 0x9f, 0x19, 0x7e, 0x83, 0x56, 0x4a, 0x0b, 0x62, 0xb8, 0xe3,
+	0x5d, 0xf8, 0xdc, 0xd5, 0xd1, 0xca, 0x94, 0x16, 0xe5, 0x84, 0xe3, 0xa7, 0x68, 0x39, 0xea, 0x37,
+	0xae, 0x55, 0xf1, 0x3a, 0x78, 0x23, 0x18, 0x7d, 0x74, 0xad, 0xc2, 0x9f, 0xd1, 0x83, 0xc9, 0x98,
+	0xe9, 0xb6, 0x37, 0xdc, 0xda, 0xfd, 0x6b, 0xce, 0x93, 0x28, 0x5d, 0xff, 0x4f, 0xf8, 0xfa, 0x0f,
+	0x34, 0x0b, 0x1f, 0xcc, 0xaa, 0x7b, 0xc1, 0xe8, 0xf5, 0xef, 0x01, 0x00, 0xde, 0x63, 0x65, 0xcf,
+	0x0d, 0x03, 0x00, 0x00,
 }
@@ -10,6 +10,9 @@ It is generated from these files:
 
 It has these top-level messages:
 	SetConfigRequest
+	PurgeStreamRequest
+	PurgePrefixRequest
+	PurgeResponse
 */
 package logdog
 
@@ -88,8 +91,103 @@ func (m *SetConfigRequest) GetStorageServiceAccountJson() []byte {
 	return nil
 }
 
+// PurgeStreamRequest is the request message for the PurgeStream RPC.
+type PurgeStreamRequest struct {
+	// The project that the log stream belongs to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The path of the log stream to purge.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// The reason for the purge. It is recorded in the log stream's tombstone
+	// and in the purge audit log.
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *PurgeStreamRequest) Reset()                    { *m = PurgeStreamRequest{} }
+func (m *PurgeStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeStreamRequest) ProtoMessage()               {}
+func (*PurgeStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *PurgeStreamRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *PurgeStreamRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PurgeStreamRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// PurgePrefixRequest is the request message for the PurgePrefix RPC.
+type PurgePrefixRequest struct {
+	// The project that the prefix belongs to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The prefix whose log streams will be purged.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix" json:"prefix,omitempty"`
+	// The reason for the purge. It is recorded in the log streams' tombstones
+	// and in the purge audit log.
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *PurgePrefixRequest) Reset()                    { *m = PurgePrefixRequest{} }
+func (m *PurgePrefixRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgePrefixRequest) ProtoMessage()               {}
+func (*PurgePrefixRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *PurgePrefixRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *PurgePrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *PurgePrefixRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// PurgeResponse is the response message for the purge RPCs.
+type PurgeResponse struct {
+	// The paths of the log streams that were purged.
+	Paths []string `protobuf:"bytes,1,rep,name=paths" json:"paths,omitempty"`
+}
+
+func (m *PurgeResponse) Reset()                    { *m = PurgeResponse{} }
+func (m *PurgeResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeResponse) ProtoMessage()               {}
+func (*PurgeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *PurgeResponse) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SetConfigRequest)(nil), "logdog.SetConfigRequest")
+	proto.RegisterType((*PurgeStreamRequest)(nil), "logdog.PurgeStreamRequest")
+	proto.RegisterType((*PurgePrefixRequest)(nil), "logdog.PurgePrefixRequest")
+	proto.RegisterType((*PurgeResponse)(nil), "logdog.PurgeResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -106,6 +204,13 @@ type AdminClient interface {
 	// SetConfig loads the supplied configuration into a config.GlobalConfig
 	// instance.
 	SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// PurgeStream deletes a log stream's log data from intermediate storage and
+	// from its archive, leaving a tombstone in its place.
+	//
+	// Purging is idempotent, so a failed purge can be retried.
+	PurgeStream(ctx context.Context, in *PurgeStreamRequest, opts ...grpc.CallOption) (*PurgeResponse, error)
+	// PurgePrefix purges all of the log streams registered under a prefix.
+	PurgePrefix(ctx context.Context, in *PurgePrefixRequest, opts ...grpc.CallOption) (*PurgeResponse, error)
 }
 type adminPRPCClient struct {
 	client *prpc.Client
@@ -124,6 +229,24 @@ func (c *adminPRPCClient) SetConfig(ctx context.Context, in *SetConfigRequest, o
 	return out, nil
 }
 
+func (c *adminPRPCClient) PurgeStream(ctx context.Context, in *PurgeStreamRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	err := c.client.Call(ctx, "logdog.Admin", "PurgeStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminPRPCClient) PurgePrefix(ctx context.Context, in *PurgePrefixRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	err := c.client.Call(ctx, "logdog.Admin", "PurgePrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type adminClient struct {
 	cc *grpc.ClientConn
 }
@@ -141,12 +264,37 @@ func (c *adminClient) SetConfig(ctx context.Context, in *SetConfigRequest, opts
 	return out, nil
 }
 
+func (c *adminClient) PurgeStream(ctx context.Context, in *PurgeStreamRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	err := grpc.Invoke(ctx, "/logdog.Admin/PurgeStream", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) PurgePrefix(ctx context.Context, in *PurgePrefixRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	err := grpc.Invoke(ctx, "/logdog.Admin/PurgePrefix", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Admin service
 
 type AdminServer interface {
 	// SetConfig loads the supplied configuration into a config.GlobalConfig
 	// instance.
 	SetConfig(context.Context, *SetConfigRequest) (*google_protobuf.Empty, error)
+	// PurgeStream deletes a log stream's log data from intermediate storage and
+	// from its archive, leaving a tombstone in its place.
+	//
+	// Purging is idempotent, so a failed purge can be retried.
+	PurgeStream(context.Context, *PurgeStreamRequest) (*PurgeResponse, error)
+	// PurgePrefix purges all of the log streams registered under a prefix.
+	PurgePrefix(context.Context, *PurgePrefixRequest) (*PurgeResponse, error)
 }
 
 func RegisterAdminServer(s prpc.Registrar, srv AdminServer) {
@@ -171,6 +319,42 @@ func _Admin_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_PurgeStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PurgeStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Admin/PurgeStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PurgeStream(ctx, req.(*PurgeStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_PurgePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PurgePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Admin/PurgePrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PurgePrefix(ctx, req.(*PurgePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -179,6 +363,14 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetConfig",
 			Handler:    _Admin_SetConfig_Handler,
 		},
+		{
+			MethodName: "PurgeStream",
+			Handler:    _Admin_PurgeStream_Handler,
+		},
+		{
+			MethodName: "PurgePrefix",
+			Handler:    _Admin_PurgePrefix_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/luci/luci-go/logdog/api/endpoints/coordinator/admin/v1/admin.proto",
@@ -189,23 +381,31 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0xa9, 0xe2, 0x60, 0xd1, 0xc3, 0xc8, 0x41, 0xea, 0x54, 0x1c, 0x9e, 0x76, 0xd0, 0x04,
-	0xf5, 0x2c, 0x32, 0x44, 0x0f, 0x82, 0x20, 0x1d, 0x9e, 0x4b, 0x9a, 0x66, 0x69, 0xa4, 0xcd, 0xab,
-	0xc9, 0xcb, 0xc0, 0x3f, 0xcf, 0xff, 0x4c, 0x96, 0x74, 0x3d, 0x78, 0x09, 0x79, 0xef, 0xfb, 0x3d,
-	0x3e, 0xbe, 0x8f, 0xbc, 0x6b, 0x83, 0x4d, 0xa8, 0x98, 0x84, 0x8e, 0xb7, 0x41, 0x9a, 0xf8, 0xdc,
-	0x6a, 0xe0, 0x2d, 0xe8, 0x1a, 0x34, 0x17, 0xbd, 0xe1, 0xca, 0xd6, 0x3d, 0x18, 0x8b, 0x9e, 0x4b,
-	0x00, 0x57, 0x1b, 0x2b, 0x10, 0x1c, 0x17, 0x75, 0x67, 0x2c, 0xdf, 0xde, 0xa5, 0x0f, 0xeb, 0x1d,
-	0x20, 0xd0, 0x49, 0x3a, 0x9b, 0x9f, 0x6b, 0x00, 0xdd, 0x2a, 0x1e, 0xb7, 0x55, 0xd8, 0x70, 0xd5,
-	0xf5, 0xf8, 0x93, 0xa0, 0xeb, 0xdf, 0x8c, 0xcc, 0xd6, 0x0a, 0x9f, 0xc1, 0x6e, 0x8c, 0x2e, 0xd4,
-	0x77, 0x50, 0x1e, 0xe9, 0x0d, 0xa1, 0x32, 0x2e, 0x4a, 0xaf, 0xdc, 0xd6, 0x48, 0x55, 0x06, 0xd7,
-	0xe6, 0xd9, 0x22, 0x5b, 0x4e, 0x8b, 0x59, 0x52, 0xd6, 0x49, 0xf8, 0x74, 0x2d, 0xbd, 0x24, 0x64,
-	0xa4, 0x31, 0x3f, 0x88, 0xd4, 0x74, 0x4f, 0x21, 0xbd, 0x22, 0xc7, 0x83, 0xdc, 0x0b, 0x6c, 0xf2,
-	0xc3, 0xa8, 0x0f, 0x17, 0x1f, 0x02, 0x1b, 0xfa, 0x44, 0x2e, 0x3c, 0x82, 0x13, 0x5a, 0x8d, 0x76,
-	0x42, 0x4a, 0x08, 0x16, 0xcb, 0x2f, 0x0f, 0x36, 0xaf, 0x17, 0xd9, 0xf2, 0xa4, 0x38, 0x1b, 0x98,
-	0xc1, 0x78, 0x95, 0x88, 0x37, 0x0f, 0xf6, 0xfe, 0x95, 0x1c, 0xad, 0x76, 0xb9, 0xe9, 0x23, 0x99,
-	0x8e, 0x59, 0x68, 0xce, 0x52, 0x7e, 0xf6, 0x3f, 0xde, 0xfc, 0x94, 0xa5, 0x46, 0xd8, 0xbe, 0x11,
-	0xf6, 0xb2, 0x6b, 0xa4, 0x9a, 0xc4, 0xf9, 0xe1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x39, 0x46, 0x12,
-	0xba, 0x88, 0x01, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x8b, 0x13, 0x31,
+	0x14, 0xc6, 0x19, 0xd7, 0xad, 0xf4, 0xad, 0xc2, 0x12, 0xb4, 0x8c, 0x55, 0xb1, 0x14, 0x84, 0x1e,
+	0x74, 0x82, 0x7a, 0x16, 0x5d, 0xc4, 0x8b, 0x20, 0x94, 0x29, 0x5e, 0x3c, 0x58, 0xd2, 0xcc, 0x6b,
+	0x9a, 0x65, 0x9a, 0x17, 0x93, 0xcc, 0xa2, 0x7f, 0x9e, 0x57, 0xff, 0x2a, 0x69, 0x92, 0x19, 0xdc,
+	0x95, 0xb2, 0x97, 0x21, 0x2f, 0xef, 0xcb, 0xef, 0x7d, 0xf3, 0x3e, 0xf8, 0xa2, 0x74, 0xd8, 0x75,
+	0x9b, 0x4a, 0xd2, 0x9e, 0xb7, 0x9d, 0xd4, 0xf1, 0xf3, 0x4a, 0x11, 0x6f, 0x49, 0x35, 0xa4, 0xb8,
+	0xb0, 0x9a, 0xa3, 0x69, 0x2c, 0x69, 0x13, 0x3c, 0x97, 0x44, 0xae, 0xd1, 0x46, 0x04, 0x72, 0x5c,
+	0x34, 0x7b, 0x6d, 0xf8, 0xd5, 0xeb, 0x74, 0xa8, 0xac, 0xa3, 0x40, 0x6c, 0x94, 0x9e, 0x4d, 0x9f,
+	0x28, 0x22, 0xd5, 0x22, 0x8f, 0xb7, 0x9b, 0x6e, 0xcb, 0x71, 0x6f, 0xc3, 0xaf, 0x24, 0x9a, 0xff,
+	0x2e, 0xe0, 0x7c, 0x85, 0xe1, 0x23, 0x99, 0xad, 0x56, 0x35, 0xfe, 0xe8, 0xd0, 0x07, 0xf6, 0x12,
+	0x98, 0x8c, 0x17, 0x6b, 0x8f, 0xee, 0x4a, 0x4b, 0x5c, 0x77, 0xae, 0x2d, 0x8b, 0x59, 0xb1, 0x18,
+	0xd7, 0xe7, 0xa9, 0xb3, 0x4a, 0x8d, 0xaf, 0xae, 0x65, 0xcf, 0x00, 0x06, 0x75, 0x28, 0xef, 0x44,
+	0xd5, 0xb8, 0x57, 0x05, 0xf6, 0x1c, 0xce, 0x72, 0xdb, 0x8a, 0xb0, 0x2b, 0x4f, 0x62, 0x3f, 0xbf,
+	0x58, 0x8a, 0xb0, 0x63, 0xef, 0xe1, 0xa9, 0x0f, 0xe4, 0x84, 0xc2, 0x61, 0x9c, 0x90, 0x92, 0x3a,
+	0x13, 0xd6, 0x97, 0x9e, 0x4c, 0xd9, 0xcc, 0x8a, 0xc5, 0xfd, 0xfa, 0x71, 0xd6, 0xe4, 0xc1, 0x17,
+	0x49, 0xf1, 0xd9, 0x93, 0x99, 0x7f, 0x03, 0xb6, 0xec, 0x9c, 0xc2, 0x55, 0x70, 0x28, 0xf6, 0xfd,
+	0x4f, 0x94, 0x70, 0xcf, 0x3a, 0xba, 0x44, 0x19, 0xb2, 0xf3, 0xbe, 0x64, 0x0c, 0xee, 0x46, 0x2b,
+	0xc9, 0x6a, 0x3c, 0xb3, 0x09, 0x8c, 0x1c, 0x8a, 0xc3, 0xb8, 0x64, 0x30, 0x57, 0xf3, 0xef, 0x99,
+	0xbd, 0x74, 0xb8, 0xd5, 0x3f, 0x6f, 0x67, 0x4f, 0x60, 0x64, 0xa3, 0x34, 0xd3, 0x73, 0x75, 0x94,
+	0xff, 0x02, 0x1e, 0x44, 0x7e, 0x8d, 0xde, 0x92, 0xf1, 0xc8, 0x1e, 0xc2, 0xe9, 0xc1, 0x90, 0x2f,
+	0x8b, 0xd9, 0xc9, 0x62, 0x5c, 0xa7, 0xe2, 0xcd, 0x9f, 0x02, 0x4e, 0x2f, 0x0e, 0xd9, 0xb2, 0x77,
+	0x30, 0x1e, 0xf2, 0x62, 0x65, 0x95, 0x32, 0xae, 0x6e, 0x46, 0x38, 0x9d, 0x54, 0x29, 0xf5, 0xaa,
+	0x4f, 0xbd, 0xfa, 0x74, 0x48, 0x9d, 0x7d, 0x80, 0xb3, 0x7f, 0x76, 0xc5, 0xa6, 0x3d, 0xe0, 0xff,
+	0x05, 0x4e, 0x1f, 0x5d, 0xeb, 0x0d, 0x06, 0x7b, 0x42, 0xda, 0xc8, 0x0d, 0xc2, 0xb5, 0x35, 0x1d,
+	0x21, 0x6c, 0x46, 0xd1, 0xd3, 0xdb, 0xbf, 0x03, 0x00, 0xa9, 0x17, 0x07, 0x4c, 0xf0, 0x02, 0x00,
+	0x00,
 }
@@ -38,3 +38,33 @@ func (s *DecoratedAdmin) SetConfig(c context.Context, req *SetConfigRequest) (rs
 	}
 	return
 }
+
+func (s *DecoratedAdmin) PurgeStream(c context.Context, req *PurgeStreamRequest) (rsp *PurgeResponse, err error) {
+	var newCtx context.Context
+	if s.Prelude != nil {
+		newCtx, err = s.Prelude(c, "PurgeStream", req)
+	}
+	if err == nil {
+		c = newCtx
+		rsp, err = s.Service.PurgeStream(c, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(c, "PurgeStream", rsp, err)
+	}
+	return
+}
+
+func (s *DecoratedAdmin) PurgePrefix(c context.Context, req *PurgePrefixRequest) (rsp *PurgeResponse, err error) {
+	var newCtx context.Context
+	if s.Prelude != nil {
+		newCtx, err = s.Prelude(c, "PurgePrefix", req)
+	}
+	if err == nil {
+		c = newCtx
+		rsp, err = s.Service.PurgePrefix(c, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(c, "PurgePrefix", rsp, err)
+	}
+	return
+}
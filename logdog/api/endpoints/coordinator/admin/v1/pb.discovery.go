@@ -12,179 +12,136 @@ func init() {
 			"logdog.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 164, 87, 207, 115, 219, 198,
-			245, 39, 0, 138, 148, 55, 182, 99, 35, 254, 202, 138, 236, 56,
-			47, 178, 18, 203, 49, 77, 37, 246, 204, 119, 58, 246, 244, 7,
-			72, 66, 18, 28, 138, 96, 1, 208, 138, 78, 54, 4, 44, 73,
-			120, 64, 44, 139, 93, 202, 81, 51, 185, 244, 47, 233, 244, 212,
-			230, 222, 222, 122, 200, 177, 51, 61, 247, 95, 232, 177, 211, 91,
-			103, 218, 67, 231, 237, 46, 40, 89, 78, 218, 67, 53, 26, 9,
-			31, 236, 219, 207, 126, 222, 143, 221, 125, 32, 127, 252, 63, 114,
-			107, 194, 216, 36, 167, 59, 243, 146, 9, 118, 188, 24, 239, 208,
-			217, 92, 156, 182, 37, 180, 223, 85, 131, 237, 106, 112, 179, 73,
-			86, 92, 28, 239, 12, 201, 123, 9, 155, 181, 47, 140, 119, 136,
-			28, 29, 34, 28, 26, 191, 54, 140, 127, 24, 198, 111, 76, 107,
-			111, 216, 249, 214, 188, 179, 167, 108, 135, 218, 182, 125, 72, 243,
-			252, 139, 130, 189, 46, 162, 211, 57, 229, 207, 126, 119, 131, 52,
-			236, 250, 157, 218, 227, 107, 228, 207, 151, 137, 113, 217, 182, 238,
-			212, 236, 71, 223, 93, 6, 57, 33, 97, 57, 116, 22, 227, 49,
-			45, 57, 60, 4, 69, 117, 143, 67, 26, 139, 24, 178, 66, 208,
-			50, 153, 198, 197, 132, 194, 152, 149, 179, 88, 16, 232, 178, 249,
-			105, 153, 77, 166, 2, 30, 125, 246, 217, 143, 244, 4, 240, 138,
-			164, 13, 224, 228, 57, 200, 49, 14, 37, 229, 180, 60, 161, 105,
-			155, 192, 84, 136, 57, 127, 178, 179, 147, 210, 19, 154, 179, 57,
-			45, 121, 229, 93, 194, 102, 42, 60, 9, 203, 31, 30, 43, 17,
-			59, 132, 64, 64, 211, 140, 139, 50, 59, 94, 136, 140, 21, 16,
-			23, 41, 44, 56, 133, 172, 0, 206, 22, 101, 66, 229, 155, 227,
-			172, 136, 203, 83, 169, 139, 183, 224, 117, 38, 166, 192, 74, 249,
-			159, 45, 4, 129, 25, 75, 179, 113, 150, 196, 200, 208, 130, 184,
-			164, 48, 167, 229, 44, 19, 130, 166, 48, 47, 217, 73, 150, 210,
-			20, 196, 52, 22, 32, 166, 232, 93, 158, 179, 215, 89, 49, 129,
-			132, 21, 105, 134, 147, 56, 78, 34, 48, 163, 226, 9, 33, 128,
-			63, 159, 94, 16, 198, 129, 141, 43, 69, 9, 75, 41, 204, 22,
-			92, 64, 73, 69, 156, 21, 146, 53, 62, 102, 39, 56, 164, 35,
-			70, 160, 96, 34, 75, 104, 11, 196, 52, 227, 144, 103, 92, 32,
-			195, 249, 21, 139, 244, 130, 156, 52, 227, 73, 30, 103, 51, 90,
-			182, 127, 72, 68, 86, 156, 143, 69, 37, 98, 94, 178, 116, 145,
-			208, 51, 29, 228, 76, 200, 255, 164, 131, 128, 246, 46, 101, 201,
-			98, 70, 11, 17, 87, 73, 218, 97, 37, 48, 49, 165, 37, 204,
-			98, 65, 203, 44, 206, 249, 89, 168, 101, 130, 196, 148, 18, 56,
-			175, 126, 233, 212, 128, 102, 114, 38, 18, 23, 241, 140, 162, 160,
-			243, 181, 85, 176, 179, 49, 25, 247, 76, 112, 244, 168, 80, 84,
-			172, 228, 48, 139, 79, 225, 152, 98, 165, 164, 32, 24, 208, 34,
-			101, 37, 167, 88, 20, 243, 146, 205, 152, 160, 160, 98, 34, 56,
-			164, 180, 204, 78, 104, 10, 227, 146, 205, 136, 138, 2, 103, 99,
-			241, 26, 203, 68, 87, 16, 240, 57, 77, 176, 130, 96, 94, 102,
-			88, 88, 37, 214, 78, 161, 170, 136, 115, 169, 157, 64, 180, 239,
-			133, 16, 250, 187, 209, 161, 19, 184, 224, 133, 48, 12, 252, 231,
-			94, 207, 237, 65, 231, 8, 162, 125, 23, 186, 254, 240, 40, 240,
-			246, 246, 35, 216, 247, 251, 61, 55, 8, 193, 25, 244, 160, 235,
-			15, 162, 192, 235, 140, 34, 63, 8, 9, 108, 58, 33, 120, 225,
-			166, 28, 113, 6, 71, 224, 126, 57, 12, 220, 48, 4, 63, 0,
-			239, 96, 216, 247, 220, 30, 28, 58, 65, 224, 12, 34, 207, 13,
-			91, 224, 13, 186, 253, 81, 207, 27, 236, 181, 160, 51, 138, 96,
-			224, 71, 4, 250, 222, 129, 23, 185, 61, 136, 252, 150, 92, 246,
-			237, 121, 224, 239, 194, 129, 27, 116, 247, 157, 65, 228, 116, 188,
-			190, 23, 29, 201, 5, 119, 189, 104, 128, 139, 237, 250, 1, 1,
-			7, 134, 78, 16, 121, 221, 81, 223, 9, 96, 56, 10, 134, 126,
-			232, 2, 122, 214, 243, 194, 110, 223, 241, 14, 220, 94, 27, 188,
-			1, 12, 124, 112, 159, 187, 131, 8, 194, 125, 167, 223, 127, 211,
-			81, 2, 254, 225, 192, 13, 80, 253, 121, 55, 161, 227, 66, 223,
-			115, 58, 125, 23, 151, 146, 126, 246, 188, 192, 237, 70, 232, 208,
-			217, 83, 215, 235, 185, 131, 200, 233, 183, 8, 132, 67, 183, 235,
-			57, 253, 22, 184, 95, 186, 7, 195, 190, 19, 28, 181, 52, 105,
-			232, 254, 124, 228, 14, 34, 207, 233, 67, 207, 57, 112, 246, 220,
-			16, 182, 255, 91, 84, 134, 129, 223, 29, 5, 238, 1, 170, 246,
-			119, 33, 28, 117, 194, 200, 139, 70, 145, 11, 123, 190, 223, 147,
-			193, 14, 221, 224, 185, 215, 117, 195, 167, 208, 247, 67, 25, 176,
-			81, 232, 182, 8, 244, 156, 200, 145, 75, 15, 3, 127, 215, 139,
-			194, 167, 248, 220, 25, 133, 158, 12, 156, 55, 136, 220, 32, 24,
-			13, 35, 207, 31, 220, 135, 125, 255, 208, 125, 238, 6, 208, 117,
-			70, 161, 219, 147, 17, 246, 7, 232, 45, 214, 138, 235, 7, 71,
-			72, 139, 113, 144, 25, 104, 193, 225, 190, 27, 237, 187, 1, 6,
-			85, 70, 203, 193, 48, 132, 81, 224, 117, 163, 243, 102, 126, 0,
-			145, 31, 68, 228, 156, 159, 48, 112, 247, 250, 222, 158, 59, 232,
-			186, 56, 236, 35, 205, 161, 23, 186, 247, 193, 9, 188, 16, 13,
-			60, 185, 48, 28, 58, 71, 224, 143, 164, 215, 152, 168, 81, 232,
-			18, 245, 124, 174, 116, 91, 50, 159, 224, 237, 130, 211, 123, 238,
-			161, 114, 109, 61, 244, 195, 208, 211, 229, 34, 195, 214, 221, 215,
-			49, 111, 19, 178, 74, 12, 211, 182, 96, 245, 38, 62, 173, 218,
-			214, 102, 237, 41, 121, 135, 212, 87, 255, 218, 172, 41, 112, 153,
-			172, 32, 48, 109, 107, 179, 121, 147, 92, 33, 13, 137, 106, 10,
-			94, 37, 77, 5, 13, 133, 181, 113, 211, 182, 54, 55, 158, 104,
-			198, 187, 181, 150, 102, 52, 20, 80, 70, 184, 236, 221, 230, 123,
-			154, 209, 64, 70, 132, 138, 209, 144, 140, 136, 181, 113, 211, 182,
-			238, 174, 61, 208, 140, 91, 181, 7, 154, 209, 84, 64, 25, 153,
-			136, 154, 183, 52, 163, 137, 140, 8, 21, 163, 41, 25, 17, 107,
-			227, 166, 109, 109, 221, 249, 84, 51, 126, 92, 219, 212, 140, 150,
-			2, 202, 200, 50, 109, 235, 227, 230, 134, 102, 180, 144, 17, 161,
-			98, 180, 36, 35, 98, 109, 140, 83, 63, 248, 72, 51, 126, 178,
-			244, 186, 174, 128, 50, 170, 155, 182, 245, 73, 115, 75, 51, 214,
-			145, 17, 161, 98, 172, 75, 70, 196, 218, 216, 178, 173, 79, 238,
-			85, 94, 223, 171, 125, 164, 25, 87, 20, 80, 70, 43, 166, 109,
-			221, 107, 174, 107, 198, 21, 100, 68, 168, 24, 87, 36, 35, 98,
-			109, 220, 180, 173, 123, 183, 64, 51, 110, 215, 62, 212, 140, 13,
-			5, 148, 81, 195, 180, 173, 237, 101, 174, 27, 200, 184, 189, 204,
-			117, 67, 50, 110, 47, 115, 221, 176, 108, 107, 123, 227, 14, 249,
-			167, 73, 204, 122, 205, 182, 30, 215, 174, 109, 252, 205, 4, 7,
-			38, 180, 160, 101, 150, 128, 108, 153, 96, 70, 57, 143, 39, 84,
-			221, 214, 167, 108, 1, 73, 92, 64, 73, 31, 98, 79, 32, 24,
-			196, 39, 44, 75, 33, 165, 227, 172, 144, 55, 213, 98, 158, 227,
-			189, 79, 83, 242, 230, 124, 121, 83, 158, 178, 69, 9, 206, 208,
-			227, 109, 112, 64, 156, 206, 179, 36, 206, 129, 126, 21, 207, 230,
-			57, 133, 140, 35, 159, 108, 53, 4, 196, 92, 94, 56, 37, 253,
-			197, 130, 114, 65, 64, 95, 64, 37, 229, 115, 86, 224, 202, 167,
-			115, 121, 75, 197, 5, 242, 97, 159, 48, 101, 105, 27, 118, 89,
-			9, 89, 193, 69, 92, 36, 180, 106, 28, 176, 21, 202, 18, 10,
-			187, 140, 193, 215, 234, 21, 64, 57, 79, 160, 19, 151, 219, 23,
-			58, 189, 182, 108, 244, 238, 99, 27, 177, 40, 11, 14, 63, 48,
-			254, 84, 209, 124, 131, 119, 208, 148, 194, 179, 208, 31, 200, 75,
-			159, 242, 229, 141, 60, 102, 37, 188, 148, 214, 47, 209, 51, 21,
-			11, 105, 200, 142, 95, 209, 68, 192, 203, 175, 191, 121, 217, 38,
-			132, 16, 171, 142, 121, 121, 188, 122, 229, 184, 33, 151, 121, 76,
-			254, 117, 133, 28, 76, 50, 49, 93, 28, 203, 38, 45, 95, 36,
-			153, 252, 243, 112, 194, 118, 114, 54, 73, 217, 100, 39, 158, 103,
-			59, 180, 72, 231, 44, 43, 4, 223, 73, 24, 43, 211, 172, 136,
-			5, 43, 119, 226, 116, 150, 21, 59, 39, 159, 171, 7, 221, 241,
-			54, 212, 180, 141, 255, 212, 22, 111, 126, 103, 144, 107, 33, 21,
-			93, 86, 140, 179, 73, 160, 34, 111, 183, 136, 157, 200, 23, 47,
-			116, 28, 95, 44, 202, 124, 221, 0, 99, 251, 82, 112, 77, 141,
-			132, 106, 96, 84, 230, 246, 7, 132, 44, 173, 197, 186, 41, 173,
-			46, 85, 86, 194, 254, 144, 188, 163, 135, 231, 177, 152, 174, 91,
-			114, 92, 207, 24, 198, 98, 106, 255, 148, 220, 230, 130, 149, 241,
-			132, 46, 151, 139, 147, 132, 45, 10, 241, 226, 21, 103, 197, 122,
-			10, 198, 246, 229, 224, 125, 109, 163, 23, 118, 148, 197, 51, 206,
-			138, 71, 187, 100, 197, 65, 191, 237, 31, 147, 75, 75, 95, 236,
-			245, 182, 242, 191, 125, 209, 189, 141, 181, 139, 189, 190, 202, 240,
-			179, 191, 19, 108, 219, 235, 181, 45, 131, 252, 222, 144, 109, 123,
-			189, 102, 63, 250, 214, 120, 163, 3, 255, 252, 255, 101, 250, 251,
-			163, 174, 7, 206, 66, 76, 89, 137, 133, 253, 189, 109, 248, 136,
-			203, 114, 213, 205, 206, 89, 211, 154, 113, 152, 176, 19, 90, 22,
-			52, 133, 69, 145, 234, 30, 204, 153, 199, 9, 18, 103, 9, 45,
-			56, 109, 193, 115, 90, 98, 207, 3, 143, 218, 159, 17, 181, 17,
-			113, 19, 30, 99, 139, 184, 40, 210, 170, 37, 236, 123, 93, 119,
-			16, 186, 48, 206, 114, 218, 38, 228, 18, 49, 173, 154, 109, 53,
-			154, 91, 250, 170, 88, 93, 189, 74, 254, 98, 200, 205, 94, 191,
-			90, 251, 192, 216, 248, 147, 1, 123, 57, 59, 142, 115, 21, 19,
-			185, 3, 145, 135, 77, 122, 108, 2, 221, 179, 162, 130, 137, 52,
-			3, 149, 169, 69, 25, 139, 170, 3, 67, 127, 240, 183, 16, 180,
-			72, 85, 235, 23, 39, 114, 251, 198, 5, 208, 66, 148, 167, 32,
-			107, 180, 45, 35, 53, 139, 95, 177, 50, 19, 167, 42, 20, 244,
-			77, 62, 2, 175, 179, 60, 71, 175, 48, 189, 84, 186, 21, 195,
-			166, 44, 124, 101, 184, 185, 220, 203, 90, 240, 50, 105, 103, 251,
-			232, 234, 234, 58, 249, 149, 65, 234, 117, 121, 211, 93, 55, 97,
-			99, 161, 173, 171, 50, 13, 250, 149, 163, 120, 114, 32, 212, 106,
-			142, 99, 78, 191, 127, 189, 54, 120, 99, 125, 158, 181, 84, 247,
-			77, 199, 139, 56, 23, 75, 61, 200, 82, 169, 199, 22, 184, 77,
-			200, 21, 178, 130, 26, 234, 118, 253, 186, 121, 85, 222, 52, 8,
-			87, 80, 211, 106, 133, 12, 219, 186, 126, 233, 86, 133, 44, 219,
-			186, 126, 231, 67, 242, 133, 20, 111, 216, 214, 13, 115, 125, 227,
-			39, 75, 241, 162, 82, 93, 53, 232, 111, 5, 16, 56, 21, 152,
-			128, 156, 197, 170, 185, 94, 170, 48, 234, 118, 253, 134, 121, 29,
-			244, 74, 198, 10, 146, 87, 42, 12, 92, 234, 210, 123, 21, 178,
-			108, 235, 198, 218, 77, 18, 73, 21, 166, 109, 173, 153, 239, 111,
-			236, 105, 21, 184, 71, 43, 25, 184, 131, 43, 25, 130, 126, 37,
-			30, 114, 249, 209, 145, 253, 146, 166, 23, 100, 157, 203, 146, 146,
-			99, 214, 237, 250, 154, 121, 99, 93, 47, 105, 174, 224, 42, 149,
-			28, 244, 124, 237, 210, 141, 10, 89, 182, 181, 118, 115, 157, 252,
-			65, 165, 212, 178, 173, 219, 230, 131, 141, 223, 26, 224, 141, 241,
-			59, 170, 202, 137, 214, 84, 165, 67, 159, 25, 234, 188, 197, 237,
-			160, 190, 168, 229, 206, 57, 159, 38, 60, 165, 9, 132, 234, 52,
-			193, 89, 148, 115, 89, 214, 126, 207, 223, 78, 139, 87, 247, 159,
-			64, 64, 103, 248, 25, 41, 55, 46, 155, 75, 127, 88, 129, 21,
-			152, 179, 69, 10, 157, 108, 18, 197, 199, 57, 133, 105, 204, 33,
-			41, 25, 231, 15, 231, 37, 147, 231, 187, 211, 237, 243, 165, 199,
-			86, 221, 174, 223, 54, 215, 222, 215, 94, 89, 43, 232, 71, 179,
-			66, 134, 109, 221, 94, 221, 170, 16, 250, 120, 239, 83, 114, 68,
-			204, 70, 205, 174, 67, 109, 203, 216, 56, 0, 121, 168, 45, 253,
-			203, 228, 246, 146, 7, 60, 126, 206, 197, 34, 59, 57, 115, 190,
-			186, 22, 228, 21, 244, 246, 94, 86, 123, 165, 129, 181, 7, 171,
-			87, 200, 11, 82, 111, 200, 173, 114, 215, 236, 108, 4, 176, 60,
-			32, 101, 21, 233, 168, 46, 230, 243, 60, 123, 43, 173, 89, 129,
-			155, 93, 191, 108, 159, 63, 72, 200, 242, 14, 110, 19, 244, 170,
-			161, 74, 253, 110, 227, 221, 10, 97, 7, 121, 13, 42, 100, 217,
-			214, 221, 7, 63, 171, 174, 191, 127, 7, 0, 0, 255, 255, 69,
-			144, 17, 109, 191, 17, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 164, 86, 79, 111, 28, 183,
+			21, 95, 146, 163, 181, 150, 182, 99, 137, 250, 227, 205, 216, 73,
+			30, 22, 74, 45, 55, 242, 172, 98, 187, 65, 32, 39, 106, 86,
+			178, 43, 72, 86, 226, 197, 74, 110, 209, 28, 106, 112, 103, 184,
+			179, 227, 206, 14, 183, 36, 87, 142, 10, 244, 210, 143, 210, 83,
+			145, 123, 123, 41, 80, 160, 199, 244, 3, 244, 115, 244, 216, 75,
+			129, 130, 156, 225, 232, 159, 211, 6, 40, 32, 8, 251, 134, 228,
+			251, 253, 222, 251, 189, 247, 72, 250, 87, 68, 239, 164, 82, 166,
+			185, 232, 78, 149, 52, 114, 56, 27, 117, 197, 100, 106, 78, 35,
+			103, 178, 91, 229, 98, 228, 23, 59, 215, 232, 220, 51, 187, 190,
+			243, 59, 186, 20, 203, 73, 116, 105, 125, 135, 186, 213, 190, 53,
+			251, 232, 107, 191, 156, 202, 156, 23, 105, 36, 85, 122, 6, 99,
+			78, 167, 66, 119, 127, 93, 200, 55, 69, 9, 57, 29, 254, 11,
+			161, 63, 96, 178, 215, 223, 249, 22, 191, 191, 87, 158, 236, 87,
+			219, 163, 95, 136, 60, 127, 110, 55, 31, 219, 115, 195, 166, 243,
+			243, 136, 254, 243, 14, 253, 50, 205, 204, 120, 54, 140, 98, 57,
+			233, 230, 179, 56, 115, 255, 30, 164, 178, 155, 203, 52, 145, 105,
+			151, 79, 179, 174, 40, 146, 169, 204, 10, 163, 187, 177, 148, 42,
+			201, 10, 110, 164, 234, 242, 100, 146, 21, 221, 147, 143, 203, 31,
+			85, 200, 205, 242, 88, 248, 223, 242, 210, 249, 27, 162, 11, 71,
+			194, 236, 202, 98, 148, 165, 3, 241, 155, 153, 208, 134, 109, 80,
+			22, 187, 15, 175, 180, 80, 39, 89, 44, 94, 205, 84, 222, 70,
+			128, 214, 91, 131, 133, 114, 229, 168, 92, 120, 169, 114, 246, 30,
+			165, 245, 110, 211, 198, 110, 87, 203, 239, 50, 236, 3, 122, 189,
+			90, 158, 114, 51, 110, 19, 183, 94, 157, 232, 115, 51, 102, 63,
+			165, 119, 181, 145, 138, 167, 162, 134, 227, 113, 44, 103, 133, 121,
+			245, 90, 203, 162, 157, 0, 90, 191, 49, 120, 183, 218, 83, 1,
+			247, 202, 29, 7, 90, 22, 157, 175, 41, 235, 207, 84, 42, 142,
+			140, 18, 124, 226, 131, 104, 211, 107, 83, 37, 95, 139, 216, 84,
+			204, 189, 201, 24, 13, 28, 149, 146, 170, 251, 205, 86, 105, 83,
+			9, 110, 225, 74, 130, 149, 213, 249, 85, 229, 187, 175, 196, 40,
+			251, 230, 127, 251, 94, 165, 205, 169, 219, 90, 37, 162, 178, 190,
+			215, 255, 135, 244, 166, 243, 63, 16, 122, 42, 11, 45, 216, 50,
+			157, 179, 132, 116, 27, 1, 89, 111, 13, 74, 227, 225, 223, 17,
+			157, 235, 89, 109, 217, 231, 180, 85, 235, 197, 218, 81, 169, 113,
+			116, 89, 194, 112, 245, 114, 65, 71, 174, 158, 217, 23, 244, 250,
+			185, 92, 177, 208, 59, 184, 154, 192, 112, 229, 194, 90, 77, 208,
+			123, 40, 51, 114, 201, 195, 133, 52, 125, 143, 135, 131, 127, 175,
+			208, 38, 11, 130, 198, 115, 68, 255, 132, 40, 186, 193, 72, 208,
+			96, 15, 191, 69, 176, 43, 167, 167, 42, 75, 199, 6, 30, 110,
+			126, 252, 9, 28, 143, 5, 28, 190, 220, 221, 135, 222, 204, 140,
+			165, 210, 17, 244, 242, 28, 220, 6, 13, 74, 216, 98, 17, 73,
+			68, 225, 165, 22, 32, 71, 96, 198, 153, 6, 45, 103, 42, 22,
+			16, 203, 68, 64, 166, 33, 149, 39, 66, 21, 34, 129, 89, 145,
+			8, 5, 102, 44, 160, 55, 229, 177, 117, 156, 197, 162, 208, 98,
+			3, 126, 46, 148, 206, 100, 1, 15, 163, 77, 10, 102, 204, 13,
+			196, 188, 128, 161, 128, 145, 156, 21, 9, 100, 133, 59, 117, 184,
+			191, 251, 236, 171, 163, 103, 48, 202, 114, 17, 81, 218, 162, 152,
+			52, 24, 105, 54, 62, 164, 243, 20, 97, 70, 230, 27, 183, 232,
+			63, 16, 197, 65, 131, 5, 239, 52, 222, 67, 225, 119, 8, 246,
+			114, 57, 228, 121, 217, 90, 150, 140, 243, 35, 211, 167, 50, 133,
+			221, 179, 230, 133, 212, 109, 131, 178, 35, 102, 138, 155, 76, 22,
+			17, 165, 112, 108, 227, 177, 127, 133, 17, 69, 34, 18, 48, 18,
+			120, 108, 128, 107, 224, 5, 136, 194, 168, 83, 112, 179, 32, 114,
+			153, 154, 240, 215, 82, 101, 230, 180, 76, 133, 184, 232, 143, 194,
+			155, 44, 207, 109, 84, 182, 141, 132, 11, 139, 67, 199, 13, 152,
+			114, 99, 7, 170, 230, 131, 138, 112, 93, 56, 148, 82, 74, 130,
+			6, 98, 228, 157, 249, 54, 253, 61, 162, 65, 208, 192, 13, 70,
+			22, 49, 132, 51, 216, 189, 48, 14, 6, 135, 62, 208, 94, 127,
+			31, 94, 14, 14, 61, 155, 33, 215, 226, 237, 120, 17, 236, 143,
+			40, 184, 193, 185, 225, 82, 157, 136, 209, 140, 231, 166, 230, 99,
+			189, 120, 246, 51, 109, 21, 167, 55, 232, 156, 229, 48, 103, 73,
+			204, 123, 11, 49, 178, 216, 186, 227, 45, 194, 200, 226, 251, 31,
+			208, 231, 142, 45, 98, 100, 25, 183, 195, 237, 154, 173, 241, 52,
+			11, 62, 17, 111, 205, 24, 104, 97, 108, 198, 115, 201, 19, 24,
+			41, 57, 169, 97, 209, 156, 245, 230, 97, 145, 245, 221, 90, 242,
+			22, 97, 100, 121, 245, 54, 61, 118, 176, 152, 145, 85, 252, 110,
+			184, 87, 193, 218, 105, 231, 113, 109, 91, 123, 92, 35, 190, 49,
+			15, 180, 80, 25, 207, 179, 223, 138, 228, 18, 143, 115, 58, 148,
+			24, 120, 206, 186, 245, 248, 24, 49, 178, 218, 90, 246, 22, 97,
+			100, 245, 118, 155, 254, 185, 84, 137, 48, 114, 23, 127, 20, 254,
+			17, 193, 254, 8, 10, 105, 124, 154, 43, 18, 62, 195, 213, 184,
+			133, 131, 163, 23, 95, 185, 10, 135, 132, 27, 94, 54, 195, 249,
+			204, 195, 72, 42, 10, 71, 229, 32, 6, 30, 199, 66, 107, 87,
+			169, 47, 158, 190, 88, 79, 138, 215, 247, 183, 96, 32, 38, 242,
+			68, 148, 189, 40, 167, 46, 0, 89, 196, 2, 118, 115, 57, 75,
+			96, 39, 75, 143, 249, 48, 23, 48, 230, 26, 98, 37, 181, 126,
+			80, 13, 78, 232, 237, 30, 234, 58, 68, 50, 103, 137, 95, 243,
+			22, 98, 228, 238, 252, 154, 183, 108, 80, 247, 126, 76, 251, 20,
+			7, 136, 5, 208, 184, 143, 194, 167, 112, 117, 126, 249, 32, 85,
+			101, 78, 132, 214, 60, 181, 109, 93, 206, 129, 115, 39, 96, 208,
+			223, 173, 202, 220, 202, 9, 243, 33, 221, 162, 65, 128, 108, 149,
+			119, 240, 74, 248, 192, 53, 152, 103, 234, 210, 98, 61, 228, 50,
+			5, 93, 58, 24, 138, 92, 22, 169, 6, 35, 171, 24, 144, 171,
+			206, 78, 37, 19, 114, 213, 217, 105, 45, 120, 139, 48, 210, 89,
+			90, 166, 143, 28, 10, 98, 100, 13, 179, 240, 71, 112, 124, 169,
+			48, 206, 1, 24, 9, 83, 75, 184, 118, 111, 171, 112, 173, 118,
+			111, 105, 175, 181, 110, 122, 139, 48, 178, 182, 176, 72, 149, 115,
+			143, 25, 89, 199, 203, 161, 112, 238, 203, 107, 167, 78, 66, 233,
+			19, 246, 93, 63, 40, 17, 75, 149, 136, 122, 230, 157, 193, 223,
+			179, 145, 77, 134, 218, 200, 66, 80, 224, 103, 99, 209, 157, 7,
+			62, 75, 50, 3, 185, 76, 107, 118, 182, 70, 215, 107, 118, 182,
+			70, 215, 91, 183, 188, 69, 24, 89, 103, 75, 78, 64, 204, 130,
+			141, 198, 79, 106, 1, 47, 92, 31, 63, 72, 192, 242, 196, 57,
+			1, 45, 214, 198, 124, 72, 63, 161, 65, 128, 173, 128, 17, 94,
+			9, 239, 191, 93, 192, 242, 110, 190, 42, 30, 118, 226, 69, 21,
+			127, 236, 196, 139, 42, 241, 176, 19, 47, 90, 90, 166, 79, 28,
+			2, 98, 100, 19, 47, 135, 81, 133, 224, 28, 190, 25, 75, 125,
+			62, 125, 186, 238, 34, 151, 47, 63, 193, 176, 19, 113, 179, 134,
+			177, 34, 110, 86, 105, 194, 78, 196, 77, 182, 68, 181, 131, 193,
+			140, 60, 198, 203, 225, 232, 255, 16, 81, 223, 59, 19, 81, 255,
+			0, 21, 177, 83, 241, 113, 77, 207, 102, 246, 113, 77, 15, 19,
+			70, 30, 179, 37, 186, 71, 113, 64, 88, 240, 105, 227, 9, 10,
+			159, 192, 133, 139, 254, 76, 192, 202, 190, 172, 160, 35, 110, 181,
+			211, 149, 120, 4, 49, 242, 233, 252, 10, 253, 156, 6, 1, 177,
+			226, 109, 225, 187, 225, 102, 221, 23, 250, 106, 99, 232, 106, 74,
+			9, 117, 41, 185, 4, 55, 2, 123, 190, 182, 230, 24, 217, 186,
+			190, 232, 45, 196, 200, 22, 187, 237, 45, 194, 200, 86, 120, 135,
+			254, 146, 226, 102, 131, 5, 219, 141, 231, 40, 252, 18, 220, 123,
+			171, 190, 133, 50, 119, 235, 186, 247, 117, 166, 141, 157, 205, 39,
+			103, 3, 212, 191, 202, 93, 95, 93, 189, 226, 203, 232, 154, 182,
+			140, 182, 231, 111, 210, 87, 52, 104, 186, 27, 180, 135, 119, 194,
+			1, 212, 111, 55, 119, 215, 84, 147, 121, 54, 157, 230, 217, 149,
+			187, 32, 43, 140, 4, 94, 125, 140, 206, 191, 47, 40, 100, 133,
+			54, 188, 136, 171, 9, 97, 1, 16, 35, 189, 230, 45, 111, 97,
+			70, 122, 11, 224, 45, 194, 72, 239, 163, 47, 232, 119, 200, 113,
+			65, 140, 236, 225, 237, 240, 47, 232, 194, 84, 76, 68, 46, 140,
+			208, 192, 207, 229, 251, 158, 118, 134, 187, 31, 236, 173, 232, 94,
+			39, 106, 34, 146, 140, 155, 242, 113, 97, 5, 230, 69, 66, 221,
+			165, 9, 153, 209, 192, 85, 60, 206, 78, 196, 6, 228, 130, 159,
+			100, 69, 10, 252, 172, 16, 237, 32, 177, 123, 166, 57, 119, 212,
+			29, 1, 187, 199, 62, 124, 18, 49, 153, 74, 35, 10, 179, 1,
+			218, 198, 61, 226, 89, 46, 146, 170, 108, 170, 87, 154, 18, 70,
+			101, 94, 117, 27, 138, 141, 165, 185, 232, 45, 204, 200, 30, 91,
+			243, 22, 97, 100, 175, 251, 25, 61, 114, 65, 99, 70, 14, 240,
+			118, 248, 179, 11, 131, 196, 249, 214, 192, 243, 252, 109, 181, 166,
+			68, 154, 105, 35, 84, 253, 160, 228, 85, 203, 215, 240, 182, 73,
+			14, 106, 120, 135, 81, 195, 99, 194, 200, 65, 247, 179, 97, 115,
+			170, 164, 145, 143, 254, 51, 0, 82, 81, 174, 217, 180, 14, 0,
+			0},
 	)
 }
 
@@ -17,6 +17,8 @@ It has these top-level messages:
 	QueryResponse
 	ListRequest
 	ListResponse
+	SearchRequest
+	SearchResponse
 	LogStreamState
 */
 package logdog
@@ -882,6 +884,260 @@ func (m *ListResponse_Component) GetDesc() *logpb.LogStreamDescriptor {
 	return nil
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+//
+// Search scans the contents of terminated text log streams, whether or not
+// they have been archived, for lines matching a pattern.
+type SearchRequest struct {
+	// The request project to request.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The path query selecting the log streams to search.
+	//
+	// This uses the same syntax as QueryRequest's path. For example,
+	// "foo/bar/**" will search all streams with the "foo/bar" prefix.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// The text to search for. It must not be empty.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern" json:"pattern,omitempty"`
+	// If true, pattern is a regular expression (RE2 syntax). Otherwise, it is
+	// matched literally.
+	Regex bool `protobuf:"varint,4,opt,name=regex" json:"regex,omitempty"`
+	// If true, pattern is matched without regard to case.
+	IgnoreCase bool `protobuf:"varint,5,opt,name=ignore_case,json=ignoreCase" json:"ignore_case,omitempty"`
+	// The number of context lines to return before and after each matching
+	// line.
+	ContextLines int32 `protobuf:"varint,6,opt,name=context_lines,json=contextLines" json:"context_lines,omitempty"`
+	// Next, if not empty, indicates that this search should continue at the
+	// point where the previous search left off.
+	Next string `protobuf:"bytes,7,opt,name=next" json:"next,omitempty"`
+	// The maximum number of log streams to consider in this request.
+	//
+	// If zero, no upper bound will be indicated. However, the number of streams
+	// is still subject to internal constraints.
+	MaxStreams int32 `protobuf:"varint,8,opt,name=max_streams,json=maxStreams" json:"max_streams,omitempty"`
+	// The maximum number of matches to return for each log stream.
+	//
+	// If zero, no upper bound will be indicated. However, the number of matches
+	// is still subject to internal constraints.
+	MaxMatches int32 `protobuf:"varint,9,opt,name=max_matches,json=maxMatches" json:"max_matches,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *SearchRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetRegex() bool {
+	if m != nil {
+		return m.Regex
+	}
+	return false
+}
+
+func (m *SearchRequest) GetIgnoreCase() bool {
+	if m != nil {
+		return m.IgnoreCase
+	}
+	return false
+}
+
+func (m *SearchRequest) GetContextLines() int32 {
+	if m != nil {
+		return m.ContextLines
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetMaxStreams() int32 {
+	if m != nil {
+		return m.MaxStreams
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetMaxMatches() int32 {
+	if m != nil {
+		return m.MaxMatches
+	}
+	return 0
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	// Project is the project name that all responses belong to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The log streams with matches, in the same order as the Query endpoint
+	// returns them.
+	Streams []*SearchResponse_Stream `protobuf:"bytes,2,rep,name=streams" json:"streams,omitempty"`
+	// If not empty, indicates that there are more log streams to search. They
+	// can be searched by repeating the Search request with the same parameters
+	// and supplying this value in the Next field.
+	Next string `protobuf:"bytes,3,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (m *SearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *SearchResponse) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetStreams() []*SearchResponse_Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// Line is a single line of text.
+type SearchResponse_Line struct {
+	// The line index of this line in its log stream. Line indices begin at
+	// zero.
+	Index uint64 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	// The stream index of the log entry holding the line. If the line spans
+	// several log entries, this is the index of the first one.
+	StreamIndex uint64 `protobuf:"varint,2,opt,name=stream_index,json=streamIndex" json:"stream_index,omitempty"`
+	// The line's text content, not including its delimiter.
+	Value string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *SearchResponse_Line) Reset()                    { *m = SearchResponse_Line{} }
+func (m *SearchResponse_Line) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Line) ProtoMessage()               {}
+func (*SearchResponse_Line) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
+func (m *SearchResponse_Line) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SearchResponse_Line) GetStreamIndex() uint64 {
+	if m != nil {
+		return m.StreamIndex
+	}
+	return 0
+}
+
+func (m *SearchResponse_Line) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Match is a line that matched the search pattern.
+type SearchResponse_Match struct {
+	// The matching line.
+	Line *SearchResponse_Line `protobuf:"bytes,1,opt,name=line" json:"line,omitempty"`
+	// The context lines preceding the matching line, oldest first.
+	Before []*SearchResponse_Line `protobuf:"bytes,2,rep,name=before" json:"before,omitempty"`
+	// The context lines following the matching line.
+	After []*SearchResponse_Line `protobuf:"bytes,3,rep,name=after" json:"after,omitempty"`
+}
+
+func (m *SearchResponse_Match) Reset()                    { *m = SearchResponse_Match{} }
+func (m *SearchResponse_Match) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Match) ProtoMessage()               {}
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 1} }
+
+func (m *SearchResponse_Match) GetLine() *SearchResponse_Line {
+	if m != nil {
+		return m.Line
+	}
+	return nil
+}
+
+func (m *SearchResponse_Match) GetBefore() []*SearchResponse_Line {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *SearchResponse_Match) GetAfter() []*SearchResponse_Line {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+// Stream is a log stream that has at least one match.
+type SearchResponse_Stream struct {
+	// Path is the log stream path.
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// The matches, in line order.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches" json:"matches,omitempty"`
+	// True if the stream has more matches than were returned.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated" json:"truncated,omitempty"`
+}
+
+func (m *SearchResponse_Stream) Reset()                    { *m = SearchResponse_Stream{} }
+func (m *SearchResponse_Stream) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Stream) ProtoMessage()               {}
+func (*SearchResponse_Stream) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 2} }
+
+func (m *SearchResponse_Stream) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchResponse_Stream) GetMatches() []*SearchResponse_Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *SearchResponse_Stream) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "logdog.GetRequest")
 	proto.RegisterType((*GetRequest_SignURLRequest)(nil), "logdog.GetRequest.SignURLRequest")
@@ -895,6 +1151,11 @@ func init() {
 	proto.RegisterType((*ListRequest)(nil), "logdog.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "logdog.ListResponse")
 	proto.RegisterType((*ListResponse_Component)(nil), "logdog.ListResponse.Component")
+	proto.RegisterType((*SearchRequest)(nil), "logdog.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "logdog.SearchResponse")
+	proto.RegisterType((*SearchResponse_Line)(nil), "logdog.SearchResponse.Line")
+	proto.RegisterType((*SearchResponse_Match)(nil), "logdog.SearchResponse.Match")
+	proto.RegisterType((*SearchResponse_Stream)(nil), "logdog.SearchResponse.Stream")
 	proto.RegisterEnum("logdog.QueryRequest_Trinary", QueryRequest_Trinary_name, QueryRequest_Trinary_value)
 	proto.RegisterEnum("logdog.ListResponse_Component_Type", ListResponse_Component_Type_name, ListResponse_Component_Type_value)
 }
@@ -918,6 +1179,9 @@ type LogsClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Search returns the lines of terminated text log streams that match a
+	// pattern.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 type logsPRPCClient struct {
 	client *prpc.Client
@@ -963,6 +1227,15 @@ func (c *logsPRPCClient) List(ctx context.Context, in *ListRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type logsClient struct {
 	cc *grpc.ClientConn
 }
@@ -1007,6 +1280,15 @@ func (c *logsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/logdog.Logs/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Logs service
 
 type LogsServer interface {
@@ -1018,6 +1300,9 @@ type LogsServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Search returns the lines of terminated text log streams that match a
+	// pattern.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
@@ -1096,6 +1381,24 @@ func _Logs_List_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -1116,6 +1419,10 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Logs_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1/logs.proto",
@@ -1126,76 +1433,91 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x56, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0xc7, 0x89, 0xf3, 0x6f, 0x9c, 0xbb, 0x86, 0xa5, 0x54, 0xc6, 0xa5, 0x6d, 0x9a, 0x52, 0x11,
-	0x24, 0x70, 0xda, 0x50, 0x28, 0x2a, 0x12, 0xa8, 0xbd, 0x5e, 0xcb, 0x9f, 0x83, 0xbb, 0x6e, 0x52,
-	0x24, 0x3e, 0x45, 0x4e, 0xb2, 0xe7, 0x1a, 0x9c, 0x5d, 0xe3, 0x5d, 0x1f, 0x97, 0x67, 0xe0, 0x13,
-	0x9f, 0x10, 0x8f, 0xc5, 0x23, 0x20, 0x1e, 0x80, 0x27, 0x40, 0x42, 0x3b, 0x6b, 0xc7, 0x69, 0x1a,
-	0x7a, 0x45, 0x94, 0x2f, 0x49, 0x66, 0xe6, 0x37, 0xbb, 0x3b, 0xbf, 0x99, 0xdf, 0x6e, 0xe0, 0xcb,
-	0x30, 0x52, 0x4f, 0xb2, 0xa9, 0x3f, 0x13, 0x8b, 0x41, 0x9c, 0xcd, 0x22, 0xfc, 0x78, 0x2f, 0x14,
-	0x83, 0x58, 0x84, 0x73, 0x11, 0x0e, 0x82, 0x24, 0x1a, 0x30, 0x3e, 0x4f, 0x44, 0xc4, 0x95, 0x1c,
-	0xcc, 0x84, 0x48, 0xe7, 0x11, 0x0f, 0x94, 0x48, 0x35, 0x40, 0x0e, 0x4e, 0x6e, 0xe2, 0xb7, 0x9f,
-	0xa4, 0x42, 0x09, 0x52, 0x37, 0x49, 0xde, 0xc1, 0x7f, 0x5e, 0x54, 0xaa, 0x40, 0x31, 0xb3, 0xaa,
-	0x37, 0x7c, 0x81, 0xd5, 0x62, 0x11, 0x26, 0x53, 0xfd, 0x99, 0xe7, 0x5c, 0x09, 0x85, 0x08, 0x63,
-	0x36, 0x40, 0x6b, 0x9a, 0x1d, 0x0f, 0x54, 0xb4, 0x60, 0x52, 0x05, 0x8b, 0x24, 0x07, 0x5c, 0xde,
-	0x04, 0xcc, 0xb3, 0x34, 0x50, 0x91, 0xe0, 0x26, 0xde, 0xfb, 0xa9, 0x0a, 0xf0, 0x90, 0x29, 0xca,
-	0x7e, 0xc8, 0x98, 0x54, 0xc4, 0x85, 0x46, 0x92, 0x8a, 0xef, 0xd8, 0x4c, 0xb9, 0x56, 0xd7, 0xea,
-	0xb7, 0x68, 0x61, 0x12, 0x02, 0x76, 0x12, 0xa8, 0x27, 0x6e, 0x05, 0xdd, 0xf8, 0x9b, 0x9c, 0x87,
-	0x1a, 0x16, 0xe0, 0x56, 0xbb, 0x56, 0xbf, 0x49, 0x8d, 0xa1, 0xbd, 0x11, 0x9f, 0xb3, 0x53, 0xd7,
-	0xee, 0x5a, 0xfd, 0x2a, 0x35, 0x06, 0xb9, 0x04, 0x30, 0x5d, 0x2a, 0x36, 0x99, 0x89, 0x8c, 0x2b,
-	0xb7, 0xd6, 0xb5, 0xfa, 0x35, 0xda, 0xd2, 0x9e, 0x3d, 0xed, 0x20, 0x17, 0xa1, 0x15, 0x8b, 0x30,
-	0x8f, 0xd6, 0x31, 0xda, 0x8c, 0x45, 0x68, 0x82, 0xd7, 0x61, 0x97, 0x0b, 0x3e, 0x99, 0x09, 0xae,
-	0xa2, 0x30, 0x13, 0x99, 0x74, 0x1b, 0xb8, 0xe1, 0x0e, 0x17, 0x7c, 0x6f, 0xe5, 0x24, 0x9f, 0xc3,
-	0xb9, 0x90, 0xa9, 0x89, 0x8c, 0x42, 0xce, 0xe6, 0x93, 0x2c, 0x8d, 0xa5, 0xdb, 0xec, 0x5a, 0x7d,
-	0x67, 0x78, 0xd5, 0x37, 0x14, 0xfa, 0x65, 0xa5, 0xfe, 0x28, 0x0a, 0xf9, 0x63, 0x7a, 0x90, 0x9b,
-	0x74, 0x27, 0x64, 0x6a, 0x84, 0x89, 0x8f, 0xd3, 0x58, 0x7a, 0x19, 0xec, 0x3e, 0x0d, 0x20, 0x1f,
-	0x40, 0x33, 0x8e, 0x8e, 0x99, 0xe6, 0x17, 0xa9, 0x71, 0x86, 0x6f, 0xf8, 0x86, 0x5b, 0xbf, 0xe0,
-	0xd6, 0xbf, 0x9f, 0x73, 0x4b, 0x57, 0x50, 0x72, 0x01, 0xea, 0x52, 0xa5, 0x2c, 0x58, 0x20, 0x71,
-	0x4d, 0x9a, 0x5b, 0x25, 0x49, 0x39, 0x75, 0x68, 0xf4, 0x1e, 0x81, 0x33, 0x0e, 0xa2, 0xf8, 0x25,
-	0x76, 0xa3, 0xf7, 0x47, 0x05, 0x1c, 0x2c, 0x5b, 0x26, 0x82, 0x4b, 0xf6, 0x9c, 0x35, 0xdf, 0x2d,
-	0xf2, 0x2b, 0x58, 0xde, 0x85, 0x82, 0xb4, 0x03, 0x11, 0x8e, 0xf0, 0xd0, 0x23, 0x1d, 0x2d, 0xba,
-	0xec, 0x83, 0x3d, 0x67, 0x72, 0x86, 0x9b, 0x39, 0x43, 0xcf, 0xc7, 0xc9, 0x2c, 0xb1, 0xf7, 0x99,
-	0x9c, 0xa5, 0x51, 0xa2, 0x44, 0x4a, 0x11, 0x47, 0xae, 0x81, 0xad, 0x87, 0xde, 0xb5, 0xbb, 0xd5,
-	0xbe, 0x33, 0x3c, 0x57, 0xe2, 0xf7, 0xb9, 0x4a, 0x97, 0x14, 0x83, 0xe4, 0x53, 0x70, 0xd6, 0xbb,
-	0x57, 0xc3, 0xb5, 0x2f, 0x3f, 0xd5, 0x3d, 0x53, 0x86, 0x5f, 0xf6, 0x8a, 0x82, 0x2c, 0xfb, 0x76,
-	0x02, 0x50, 0x46, 0xc8, 0x1d, 0x00, 0x76, 0x9a, 0x44, 0xa6, 0x29, 0x79, 0xd7, 0xbc, 0x67, 0xba,
-	0x36, 0x2e, 0x24, 0x43, 0xd7, 0xd0, 0x1b, 0x8d, 0x6b, 0x6d, 0x6f, 0x5c, 0xab, 0x68, 0xdc, 0x2f,
-	0x35, 0x68, 0x3f, 0xca, 0x58, 0xba, 0x7c, 0xc9, 0x42, 0xc2, 0x43, 0xa2, 0x90, 0x9a, 0xd4, 0x18,
-	0x3a, 0x9f, 0xb3, 0x53, 0x23, 0xa1, 0x16, 0xc5, 0xdf, 0xe4, 0x0a, 0x38, 0x8b, 0xe0, 0x74, 0x92,
-	0x32, 0x99, 0xc5, 0x4a, 0xe6, 0xfa, 0x81, 0x45, 0x70, 0x4a, 0x8d, 0x87, 0x5c, 0x85, 0xb6, 0x56,
-	0x0f, 0xe3, 0x6a, 0xa2, 0x96, 0x09, 0x73, 0x01, 0x93, 0x9d, 0xdc, 0x37, 0x5e, 0x26, 0x8c, 0x3c,
-	0x00, 0xc7, 0x94, 0x68, 0x10, 0x0e, 0xb2, 0x75, 0xbd, 0xe0, 0x7e, 0xbd, 0x38, 0xdf, 0xb4, 0x58,
-	0x67, 0x3d, 0x88, 0x62, 0xc5, 0x52, 0x0a, 0x72, 0xe5, 0x21, 0x37, 0xa0, 0xc6, 0xd9, 0x8f, 0x2c,
-	0x75, 0xdb, 0x67, 0xf2, 0x6d, 0x80, 0x3a, 0x43, 0xc4, 0x73, 0x96, 0xba, 0x3b, 0x67, 0x67, 0x20,
-	0x90, 0x5c, 0x83, 0x1d, 0x0c, 0x4e, 0x4e, 0x58, 0x2a, 0x75, 0x6f, 0x77, 0xb1, 0x9e, 0x36, 0x3a,
-	0xbf, 0x31, 0x3e, 0x32, 0x04, 0x5b, 0x05, 0xa1, 0x74, 0xcf, 0xe1, 0xc4, 0x5d, 0xde, 0x5a, 0xc9,
-	0x38, 0x08, 0x65, 0x3e, 0x80, 0x1a, 0x4b, 0x6e, 0x41, 0x3d, 0xc9, 0xd2, 0x90, 0xcd, 0xdd, 0x4e,
-	0xd7, 0xea, 0xef, 0x0e, 0xdf, 0xdc, 0x9e, 0x95, 0x46, 0x3c, 0x48, 0x97, 0x34, 0xc7, 0x7a, 0x1f,
-	0x43, 0x67, 0x93, 0x12, 0xf2, 0x36, 0xd4, 0x4e, 0x82, 0x38, 0x33, 0x97, 0xc5, 0xee, 0xf0, 0xd5,
-	0x7c, 0xe0, 0x4b, 0x1c, 0x35, 0x71, 0xef, 0x36, 0xb4, 0x56, 0xa7, 0x20, 0x1d, 0xa8, 0x7e, 0xcf,
-	0x96, 0xf9, 0xc8, 0xe8, 0x9f, 0x7a, 0x08, 0xcc, 0x3a, 0x66, 0x5e, 0x8c, 0x71, 0xa7, 0xf2, 0x91,
-	0xd5, 0x7b, 0x0b, 0x1a, 0xf9, 0x41, 0x48, 0x13, 0xec, 0x7b, 0x87, 0xe3, 0xcf, 0x3a, 0xaf, 0x90,
-	0x06, 0x54, 0xbf, 0xdd, 0x1f, 0x75, 0x2c, 0x52, 0x87, 0xca, 0xd7, 0x87, 0x9d, 0x4a, 0xef, 0xe7,
-	0x0a, 0xec, 0xe4, 0x87, 0x3f, 0xf3, 0x06, 0xf8, 0x10, 0x1a, 0xa6, 0x91, 0xd2, 0xad, 0x20, 0x69,
-	0x9b, 0xe5, 0x17, 0xe2, 0x43, 0x10, 0x2d, 0xc0, 0xab, 0x91, 0xac, 0x96, 0x23, 0xe9, 0xfd, 0x6a,
-	0x41, 0xdd, 0xe0, 0x56, 0x13, 0x6f, 0xad, 0x4d, 0xfc, 0xff, 0x7b, 0xd9, 0x5c, 0x02, 0xd0, 0xdf,
-	0x93, 0x52, 0x3e, 0x6d, 0xda, 0xd2, 0x9e, 0x23, 0x7c, 0xf4, 0xfe, 0xb4, 0xc0, 0x39, 0x88, 0xe4,
-	0x0b, 0xbc, 0x7a, 0x17, 0xa1, 0xa5, 0x8f, 0x3b, 0x99, 0x06, 0xb2, 0xe8, 0x40, 0x53, 0x3b, 0xee,
-	0x05, 0x92, 0xfd, 0x83, 0x6a, 0x0b, 0x32, 0xec, 0xa7, 0xf5, 0x99, 0x6b, 0x4b, 0xf0, 0x78, 0x89,
-	0xd2, 0x6d, 0x16, 0xa2, 0x39, 0xe4, 0xf1, 0x52, 0xbf, 0x70, 0x11, 0x9f, 0xc5, 0xd9, 0x9c, 0x4d,
-	0xf2, 0xf9, 0xab, 0x9b, 0x17, 0x2e, 0xf7, 0x1e, 0xa1, 0x53, 0x5f, 0x4a, 0xe2, 0xf8, 0x58, 0x32,
-	0x85, 0x0f, 0x60, 0x8d, 0xe6, 0xd6, 0xa6, 0xfe, 0x9b, 0x9b, 0xfa, 0xef, 0xfd, 0x55, 0x81, 0xb6,
-	0xa9, 0xf8, 0xcc, 0x21, 0x78, 0x6e, 0xc9, 0x5b, 0x3a, 0x4d, 0x3e, 0x01, 0x98, 0x89, 0x45, 0x22,
-	0x38, 0xe3, 0xaa, 0xb8, 0xdf, 0x57, 0x6a, 0x5b, 0xdf, 0xd4, 0xdf, 0x2b, 0x60, 0x74, 0x2d, 0xc3,
-	0xfb, 0xdd, 0x82, 0xd6, 0x2a, 0x82, 0x3b, 0x04, 0xf9, 0x1b, 0xab, 0x77, 0x08, 0x16, 0x8c, 0xdc,
-	0x06, 0x1b, 0xef, 0xa4, 0x0a, 0x4a, 0xe9, 0xda, 0xf3, 0xd7, 0xf6, 0x51, 0x5c, 0x98, 0x50, 0x4e,
-	0x59, 0xf5, 0xdf, 0x4c, 0x99, 0xfd, 0x62, 0x53, 0xd6, 0x7b, 0x07, 0x6c, 0xbc, 0xf1, 0x9a, 0x60,
-	0x1f, 0xdd, 0x45, 0xf5, 0x01, 0xd4, 0x47, 0x63, 0xba, 0x7f, 0xf7, 0xab, 0x8e, 0x45, 0x1c, 0x68,
-	0x1c, 0xd1, 0xc3, 0x2f, 0xf6, 0xf7, 0xc6, 0x9d, 0xca, 0xf0, 0x37, 0x0b, 0xec, 0x03, 0xfd, 0xc2,
-	0xf9, 0x50, 0x7d, 0xc8, 0x14, 0x21, 0xcf, 0xfe, 0x23, 0xf1, 0x5e, 0xdb, 0xf2, 0xce, 0x91, 0x1b,
-	0x60, 0xeb, 0x7f, 0x04, 0x64, 0x15, 0x5c, 0xfb, 0x7f, 0xb0, 0x3d, 0xe3, 0x16, 0xd4, 0x50, 0xad,
-	0xe4, 0xfc, 0xb6, 0xbb, 0xcb, 0x7b, 0x7d, 0xab, 0xa4, 0xc9, 0x4d, 0xb0, 0x35, 0x9d, 0xe5, 0x3e,
-	0x6b, 0xfa, 0xf0, 0xce, 0x6f, 0x63, 0x7c, 0x5a, 0x47, 0x75, 0xbd, 0xff, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x92, 0xcb, 0xcc, 0xa4, 0x5b, 0x0b, 0x00, 0x00,
+	// 1371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x72, 0x1b, 0xc5,
+	0x12, 0x3e, 0x2b, 0xad, 0xfe, 0x5a, 0xb2, 0xa3, 0x33, 0x27, 0x49, 0xed, 0x51, 0xfe, 0x14, 0xf9,
+	0xa4, 0x8e, 0xa9, 0x82, 0x55, 0xa2, 0x84, 0x98, 0x0a, 0x55, 0x50, 0x89, 0xe3, 0x84, 0x80, 0x83,
+	0x9d, 0xb1, 0x43, 0x15, 0x57, 0xaa, 0xd5, 0x6a, 0xbc, 0x5e, 0x58, 0xcd, 0x2c, 0x33, 0xb3, 0xc6,
+	0x7a, 0x04, 0xe0, 0x8a, 0x2b, 0x8a, 0x3b, 0x5e, 0x8d, 0xa2, 0xb8, 0xe6, 0x09, 0xa8, 0xa2, 0xe6,
+	0x67, 0xb5, 0xb2, 0xa2, 0xd8, 0x06, 0xc2, 0x8d, 0xb4, 0xd3, 0xfd, 0xf5, 0xcc, 0x74, 0xf7, 0xd7,
+	0xdd, 0x03, 0x9f, 0x44, 0xb1, 0x3c, 0xcc, 0x46, 0x7e, 0xc8, 0x26, 0xfd, 0x24, 0x0b, 0x63, 0xfd,
+	0xf3, 0x4e, 0xc4, 0xfa, 0x09, 0x8b, 0xc6, 0x2c, 0xea, 0x07, 0x69, 0xdc, 0x27, 0x74, 0x9c, 0xb2,
+	0x98, 0x4a, 0xd1, 0x0f, 0x19, 0xe3, 0xe3, 0x98, 0x06, 0x92, 0x71, 0x05, 0x10, 0xfd, 0xa3, 0x3b,
+	0xfa, 0xdf, 0x4f, 0x39, 0x93, 0x0c, 0x55, 0x8d, 0x51, 0x67, 0xfb, 0x6f, 0x6f, 0x2a, 0x64, 0x20,
+	0x89, 0xd9, 0xb5, 0x33, 0x38, 0xc7, 0x6e, 0x09, 0x8b, 0xd2, 0x91, 0xfa, 0xb5, 0x36, 0x37, 0x22,
+	0xc6, 0xa2, 0x84, 0xf4, 0xf5, 0x6a, 0x94, 0x1d, 0xf4, 0x65, 0x3c, 0x21, 0x42, 0x06, 0x93, 0xd4,
+	0x02, 0xae, 0x2f, 0x02, 0xc6, 0x19, 0x0f, 0x64, 0xcc, 0xa8, 0xd1, 0xf7, 0xbe, 0x2b, 0x03, 0x3c,
+	0x25, 0x12, 0x93, 0xaf, 0x32, 0x22, 0x24, 0xf2, 0xa0, 0x96, 0x72, 0xf6, 0x05, 0x09, 0xa5, 0xe7,
+	0x74, 0x9d, 0xf5, 0x06, 0xce, 0x97, 0x08, 0x81, 0x9b, 0x06, 0xf2, 0xd0, 0x2b, 0x69, 0xb1, 0xfe,
+	0x46, 0x17, 0xa1, 0xa2, 0x1d, 0xf0, 0xca, 0x5d, 0x67, 0xbd, 0x8e, 0xcd, 0x42, 0x49, 0x63, 0x3a,
+	0x26, 0xc7, 0x9e, 0xdb, 0x75, 0xd6, 0xcb, 0xd8, 0x2c, 0xd0, 0x35, 0x80, 0xd1, 0x54, 0x92, 0x61,
+	0xc8, 0x32, 0x2a, 0xbd, 0x4a, 0xd7, 0x59, 0xaf, 0xe0, 0x86, 0x92, 0x6c, 0x2a, 0x01, 0xba, 0x02,
+	0x8d, 0x84, 0x45, 0x56, 0x5b, 0xd5, 0xda, 0x7a, 0xc2, 0x22, 0xa3, 0xbc, 0x05, 0xab, 0x94, 0xd1,
+	0x61, 0xc8, 0xa8, 0x8c, 0xa3, 0x8c, 0x65, 0xc2, 0xab, 0xe9, 0x03, 0x57, 0x28, 0xa3, 0x9b, 0x33,
+	0x21, 0x7a, 0x06, 0x17, 0x22, 0x22, 0x87, 0x22, 0x8e, 0x28, 0x19, 0x0f, 0x33, 0x9e, 0x08, 0xaf,
+	0xde, 0x75, 0xd6, 0x9b, 0x83, 0x9b, 0xbe, 0x09, 0xa1, 0x5f, 0x78, 0xea, 0xef, 0xc5, 0x11, 0x7d,
+	0x89, 0xb7, 0xed, 0x12, 0xaf, 0x44, 0x44, 0xee, 0x69, 0xc3, 0x97, 0x3c, 0x11, 0x9d, 0x0c, 0x56,
+	0x4f, 0x02, 0xd0, 0xbb, 0x50, 0x4f, 0xe2, 0x03, 0xa2, 0xe2, 0xab, 0x43, 0xd3, 0x1c, 0xfc, 0xd7,
+	0x37, 0xb1, 0xf5, 0xf3, 0xd8, 0xfa, 0x8f, 0x6d, 0x6c, 0xf1, 0x0c, 0x8a, 0x2e, 0x43, 0x55, 0x48,
+	0x4e, 0x82, 0x89, 0x0e, 0x5c, 0x1d, 0xdb, 0x55, 0x11, 0x24, 0x1b, 0x3a, 0xbd, 0xe8, 0xbd, 0x80,
+	0xe6, 0x7e, 0x10, 0x27, 0x6f, 0x30, 0x1b, 0xbd, 0x5f, 0x4a, 0xd0, 0xd4, 0x6e, 0x8b, 0x94, 0x51,
+	0x41, 0x4e, 0xd9, 0xf3, 0xed, 0xdc, 0xbe, 0xa4, 0xdd, 0xbb, 0x9c, 0x07, 0x6d, 0x9b, 0x45, 0x7b,
+	0xfa, 0xd2, 0x7b, 0x4a, 0x9b, 0x67, 0xd9, 0x07, 0x77, 0x4c, 0x44, 0xa8, 0x0f, 0x6b, 0x0e, 0x3a,
+	0xbe, 0x66, 0x66, 0x81, 0x7d, 0x4c, 0x44, 0xc8, 0xe3, 0x54, 0x32, 0x8e, 0x35, 0x0e, 0xad, 0x81,
+	0xab, 0x48, 0xef, 0xb9, 0xdd, 0xf2, 0x7a, 0x73, 0x70, 0xa1, 0xc0, 0x6f, 0x51, 0xc9, 0xa7, 0x58,
+	0x2b, 0xd1, 0x87, 0xd0, 0x9c, 0xcf, 0x5e, 0x45, 0xef, 0x7d, 0xfd, 0x44, 0xf6, 0x8c, 0x1b, 0x7e,
+	0x91, 0x2b, 0x0c, 0xa2, 0xc8, 0xdb, 0x11, 0x40, 0xa1, 0x41, 0x0f, 0x00, 0xc8, 0x71, 0x1a, 0x9b,
+	0xa4, 0xd8, 0xac, 0x75, 0x5e, 0xc9, 0xda, 0x7e, 0x5e, 0x32, 0x78, 0x0e, 0xbd, 0x90, 0xb8, 0xc6,
+	0xf2, 0xc4, 0x35, 0xf2, 0xc4, 0xfd, 0x50, 0x81, 0xd6, 0x8b, 0x8c, 0xf0, 0xe9, 0x1b, 0x2e, 0x24,
+	0x7d, 0x49, 0x5d, 0x48, 0x75, 0x6c, 0x16, 0xca, 0x9e, 0x92, 0x63, 0x53, 0x42, 0x0d, 0xac, 0xbf,
+	0xd1, 0x0d, 0x68, 0x4e, 0x82, 0xe3, 0x21, 0x27, 0x22, 0x4b, 0xa4, 0xb0, 0xf5, 0x03, 0x93, 0xe0,
+	0x18, 0x1b, 0x09, 0xba, 0x09, 0x2d, 0x55, 0x3d, 0x84, 0xca, 0xa1, 0x9c, 0xa6, 0xc4, 0x03, 0x6d,
+	0xdc, 0xb4, 0xb2, 0xfd, 0x69, 0x4a, 0xd0, 0x13, 0x68, 0x1a, 0x17, 0x0d, 0xa2, 0xa9, 0xa3, 0x75,
+	0x2b, 0x8f, 0xfd, 0xbc, 0x73, 0xbe, 0x49, 0xb1, 0xb2, 0x7a, 0x12, 0x27, 0x92, 0x70, 0x0c, 0x62,
+	0x26, 0x41, 0xb7, 0xa1, 0x42, 0xc9, 0xd7, 0x84, 0x7b, 0xad, 0x33, 0xe3, 0x6d, 0x80, 0xca, 0x82,
+	0x25, 0x63, 0xc2, 0xbd, 0x95, 0xb3, 0x2d, 0x34, 0x10, 0xad, 0xc1, 0x8a, 0x56, 0x0e, 0x8f, 0x08,
+	0x17, 0x2a, 0xb7, 0xab, 0xda, 0x9f, 0x96, 0x16, 0x7e, 0x66, 0x64, 0x68, 0x00, 0xae, 0x0c, 0x22,
+	0xe1, 0x5d, 0xe8, 0x96, 0xe7, 0x59, 0x74, 0xc2, 0x93, 0xfd, 0x20, 0x12, 0x96, 0x80, 0x0a, 0x8b,
+	0xee, 0x41, 0x35, 0xcd, 0x78, 0x44, 0xc6, 0x5e, 0xbb, 0xeb, 0xac, 0xaf, 0x0e, 0xae, 0x2e, 0xb7,
+	0xe2, 0x31, 0x0d, 0xf8, 0x14, 0x5b, 0x6c, 0xe7, 0x7d, 0x68, 0x2f, 0x86, 0x04, 0xfd, 0x1f, 0x2a,
+	0x47, 0x41, 0x92, 0x99, 0x66, 0xb1, 0x3a, 0xf8, 0xb7, 0x25, 0x7c, 0x81, 0xc3, 0x46, 0xdf, 0xd9,
+	0x80, 0xc6, 0xec, 0x16, 0xa8, 0x0d, 0xe5, 0x2f, 0xc9, 0xd4, 0x52, 0x46, 0x7d, 0x2a, 0x12, 0x98,
+	0x7d, 0x0c, 0x5f, 0xcc, 0xe2, 0x41, 0xe9, 0x3d, 0xa7, 0xf7, 0x3f, 0xa8, 0xd9, 0x8b, 0xa0, 0x3a,
+	0xb8, 0x8f, 0x76, 0xf6, 0x3f, 0x6a, 0xff, 0x0b, 0xd5, 0xa0, 0xfc, 0xf9, 0xd6, 0x5e, 0xdb, 0x41,
+	0x55, 0x28, 0x7d, 0xba, 0xd3, 0x2e, 0xf5, 0xbe, 0x2f, 0xc1, 0x8a, 0xbd, 0xfc, 0x99, 0x1d, 0xe0,
+	0x3e, 0xd4, 0x4c, 0x22, 0x85, 0x57, 0xd2, 0x41, 0x5b, 0x74, 0x3f, 0x2f, 0x3e, 0x0d, 0xc2, 0x39,
+	0x78, 0x46, 0xc9, 0x72, 0x41, 0xc9, 0xce, 0x8f, 0x0e, 0x54, 0x0d, 0x6e, 0xc6, 0x78, 0x67, 0x8e,
+	0xf1, 0xff, 0x6c, 0xb3, 0xb9, 0x06, 0xa0, 0xfe, 0x87, 0x45, 0xf9, 0xb4, 0x70, 0x43, 0x49, 0x76,
+	0xf5, 0xd0, 0xfb, 0xcd, 0x81, 0xe6, 0x76, 0x2c, 0xce, 0x31, 0xf5, 0xae, 0x40, 0x43, 0x5d, 0x77,
+	0x38, 0x0a, 0x44, 0x9e, 0x81, 0xba, 0x12, 0x3c, 0x0a, 0x04, 0x79, 0x4d, 0xd5, 0xe6, 0xc1, 0x70,
+	0x4f, 0xd6, 0xa7, 0xad, 0x2d, 0x46, 0x93, 0xa9, 0x2e, 0xdd, 0x7a, 0x5e, 0x34, 0x3b, 0x34, 0x99,
+	0xaa, 0x09, 0x17, 0xd3, 0x30, 0xc9, 0xc6, 0x64, 0x68, 0xf9, 0x57, 0x35, 0x13, 0xce, 0x4a, 0x77,
+	0xb5, 0x50, 0x35, 0x25, 0x76, 0x70, 0x20, 0x88, 0xd4, 0x03, 0xb0, 0x82, 0xed, 0x6a, 0xb1, 0xfe,
+	0xeb, 0x8b, 0xf5, 0xdf, 0xfb, 0xbd, 0x04, 0x2d, 0xe3, 0xf1, 0x99, 0x24, 0x38, 0xd5, 0xe5, 0x25,
+	0x99, 0x46, 0x1f, 0x00, 0x84, 0x6c, 0x92, 0x32, 0x4a, 0xa8, 0xcc, 0xfb, 0xfb, 0xac, 0xda, 0xe6,
+	0x0f, 0xf5, 0x37, 0x73, 0x18, 0x9e, 0xb3, 0xe8, 0xfc, 0xec, 0x40, 0x63, 0xa6, 0xd1, 0x27, 0x04,
+	0x76, 0xc6, 0xaa, 0x13, 0x82, 0x09, 0x41, 0x1b, 0xe0, 0xea, 0x9e, 0x54, 0xd2, 0xa5, 0xb4, 0x76,
+	0xfa, 0xde, 0xbe, 0x2e, 0x2e, 0x6d, 0x50, 0xb0, 0xac, 0xfc, 0x67, 0x58, 0xe6, 0x9e, 0x8f, 0x65,
+	0xbd, 0xb7, 0xc0, 0xd5, 0x1d, 0xaf, 0x0e, 0xee, 0xee, 0x43, 0x5d, 0x7d, 0x00, 0xd5, 0xbd, 0x7d,
+	0xbc, 0xf5, 0xf0, 0x79, 0xdb, 0x41, 0x4d, 0xa8, 0xed, 0xe2, 0x9d, 0x8f, 0xb7, 0x36, 0xf7, 0xdb,
+	0xa5, 0xde, 0x37, 0x25, 0x58, 0xd9, 0x23, 0x01, 0x0f, 0x0f, 0xff, 0xda, 0x80, 0x50, 0xe8, 0x40,
+	0x4a, 0xc2, 0xa9, 0x0d, 0x7d, 0xbe, 0x54, 0x24, 0xe4, 0x24, 0xb2, 0xaf, 0xad, 0x3a, 0x36, 0x0b,
+	0x45, 0x88, 0x38, 0xa2, 0x8c, 0x93, 0x61, 0xa8, 0xd2, 0x68, 0x09, 0x67, 0x44, 0x9b, 0x2a, 0x91,
+	0x6b, 0xb0, 0xa2, 0x9b, 0xff, 0xb1, 0x1c, 0x26, 0x31, 0x25, 0xf9, 0xcc, 0x68, 0x59, 0xe1, 0xb6,
+	0x92, 0xcd, 0xb2, 0x5d, 0x7b, 0x75, 0xd4, 0xe4, 0x7d, 0xa2, 0xa0, 0x9a, 0x09, 0x92, 0xc8, 0x01,
+	0x93, 0x40, 0x86, 0x87, 0x44, 0x78, 0x8d, 0x19, 0xe0, 0xb9, 0x91, 0xf4, 0x7e, 0x2d, 0xc3, 0x6a,
+	0x1e, 0x8b, 0x33, 0xd9, 0xb8, 0xb1, 0xd8, 0x92, 0xae, 0xe5, 0x39, 0x3c, 0xb9, 0xc5, 0xb9, 0x7a,
+	0xd2, 0x4b, 0x70, 0x95, 0x63, 0xc5, 0x0c, 0x57, 0x87, 0xb9, 0xf9, 0x0b, 0xf5, 0x26, 0xb4, 0x6c,
+	0x91, 0x1a, 0x65, 0x49, 0x2b, 0x6d, 0xe1, 0x3e, 0xd3, 0x90, 0x59, 0x33, 0x2e, 0xcf, 0x35, 0xe3,
+	0xce, 0x4f, 0x0e, 0x54, 0xb4, 0x73, 0xa8, 0x0f, 0xae, 0x8a, 0xa6, 0x7d, 0x6a, 0x5c, 0x79, 0xcd,
+	0x55, 0xd5, 0x1d, 0xb0, 0x06, 0xa2, 0xbb, 0x50, 0x1d, 0x91, 0x03, 0xc6, 0x89, 0xf5, 0xee, 0x54,
+	0x13, 0x0b, 0x45, 0x77, 0xa0, 0x12, 0x1c, 0x48, 0xc2, 0xbd, 0xf2, 0xd9, 0x36, 0x06, 0xd9, 0xe1,
+	0xa7, 0x36, 0xe3, 0xfb, 0x50, 0xcb, 0xd3, 0xb5, 0xd0, 0xf7, 0x17, 0xb6, 0xd4, 0x5e, 0xe2, 0x1c,
+	0x8c, 0xae, 0x42, 0x43, 0xf2, 0x8c, 0x86, 0x81, 0x24, 0x63, 0xdb, 0x04, 0x0b, 0xc1, 0xe0, 0xdb,
+	0x12, 0xb8, 0xdb, 0xea, 0x55, 0xe7, 0x43, 0xf9, 0x29, 0x91, 0x08, 0xbd, 0xfa, 0x0a, 0xef, 0xfc,
+	0x67, 0xc9, 0xdb, 0x0e, 0xdd, 0x06, 0x57, 0xbd, 0x82, 0xd1, 0x4c, 0x39, 0xf7, 0x26, 0x5e, 0x6e,
+	0x71, 0x0f, 0x2a, 0x7a, 0x42, 0xa1, 0x8b, 0xcb, 0xe6, 0x75, 0xe7, 0xd2, 0xd2, 0x31, 0x86, 0xee,
+	0x28, 0x3a, 0x08, 0x59, 0x9c, 0x33, 0x37, 0x13, 0x3a, 0x17, 0x97, 0x75, 0x19, 0xb4, 0x01, 0x55,
+	0x13, 0x12, 0x74, 0x69, 0x31, 0x44, 0xc6, 0xec, 0xf2, 0xf2, 0xc8, 0x8d, 0xaa, 0x7a, 0x14, 0xdd,
+	0xfd, 0x63, 0x00, 0xef, 0x4d, 0x40, 0xca, 0x88, 0x0e, 0x00, 0x00,
 }
@@ -12,1100 +12,1015 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 189, 13, 112, 92, 201,
-			121, 24, 136, 238, 247, 102, 48, 104, 128, 4, 240, 0, 130, 224,
-			3, 73, 52, 103, 73, 2, 32, 7, 3, 44, 151, 187, 210, 146,
-			75, 197, 32, 9, 146, 179, 194, 2, 208, 0, 88, 122, 215, 178,
-			200, 135, 153, 6, 240, 118, 103, 222, 155, 125, 239, 13, 64, 172,
-			188, 209, 218, 183, 113, 164, 36, 74, 201, 177, 207, 235, 36, 170,
-			205, 37, 87, 167, 58, 43, 137, 92, 82, 34, 157, 229, 43, 57,
-			201, 201, 169, 40, 235, 31, 157, 173, 156, 44, 159, 78, 41, 199,
-			82, 157, 74, 42, 91, 185, 146, 237, 186, 84, 233, 78, 190, 250,
-			190, 238, 126, 63, 3, 128, 228, 74, 235, 170, 115, 74, 172, 93,
-			114, 190, 126, 253, 186, 191, 239, 235, 175, 191, 254, 250, 251, 190,
-			238, 199, 62, 56, 193, 198, 55, 125, 127, 179, 33, 102, 90, 129,
-			31, 249, 235, 237, 141, 153, 200, 109, 138, 48, 114, 154, 173, 50,
-			22, 89, 253, 178, 66, 89, 87, 40, 94, 102, 61, 171, 186, 142,
-			53, 202, 186, 67, 81, 243, 189, 122, 56, 74, 56, 153, 52, 170,
-			26, 180, 134, 89, 206, 115, 60, 63, 28, 165, 156, 76, 230, 170,
-			18, 184, 186, 202, 134, 106, 126, 179, 220, 209, 230, 213, 195, 113,
-			139, 203, 80, 180, 76, 254, 59, 66, 254, 11, 33, 255, 132, 26,
-			55, 151, 175, 126, 156, 158, 188, 41, 235, 47, 171, 250, 229, 219,
-			162, 209, 120, 183, 231, 239, 120, 171, 187, 45, 17, 62, 253, 171,
-			103, 88, 222, 50, 79, 118, 53, 9, 251, 173, 62, 70, 250, 44,
-			227, 100, 151, 117, 225, 223, 244, 113, 124, 161, 230, 55, 248, 213,
-			246, 198, 134, 8, 66, 62, 205, 101, 83, 19, 33, 175, 59, 145,
-			195, 93, 47, 18, 65, 109, 203, 241, 54, 5, 223, 240, 131, 166,
-			19, 49, 126, 205, 111, 237, 6, 238, 230, 86, 196, 47, 204, 206,
-			190, 83, 189, 192, 43, 94, 173, 204, 249, 92, 163, 193, 241, 89,
-			200, 3, 17, 138, 96, 91, 212, 203, 140, 111, 69, 81, 43, 188,
-			52, 51, 83, 23, 219, 162, 225, 183, 68, 16, 106, 10, 107, 126,
-			83, 178, 182, 230, 55, 166, 215, 37, 18, 51, 140, 241, 170, 168,
-			187, 97, 20, 184, 235, 237, 200, 245, 61, 238, 120, 117, 222, 14,
-			5, 119, 61, 30, 250, 237, 160, 38, 176, 100, 221, 245, 156, 96,
-			23, 241, 10, 75, 124, 199, 141, 182, 184, 31, 224, 191, 126, 59,
-			98, 188, 233, 215, 221, 13, 183, 230, 64, 11, 37, 238, 4, 130,
-			183, 68, 208, 116, 163, 72, 212, 121, 43, 240, 183, 221, 186, 168,
-			243, 104, 203, 137, 120, 180, 5, 212, 53, 26, 254, 142, 235, 109,
-			114, 24, 31, 23, 94, 10, 225, 37, 198, 155, 34, 186, 196, 24,
-			135, 63, 231, 58, 16, 11, 185, 191, 161, 49, 170, 249, 117, 193,
-			155, 237, 48, 226, 129, 136, 28, 215, 195, 86, 157, 117, 127, 27,
-			30, 41, 142, 49, 238, 249, 145, 91, 19, 37, 30, 109, 185, 33,
-			111, 184, 97, 4, 45, 164, 123, 244, 234, 29, 232, 212, 221, 176,
-			214, 112, 220, 166, 8, 202, 7, 33, 225, 122, 105, 94, 104, 36,
-			90, 129, 95, 111, 215, 68, 130, 7, 75, 16, 249, 161, 240, 96,
-			92, 81, 87, 247, 107, 237, 166, 240, 34, 71, 15, 210, 140, 31,
-			112, 63, 218, 18, 1, 111, 58, 145, 8, 92, 167, 17, 38, 172,
-			198, 1, 138, 182, 4, 227, 105, 236, 99, 162, 22, 133, 139, 111,
-			66, 195, 158, 211, 20, 128, 80, 90, 182, 60, 63, 121, 134, 124,
-			119, 163, 16, 40, 242, 100, 83, 126, 16, 242, 166, 179, 203, 215,
-			5, 72, 74, 157, 71, 62, 23, 94, 221, 15, 66, 1, 66, 209,
-			10, 252, 166, 31, 9, 46, 121, 18, 133, 188, 46, 2, 119, 91,
-			212, 249, 70, 224, 55, 153, 228, 66, 232, 111, 68, 59, 32, 38,
-			74, 130, 120, 216, 18, 53, 144, 32, 222, 10, 92, 16, 172, 0,
-			100, 199, 147, 82, 20, 134, 136, 59, 227, 171, 183, 42, 43, 124,
-			101, 233, 198, 234, 237, 185, 234, 60, 175, 172, 240, 229, 234, 210,
-			179, 149, 235, 243, 215, 249, 213, 231, 248, 234, 173, 121, 126, 109,
-			105, 249, 185, 106, 229, 230, 173, 85, 126, 107, 105, 225, 250, 124,
-			117, 133, 207, 45, 94, 231, 215, 150, 22, 87, 171, 149, 171, 107,
-			171, 75, 213, 21, 198, 139, 115, 43, 188, 178, 82, 196, 39, 115,
-			139, 207, 241, 249, 31, 95, 174, 206, 175, 172, 240, 165, 42, 175,
-			60, 179, 188, 80, 153, 191, 206, 111, 207, 85, 171, 115, 139, 171,
-			149, 249, 149, 18, 175, 44, 94, 91, 88, 187, 94, 89, 188, 89,
-			226, 87, 215, 86, 249, 226, 210, 42, 227, 11, 149, 103, 42, 171,
-			243, 215, 249, 234, 82, 9, 187, 221, 251, 30, 95, 186, 193, 159,
-			153, 175, 94, 187, 53, 183, 184, 58, 119, 181, 178, 80, 89, 125,
-			14, 59, 188, 81, 89, 93, 132, 206, 110, 44, 85, 25, 159, 227,
-			203, 115, 213, 213, 202, 181, 181, 133, 185, 42, 95, 94, 171, 46,
-			47, 173, 204, 115, 160, 236, 122, 101, 229, 218, 194, 92, 229, 153,
-			249, 235, 101, 94, 89, 228, 139, 75, 124, 254, 217, 249, 197, 85,
-			190, 114, 107, 110, 97, 33, 75, 40, 227, 75, 183, 23, 231, 171,
-			128, 125, 154, 76, 126, 117, 158, 47, 84, 230, 174, 46, 204, 67,
-			87, 72, 231, 245, 74, 117, 254, 218, 42, 16, 148, 252, 186, 86,
-			185, 62, 191, 184, 58, 183, 80, 98, 124, 101, 121, 254, 90, 101,
-			110, 161, 196, 231, 127, 124, 254, 153, 229, 133, 185, 234, 115, 37,
-			213, 232, 202, 252, 123, 214, 230, 23, 87, 43, 115, 11, 252, 250,
-			220, 51, 115, 55, 231, 87, 248, 228, 131, 184, 178, 92, 93, 186,
-			182, 86, 157, 127, 6, 176, 94, 186, 193, 87, 214, 174, 174, 172,
-			86, 86, 215, 86, 231, 249, 205, 165, 165, 235, 200, 236, 149, 249,
-			234, 179, 149, 107, 243, 43, 151, 249, 194, 210, 10, 50, 108, 109,
-			101, 190, 196, 248, 245, 185, 213, 57, 236, 122, 185, 186, 116, 163,
-			178, 186, 114, 25, 126, 95, 93, 91, 169, 32, 227, 42, 139, 171,
-			243, 213, 234, 218, 242, 106, 101, 105, 113, 138, 223, 90, 186, 61,
-			255, 236, 124, 149, 95, 155, 91, 91, 153, 191, 142, 28, 94, 90,
-			4, 106, 65, 86, 230, 151, 170, 207, 65, 179, 192, 7, 28, 129,
-			18, 191, 125, 107, 126, 245, 214, 124, 21, 152, 138, 220, 154, 3,
-			54, 172, 172, 86, 43, 215, 86, 211, 213, 150, 170, 124, 117, 169,
-			186, 202, 82, 116, 242, 197, 249, 155, 11, 149, 155, 243, 139, 215,
-			230, 225, 241, 18, 52, 115, 187, 178, 50, 63, 197, 231, 170, 149,
-			21, 168, 80, 193, 142, 249, 237, 185, 231, 248, 210, 26, 82, 13,
-			3, 181, 182, 50, 207, 228, 239, 148, 232, 150, 112, 60, 121, 229,
-			6, 159, 187, 254, 108, 5, 48, 87, 181, 151, 151, 86, 86, 42,
-			74, 92, 144, 109, 215, 110, 41, 158, 151, 25, 43, 48, 66, 45,
-			131, 23, 142, 194, 175, 130, 101, 20, 187, 46, 179, 94, 102, 22,
-			190, 217, 221, 37, 129, 62, 150, 3, 128, 90, 70, 177, 251, 40,
-			59, 196, 242, 8, 117, 73, 240, 48, 235, 150, 32, 145, 176, 170,
-			220, 109, 25, 69, 251, 146, 106, 241, 145, 174, 113, 213, 34, 145,
-			128, 172, 4, 221, 62, 18, 183, 72, 160, 197, 71, 226, 22, 9,
-			182, 248, 72, 220, 34, 49, 44, 227, 17, 251, 164, 106, 241, 116,
-			87, 73, 181, 72, 37, 32, 43, 81, 128, 186, 135, 84, 139, 20,
-			90, 4, 80, 182, 72, 177, 69, 128, 85, 229, 110, 203, 56, 61,
-			114, 94, 181, 120, 166, 107, 70, 181, 104, 72, 64, 86, 50, 168,
-			101, 156, 233, 30, 83, 45, 26, 208, 34, 128, 178, 69, 3, 91,
-			4, 88, 85, 238, 182, 140, 51, 39, 203, 170, 197, 179, 93, 69,
-			213, 162, 41, 1, 89, 201, 164, 150, 113, 182, 219, 86, 45, 154,
-			208, 34, 128, 178, 69, 19, 91, 4, 88, 85, 54, 44, 227, 236,
-			137, 83, 170, 197, 137, 152, 234, 156, 4, 100, 165, 28, 181, 140,
-			137, 238, 211, 170, 197, 28, 180, 8, 160, 108, 49, 135, 45, 2,
-			172, 42, 27, 150, 49, 49, 161, 169, 158, 236, 58, 165, 90, 204,
-			75, 64, 86, 202, 83, 203, 152, 236, 30, 85, 45, 230, 161, 69,
-			0, 101, 139, 121, 108, 17, 96, 85, 185, 219, 50, 38, 199, 56,
-			251, 240, 0, 163, 102, 151, 101, 58, 93, 77, 98, 191, 58, 192,
-			231, 120, 108, 241, 224, 74, 38, 66, 225, 69, 33, 119, 120, 203,
-			119, 189, 8, 215, 31, 183, 9, 246, 64, 93, 180, 132, 87, 23,
-			30, 174, 95, 142, 183, 43, 203, 95, 246, 61, 193, 64, 239, 215,
-			156, 134, 240, 234, 78, 80, 74, 90, 17, 117, 238, 132, 92, 153,
-			97, 184, 206, 109, 4, 78, 45, 89, 205, 245, 3, 88, 172, 193,
-			38, 67, 24, 172, 25, 191, 33, 141, 17, 215, 227, 107, 171, 215,
-			248, 124, 203, 175, 109, 97, 119, 101, 94, 137, 184, 27, 114, 225,
-			129, 13, 0, 150, 10, 172, 151, 184, 210, 45, 7, 126, 67, 180,
-			34, 183, 198, 111, 6, 98, 211, 15, 92, 199, 227, 215, 20, 78,
-			124, 103, 203, 173, 109, 113, 113, 47, 18, 208, 33, 172, 109, 73,
-			37, 141, 56, 227, 235, 78, 237, 197, 29, 39, 128, 26, 62, 223,
-			21, 78, 192, 125, 111, 79, 151, 78, 24, 182, 155, 208, 171, 211,
-			104, 240, 166, 235, 181, 35, 129, 214, 11, 127, 98, 150, 197, 36,
-			53, 124, 111, 179, 196, 221, 178, 40, 243, 134, 112, 90, 9, 169,
-			129, 224, 197, 176, 41, 156, 64, 212, 139, 60, 244, 165, 81, 228,
-			249, 233, 90, 140, 71, 206, 122, 67, 64, 159, 158, 16, 208, 229,
-			134, 31, 72, 243, 176, 5, 246, 14, 46, 229, 188, 138, 134, 162,
-			27, 170, 101, 117, 118, 118, 246, 209, 105, 252, 111, 117, 118, 246,
-			18, 254, 247, 60, 80, 241, 228, 147, 79, 62, 57, 253, 232, 133,
-			233, 199, 30, 93, 189, 240, 216, 165, 199, 159, 188, 244, 248, 147,
-			229, 39, 245, 159, 231, 203, 140, 95, 221, 5, 134, 71, 129, 91,
-			139, 144, 149, 10, 165, 0, 154, 47, 241, 29, 193, 133, 23, 182,
-			3, 33, 75, 119, 4, 175, 1, 199, 124, 111, 91, 4, 17, 143,
-			124, 166, 70, 213, 111, 114, 94, 189, 113, 141, 63, 246, 216, 99,
-			79, 130, 57, 43, 56, 52, 233, 109, 134, 101, 198, 87, 132, 224,
-			63, 161, 237, 210, 157, 157, 157, 178, 43, 162, 141, 178, 31, 108,
-			206, 4, 27, 53, 248, 31, 94, 42, 71, 247, 162, 159, 156, 124,
-			152, 90, 83, 96, 10, 204, 223, 115, 154, 173, 134, 224, 143, 94,
-			226, 215, 252, 102, 171, 29, 137, 148, 20, 35, 58, 203, 75, 43,
-			149, 31, 231, 119, 65, 104, 38, 167, 238, 150, 149, 85, 153, 84,
-			138, 55, 23, 151, 229, 147, 100, 179, 17, 138, 232, 142, 26, 175,
-			73, 124, 125, 113, 109, 97, 97, 106, 106, 223, 122, 40, 182, 147,
-			179, 83, 151, 83, 56, 93, 120, 16, 78, 155, 34, 130, 86, 252,
-			141, 186, 179, 155, 194, 45, 140, 130, 118, 45, 194, 14, 182, 157,
-			6, 143, 182, 85, 143, 153, 234, 103, 163, 237, 18, 71, 132, 46,
-			255, 160, 36, 109, 151, 163, 109, 128, 238, 71, 145, 172, 212, 14,
-			69, 141, 159, 227, 143, 206, 206, 102, 41, 124, 236, 64, 10, 111,
-			187, 222, 99, 23, 248, 221, 155, 34, 90, 217, 13, 35, 209, 132,
-			199, 115, 225, 13, 183, 33, 86, 179, 3, 113, 163, 178, 48, 191,
-			90, 121, 102, 158, 111, 68, 10, 141, 131, 222, 57, 187, 17, 105,
-			76, 215, 42, 139, 171, 79, 92, 228, 145, 91, 123, 49, 228, 87,
-			248, 228, 228, 164, 44, 153, 218, 136, 202, 245, 157, 91, 238, 230,
-			214, 117, 39, 194, 183, 166, 248, 83, 79, 241, 199, 46, 76, 241,
-			159, 226, 248, 108, 193, 223, 209, 143, 52, 223, 102, 102, 248, 28,
-			224, 91, 247, 119, 66, 108, 18, 38, 211, 163, 179, 179, 41, 85,
-			20, 150, 227, 10, 2, 85, 208, 163, 79, 236, 157, 101, 113, 107,
-			240, 250, 163, 79, 92, 188, 120, 241, 29, 143, 61, 49, 59, 27,
-			79, 249, 117, 177, 225, 7, 130, 175, 121, 238, 61, 221, 202, 147,
-			239, 152, 237, 108, 165, 252, 131, 13, 230, 164, 164, 159, 79, 78,
-			74, 166, 204, 224, 96, 193, 159, 41, 62, 157, 70, 231, 1, 18,
-			12, 237, 0, 187, 116, 59, 103, 82, 237, 160, 0, 76, 101, 4,
-			224, 226, 129, 2, 240, 180, 179, 237, 240, 187, 114, 32, 203, 181,
-			118, 16, 8, 47, 130, 42, 207, 184, 141, 134, 27, 166, 4, 0,
-			52, 36, 111, 98, 41, 191, 194, 15, 126, 225, 62, 98, 206, 175,
-			36, 165, 101, 79, 236, 92, 109, 187, 141, 186, 8, 38, 167, 128,
-			176, 21, 197, 33, 213, 133, 100, 204, 148, 108, 11, 254, 64, 157,
-			69, 73, 187, 235, 69, 64, 185, 170, 41, 73, 87, 100, 35, 7,
-			166, 202, 235, 208, 50, 226, 146, 240, 224, 241, 3, 121, 160, 168,
-			208, 235, 38, 95, 222, 141, 182, 228, 14, 6, 254, 120, 254, 14,
-			191, 34, 23, 51, 169, 156, 212, 236, 87, 226, 114, 5, 52, 253,
-			164, 231, 239, 168, 114, 28, 31, 85, 10, 197, 124, 90, 87, 149,
-			40, 158, 59, 247, 228, 84, 199, 184, 166, 249, 50, 169, 42, 95,
-			81, 255, 150, 100, 131, 87, 240, 239, 41, 134, 127, 12, 19, 44,
-			5, 167, 48, 200, 254, 49, 97, 166, 137, 54, 227, 6, 29, 182,
-			127, 158, 240, 106, 98, 16, 104, 4, 253, 13, 92, 147, 145, 184,
-			208, 245, 106, 105, 209, 102, 251, 203, 54, 127, 6, 182, 201, 235,
-			66, 178, 7, 255, 58, 96, 189, 98, 251, 45, 88, 207, 115, 215,
-			171, 53, 218, 161, 187, 45, 202, 140, 29, 98, 57, 64, 209, 180,
-			204, 13, 234, 160, 145, 8, 96, 14, 80, 238, 214, 16, 177, 140,
-			141, 66, 191, 134, 12, 203, 216, 176, 134, 216, 55, 36, 113, 196,
-			50, 26, 212, 178, 191, 76, 248, 162, 239, 77, 123, 98, 211, 137,
-			220, 109, 145, 181, 76, 28, 69, 45, 135, 197, 121, 63, 203, 164,
-			204, 23, 213, 139, 122, 205, 231, 219, 78, 163, 45, 66, 185, 245,
-			78, 26, 67, 7, 65, 24, 185, 141, 6, 223, 114, 182, 5, 247,
-			210, 125, 202, 177, 149, 47, 50, 185, 194, 214, 252, 182, 23, 193,
-			130, 15, 118, 136, 54, 190, 58, 24, 56, 171, 22, 246, 146, 250,
-			159, 237, 195, 31, 98, 90, 102, 131, 110, 12, 43, 30, 144, 28,
-			80, 173, 249, 67, 128, 7, 133, 67, 26, 50, 44, 163, 49, 48,
-			184, 158, 71, 239, 208, 99, 236, 15, 134, 217, 194, 166, 27, 109,
-			181, 215, 209, 103, 212, 104, 215, 92, 252, 107, 122, 211, 159, 105,
-			248, 155, 117, 127, 115, 198, 105, 185, 51, 194, 171, 163, 133, 24,
-			206, 212, 124, 63, 168, 187, 158, 19, 249, 1, 84, 8, 103, 182,
-			31, 157, 9, 35, 39, 82, 94, 53, 43, 47, 223, 178, 31, 228,
-			224, 43, 254, 188, 193, 14, 47, 248, 155, 43, 81, 32, 156, 230,
-			10, 180, 96, 61, 194, 14, 225, 179, 59, 219, 34, 8, 93, 223,
-			67, 223, 94, 79, 181, 15, 11, 159, 149, 101, 214, 69, 214, 93,
-			11, 132, 19, 137, 58, 186, 248, 122, 47, 216, 157, 110, 189, 114,
-			60, 33, 170, 186, 170, 117, 134, 29, 142, 68, 208, 116, 61, 167,
-			113, 7, 172, 219, 123, 163, 6, 250, 13, 15, 233, 210, 10, 20,
-			90, 79, 177, 110, 39, 168, 109, 185, 219, 98, 212, 196, 198, 139,
-			101, 73, 79, 57, 139, 106, 121, 78, 214, 170, 120, 27, 126, 85,
-			191, 98, 141, 176, 124, 171, 29, 108, 138, 250, 104, 142, 147, 201,
-			66, 85, 65, 246, 255, 72, 88, 111, 234, 5, 107, 140, 245, 32,
-			14, 119, 218, 65, 67, 209, 88, 192, 130, 181, 160, 97, 157, 96,
-			44, 196, 142, 240, 41, 197, 167, 61, 178, 4, 30, 31, 99, 133,
-			186, 19, 57, 248, 208, 192, 135, 221, 0, 195, 35, 155, 21, 106,
-			62, 168, 171, 72, 98, 95, 168, 198, 176, 117, 150, 245, 55, 252,
-			205, 59, 194, 139, 130, 221, 59, 40, 119, 136, 163, 81, 61, 212,
-			240, 55, 231, 161, 244, 26, 20, 62, 253, 111, 6, 88, 222, 50,
-			205, 174, 89, 194, 62, 77, 208, 165, 105, 118, 89, 23, 62, 78,
-			50, 222, 201, 71, 159, 224, 171, 91, 130, 47, 172, 93, 171, 240,
-			185, 118, 180, 229, 7, 97, 249, 0, 23, 229, 90, 136, 14, 39,
-			229, 8, 74, 28, 122, 110, 200, 55, 253, 109, 17, 120, 96, 215,
-			123, 117, 229, 159, 154, 107, 57, 53, 104, 216, 173, 9, 47, 20,
-			37, 174, 198, 156, 95, 40, 207, 234, 41, 227, 120, 56, 53, 252,
-			182, 87, 215, 238, 178, 133, 202, 181, 249, 197, 149, 121, 190, 225,
-			54, 68, 188, 119, 206, 23, 14, 179, 30, 70, 141, 46, 203, 40,
-			116, 79, 178, 55, 136, 220, 8, 29, 234, 154, 37, 246, 207, 17,
-			158, 29, 78, 64, 199, 225, 235, 110, 221, 13, 4, 206, 101, 167,
-			193, 81, 168, 229, 124, 149, 158, 47, 216, 159, 180, 192, 220, 149,
-			47, 194, 86, 162, 17, 130, 162, 223, 219, 150, 104, 174, 139, 122,
-			93, 26, 246, 30, 159, 215, 147, 135, 7, 226, 165, 182, 8, 163,
-			153, 64, 132, 45, 223, 11, 133, 50, 3, 161, 21, 173, 153, 15,
-			21, 70, 216, 117, 173, 152, 251, 11, 167, 236, 119, 72, 111, 178,
-			102, 133, 43, 119, 52, 90, 214, 185, 154, 42, 184, 109, 144, 92,
-			70, 84, 50, 186, 179, 191, 112, 104, 52, 165, 59, 251, 11, 135,
-			83, 186, 179, 191, 255, 120, 74, 119, 246, 143, 115, 182, 172, 85,
-			167, 85, 40, 219, 215, 112, 168, 113, 1, 216, 217, 18, 146, 225,
-			13, 127, 83, 117, 195, 119, 28, 160, 106, 211, 13, 35, 17, 164,
-			124, 145, 252, 90, 162, 38, 50, 106, 202, 42, 244, 159, 210, 170,
-			40, 15, 61, 156, 74, 169, 41, 171, 56, 149, 82, 83, 86, 105,
-			154, 109, 35, 42, 212, 50, 70, 10, 167, 108, 23, 81, 81, 29,
-			227, 124, 145, 162, 149, 70, 104, 34, 228, 122, 70, 243, 166, 8,
-			67, 103, 19, 246, 116, 178, 150, 28, 75, 55, 228, 211, 143, 150,
-			88, 252, 30, 178, 12, 84, 182, 108, 192, 245, 54, 99, 132, 169,
-			105, 153, 35, 5, 171, 172, 144, 162, 57, 192, 67, 107, 82, 96,
-			208, 200, 97, 205, 59, 106, 88, 198, 200, 56, 103, 183, 0, 97,
-			163, 203, 50, 143, 209, 73, 195, 190, 196, 83, 211, 30, 125, 170,
-			142, 139, 238, 111, 44, 228, 117, 17, 57, 110, 35, 84, 99, 151,
-			38, 163, 204, 100, 187, 6, 140, 208, 49, 118, 132, 189, 135, 229,
-			1, 2, 161, 24, 51, 143, 217, 87, 145, 21, 202, 141, 187, 18,
-			249, 129, 179, 41, 248, 90, 117, 1, 198, 40, 16, 123, 121, 34,
-			185, 229, 198, 93, 215, 203, 140, 245, 179, 110, 217, 164, 105, 153,
-			99, 230, 49, 116, 242, 200, 130, 28, 116, 194, 18, 152, 88, 198,
-			88, 239, 112, 2, 27, 150, 49, 118, 116, 148, 253, 132, 194, 137,
-			88, 198, 9, 211, 182, 23, 222, 34, 78, 129, 179, 163, 7, 83,
-			198, 68, 246, 197, 14, 132, 230, 132, 57, 118, 44, 238, 29, 86,
-			183, 19, 41, 236, 64, 112, 78, 244, 30, 73, 96, 195, 50, 78,
-			140, 30, 99, 207, 43, 236, 168, 101, 140, 155, 163, 246, 187, 223,
-			34, 118, 78, 24, 138, 230, 122, 67, 212, 239, 135, 28, 8, 200,
-			184, 121, 194, 142, 59, 7, 17, 25, 79, 33, 7, 188, 25, 239,
-			29, 74, 96, 195, 50, 198, 71, 142, 178, 175, 17, 133, 157, 97,
-			25, 167, 205, 17, 251, 183, 9, 10, 105, 208, 22, 37, 116, 53,
-			0, 42, 160, 171, 93, 1, 123, 138, 104, 71, 8, 143, 207, 202,
-			224, 65, 102, 21, 227, 59, 128, 124, 140, 25, 175, 108, 48, 190,
-			225, 52, 66, 29, 129, 112, 189, 186, 91, 115, 34, 17, 38, 1,
-			153, 142, 201, 235, 249, 96, 135, 200, 85, 162, 177, 203, 27, 190,
-			83, 71, 93, 7, 230, 25, 186, 33, 154, 162, 238, 202, 61, 190,
-			228, 89, 172, 5, 100, 175, 78, 67, 86, 131, 31, 226, 94, 203,
-			13, 50, 12, 50, 76, 203, 60, 109, 142, 143, 198, 12, 48, 114,
-			64, 112, 33, 129, 137, 101, 156, 238, 25, 76, 96, 96, 200, 240,
-			17, 246, 136, 226, 143, 105, 25, 19, 230, 73, 123, 24, 71, 207,
-			107, 55, 215, 69, 0, 147, 30, 136, 72, 122, 49, 77, 203, 156,
-			48, 79, 143, 196, 173, 152, 57, 120, 173, 39, 129, 137, 101, 76,
-			176, 68, 134, 76, 195, 50, 38, 142, 159, 96, 14, 170, 23, 195,
-			50, 206, 83, 219, 94, 133, 33, 64, 147, 205, 109, 148, 58, 89,
-			149, 26, 255, 146, 10, 226, 184, 33, 223, 112, 69, 163, 222, 57,
-			175, 157, 6, 211, 51, 59, 214, 36, 192, 135, 243, 116, 210, 80,
-			218, 194, 200, 67, 151, 90, 147, 0, 15, 206, 31, 62, 162, 33,
-			64, 103, 244, 24, 251, 0, 226, 102, 90, 198, 76, 97, 212, 14,
-			120, 37, 53, 146, 130, 75, 195, 66, 173, 81, 104, 195, 54, 252,
-			205, 50, 159, 67, 148, 113, 168, 183, 28, 144, 28, 225, 233, 170,
-			110, 200, 125, 175, 177, 203, 184, 83, 123, 209, 243, 119, 26, 162,
-			190, 41, 163, 57, 78, 189, 233, 122, 110, 24, 5, 210, 76, 173,
-			53, 92, 216, 1, 196, 152, 3, 111, 103, 10, 231, 109, 133, 29,
-			112, 118, 166, 208, 167, 33, 98, 25, 51, 135, 180, 93, 14, 92,
-			157, 25, 57, 26, 219, 150, 127, 122, 138, 157, 236, 180, 2, 235,
-			237, 64, 250, 180, 14, 136, 242, 94, 98, 133, 235, 170, 202, 91,
-			14, 242, 46, 239, 31, 228, 61, 164, 27, 76, 98, 188, 15, 27,
-			224, 253, 101, 46, 3, 188, 119, 126, 20, 224, 253, 81, 128, 247,
-			71, 1, 222, 31, 5, 120, 127, 20, 224, 253, 81, 128, 247, 175,
-			76, 128, 183, 148, 14, 240, 150, 50, 1, 222, 161, 108, 128, 119,
-			168, 35, 192, 171, 195, 177, 164, 219, 50, 30, 137, 195, 177, 167,
-			187, 202, 233, 0, 111, 57, 19, 224, 29, 203, 6, 120, 199, 58,
-			2, 188, 99, 233, 0, 239, 201, 233, 56, 192, 91, 76, 7, 120,
-			139, 153, 0, 175, 157, 13, 240, 218, 29, 1, 94, 29, 142, 5,
-			123, 233, 76, 28, 142, 61, 27, 83, 109, 74, 32, 29, 224, 61,
-			157, 13, 240, 158, 238, 8, 240, 158, 78, 7, 120, 227, 112, 236,
-			68, 28, 142, 205, 73, 32, 29, 224, 29, 205, 6, 120, 71, 59,
-			2, 188, 58, 28, 155, 235, 182, 140, 137, 49, 206, 190, 206, 164,
-			23, 162, 218, 117, 135, 216, 95, 6, 165, 161, 109, 147, 108, 52,
-			54, 116, 55, 61, 48, 52, 55, 220, 123, 162, 62, 221, 16, 222,
-			102, 180, 197, 195, 150, 227, 225, 134, 23, 246, 226, 169, 176, 43,
-			227, 14, 188, 35, 157, 137, 233, 72, 235, 193, 33, 216, 148, 159,
-			147, 101, 28, 157, 50, 250, 185, 79, 248, 87, 199, 77, 177, 213,
-			154, 239, 213, 68, 43, 130, 245, 239, 69, 193, 139, 117, 103, 183,
-			136, 81, 225, 98, 211, 247, 162, 173, 162, 110, 38, 16, 13, 39,
-			146, 43, 74, 226, 52, 199, 21, 80, 153, 14, 117, 23, 236, 22,
-			225, 213, 132, 222, 228, 48, 30, 237, 164, 107, 43, 63, 43, 186,
-			103, 98, 86, 1, 10, 110, 236, 10, 114, 234, 176, 70, 250, 1,
-			15, 219, 235, 17, 144, 11, 28, 65, 215, 169, 147, 138, 24, 36,
-			33, 84, 167, 213, 10, 252, 123, 46, 172, 179, 141, 93, 126, 126,
-			250, 209, 217, 210, 236, 236, 44, 70, 128, 195, 3, 162, 141, 113,
-			207, 216, 108, 6, 67, 180, 33, 90, 161, 104, 215, 125, 52, 100,
-			246, 198, 30, 195, 200, 9, 34, 126, 133, 151, 203, 229, 203, 157,
-			207, 132, 87, 207, 60, 137, 59, 210, 102, 178, 126, 42, 31, 199,
-			198, 115, 18, 63, 16, 94, 61, 134, 166, 101, 95, 26, 190, 220,
-			241, 146, 14, 46, 192, 43, 242, 183, 126, 1, 33, 221, 137, 187,
-			193, 39, 247, 116, 244, 20, 159, 229, 103, 207, 118, 182, 245, 46,
-			62, 59, 197, 223, 175, 195, 44, 123, 94, 58, 127, 133, 63, 122,
-			121, 207, 83, 213, 245, 149, 56, 232, 52, 59, 171, 42, 189, 194,
-			69, 3, 108, 213, 20, 2, 97, 220, 216, 187, 246, 197, 224, 169,
-			251, 99, 48, 125, 31, 12, 206, 239, 135, 193, 67, 69, 118, 19,
-			240, 124, 50, 98, 111, 93, 12, 14, 28, 236, 131, 133, 68, 62,
-			74, 143, 249, 149, 236, 152, 243, 243, 123, 152, 112, 57, 121, 73,
-			75, 64, 106, 212, 211, 47, 236, 17, 131, 228, 157, 44, 159, 51,
-			66, 151, 102, 113, 242, 194, 190, 220, 77, 198, 55, 169, 248, 174,
-			116, 197, 3, 250, 56, 191, 127, 31, 251, 202, 80, 42, 200, 85,
-			45, 12, 176, 150, 118, 165, 62, 75, 135, 237, 26, 95, 65, 197,
-			154, 14, 111, 129, 30, 74, 107, 214, 142, 240, 203, 244, 99, 143,
-			62, 94, 122, 252, 29, 79, 128, 142, 128, 255, 25, 104, 179, 243,
-			29, 133, 7, 132, 172, 158, 165, 85, 43, 229, 118, 125, 54, 19,
-			178, 122, 54, 19, 178, 122, 214, 26, 98, 255, 141, 161, 253, 174,
-			239, 163, 150, 253, 231, 84, 35, 251, 214, 130, 85, 105, 154, 88,
-			66, 148, 22, 182, 144, 55, 68, 136, 222, 32, 143, 251, 158, 136,
-			91, 11, 50, 75, 138, 220, 114, 56, 124, 150, 241, 187, 138, 87,
-			119, 149, 179, 3, 148, 175, 195, 91, 126, 232, 162, 187, 192, 15,
-			120, 28, 225, 186, 139, 8, 169, 138, 101, 126, 195, 15, 98, 217,
-			10, 17, 149, 84, 135, 126, 192, 155, 126, 32, 74, 220, 65, 151,
-			203, 203, 34, 240, 149, 127, 86, 59, 67, 51, 173, 201, 173, 217,
-			186, 96, 49, 121, 14, 70, 36, 55, 61, 88, 252, 176, 122, 22,
-			207, 206, 97, 204, 68, 208, 96, 8, 83, 5, 7, 68, 212, 222,
-			71, 159, 77, 71, 212, 222, 151, 137, 168, 189, 47, 19, 81, 123,
-			95, 42, 162, 246, 167, 85, 118, 225, 33, 34, 106, 13, 127, 179,
-			181, 14, 127, 43, 79, 72, 14, 11, 30, 24, 54, 179, 31, 224,
-			81, 41, 254, 9, 101, 67, 113, 64, 226, 186, 8, 107, 129, 219,
-			138, 252, 0, 99, 83, 129, 216, 112, 239, 169, 128, 147, 130, 44,
-			139, 153, 176, 49, 84, 129, 38, 252, 109, 93, 96, 189, 42, 4,
-			21, 237, 182, 4, 134, 153, 14, 95, 24, 44, 35, 134, 101, 217,
-			242, 234, 110, 75, 84, 85, 160, 10, 126, 91, 167, 88, 31, 108,
-			41, 133, 23, 201, 151, 76, 108, 175, 87, 149, 97, 149, 119, 178,
-			158, 152, 26, 140, 62, 221, 63, 118, 151, 84, 182, 222, 201, 204,
-			200, 217, 12, 71, 243, 220, 152, 236, 189, 112, 90, 97, 178, 15,
-			153, 229, 85, 103, 51, 196, 112, 86, 21, 223, 176, 206, 178, 126,
-			185, 195, 191, 179, 225, 54, 196, 29, 113, 47, 26, 237, 70, 204,
-			14, 201, 226, 27, 110, 67, 204, 223, 139, 236, 119, 176, 158, 248,
-			85, 107, 128, 25, 47, 138, 93, 197, 40, 248, 105, 13, 179, 28,
-			74, 167, 98, 147, 4, 46, 209, 119, 146, 226, 11, 204, 92, 21,
-			247, 34, 235, 44, 203, 53, 92, 79, 132, 163, 4, 113, 28, 80,
-			56, 194, 179, 242, 130, 235, 137, 170, 124, 108, 95, 98, 38, 128,
-			73, 139, 36, 213, 162, 117, 156, 245, 212, 69, 195, 109, 186, 145,
-			8, 116, 236, 47, 46, 40, 94, 100, 249, 171, 136, 53, 140, 166,
-			191, 177, 17, 138, 8, 95, 55, 171, 10, 130, 209, 172, 59, 145,
-			131, 175, 246, 85, 241, 119, 241, 31, 16, 86, 184, 238, 68, 206,
-			102, 224, 52, 227, 10, 36, 169, 96, 61, 202, 186, 91, 78, 16,
-			185, 78, 67, 69, 84, 143, 42, 228, 245, 91, 229, 101, 249, 184,
-			170, 235, 217, 55, 89, 183, 42, 3, 66, 100, 64, 21, 154, 60,
-			84, 149, 0, 244, 19, 186, 47, 75, 126, 153, 85, 252, 13, 101,
-			13, 39, 140, 80, 158, 10, 85, 252, 93, 252, 4, 101, 133, 5,
-			21, 129, 180, 46, 177, 94, 24, 243, 59, 41, 210, 122, 47, 28,
-			219, 35, 34, 90, 151, 85, 25, 212, 94, 146, 148, 159, 98, 125,
-			82, 162, 85, 120, 87, 118, 220, 43, 203, 100, 112, 247, 20, 235,
-			83, 98, 157, 68, 128, 205, 170, 18, 117, 89, 197, 102, 133, 80,
-			188, 212, 6, 147, 20, 37, 216, 172, 198, 176, 117, 138, 153, 17,
-			200, 15, 67, 180, 122, 83, 3, 124, 171, 171, 138, 143, 172, 9,
-			150, 151, 98, 53, 218, 139, 149, 14, 169, 74, 114, 212, 110, 117,
-			85, 213, 99, 107, 90, 70, 113, 129, 185, 163, 125, 88, 181, 191,
-			131, 231, 183, 186, 170, 113, 149, 171, 61, 172, 91, 77, 164, 226,
-			63, 53, 144, 97, 18, 221, 50, 51, 235, 34, 172, 41, 78, 217,
-			7, 207, 139, 42, 214, 179, 102, 88, 183, 138, 42, 140, 82, 20,
-			211, 35, 201, 43, 216, 98, 89, 206, 29, 93, 203, 58, 199, 6,
-			97, 152, 238, 100, 88, 43, 249, 214, 15, 15, 150, 83, 236, 213,
-			117, 51, 60, 54, 147, 186, 43, 41, 62, 31, 16, 142, 54, 59,
-			194, 209, 246, 231, 8, 203, 73, 217, 56, 72, 226, 211, 35, 70,
-			247, 140, 88, 223, 62, 136, 223, 87, 38, 204, 189, 50, 209, 33,
-			149, 185, 183, 32, 149, 231, 102, 25, 75, 244, 165, 85, 96, 230,
-			234, 252, 143, 175, 14, 116, 89, 140, 229, 175, 86, 22, 231, 170,
-			207, 13, 16, 171, 143, 21, 174, 207, 173, 206, 221, 172, 206, 61,
-			51, 64, 159, 254, 103, 55, 88, 183, 149, 51, 187, 254, 17, 189,
-			111, 4, 254, 241, 191, 10, 17, 248, 67, 233, 8, 60, 252, 36,
-			150, 209, 211, 61, 193, 56, 163, 185, 46, 203, 236, 235, 26, 32,
-			246, 176, 10, 90, 168, 56, 11, 172, 29, 101, 14, 38, 92, 14,
-			172, 164, 190, 92, 63, 108, 190, 115, 104, 194, 29, 162, 189, 176,
-			230, 230, 164, 1, 117, 136, 230, 53, 68, 45, 227, 80, 15, 83,
-			21, 137, 101, 28, 150, 161, 149, 156, 92, 170, 15, 211, 130, 134,
-			168, 101, 28, 238, 237, 83, 21, 169, 101, 244, 211, 126, 245, 8,
-			94, 235, 167, 76, 67, 240, 236, 208, 97, 246, 146, 220, 174, 143,
-			116, 189, 155, 216, 226, 28, 70, 250, 227, 144, 101, 60, 183, 208,
-			225, 95, 230, 171, 24, 111, 147, 198, 200, 70, 187, 209, 224, 161,
-			192, 221, 179, 235, 73, 255, 63, 112, 17, 24, 200, 212, 171, 235,
-			34, 148, 97, 155, 77, 215, 75, 133, 125, 181, 241, 58, 82, 24,
-			99, 127, 24, 103, 104, 141, 211, 97, 251, 247, 8, 75, 5, 192,
-			39, 66, 46, 229, 152, 79, 6, 226, 165, 182, 27, 136, 250, 148,
-			74, 70, 8, 185, 31, 184, 155, 174, 231, 96, 146, 175, 220, 179,
-			106, 147, 233, 106, 59, 106, 136, 128, 187, 94, 24, 57, 176, 239,
-			222, 193, 200, 247, 22, 216, 127, 14, 151, 179, 25, 90, 153, 3,
-			99, 204, 173, 235, 46, 226, 200, 185, 195, 165, 56, 47, 66, 91,
-			154, 14, 16, 131, 75, 73, 176, 226, 32, 227, 167, 230, 55, 155,
-			190, 167, 109, 32, 24, 232, 48, 109, 45, 143, 211, 145, 19, 41,
-			107, 121, 92, 142, 154, 178, 150, 199, 123, 210, 214, 242, 184, 53,
-			196, 254, 56, 78, 240, 154, 162, 150, 253, 31, 21, 111, 50, 33,
-			92, 116, 134, 103, 185, 163, 7, 73, 123, 186, 219, 158, 251, 82,
-			91, 52, 118, 185, 91, 23, 94, 228, 110, 236, 202, 33, 137, 3,
-			164, 110, 180, 165, 68, 60, 172, 249, 45, 17, 123, 207, 91, 123,
-			56, 133, 157, 253, 101, 243, 9, 204, 210, 41, 58, 158, 54, 75,
-			167, 98, 62, 129, 172, 79, 245, 164, 205, 210, 169, 129, 65, 246,
-			78, 157, 65, 81, 162, 39, 236, 243, 123, 153, 164, 214, 21, 156,
-			120, 105, 102, 241, 84, 14, 68, 137, 78, 233, 141, 12, 205, 67,
-			75, 125, 169, 28, 136, 82, 156, 91, 66, 13, 203, 40, 141, 29,
-			103, 255, 145, 232, 176, 234, 227, 212, 182, 255, 215, 78, 177, 61,
-			168, 71, 61, 60, 202, 212, 231, 142, 199, 111, 173, 174, 46, 243,
-			107, 178, 254, 52, 168, 80, 201, 97, 237, 96, 106, 58, 117, 193,
-			157, 109, 199, 109, 96, 170, 77, 228, 131, 244, 95, 247, 55, 153,
-			142, 98, 202, 96, 245, 75, 109, 17, 236, 38, 147, 140, 55, 69,
-			228, 200, 57, 91, 137, 228, 4, 112, 26, 161, 143, 93, 182, 90,
-			13, 87, 133, 69, 85, 180, 151, 233, 8, 17, 112, 13, 223, 74,
-			199, 116, 31, 167, 37, 45, 180, 70, 14, 232, 45, 164, 98, 186,
-			143, 247, 164, 99, 186, 143, 143, 30, 99, 255, 51, 209, 65, 221,
-			43, 244, 156, 253, 207, 247, 19, 218, 117, 39, 20, 169, 236, 206,
-			125, 248, 227, 249, 58, 10, 44, 125, 10, 232, 15, 220, 147, 8,
-			35, 151, 3, 101, 90, 185, 34, 228, 226, 30, 108, 239, 240, 69,
-			55, 96, 169, 46, 156, 144, 55, 221, 90, 160, 183, 146, 114, 153,
-			11, 181, 222, 208, 81, 238, 76, 64, 248, 10, 125, 60, 14, 8,
-			231, 129, 152, 177, 84, 64, 248, 202, 241, 51, 169, 128, 240, 149,
-			201, 41, 246, 243, 146, 236, 156, 101, 92, 167, 227, 246, 223, 0,
-			178, 29, 76, 188, 113, 60, 238, 4, 235, 110, 20, 0, 131, 95,
-			20, 187, 51, 114, 2, 69, 206, 38, 119, 194, 208, 175, 185, 78,
-			148, 196, 191, 220, 48, 155, 32, 163, 6, 59, 30, 107, 88, 152,
-			112, 168, 113, 11, 153, 84, 149, 60, 173, 115, 208, 191, 206, 166,
-			114, 41, 198, 228, 228, 76, 203, 188, 78, 175, 156, 83, 40, 231,
-			242, 128, 164, 30, 183, 28, 177, 140, 235, 35, 154, 212, 156, 97,
-			25, 215, 79, 156, 100, 127, 95, 146, 147, 183, 140, 167, 233, 9,
-			251, 111, 19, 198, 43, 27, 160, 237, 75, 106, 80, 148, 234, 104,
-			52, 64, 164, 94, 240, 93, 15, 37, 106, 83, 96, 148, 174, 222,
-			14, 240, 60, 137, 78, 158, 136, 124, 30, 8, 153, 165, 40, 99,
-			124, 74, 119, 235, 124, 36, 220, 112, 119, 8, 186, 19, 241, 167,
-			164, 6, 122, 215, 204, 249, 153, 167, 64, 245, 188, 171, 12, 27,
-			13, 77, 84, 222, 180, 204, 167, 233, 245, 113, 133, 120, 62, 7,
-			168, 106, 209, 204, 19, 203, 120, 186, 71, 79, 218, 188, 97, 25,
-			79, 143, 29, 103, 69, 6, 131, 103, 46, 118, 253, 36, 177, 71,
-			56, 88, 181, 26, 1, 53, 95, 229, 170, 108, 130, 150, 89, 44,
-			244, 177, 203, 204, 52, 137, 209, 101, 153, 203, 244, 39, 12, 123,
-			26, 103, 169, 187, 217, 246, 219, 33, 7, 83, 152, 227, 110, 71,
-			71, 67, 221, 128, 199, 187, 152, 176, 204, 177, 107, 130, 185, 77,
-			203, 236, 48, 187, 193, 242, 0, 193, 58, 87, 53, 143, 216, 239,
-			144, 147, 194, 245, 196, 132, 106, 75, 97, 80, 194, 100, 21, 220,
-			172, 215, 129, 135, 46, 134, 35, 85, 179, 101, 142, 233, 32, 68,
-			37, 52, 85, 205, 229, 1, 76, 247, 32, 42, 161, 169, 170, 178,
-			114, 136, 74, 104, 170, 246, 166, 158, 27, 150, 81, 29, 26, 102,
-			255, 130, 40, 76, 136, 101, 60, 111, 30, 179, 255, 123, 61, 65,
-			37, 46, 113, 95, 234, 188, 11, 200, 96, 69, 89, 86, 82, 152,
-			69, 179, 21, 237, 170, 167, 113, 136, 216, 195, 21, 1, 104, 112,
-			189, 182, 136, 109, 38, 15, 89, 140, 166, 42, 236, 163, 24, 214,
-			44, 197, 241, 99, 213, 167, 182, 101, 117, 238, 75, 221, 23, 50,
-			103, 199, 169, 111, 195, 2, 174, 114, 109, 136, 202, 148, 122, 222,
-			172, 30, 137, 201, 130, 229, 225, 249, 20, 217, 48, 116, 207, 171,
-			60, 46, 162, 50, 165, 158, 63, 58, 10, 230, 144, 137, 129, 160,
-			247, 82, 41, 234, 4, 88, 104, 188, 87, 154, 67, 0, 229, 45,
-			227, 189, 189, 253, 26, 34, 150, 241, 222, 129, 35, 26, 50, 44,
-			227, 189, 163, 199, 216, 105, 70, 77, 106, 153, 119, 187, 4, 177,
-			71, 185, 220, 240, 236, 47, 65, 192, 221, 187, 133, 195, 236, 38,
-			51, 77, 140, 22, 173, 211, 97, 251, 18, 50, 122, 125, 23, 211,
-			89, 64, 1, 105, 54, 169, 38, 148, 126, 219, 112, 3, 88, 28,
-			100, 53, 101, 120, 161, 204, 83, 28, 245, 117, 122, 119, 0, 241,
-			162, 56, 230, 235, 74, 230, 101, 16, 106, 93, 217, 16, 20, 113,
-			94, 183, 134, 216, 36, 98, 64, 44, 163, 78, 7, 237, 49, 137,
-			65, 26, 113, 149, 204, 17, 119, 1, 28, 174, 211, 245, 97, 213,
-			12, 240, 183, 174, 188, 66, 20, 185, 91, 87, 153, 49, 20, 121,
-			91, 239, 31, 96, 231, 25, 104, 65, 115, 171, 235, 175, 19, 123,
-			156, 235, 205, 93, 7, 99, 82, 86, 175, 9, 11, 199, 86, 97,
-			128, 21, 153, 105, 98, 228, 235, 5, 58, 104, 31, 145, 154, 95,
-			239, 7, 211, 88, 25, 72, 248, 11, 116, 75, 174, 208, 6, 18,
-			254, 130, 194, 74, 198, 202, 94, 80, 88, 25, 72, 248, 11, 253,
-			3, 236, 85, 208, 96, 6, 204, 222, 22, 253, 41, 195, 14, 50,
-			114, 140, 210, 197, 213, 78, 63, 238, 179, 148, 206, 120, 66, 229,
-			38, 39, 162, 224, 129, 80, 185, 114, 187, 32, 188, 76, 37, 37,
-			117, 230, 54, 162, 73, 172, 27, 83, 233, 141, 6, 170, 128, 22,
-			27, 100, 14, 203, 3, 4, 244, 182, 205, 35, 118, 85, 206, 59,
-			220, 146, 149, 228, 50, 7, 19, 30, 23, 130, 151, 69, 224, 151,
-			226, 29, 77, 204, 146, 141, 192, 217, 108, 10, 47, 150, 27, 232,
-			143, 165, 59, 132, 105, 98, 40, 237, 208, 54, 91, 50, 39, 207,
-			80, 218, 161, 173, 166, 137, 161, 180, 67, 91, 105, 7, 67, 105,
-			135, 246, 208, 48, 43, 43, 28, 137, 101, 220, 51, 135, 237, 113,
-			105, 213, 184, 47, 199, 139, 111, 134, 68, 30, 119, 8, 82, 115,
-			207, 108, 31, 137, 27, 4, 185, 185, 151, 234, 16, 36, 231, 94,
-			111, 127, 2, 27, 150, 113, 207, 26, 98, 171, 170, 67, 106, 25,
-			239, 55, 45, 123, 62, 73, 17, 140, 82, 123, 13, 216, 90, 239,
-			25, 45, 205, 5, 216, 224, 57, 105, 198, 39, 104, 129, 97, 247,
-			126, 243, 222, 112, 220, 45, 172, 207, 239, 87, 169, 121, 134, 202,
-			93, 124, 127, 207, 161, 4, 54, 44, 227, 253, 3, 131, 168, 46,
-			12, 120, 248, 10, 29, 81, 34, 8, 36, 190, 66, 127, 202, 80,
-			98, 70, 242, 240, 176, 71, 67, 80, 149, 13, 106, 200, 176, 140,
-			87, 134, 143, 176, 95, 39, 12, 76, 137, 252, 207, 146, 174, 207,
-			18, 98, 255, 10, 57, 199, 248, 156, 135, 9, 139, 219, 110, 189,
-			237, 36, 57, 144, 187, 177, 229, 19, 39, 218, 161, 98, 104, 183,
-			68, 160, 246, 88, 81, 224, 120, 33, 230, 150, 192, 242, 24, 91,
-			106, 188, 18, 37, 230, 38, 74, 110, 200, 120, 184, 229, 183, 27,
-			117, 88, 154, 227, 83, 150, 137, 82, 198, 202, 247, 162, 125, 243,
-			138, 51, 147, 21, 118, 155, 134, 9, 43, 230, 207, 146, 194, 0,
-			251, 167, 48, 161, 76, 218, 101, 153, 31, 38, 244, 188, 253, 209,
-			140, 205, 171, 45, 52, 84, 107, 113, 158, 182, 86, 138, 154, 56,
-			173, 246, 66, 21, 209, 196, 195, 153, 251, 25, 135, 197, 216, 116,
-			43, 74, 235, 33, 244, 27, 219, 202, 120, 72, 172, 186, 36, 31,
-			92, 39, 227, 92, 139, 59, 236, 103, 57, 64, 214, 180, 242, 31,
-			38, 244, 103, 137, 133, 163, 104, 130, 154, 7, 244, 109, 13, 18,
-			0, 199, 206, 106, 208, 0, 112, 234, 156, 52, 239, 65, 139, 155,
-			191, 72, 168, 109, 127, 81, 209, 170, 178, 173, 85, 170, 113, 106,
-			231, 180, 188, 223, 54, 85, 111, 196, 226, 29, 147, 220, 137, 73,
-			170, 221, 80, 27, 174, 187, 220, 1, 203, 84, 142, 56, 44, 148,
-			129, 208, 251, 106, 229, 162, 98, 184, 123, 85, 7, 131, 19, 78,
-			169, 173, 172, 218, 40, 232, 237, 94, 93, 132, 238, 166, 7, 234,
-			169, 237, 57, 205, 117, 101, 175, 52, 96, 199, 224, 7, 117, 161,
-			22, 117, 201, 30, 98, 90, 249, 95, 36, 244, 195, 228, 188, 98,
-			0, 201, 33, 197, 5, 13, 34, 3, 122, 142, 104, 208, 0, 112,
-			244, 24, 251, 3, 201, 30, 106, 153, 111, 0, 123, 222, 188, 31,
-			123, 192, 130, 81, 167, 9, 246, 97, 79, 39, 111, 20, 43, 96,
-			62, 235, 220, 224, 12, 237, 176, 215, 84, 204, 6, 43, 66, 54,
-			204, 56, 236, 65, 31, 154, 17, 49, 31, 50, 123, 95, 109, 114,
-			75, 206, 80, 211, 202, 191, 65, 232, 47, 18, 45, 41, 52, 135,
-			196, 106, 206, 128, 104, 188, 145, 112, 134, 26, 0, 142, 30, 99,
-			191, 77, 145, 51, 134, 101, 254, 50, 161, 35, 246, 175, 83, 53,
-			73, 58, 76, 156, 204, 82, 175, 39, 29, 42, 2, 57, 75, 83,
-			210, 129, 172, 18, 247, 162, 75, 25, 239, 139, 52, 185, 210, 231,
-			3, 100, 91, 106, 193, 170, 99, 133, 50, 95, 80, 213, 220, 26,
-			38, 89, 111, 186, 158, 12, 33, 58, 17, 174, 49, 101, 166, 172,
-			152, 108, 227, 105, 19, 101, 175, 81, 162, 24, 22, 247, 36, 55,
-			140, 241, 186, 159, 109, 42, 131, 98, 162, 161, 87, 227, 38, 99,
-			109, 190, 229, 168, 20, 126, 137, 161, 68, 79, 141, 134, 97, 90,
-			249, 95, 38, 244, 141, 120, 52, 140, 28, 50, 88, 143, 134, 65,
-			0, 236, 25, 212, 32, 178, 127, 248, 8, 139, 96, 48, 10, 93,
-			86, 254, 87, 8, 253, 85, 98, 216, 117, 57, 26, 154, 225, 10,
-			173, 248, 12, 133, 196, 10, 22, 127, 244, 2, 186, 33, 111, 249,
-			173, 182, 76, 240, 192, 12, 122, 216, 182, 51, 222, 116, 162, 218,
-			150, 86, 92, 19, 33, 191, 155, 10, 36, 221, 85, 251, 19, 179,
-			0, 170, 229, 87, 72, 161, 159, 205, 160, 68, 152, 150, 249, 73,
-			98, 14, 217, 167, 228, 190, 67, 10, 238, 37, 28, 160, 80, 103,
-			123, 131, 90, 86, 38, 143, 9, 155, 78, 120, 67, 147, 8, 106,
-			248, 147, 164, 231, 144, 6, 13, 0, 7, 44, 86, 194, 214, 115,
-			150, 249, 47, 137, 121, 212, 62, 153, 181, 74, 47, 201, 68, 221,
-			80, 160, 217, 16, 55, 157, 203, 99, 117, 166, 65, 2, 96, 175,
-			230, 94, 206, 0, 112, 120, 132, 157, 199, 166, 243, 150, 249, 63,
-			17, 115, 204, 62, 209, 105, 217, 93, 138, 11, 194, 184, 229, 188,
-			172, 221, 167, 65, 2, 224, 33, 61, 75, 242, 6, 128, 163, 54,
-			251, 58, 101, 212, 204, 89, 249, 223, 32, 93, 255, 136, 18, 251,
-			63, 80, 233, 119, 172, 196, 39, 56, 60, 125, 154, 195, 139, 124,
-			220, 65, 71, 211, 129, 8, 35, 181, 82, 96, 146, 190, 84, 33,
-			153, 4, 118, 208, 8, 120, 220, 5, 223, 117, 2, 193, 55, 133,
-			39, 2, 28, 191, 245, 93, 233, 251, 197, 61, 169, 11, 130, 151,
-			221, 164, 162, 159, 203, 139, 93, 34, 157, 121, 241, 60, 20, 168,
-			140, 100, 34, 172, 222, 5, 198, 26, 124, 35, 112, 154, 34, 44,
-			39, 22, 29, 72, 73, 75, 57, 63, 39, 80, 235, 184, 53, 185,
-			222, 199, 169, 20, 160, 24, 101, 15, 37, 229, 107, 83, 59, 35,
-			183, 41, 112, 122, 71, 190, 116, 196, 97, 227, 176, 50, 202, 161,
-			205, 238, 29, 246, 67, 120, 189, 225, 175, 171, 213, 27, 198, 246,
-			55, 96, 245, 254, 34, 168, 236, 28, 172, 222, 191, 73, 232, 184,
-			253, 175, 149, 202, 222, 39, 142, 146, 44, 171, 169, 38, 59, 85,
-			183, 158, 217, 48, 24, 177, 167, 70, 173, 75, 251, 181, 169, 111,
-			134, 112, 148, 43, 131, 215, 28, 143, 241, 23, 61, 127, 103, 143,
-			51, 25, 122, 213, 238, 43, 24, 182, 186, 191, 227, 53, 124, 39,
-			222, 14, 99, 199, 74, 53, 228, 112, 133, 255, 77, 66, 127, 67,
-			173, 240, 57, 92, 225, 127, 147, 208, 35, 26, 36, 0, 142, 216,
-			26, 52, 0, 60, 113, 146, 253, 15, 200, 15, 163, 203, 202, 127,
-			137, 208, 255, 135, 24, 246, 47, 16, 198, 229, 96, 202, 241, 118,
-			189, 205, 134, 166, 50, 101, 162, 137, 228, 156, 81, 205, 111, 182,
-			124, 244, 186, 108, 100, 4, 68, 45, 92, 37, 46, 156, 218, 22,
-			175, 249, 129, 60, 10, 87, 87, 215, 81, 56, 44, 181, 37, 230,
-			161, 231, 180, 194, 45, 31, 41, 223, 235, 224, 146, 83, 52, 7,
-			123, 8, 243, 75, 132, 245, 179, 159, 134, 237, 123, 14, 119, 17,
-			230, 87, 136, 57, 98, 191, 196, 14, 218, 85, 10, 149, 206, 158,
-			26, 69, 213, 65, 85, 212, 252, 160, 94, 89, 82, 43, 142, 218,
-			190, 176, 68, 156, 247, 224, 140, 226, 160, 151, 163, 65, 214, 45,
-			81, 48, 173, 252, 87, 136, 249, 37, 50, 136, 6, 119, 78, 238,
-			51, 0, 45, 150, 20, 16, 40, 232, 77, 213, 48, 160, 0, 140,
-			99, 170, 40, 33, 150, 249, 71, 196, 28, 181, 255, 249, 91, 94,
-			43, 223, 182, 165, 81, 46, 57, 184, 62, 254, 213, 89, 26, 245,
-			48, 128, 17, 247, 71, 196, 252, 10, 25, 137, 153, 12, 102, 220,
-			31, 165, 135, 129, 32, 147, 123, 135, 146, 2, 3, 10, 70, 142,
-			178, 127, 166, 5, 138, 90, 230, 183, 137, 121, 220, 254, 135, 41,
-			143, 173, 68, 81, 229, 50, 226, 149, 49, 32, 29, 73, 108, 231,
-			62, 129, 135, 245, 221, 216, 105, 9, 122, 44, 9, 61, 196, 182,
-			122, 44, 109, 202, 10, 115, 148, 2, 96, 177, 79, 83, 91, 126,
-			169, 168, 141, 38, 26, 236, 179, 111, 19, 243, 143, 200, 104, 76,
-			18, 44, 130, 223, 78, 19, 13, 146, 245, 109, 210, 123, 52, 41,
-			48, 160, 192, 30, 99, 63, 167, 137, 54, 44, 243, 187, 64, 244,
-			171, 138, 232, 244, 46, 70, 111, 191, 227, 61, 218, 219, 77, 46,
-			218, 228, 241, 68, 215, 148, 129, 173, 243, 93, 98, 126, 155, 28,
-			143, 241, 6, 107, 231, 187, 105, 202, 192, 222, 249, 110, 154, 50,
-			3, 9, 177, 199, 216, 119, 52, 101, 166, 101, 126, 143, 152, 211,
-			246, 215, 30, 134, 178, 18, 72, 110, 202, 65, 30, 166, 233, 203,
-			108, 213, 146, 144, 224, 68, 152, 217, 165, 41, 187, 41, 69, 59,
-			170, 148, 152, 252, 100, 215, 150, 234, 61, 99, 178, 31, 196, 66,
-			182, 15, 15, 215, 229, 101, 77, 41, 182, 193, 62, 251, 123, 196,
-			252, 110, 138, 109, 96, 65, 125, 143, 152, 169, 2, 2, 5, 39,
-			38, 147, 2, 3, 10, 206, 151, 216, 215, 40, 174, 142, 196, 50,
-			63, 72, 233, 9, 251, 119, 40, 227, 115, 41, 141, 238, 132, 53,
-			129, 186, 112, 26, 183, 14, 162, 174, 86, 10, 101, 57, 134, 73,
-			94, 25, 134, 96, 148, 74, 151, 175, 122, 108, 191, 53, 26, 24,
-			124, 91, 239, 62, 96, 7, 43, 135, 37, 219, 172, 116, 182, 23,
-			229, 168, 21, 75, 188, 152, 142, 244, 23, 75, 140, 23, 211, 113,
-			253, 162, 52, 31, 138, 169, 64, 190, 26, 150, 48, 246, 212, 199,
-			132, 232, 197, 108, 35, 144, 218, 118, 119, 111, 239, 218, 79, 86,
-			23, 27, 174, 39, 234, 151, 185, 43, 199, 170, 165, 101, 33, 182,
-			165, 24, 111, 5, 126, 13, 3, 47, 62, 175, 109, 249, 126, 40,
-			184, 147, 52, 173, 87, 49, 116, 161, 124, 144, 210, 24, 204, 3,
-			216, 59, 160, 65, 228, 254, 224, 168, 6, 13, 0, 199, 142, 179,
-			95, 151, 150, 11, 181, 204, 143, 80, 58, 46, 189, 40, 171, 177,
-			83, 72, 238, 5, 181, 181, 184, 119, 253, 140, 197, 216, 111, 201,
-			67, 239, 120, 84, 90, 58, 216, 34, 52, 234, 65, 175, 201, 211,
-			69, 158, 159, 137, 100, 59, 235, 126, 59, 138, 253, 79, 44, 211,
-			87, 137, 203, 69, 19, 79, 166, 10, 108, 47, 222, 176, 42, 52,
-			226, 248, 169, 52, 84, 64, 99, 125, 132, 210, 15, 210, 19, 138,
-			64, 208, 87, 31, 161, 106, 15, 147, 67, 109, 245, 17, 218, 163,
-			13, 21, 80, 77, 31, 161, 39, 78, 106, 242, 13, 203, 124, 125,
-			47, 249, 153, 131, 226, 127, 201, 228, 167, 251, 122, 8, 242, 51,
-			27, 234, 28, 170, 181, 215, 41, 253, 8, 29, 87, 4, 130, 82,
-			123, 61, 33, 31, 84, 218, 235, 9, 249, 6, 210, 123, 226, 36,
-			251, 95, 36, 249, 166, 101, 190, 1, 51, 243, 95, 104, 242, 179,
-			231, 100, 15, 234, 251, 109, 33, 95, 118, 197, 58, 250, 122, 235,
-			44, 0, 21, 245, 6, 165, 175, 199, 44, 48, 115, 72, 149, 102,
-			1, 168, 167, 55, 104, 143, 158, 0, 160, 156, 222, 160, 99, 199,
-			227, 172, 203, 87, 95, 39, 236, 221, 63, 244, 69, 38, 240, 111,
-			199, 61, 38, 111, 235, 237, 40, 246, 15, 144, 25, 250, 195, 167,
-			132, 254, 109, 131, 177, 155, 34, 170, 202, 203, 39, 172, 81, 214,
-			221, 10, 252, 23, 68, 45, 82, 185, 135, 26, 180, 44, 102, 182,
-			156, 104, 75, 231, 130, 194, 111, 107, 152, 229, 144, 0, 149, 181,
-			39, 129, 36, 233, 207, 196, 131, 185, 42, 233, 239, 4, 99, 96,
-			234, 165, 18, 186, 114, 213, 30, 40, 193, 100, 46, 107, 140, 245,
-			52, 252, 77, 245, 52, 143, 79, 11, 13, 127, 83, 62, 60, 195,
-			14, 123, 190, 119, 39, 217, 64, 98, 158, 102, 161, 122, 200, 243,
-			189, 36, 182, 104, 85, 88, 255, 166, 136, 238, 200, 3, 50, 119,
-			218, 65, 35, 28, 45, 96, 66, 214, 41, 125, 81, 75, 66, 105,
-			121, 197, 221, 244, 214, 170, 11, 10, 172, 30, 218, 20, 145, 204,
-			169, 94, 11, 26, 161, 221, 102, 135, 179, 21, 172, 199, 89, 161,
-			225, 110, 8, 224, 239, 131, 147, 15, 227, 170, 214, 8, 203, 75,
-			105, 70, 198, 21, 170, 10, 74, 152, 164, 88, 39, 87, 162, 247,
-			176, 222, 85, 199, 109, 188, 141, 163, 81, 252, 58, 101, 189, 72,
-			182, 188, 85, 228, 62, 109, 150, 244, 251, 50, 209, 115, 100, 255,
-			219, 109, 244, 40, 235, 244, 66, 227, 33, 211, 11, 31, 97, 38,
-			8, 253, 168, 137, 185, 133, 253, 73, 125, 149, 145, 11, 15, 173,
-			191, 198, 122, 211, 163, 39, 211, 233, 78, 102, 70, 79, 146, 81,
-			78, 198, 170, 202, 194, 100, 220, 182, 25, 75, 158, 88, 151, 24,
-			195, 107, 7, 112, 80, 226, 68, 200, 131, 179, 138, 83, 181, 59,
-			6, 174, 103, 255, 129, 235, 209, 3, 247, 122, 142, 245, 189, 167,
-			45, 130, 221, 183, 121, 34, 33, 146, 234, 194, 30, 9, 96, 82,
-			182, 184, 39, 167, 80, 79, 21, 127, 91, 227, 172, 183, 233, 220,
-			187, 19, 136, 176, 221, 136, 66, 53, 127, 88, 211, 185, 87, 149,
-			37, 123, 50, 176, 217, 222, 12, 236, 27, 217, 196, 110, 153, 164,
-			122, 70, 243, 62, 77, 92, 42, 205, 251, 134, 219, 136, 68, 144,
-			73, 246, 158, 101, 57, 79, 236, 136, 64, 229, 174, 222, 143, 223,
-			178, 34, 188, 225, 55, 234, 34, 24, 61, 244, 224, 55, 176, 226,
-			222, 203, 160, 14, 239, 115, 25, 212, 5, 149, 24, 222, 143, 18,
-			119, 114, 95, 74, 58, 83, 194, 47, 198, 183, 52, 13, 96, 98,
-			251, 241, 253, 223, 10, 112, 135, 27, 223, 225, 116, 153, 13, 116,
-			178, 196, 154, 72, 231, 112, 239, 155, 33, 47, 159, 255, 224, 217,
-			229, 167, 89, 183, 66, 196, 42, 48, 243, 234, 210, 234, 173, 129,
-			46, 171, 155, 25, 207, 205, 175, 12, 16, 43, 207, 232, 226, 210,
-			0, 45, 254, 28, 101, 135, 20, 242, 15, 212, 0, 79, 176, 110,
-			181, 133, 84, 41, 192, 157, 228, 235, 201, 135, 149, 170, 186, 114,
-			44, 146, 70, 34, 146, 246, 223, 39, 44, 47, 235, 197, 18, 79,
-			82, 18, 255, 151, 171, 108, 78, 48, 6, 255, 222, 73, 166, 79,
-			95, 181, 7, 74, 240, 110, 134, 226, 127, 38, 172, 119, 193, 13,
-			31, 98, 213, 27, 99, 61, 128, 238, 157, 117, 39, 212, 35, 80,
-			128, 130, 171, 78, 40, 14, 152, 181, 154, 25, 102, 118, 126, 170,
-			185, 229, 123, 141, 93, 117, 3, 152, 154, 52, 75, 94, 99, 23,
-			86, 56, 229, 252, 184, 163, 228, 47, 47, 87, 56, 85, 186, 140,
-			133, 169, 68, 231, 110, 156, 226, 58, 209, 185, 99, 254, 23, 58,
-			231, 127, 241, 255, 165, 172, 79, 82, 252, 64, 33, 184, 47, 201,
-			251, 140, 180, 245, 46, 198, 208, 163, 231, 193, 22, 84, 233, 247,
-			120, 182, 165, 59, 45, 95, 211, 213, 170, 169, 55, 236, 255, 68,
-			88, 79, 252, 36, 62, 115, 66, 82, 103, 78, 222, 193, 76, 212,
-			73, 20, 167, 210, 35, 247, 111, 187, 140, 147, 11, 95, 72, 164,
-			204, 120, 43, 82, 102, 62, 156, 148, 21, 167, 152, 169, 83, 183,
-			151, 231, 112, 246, 49, 150, 95, 89, 173, 206, 207, 61, 51, 64,
-			172, 94, 214, 189, 92, 93, 122, 122, 254, 218, 234, 0, 189, 240,
-			187, 132, 153, 11, 176, 194, 149, 153, 113, 83, 68, 150, 181, 215,
-			34, 177, 135, 246, 89, 231, 172, 89, 102, 130, 69, 96, 197, 15,
-			83, 246, 193, 254, 111, 92, 100, 57, 156, 173, 214, 240, 126, 186,
-			203, 62, 178, 239, 148, 182, 30, 101, 38, 176, 51, 233, 39, 53,
-			63, 236, 225, 253, 56, 254, 244, 63, 126, 149, 200, 116, 244, 191,
-			184, 127, 58, 250, 95, 181, 11, 225, 214, 146, 116, 244, 203, 248,
-			147, 90, 6, 83, 73, 234, 134, 101, 244, 118, 79, 176, 127, 75,
-			101, 242, 247, 112, 215, 123, 136, 253, 171, 148, 39, 163, 168, 157,
-			148, 234, 54, 55, 117, 137, 91, 59, 72, 206, 174, 181, 67, 17,
-			192, 11, 92, 239, 12, 226, 84, 177, 248, 173, 172, 243, 89, 220,
-			115, 195, 40, 44, 113, 71, 101, 19, 167, 58, 147, 25, 218, 237,
-			90, 77, 8, 60, 172, 189, 233, 4, 117, 60, 195, 231, 111, 240,
-			157, 45, 17, 95, 253, 145, 109, 23, 175, 116, 198, 11, 158, 226,
-			180, 65, 192, 97, 209, 143, 68, 198, 103, 41, 209, 195, 11, 64,
-			2, 17, 181, 3, 143, 111, 192, 122, 13, 109, 168, 83, 130, 73,
-			187, 117, 25, 159, 151, 91, 66, 166, 27, 118, 27, 110, 180, 203,
-			245, 165, 213, 158, 211, 224, 53, 223, 11, 163, 192, 113, 189, 204,
-			197, 118, 195, 5, 139, 149, 117, 62, 251, 8, 61, 98, 159, 66,
-			153, 209, 76, 84, 170, 73, 102, 52, 200, 37, 56, 157, 29, 62,
-			66, 135, 135, 83, 217, 225, 35, 153, 236, 240, 145, 158, 129, 84,
-			118, 248, 200, 208, 48, 251, 151, 84, 103, 135, 143, 83, 203, 254,
-			24, 197, 174, 64, 195, 237, 141, 35, 64, 143, 155, 34, 201, 195,
-			0, 129, 82, 187, 222, 117, 193, 29, 157, 101, 170, 42, 203, 54,
-			36, 199, 87, 110, 205, 93, 120, 252, 9, 190, 229, 132, 91, 50,
-			204, 161, 179, 143, 83, 117, 161, 217, 21, 191, 41, 120, 59, 2,
-			70, 193, 6, 24, 120, 189, 225, 122, 117, 222, 114, 194, 80, 134,
-			217, 80, 120, 29, 25, 233, 81, 253, 97, 71, 17, 102, 37, 215,
-			112, 143, 29, 250, 77, 193, 244, 24, 184, 81, 200, 229, 181, 0,
-			232, 20, 223, 69, 172, 253, 22, 178, 15, 154, 213, 109, 2, 154,
-			136, 159, 235, 133, 145, 112, 234, 50, 67, 43, 16, 176, 21, 223,
-			22, 114, 148, 229, 181, 234, 110, 148, 57, 252, 56, 78, 71, 142,
-			164, 178, 204, 199, 51, 89, 230, 227, 153, 44, 243, 241, 129, 65,
-			86, 209, 89, 230, 69, 58, 104, 63, 149, 36, 42, 169, 161, 220,
-			247, 250, 177, 137, 80, 165, 138, 225, 85, 1, 32, 123, 162, 158,
-			185, 122, 175, 72, 199, 173, 212, 213, 123, 69, 121, 224, 67, 165,
-			157, 23, 187, 251, 82, 105, 231, 197, 254, 1, 149, 233, 110, 88,
-			198, 25, 106, 169, 76, 119, 215, 115, 49, 144, 148, 14, 88, 74,
-			183, 189, 31, 51, 33, 147, 207, 125, 134, 22, 7, 83, 249, 220,
-			103, 226, 51, 159, 160, 43, 206, 196, 103, 62, 241, 206, 137, 129,
-			65, 246, 127, 82, 157, 207, 61, 77, 143, 218, 95, 145, 98, 214,
-			116, 238, 185, 205, 118, 51, 229, 133, 129, 45, 112, 168, 250, 108,
-			7, 94, 89, 95, 52, 38, 125, 45, 210, 117, 168, 83, 207, 97,
-			198, 178, 212, 20, 130, 215, 48, 237, 52, 229, 110, 81, 222, 22,
-			197, 85, 121, 118, 55, 230, 159, 202, 38, 242, 26, 122, 70, 135,
-			241, 253, 110, 248, 82, 153, 207, 233, 75, 237, 177, 13, 60, 250,
-			161, 117, 4, 98, 3, 26, 135, 105, 117, 224, 68, 188, 33, 156,
-			48, 194, 195, 187, 192, 199, 73, 177, 45, 60, 238, 110, 64, 205,
-			109, 215, 111, 196, 119, 146, 97, 42, 92, 130, 248, 148, 60, 42,
-			28, 50, 222, 116, 188, 93, 238, 212, 229, 13, 73, 114, 52, 66,
-			160, 83, 221, 225, 160, 111, 13, 18, 247, 64, 197, 201, 108, 22,
-			153, 31, 165, 90, 202, 164, 158, 79, 211, 51, 86, 234, 46, 178,
-			233, 120, 132, 76, 98, 25, 211, 5, 43, 149, 122, 62, 125, 100,
-			132, 253, 61, 170, 83, 207, 47, 210, 17, 251, 181, 131, 70, 8,
-			85, 38, 70, 254, 194, 172, 6, 138, 19, 35, 227, 100, 28, 57,
-			104, 158, 175, 46, 220, 72, 141, 84, 236, 72, 150, 67, 89, 206,
-			190, 203, 224, 229, 228, 240, 117, 220, 76, 218, 69, 167, 91, 136,
-			135, 51, 81, 73, 201, 245, 76, 140, 111, 136, 168, 182, 149, 22,
-			234, 36, 242, 16, 198, 236, 196, 74, 168, 94, 188, 221, 52, 125,
-			153, 204, 247, 139, 116, 250, 168, 206, 110, 71, 30, 117, 167, 50,
-			223, 47, 22, 6, 83, 153, 239, 23, 135, 143, 176, 87, 77, 157,
-			249, 126, 149, 218, 246, 119, 141, 204, 165, 133, 120, 181, 58, 104,
-			116, 92, 122, 180, 94, 143, 165, 30, 37, 62, 149, 31, 144, 160,
-			195, 231, 120, 166, 92, 190, 56, 89, 23, 27, 78, 187, 17, 77,
-			169, 108, 211, 8, 147, 21, 244, 109, 201, 97, 234, 92, 83, 91,
-			242, 155, 113, 153, 35, 1, 98, 23, 70, 126, 11, 100, 52, 117,
-			53, 150, 192, 179, 13, 169, 188, 5, 225, 225, 8, 98, 200, 34,
-			118, 87, 186, 56, 253, 48, 149, 208, 203, 234, 140, 50, 159, 227,
-			139, 105, 95, 84, 140, 41, 226, 23, 136, 166, 191, 173, 238, 145,
-			116, 162, 8, 22, 200, 248, 86, 48, 160, 241, 134, 31, 112, 33,
-			175, 150, 40, 241, 208, 217, 237, 92, 133, 64, 142, 192, 6, 224,
-			254, 198, 37, 198, 127, 226, 177, 18, 191, 88, 226, 79, 148, 248,
-			59, 126, 242, 32, 6, 97, 54, 172, 36, 249, 49, 141, 3, 48,
-			250, 146, 124, 251, 39, 75, 200, 133, 22, 208, 177, 46, 106, 78,
-			59, 20, 140, 63, 142, 199, 102, 36, 117, 64, 208, 158, 49, 201,
-			80, 132, 195, 150, 70, 37, 115, 192, 224, 42, 189, 56, 146, 58,
-			96, 112, 53, 86, 207, 121, 98, 25, 87, 187, 143, 164, 14, 24,
-			92, 29, 61, 198, 190, 66, 244, 213, 168, 55, 233, 146, 97, 255,
-			123, 188, 241, 82, 143, 93, 73, 153, 48, 234, 90, 92, 236, 95,
-			101, 123, 114, 135, 39, 238, 158, 56, 141, 46, 185, 19, 45, 177,
-			79, 164, 143, 136, 175, 85, 23, 66, 156, 123, 41, 24, 181, 156,
-			231, 71, 216, 161, 31, 164, 146, 94, 48, 7, 133, 197, 215, 184,
-			235, 91, 46, 67, 188, 163, 190, 3, 173, 164, 115, 57, 77, 49,
-			221, 63, 115, 85, 235, 77, 54, 202, 110, 37, 87, 181, 86, 204,
-			115, 246, 147, 234, 8, 129, 116, 22, 38, 11, 97, 130, 93, 220,
-			222, 186, 92, 179, 253, 50, 46, 234, 217, 27, 90, 43, 230, 205,
-			228, 154, 209, 174, 60, 180, 125, 60, 115, 67, 107, 229, 196, 153,
-			204, 13, 173, 149, 201, 41, 246, 116, 114, 67, 235, 130, 57, 108,
-			95, 230, 85, 165, 212, 211, 189, 167, 110, 159, 157, 8, 147, 252,
-			135, 248, 134, 97, 117, 232, 41, 125, 33, 235, 130, 89, 57, 151,
-			185, 144, 117, 33, 117, 165, 39, 88, 8, 11, 61, 253, 153, 11,
-			89, 23, 172, 33, 54, 159, 92, 200, 186, 104, 14, 217, 79, 60,
-			4, 50, 113, 222, 83, 236, 38, 202, 222, 189, 186, 104, 46, 12,
-			103, 238, 94, 93, 76, 225, 1, 84, 47, 246, 28, 206, 220, 189,
-			186, 56, 104, 97, 254, 114, 23, 237, 182, 140, 101, 122, 70, 137,
-			115, 183, 105, 153, 203, 116, 73, 95, 207, 217, 157, 135, 135, 218,
-			166, 236, 38, 150, 177, 60, 200, 53, 100, 88, 198, 242, 35, 167,
-			217, 63, 32, 242, 192, 204, 90, 87, 157, 216, 31, 38, 60, 181,
-			103, 123, 200, 253, 1, 188, 145, 108, 16, 120, 37, 210, 235, 53,
-			75, 242, 186, 101, 162, 1, 119, 248, 166, 11, 171, 110, 58, 159,
-			75, 74, 81, 250, 240, 87, 178, 106, 233, 131, 58, 107, 133, 33,
-			180, 185, 241, 116, 199, 237, 135, 183, 185, 241, 0, 136, 121, 155,
-			174, 197, 167, 60, 114, 240, 126, 33, 117, 2, 228, 182, 178, 185,
-			229, 9, 144, 219, 218, 230, 38, 120, 186, 227, 71, 54, 247, 91,
-			179, 185, 9, 206, 170, 187, 244, 182, 230, 55, 204, 169, 187, 49,
-			191, 97, 40, 239, 42, 155, 155, 224, 124, 186, 171, 108, 110, 2,
-			179, 169, 246, 182, 216, 220, 4, 103, 84, 141, 222, 181, 84, 63,
-			48, 159, 106, 74, 169, 19, 156, 77, 53, 101, 115, 19, 156, 75,
-			181, 254, 1, 182, 132, 167, 126, 114, 155, 93, 31, 34, 196, 190,
-			202, 83, 62, 138, 100, 18, 100, 174, 58, 127, 208, 46, 89, 31,
-			16, 218, 44, 128, 194, 80, 7, 132, 92, 122, 196, 126, 39, 95,
-			86, 210, 154, 220, 128, 142, 32, 30, 188, 213, 164, 134, 138, 191,
-			235, 2, 63, 57, 18, 249, 138, 56, 121, 60, 200, 165, 155, 71,
-			82, 199, 131, 220, 204, 241, 32, 183, 103, 32, 117, 60, 200, 29,
-			26, 150, 43, 22, 18, 238, 211, 49, 251, 77, 210, 153, 82, 153,
-			58, 2, 46, 109, 14, 101, 159, 116, 220, 195, 14, 51, 91, 89,
-			112, 201, 90, 181, 190, 203, 67, 17, 69, 58, 119, 91, 61, 152,
-			8, 185, 188, 67, 62, 206, 24, 129, 33, 213, 23, 152, 51, 121,
-			237, 172, 122, 8, 108, 80, 135, 65, 132, 124, 107, 34, 228, 233,
-			76, 197, 228, 164, 14, 44, 124, 235, 73, 234, 85, 204, 19, 16,
-			58, 159, 186, 154, 39, 36, 15, 148, 14, 164, 206, 51, 249, 131,
-			35, 169, 243, 76, 254, 49, 155, 253, 223, 146, 39, 212, 50, 118,
-			232, 25, 251, 91, 146, 39, 226, 94, 203, 241, 234, 162, 190, 111,
-			118, 99, 172, 186, 227, 203, 229, 34, 225, 233, 15, 93, 61, 189,
-			178, 180, 40, 119, 59, 237, 102, 75, 155, 74, 202, 81, 146, 248,
-			64, 48, 159, 63, 67, 121, 250, 142, 107, 189, 122, 198, 249, 200,
-			151, 153, 188, 75, 117, 199, 13, 21, 123, 66, 188, 82, 213, 125,
-			89, 94, 96, 43, 151, 53, 253, 154, 190, 157, 84, 37, 110, 36,
-			152, 99, 151, 44, 115, 250, 149, 226, 28, 217, 161, 254, 152, 98,
-			11, 152, 194, 59, 52, 134, 136, 101, 236, 28, 231, 26, 50, 44,
-			99, 231, 145, 211, 236, 175, 49, 153, 4, 111, 188, 76, 31, 177,
-			47, 224, 29, 2, 169, 203, 254, 36, 122, 152, 224, 162, 85, 68,
-			189, 195, 92, 151, 205, 25, 38, 180, 16, 67, 121, 203, 120, 185,
-			247, 152, 134, 136, 101, 188, 108, 159, 212, 16, 244, 117, 170, 200,
-			222, 13, 29, 27, 93, 86, 238, 21, 250, 55, 136, 97, 63, 197,
-			111, 249, 141, 122, 120, 80, 30, 67, 70, 71, 200, 5, 89, 102,
-			118, 173, 85, 23, 52, 18, 104, 227, 188, 194, 134, 217, 101, 150,
-			7, 8, 38, 231, 7, 204, 105, 187, 180, 231, 35, 1, 110, 184,
-			199, 192, 145, 183, 131, 171, 21, 156, 42, 179, 230, 3, 230, 43,
-			242, 218, 110, 170, 204, 154, 15, 152, 39, 18, 152, 88, 198, 7,
-			78, 78, 38, 176, 97, 25, 31, 56, 95, 98, 151, 84, 239, 196,
-			50, 127, 134, 152, 35, 246, 57, 117, 20, 11, 251, 75, 77, 208,
-			181, 234, 66, 9, 118, 1, 241, 180, 43, 51, 54, 160, 218, 34,
-			166, 149, 251, 25, 98, 126, 96, 58, 70, 135, 228, 176, 185, 4,
-			63, 130, 237, 171, 252, 76, 170, 18, 3, 127, 134, 12, 31, 97,
-			79, 42, 4, 168, 101, 190, 70, 204, 35, 246, 84, 39, 2, 210,
-			203, 176, 95, 255, 131, 170, 45, 106, 90, 249, 215, 136, 249, 51,
-			42, 51, 145, 170, 36, 189, 215, 210, 8, 0, 129, 175, 145, 222,
-			129, 164, 192, 128, 130, 161, 97, 188, 214, 12, 101, 241, 131, 132,
-			158, 176, 215, 249, 156, 23, 103, 113, 164, 209, 144, 3, 184, 215,
-			164, 219, 131, 24, 223, 118, 29, 198, 139, 240, 170, 186, 148, 165,
-			29, 52, 238, 104, 163, 181, 168, 210, 52, 40, 166, 105, 124, 144,
-			128, 56, 169, 249, 96, 230, 17, 135, 62, 13, 18, 0, 15, 141,
-			106, 208, 0, 112, 236, 56, 171, 226, 49, 200, 252, 223, 33, 93,
-			223, 32, 196, 190, 206, 211, 238, 234, 135, 52, 151, 240, 149, 244,
-			82, 209, 43, 79, 75, 154, 127, 135, 20, 134, 217, 227, 204, 52,
-			13, 179, 203, 202, 255, 93, 66, 255, 91, 98, 216, 103, 184, 138,
-			155, 101, 47, 214, 140, 84, 161, 188, 70, 64, 78, 106, 116, 79,
-			154, 127, 151, 116, 203, 147, 200, 6, 122, 40, 205, 143, 16, 243,
-			144, 253, 4, 191, 234, 71, 91, 201, 109, 103, 160, 230, 227, 235,
-			206, 84, 36, 102, 239, 166, 93, 157, 53, 148, 206, 73, 104, 168,
-			144, 20, 80, 40, 232, 237, 67, 9, 50, 208, 67, 105, 254, 61,
-			98, 246, 217, 83, 124, 201, 107, 236, 38, 61, 61, 68, 227, 68,
-			190, 219, 157, 20, 80, 40, 96, 189, 113, 227, 212, 50, 127, 129,
-			152, 189, 186, 241, 183, 130, 57, 32, 246, 11, 196, 204, 39, 5,
-			216, 88, 15, 195, 35, 27, 6, 112, 232, 151, 200, 195, 25, 148,
-			253, 250, 148, 106, 254, 151, 112, 108, 244, 177, 213, 28, 54, 81,
-			208, 32, 1, 176, 103, 64, 131, 6, 128, 67, 195, 236, 255, 40,
-			168, 227, 134, 230, 39, 9, 181, 236, 223, 46, 96, 135, 242, 156,
-			127, 203, 9, 156, 166, 136, 68, 160, 51, 246, 208, 128, 83, 23,
-			29, 128, 122, 3, 179, 47, 108, 175, 135, 145, 27, 181, 35, 48,
-			44, 55, 27, 254, 58, 159, 44, 158, 43, 78, 161, 112, 165, 82,
-			80, 225, 85, 198, 227, 144, 21, 152, 120, 120, 204, 165, 132, 254,
-			19, 109, 182, 66, 25, 30, 113, 17, 90, 102, 155, 142, 235, 41,
-			87, 129, 146, 218, 151, 218, 78, 195, 221, 192, 163, 105, 89, 111,
-			61, 24, 144, 202, 141, 164, 46, 65, 77, 122, 199, 113, 199, 233,
-			171, 215, 27, 152, 211, 109, 15, 183, 132, 120, 7, 66, 163, 94,
-			115, 130, 58, 146, 228, 180, 90, 194, 9, 244, 181, 175, 10, 99,
-			189, 35, 80, 126, 142, 117, 95, 111, 144, 91, 201, 145, 48, 180,
-			149, 36, 239, 146, 224, 92, 153, 23, 207, 157, 43, 198, 100, 57,
-			210, 153, 161, 200, 74, 85, 75, 86, 102, 189, 107, 151, 252, 150,
-			237, 197, 7, 211, 147, 109, 59, 62, 13, 5, 140, 18, 24, 2,
-			147, 197, 243, 197, 169, 148, 131, 113, 93, 240, 72, 126, 157, 9,
-			157, 129, 27, 169, 196, 64, 80, 9, 128, 84, 234, 62, 214, 240,
-			18, 227, 156, 79, 243, 121, 60, 98, 63, 89, 44, 78, 101, 188,
-			20, 78, 252, 1, 151, 176, 44, 43, 158, 59, 55, 115, 126, 230,
-			220, 185, 7, 212, 218, 240, 253, 153, 117, 39, 184, 79, 197, 228,
-			155, 54, 69, 85, 185, 24, 167, 47, 118, 52, 49, 115, 126, 102,
-			221, 121, 249, 192, 134, 48, 135, 222, 139, 15, 50, 103, 154, 148,
-			217, 235, 29, 67, 85, 231, 197, 117, 231, 229, 34, 159, 20, 229,
-			205, 114, 41, 174, 60, 243, 82, 251, 222, 76, 195, 111, 200, 238,
-			138, 83, 89, 52, 238, 71, 180, 190, 215, 241, 62, 148, 60, 136,
-			8, 213, 66, 180, 227, 79, 39, 162, 167, 240, 222, 217, 242, 67,
-			33, 41, 145, 89, 250, 177, 211, 20, 58, 44, 34, 93, 88, 71,
-			10, 34, 148, 3, 1, 89, 62, 30, 220, 115, 246, 77, 77, 130,
-			30, 238, 153, 7, 141, 96, 6, 99, 188, 12, 63, 86, 78, 196,
-			180, 242, 159, 36, 244, 151, 200, 17, 125, 160, 57, 135, 250, 70,
-			43, 39, 146, 62, 96, 134, 103, 152, 205, 79, 146, 129, 65, 86,
-			71, 221, 68, 45, 243, 211, 132, 14, 218, 207, 166, 247, 96, 210,
-			243, 158, 120, 123, 36, 42, 19, 234, 52, 79, 231, 30, 44, 222,
-			43, 250, 27, 252, 5, 60, 72, 7, 202, 98, 89, 110, 102, 37,
-			142, 96, 48, 124, 154, 208, 79, 170, 195, 60, 6, 154, 11, 159,
-			38, 52, 175, 65, 2, 96, 119, 159, 6, 13, 0, 251, 7, 216,
-			7, 241, 172, 63, 128, 159, 5, 36, 95, 78, 144, 68, 159, 96,
-			216, 17, 60, 148, 168, 201, 77, 112, 140, 29, 126, 216, 119, 143,
-			9, 205, 244, 167, 46, 98, 212, 235, 34, 85, 13, 108, 251, 68,
-			45, 38, 204, 54, 76, 43, 255, 89, 66, 63, 77, 6, 21, 170,
-			70, 14, 113, 211, 132, 192, 154, 254, 217, 132, 16, 3, 49, 239,
-			31, 96, 127, 29, 233, 48, 45, 243, 115, 176, 16, 180, 248, 162,
-			184, 23, 161, 21, 3, 90, 7, 93, 113, 165, 189, 223, 187, 113,
-			67, 165, 159, 212, 177, 111, 125, 13, 135, 214, 150, 104, 76, 176,
-			212, 71, 128, 90, 129, 216, 118, 253, 182, 126, 173, 33, 54, 192,
-			54, 223, 136, 177, 7, 11, 232, 115, 132, 126, 54, 198, 222, 204,
-			33, 70, 90, 84, 192, 2, 250, 92, 34, 42, 96, 1, 125, 14,
-			68, 229, 15, 228, 48, 228, 44, 243, 243, 132, 142, 194, 110, 242,
-			153, 56, 117, 67, 91, 64, 123, 227, 18, 18, 9, 189, 94, 39,
-			17, 36, 169, 139, 179, 45, 196, 17, 133, 118, 171, 37, 2, 229,
-			76, 212, 75, 188, 102, 76, 189, 204, 111, 249, 59, 98, 91, 4,
-			37, 237, 62, 85, 99, 44, 59, 81, 81, 141, 248, 163, 88, 235,
-			160, 237, 215, 245, 162, 126, 64, 136, 87, 114, 38, 103, 90, 249,
-			207, 19, 250, 185, 88, 64, 115, 146, 216, 110, 13, 18, 0, 11,
-			67, 26, 52, 0, 28, 57, 202, 182, 144, 49, 121, 203, 252, 119,
-			132, 142, 217, 207, 235, 115, 234, 171, 187, 45, 209, 57, 188, 250,
-			227, 197, 97, 154, 37, 123, 244, 116, 18, 16, 232, 56, 178, 47,
-			241, 204, 155, 86, 254, 223, 17, 250, 121, 50, 170, 48, 201, 231,
-			176, 111, 61, 130, 121, 2, 96, 143, 86, 5, 121, 3, 192, 81,
-			91, 26, 62, 70, 151, 149, 127, 147, 208, 223, 38, 134, 190, 0,
-			34, 185, 183, 142, 227, 14, 181, 17, 193, 208, 121, 218, 184, 196,
-			227, 105, 111, 18, 102, 179, 139, 241, 29, 23, 230, 111, 17, 115,
-			220, 62, 141, 239, 39, 201, 98, 74, 91, 118, 52, 50, 152, 92,
-			91, 145, 255, 45, 98, 190, 169, 206, 112, 24, 114, 187, 4, 45,
-			13, 39, 5, 4, 10, 142, 216, 73, 129, 1, 5, 39, 96, 99,
-			8, 60, 238, 182, 204, 223, 33, 244, 180, 226, 67, 183, 105, 229,
-			127, 7, 73, 81, 148, 118, 231, 241, 185, 30, 190, 110, 2, 224,
-			208, 73, 13, 26, 0, 158, 122, 132, 173, 98, 91, 5, 203, 252,
-			93, 66, 39, 236, 27, 124, 17, 19, 6, 238, 59, 52, 53, 189,
-			206, 111, 68, 42, 26, 163, 44, 31, 121, 14, 44, 25, 155, 130,
-			105, 229, 127, 151, 208, 223, 33, 167, 85, 175, 133, 60, 246, 51,
-			166, 65, 2, 224, 241, 83, 26, 52, 0, 60, 125, 150, 173, 33,
-			78, 61, 150, 249, 37, 192, 233, 38, 95, 106, 212, 31, 22, 39,
-			245, 201, 222, 251, 32, 213, 99, 226, 65, 200, 223, 37, 19, 170,
-			219, 158, 60, 118, 164, 145, 234, 193, 3, 136, 49, 82, 61, 6,
-			128, 167, 207, 178, 151, 17, 41, 102, 153, 191, 79, 232, 113, 187,
-			33, 163, 190, 177, 44, 199, 83, 40, 246, 147, 105, 12, 35, 92,
-			232, 228, 114, 183, 231, 59, 127, 242, 114, 33, 150, 177, 66, 99,
-			123, 76, 85, 138, 49, 103, 166, 149, 255, 125, 66, 191, 20, 99,
-			206, 114, 136, 141, 22, 117, 70, 0, 236, 209, 215, 120, 48, 3,
-			192, 99, 99, 236, 63, 81, 68, 189, 215, 50, 255, 144, 80, 110,
-			255, 30, 229, 171, 206, 102, 172, 166, 244, 49, 41, 40, 138, 252,
-			132, 16, 124, 40, 53, 22, 204, 69, 25, 217, 91, 117, 244, 54,
-			20, 44, 215, 216, 201, 123, 137, 241, 105, 62, 151, 186, 243, 11,
-			223, 195, 179, 86, 242, 243, 237, 53, 188, 7, 45, 197, 24, 39,
-			72, 69, 151, 165, 7, 173, 225, 111, 226, 241, 68, 201, 170, 200,
-			217, 212, 46, 46, 165, 254, 147, 214, 91, 142, 27, 148, 227, 46,
-			149, 17, 227, 197, 113, 190, 73, 207, 109, 76, 201, 249, 247, 0,
-			20, 160, 187, 148, 242, 211, 88, 232, 111, 110, 109, 107, 87, 164,
-			179, 9, 29, 149, 14, 218, 1, 196, 35, 212, 107, 90, 249, 63,
-			36, 244, 247, 201, 113, 53, 6, 189, 121, 100, 186, 214, 62, 189,
-			4, 64, 117, 68, 215, 160, 189, 6, 128, 39, 198, 217, 109, 28,
-			160, 62, 203, 252, 58, 161, 35, 118, 133, 203, 228, 193, 148, 196,
-			39, 99, 145, 146, 249, 4, 75, 63, 192, 127, 189, 137, 40, 253,
-			149, 176, 24, 173, 62, 211, 202, 127, 157, 208, 63, 36, 250, 146,
-			161, 190, 60, 118, 213, 163, 65, 2, 32, 211, 187, 181, 62, 3,
-			192, 161, 35, 108, 85, 94, 234, 242, 77, 210, 245, 183, 40, 177,
-			111, 240, 76, 2, 218, 195, 186, 132, 247, 221, 233, 195, 186, 250,
-			77, 82, 56, 130, 110, 111, 188, 105, 229, 91, 176, 255, 188, 252,
-			96, 183, 176, 220, 208, 200, 46, 179, 158, 225, 228, 30, 148, 111,
-			17, 250, 77, 114, 84, 223, 116, 146, 195, 198, 11, 169, 123, 80,
-			190, 165, 119, 166, 242, 30, 148, 111, 193, 206, 244, 26, 32, 2,
-			235, 193, 31, 19, 250, 211, 212, 176, 31, 211, 87, 121, 116, 124,
-			202, 3, 207, 74, 199, 11, 121, 76, 121, 114, 132, 217, 196, 53,
-			226, 143, 129, 157, 101, 150, 7, 16, 200, 251, 14, 49, 135, 237,
-			147, 104, 3, 198, 167, 119, 179, 49, 17, 181, 58, 152, 106, 117,
-			248, 14, 49, 255, 152, 88, 168, 251, 77, 117, 220, 248, 59, 218,
-			155, 100, 170, 213, 225, 59, 164, 183, 63, 41, 48, 160, 64, 126,
-			44, 89, 118, 75, 44, 243, 207, 136, 121, 194, 254, 18, 225, 241,
-			183, 76, 163, 255, 106, 156, 222, 154, 89, 96, 237, 255, 25, 49,
-			191, 67, 134, 99, 86, 144, 60, 82, 158, 112, 143, 32, 43, 134,
-			70, 147, 2, 3, 10, 198, 142, 179, 111, 82, 197, 44, 106, 153,
-			223, 39, 230, 132, 253, 101, 25, 233, 2, 115, 119, 186, 229, 212,
-			94, 204, 250, 32, 235, 251, 120, 194, 81, 49, 166, 176, 22, 29,
-			7, 203, 67, 237, 140, 198, 193, 79, 236, 26, 220, 250, 168, 19,
-			254, 122, 47, 174, 5, 107, 63, 86, 202, 179, 147, 165, 142, 195,
-			169, 218, 38, 100, 137, 199, 60, 254, 68, 234, 254, 110, 246, 235,
-			123, 222, 61, 200, 217, 222, 225, 61, 223, 91, 61, 69, 77, 178,
-			83, 72, 112, 99, 105, 38, 233, 241, 130, 157, 207, 247, 137, 249,
-			103, 228, 68, 60, 26, 96, 46, 126, 159, 152, 169, 2, 2, 5,
-			39, 139, 73, 129, 1, 5, 103, 206, 178, 31, 83, 195, 101, 88,
-			230, 171, 212, 28, 181, 103, 213, 65, 250, 184, 247, 212, 96, 237,
-			23, 182, 72, 240, 128, 141, 203, 171, 212, 252, 62, 153, 136, 123,
-			129, 173, 203, 171, 212, 236, 73, 10, 8, 20, 176, 68, 178, 12,
-			236, 119, 228, 40, 154, 77, 120, 107, 210, 107, 148, 158, 180, 111,
-			168, 172, 0, 249, 253, 184, 140, 126, 198, 79, 122, 170, 35, 190,
-			42, 35, 44, 89, 132, 116, 44, 21, 135, 93, 235, 15, 12, 228,
-			188, 166, 15, 143, 154, 40, 205, 175, 209, 248, 210, 18, 130, 157,
-			90, 199, 82, 55, 21, 189, 70, 143, 159, 96, 255, 123, 124, 83,
-			209, 135, 40, 181, 236, 223, 36, 29, 22, 202, 222, 143, 135, 170,
-			243, 124, 77, 63, 16, 29, 91, 147, 84, 150, 41, 80, 22, 38,
-			139, 230, 126, 90, 32, 16, 45, 225, 196, 122, 224, 61, 105, 17,
-			78, 101, 118, 200, 11, 141, 96, 10, 36, 159, 158, 64, 83, 103,
-			55, 78, 18, 75, 185, 211, 4, 110, 8, 99, 247, 89, 114, 85,
-			209, 135, 40, 125, 141, 158, 76, 93, 85, 244, 33, 154, 185, 170,
-			232, 67, 52, 190, 57, 6, 132, 228, 67, 116, 96, 144, 125, 204,
-			148, 151, 176, 188, 78, 187, 254, 53, 37, 246, 235, 38, 79, 229,
-			74, 63, 164, 195, 26, 222, 200, 36, 0, 99, 138, 116, 186, 144,
-			11, 15, 88, 6, 203, 132, 252, 242, 180, 31, 236, 78, 71, 129,
-			128, 245, 97, 183, 33, 120, 20, 56, 96, 209, 57, 13, 61, 234,
-			106, 113, 99, 241, 109, 46, 74, 110, 195, 150, 131, 87, 65, 102,
-			179, 140, 220, 141, 120, 53, 44, 54, 119, 225, 103, 145, 111, 57,
-			245, 88, 218, 138, 142, 242, 32, 149, 184, 131, 162, 136, 174, 41,
-			216, 38, 235, 109, 33, 90, 105, 138, 234, 75, 186, 177, 43, 197,
-			98, 9, 215, 31, 252, 161, 23, 247, 75, 252, 253, 178, 143, 87,
-			248, 228, 114, 106, 13, 14, 167, 246, 111, 67, 33, 180, 127, 75,
-			14, 52, 2, 3, 159, 184, 31, 31, 174, 25, 39, 219, 206, 249,
-			61, 237, 60, 100, 51, 51, 231, 179, 13, 173, 59, 47, 191, 194,
-			39, 245, 135, 191, 147, 198, 226, 123, 100, 94, 167, 133, 33, 246,
-			83, 250, 26, 153, 143, 82, 122, 196, 246, 164, 75, 58, 113, 137,
-			235, 57, 155, 190, 108, 81, 77, 181, 72, 43, 131, 140, 107, 62,
-			220, 242, 119, 208, 124, 81, 141, 164, 252, 73, 40, 97, 120, 79,
-			140, 83, 171, 137, 48, 204, 220, 249, 242, 81, 74, 95, 79, 46,
-			121, 201, 33, 62, 133, 212, 157, 47, 31, 165, 61, 3, 169, 59,
-			95, 62, 74, 135, 134, 217, 39, 137, 62, 229, 255, 49, 74, 143,
-			218, 255, 132, 36, 14, 117, 121, 37, 113, 10, 255, 140, 148, 165,
-			46, 218, 69, 113, 73, 252, 144, 235, 206, 203, 186, 224, 252, 204,
-			75, 237, 123, 184, 236, 197, 54, 105, 124, 0, 69, 57, 7, 211,
-			206, 187, 34, 250, 38, 241, 196, 62, 12, 4, 250, 178, 59, 28,
-			244, 9, 197, 176, 156, 127, 140, 210, 143, 198, 20, 147, 28, 18,
-			81, 72, 157, 157, 255, 24, 237, 177, 82, 103, 231, 63, 70, 143,
-			140, 176, 59, 250, 232, 252, 199, 41, 29, 180, 223, 35, 87, 78,
-			156, 53, 111, 197, 135, 151, 245, 219, 201, 75, 137, 82, 126, 59,
-			121, 182, 253, 227, 148, 126, 140, 30, 77, 157, 109, 255, 56, 85,
-			238, 46, 121, 182, 253, 227, 84, 185, 187, 228, 217, 246, 143, 211,
-			254, 1, 118, 79, 31, 109, 255, 4, 40, 231, 23, 30, 164, 155,
-			31, 202, 209, 37, 253, 92, 236, 33, 28, 93, 242, 80, 250, 39,
-			40, 253, 56, 29, 76, 29, 74, 255, 68, 246, 80, 250, 39, 180,
-			234, 148, 135, 210, 63, 1, 170, 51, 208, 103, 210, 63, 5, 114,
-			84, 79, 220, 141, 233, 1, 198, 244, 100, 237, 137, 231, 75, 137,
-			1, 145, 249, 228, 116, 43, 171, 2, 88, 246, 178, 238, 76, 212,
-			74, 159, 33, 255, 20, 165, 159, 160, 86, 234, 12, 249, 167, 18,
-			78, 195, 22, 226, 83, 180, 219, 74, 157, 33, 255, 20, 8, 194,
-			71, 164, 232, 231, 44, 243, 51, 148, 218, 246, 79, 167, 190, 197,
-			221, 193, 230, 248, 179, 203, 250, 14, 22, 100, 53, 78, 211, 118,
-			43, 190, 99, 64, 106, 82, 149, 239, 192, 240, 94, 224, 32, 240,
-			3, 144, 44, 249, 181, 35, 252, 228, 178, 156, 194, 169, 100, 157,
-			248, 36, 126, 76, 80, 206, 180, 242, 159, 161, 244, 83, 177, 232,
-			228, 36, 142, 154, 32, 80, 60, 159, 161, 221, 90, 238, 115, 6,
-			128, 163, 199, 216, 223, 148, 4, 229, 45, 243, 215, 40, 29, 178,
-			119, 185, 252, 26, 5, 202, 246, 187, 174, 240, 217, 18, 138, 109,
-			208, 214, 251, 68, 105, 134, 180, 132, 250, 12, 25, 108, 24, 95,
-			116, 91, 89, 215, 66, 234, 160, 191, 210, 81, 251, 36, 39, 195,
-			250, 215, 114, 228, 167, 7, 82, 116, 228, 77, 43, 255, 107, 148,
-			126, 134, 234, 27, 12, 242, 57, 68, 173, 91, 131, 4, 192, 194,
-			97, 13, 26, 0, 14, 90, 236, 87, 37, 29, 221, 150, 249, 175,
-			40, 29, 181, 127, 153, 28, 144, 189, 45, 69, 100, 31, 7, 233,
-			83, 72, 236, 91, 115, 137, 118, 122, 68, 217, 15, 236, 18, 205,
-			161, 139, 237, 95, 81, 250, 107, 116, 72, 145, 214, 157, 67, 90,
-			52, 225, 221, 4, 192, 66, 252, 212, 0, 112, 228, 40, 134, 203,
-			243, 86, 254, 243, 180, 235, 255, 162, 196, 190, 206, 211, 103, 178,
-			30, 118, 23, 221, 105, 126, 192, 74, 5, 140, 254, 60, 45, 12,
-			179, 127, 11, 156, 205, 195, 82, 245, 5, 88, 170, 62, 67, 178,
-			107, 85, 135, 198, 203, 108, 154, 59, 206, 49, 225, 25, 35, 221,
-			113, 220, 128, 155, 220, 77, 133, 194, 181, 229, 138, 192, 9, 106,
-			91, 187, 169, 156, 29, 22, 167, 208, 38, 183, 94, 39, 147, 93,
-			190, 151, 73, 4, 214, 173, 139, 134, 104, 166, 86, 128, 60, 174,
-			121, 95, 160, 244, 243, 234, 62, 218, 60, 174, 121, 95, 208, 170,
-			42, 143, 107, 222, 23, 244, 154, 151, 199, 53, 239, 11, 176, 230,
-			61, 129, 76, 32, 150, 249, 38, 168, 170, 73, 228, 65, 140, 169,
-			90, 246, 208, 34, 119, 194, 76, 14, 135, 236, 21, 214, 157, 55,
-			41, 253, 130, 90, 119, 242, 184, 238, 188, 153, 244, 74, 176, 97,
-			181, 238, 228, 113, 221, 121, 19, 212, 205, 87, 36, 239, 169, 101,
-			126, 17, 52, 251, 191, 127, 107, 102, 119, 198, 74, 248, 97, 172,
-			238, 5, 217, 208, 219, 110, 116, 231, 113, 197, 251, 34, 165, 111,
-			42, 181, 149, 199, 21, 239, 139, 9, 99, 128, 227, 95, 212, 43,
-			71, 30, 87, 188, 47, 194, 202, 129, 195, 97, 116, 89, 249, 223,
-			163, 244, 59, 212, 176, 207, 170, 157, 154, 188, 249, 206, 85, 225,
-			169, 172, 9, 166, 143, 51, 228, 209, 135, 242, 123, 148, 13, 177,
-			1, 150, 7, 208, 236, 178, 242, 95, 162, 230, 239, 211, 28, 238,
-			197, 176, 132, 88, 230, 151, 160, 227, 1, 86, 144, 5, 48, 3,
-			254, 3, 205, 247, 179, 65, 214, 163, 75, 8, 22, 177, 116, 17,
-			133, 162, 67, 135, 83, 239, 17, 203, 252, 223, 104, 126, 48, 85,
-			137, 200, 162, 190, 116, 17, 133, 162, 254, 129, 212, 123, 212, 50,
-			191, 76, 243, 86, 170, 18, 52, 245, 101, 154, 63, 148, 46, 194,
-			90, 3, 131, 160, 200, 145, 22, 64, 243, 171, 212, 28, 182, 119,
-			240, 54, 87, 173, 2, 244, 215, 190, 113, 84, 178, 139, 101, 153,
-			243, 219, 91, 194, 131, 130, 117, 116, 151, 166, 19, 230, 149, 226,
-			184, 234, 100, 115, 232, 240, 27, 232, 201, 141, 131, 123, 28, 77,
-			121, 229, 104, 250, 106, 134, 171, 56, 217, 190, 74, 149, 163, 41,
-			175, 28, 77, 95, 165, 202, 209, 148, 87, 142, 166, 175, 82, 107,
-			136, 149, 20, 57, 196, 50, 191, 70, 77, 203, 62, 46, 243, 198,
-			118, 91, 113, 14, 115, 66, 64, 210, 39, 76, 180, 175, 81, 243,
-			171, 116, 56, 110, 17, 118, 184, 95, 163, 42, 177, 38, 175, 252,
-			53, 95, 131, 161, 141, 11, 12, 40, 24, 24, 100, 255, 144, 170,
-			78, 169, 101, 126, 131, 154, 39, 236, 191, 69, 127, 56, 231, 150,
-			254, 106, 8, 147, 86, 204, 186, 224, 27, 110, 163, 33, 234, 50,
-			133, 65, 221, 11, 198, 229, 25, 225, 12, 61, 255, 127, 245, 137,
-			229, 149, 143, 229, 27, 212, 252, 26, 181, 98, 14, 130, 253, 240,
-			13, 106, 166, 10, 8, 20, 40, 159, 88, 94, 249, 88, 190, 65,
-			199, 142, 179, 95, 210, 60, 54, 44, 243, 79, 168, 57, 97, 255,
-			77, 154, 198, 64, 49, 250, 45, 120, 200, 126, 112, 30, 191, 109,
-			142, 181, 70, 74, 63, 254, 192, 126, 181, 132, 193, 96, 78, 255,
-			9, 53, 191, 65, 79, 196, 236, 51, 242, 200, 173, 84, 1, 129,
-			2, 229, 196, 202, 43, 231, 209, 159, 208, 51, 103, 49, 126, 151,
-			7, 238, 254, 103, 74, 79, 43, 189, 105, 152, 8, 50, 13, 230,
-			1, 236, 29, 214, 32, 1, 240, 200, 184, 6, 241, 221, 226, 35,
-			108, 145, 209, 124, 151, 149, 255, 83, 218, 245, 23, 148, 216, 63,
-			38, 191, 23, 165, 6, 8, 236, 134, 233, 13, 167, 166, 206, 6,
-			170, 173, 37, 78, 133, 151, 50, 62, 121, 30, 138, 96, 219, 173,
-			169, 107, 208, 243, 48, 225, 255, 148, 22, 250, 216, 77, 102, 230,
-			81, 89, 253, 57, 165, 37, 251, 73, 76, 238, 214, 219, 40, 57,
-			153, 180, 227, 2, 51, 7, 84, 10, 150, 244, 144, 119, 222, 236,
-			153, 151, 138, 228, 207, 105, 190, 71, 131, 20, 64, 54, 172, 65,
-			3, 192, 241, 115, 236, 54, 246, 74, 44, 243, 191, 80, 90, 182,
-			43, 242, 96, 69, 178, 123, 203, 30, 165, 232, 152, 241, 247, 61,
-			70, 33, 251, 33, 216, 114, 62, 6, 41, 128, 189, 35, 26, 52,
-			0, 60, 85, 98, 139, 136, 5, 181, 204, 239, 81, 122, 193, 254,
-			177, 216, 171, 37, 209, 232, 112, 221, 135, 169, 84, 178, 142, 4,
-			140, 148, 103, 47, 47, 231, 220, 247, 104, 190, 87, 131, 216, 126,
-			223, 168, 6, 13, 0, 31, 153, 85, 157, 27, 150, 249, 125, 74,
-			103, 96, 84, 165, 240, 30, 208, 119, 224, 251, 81, 230, 152, 61,
-			174, 29, 177, 229, 19, 119, 14, 50, 244, 253, 132, 114, 131, 2,
-			24, 83, 110, 96, 119, 167, 166, 245, 29, 92, 255, 95, 0, 0,
-			0, 255, 255, 167, 145, 129, 151, 215, 163, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 123, 144, 36, 71,
+			121, 32, 62, 153, 89, 221, 211, 157, 51, 179, 51, 147, 243, 216,
+			217, 218, 157, 221, 84, 235, 177, 175, 217, 30, 177, 122, 225, 21,
+			146, 217, 213, 174, 164, 17, 203, 106, 233, 93, 161, 31, 2, 126,
+			171, 154, 238, 156, 153, 18, 221, 85, 173, 170, 234, 221, 29, 97,
+			204, 227, 100, 12, 216, 38, 100, 142, 59, 249, 65, 40, 8, 59,
+			78, 182, 192, 128, 101, 44, 108, 236, 0, 27, 99, 115, 24, 140,
+			117, 6, 108, 124, 112, 224, 23, 103, 7, 14, 27, 95, 16, 14,
+			31, 17, 190, 227, 46, 190, 47, 31, 85, 221, 51, 179, 15, 89,
+			118, 156, 47, 78, 127, 104, 231, 203, 170, 202, 252, 190, 47, 191,
+			252, 94, 249, 101, 54, 255, 95, 132, 239, 89, 141, 227, 213, 182,
+			90, 236, 38, 113, 22, 47, 247, 86, 22, 179, 176, 163, 210, 44,
+			232, 116, 235, 216, 36, 198, 245, 11, 117, 251, 66, 237, 118, 94,
+			61, 107, 223, 17, 115, 124, 56, 85, 205, 56, 106, 165, 115, 68,
+			146, 125, 172, 97, 65, 49, 205, 75, 81, 16, 197, 233, 28, 149,
+			100, 95, 169, 161, 129, 99, 63, 68, 248, 84, 51, 238, 212, 7,
+			58, 61, 182, 205, 117, 121, 26, 154, 78, 147, 135, 14, 155, 87,
+			86, 227, 118, 16, 173, 214, 227, 100, 181, 128, 227, 122, 87, 165,
+			139, 111, 136, 226, 11, 81, 142, 111, 119, 249, 187, 132, 252, 12,
+			101, 247, 156, 62, 246, 1, 186, 251, 30, 253, 245, 105, 243, 73,
+			253, 65, 213, 110, 191, 2, 62, 56, 11, 223, 46, 151, 177, 175,
+			155, 248, 111, 236, 228, 39, 87, 195, 108, 173, 183, 92, 111, 198,
+			157, 197, 118, 175, 25, 226, 255, 14, 173, 198, 139, 237, 120, 181,
+			21, 175, 46, 6, 221, 112, 81, 69, 173, 110, 28, 70, 89, 186,
+			216, 140, 227, 164, 21, 70, 65, 22, 39, 240, 66, 186, 120, 254,
+			37, 139, 105, 22, 100, 134, 22, 81, 214, 95, 249, 151, 227, 107,
+			237, 93, 37, 190, 237, 100, 188, 122, 38, 75, 84, 208, 57, 3,
+			61, 136, 107, 249, 24, 190, 126, 238, 188, 74, 210, 48, 142, 144,
+			165, 213, 198, 40, 54, 190, 90, 183, 137, 155, 249, 112, 51, 81,
+			65, 166, 90, 200, 217, 145, 195, 254, 32, 51, 235, 142, 151, 13,
+			251, 170, 184, 158, 111, 203, 84, 210, 9, 163, 160, 125, 46, 140,
+			90, 234, 226, 28, 195, 233, 26, 179, 173, 75, 208, 40, 94, 198,
+			135, 131, 164, 185, 22, 158, 87, 115, 30, 118, 94, 171, 107, 122,
+			234, 253, 168, 214, 143, 234, 183, 150, 162, 149, 184, 97, 63, 17,
+			179, 188, 220, 237, 37, 171, 170, 53, 87, 146, 100, 95, 165, 97,
+			32, 113, 39, 175, 102, 113, 103, 57, 205, 226, 72, 205, 149, 177,
+			95, 185, 69, 191, 103, 237, 123, 141, 252, 19, 81, 231, 83, 143,
+			246, 226, 44, 56, 215, 74, 226, 110, 87, 181, 206, 45, 175, 103,
+			42, 157, 27, 150, 100, 159, 215, 152, 196, 71, 199, 245, 147, 99,
+			240, 64, 44, 112, 145, 4, 153, 26, 120, 189, 130, 175, 79, 192,
+			147, 226, 219, 254, 207, 19, 62, 82, 32, 71, 236, 228, 85, 228,
+			208, 185, 94, 210, 54, 51, 80, 193, 134, 7, 146, 182, 152, 231,
+			60, 69, 116, 241, 41, 197, 167, 85, 221, 2, 143, 119, 240, 74,
+			43, 200, 2, 124, 200, 240, 225, 48, 192, 240, 200, 231, 149, 102,
+			220, 233, 182, 85, 166, 121, 91, 105, 56, 88, 220, 192, 199, 219,
+			241, 234, 57, 21, 101, 201, 250, 185, 102, 220, 139, 50, 228, 32,
+			107, 140, 181, 227, 213, 19, 208, 122, 23, 52, 250, 15, 242, 170,
+			99, 144, 56, 236, 184, 77, 46, 43, 7, 118, 38, 102, 121, 57,
+			81, 65, 26, 71, 40, 59, 213, 134, 129, 238, 251, 197, 25, 94,
+			22, 158, 55, 116, 140, 240, 231, 8, 39, 163, 130, 121, 67, 226,
+			240, 7, 136, 188, 43, 238, 174, 39, 225, 234, 90, 38, 15, 223,
+			248, 146, 91, 229, 217, 53, 37, 79, 62, 112, 215, 146, 60, 218,
+			203, 214, 226, 36, 173, 203, 163, 237, 182, 196, 23, 82, 153, 168,
+			84, 37, 231, 85, 171, 206, 229, 3, 169, 146, 241, 138, 204, 214,
+			194, 84, 166, 113, 47, 105, 42, 217, 140, 91, 74, 134, 169, 92,
+			141, 207, 171, 36, 82, 45, 217, 139, 90, 42, 145, 217, 154, 146,
+			71, 187, 65, 19, 58, 14, 155, 42, 74, 213, 130, 52, 162, 46,
+			15, 215, 111, 228, 50, 91, 11, 50, 217, 12, 34, 185, 172, 228,
+			74, 220, 139, 90, 50, 140, 240, 171, 147, 75, 119, 157, 56, 117,
+			230, 132, 92, 9, 219, 170, 206, 121, 133, 19, 42, 88, 121, 104,
+			156, 87, 57, 101, 67, 130, 85, 134, 246, 243, 167, 8, 167, 222,
+			144, 240, 198, 134, 142, 17, 255, 199, 137, 236, 151, 54, 64, 39,
+			144, 203, 97, 43, 76, 84, 51, 11, 227, 40, 104, 75, 92, 203,
+			242, 124, 208, 238, 41, 217, 75, 21, 142, 246, 64, 183, 21, 100,
+			74, 175, 84, 217, 12, 218, 237, 180, 206, 249, 38, 125, 169, 206,
+			178, 106, 181, 130, 229, 182, 130, 175, 78, 88, 157, 33, 19, 245,
+			104, 79, 165, 217, 98, 162, 210, 110, 28, 165, 74, 166, 89, 210,
+			107, 102, 208, 11, 231, 204, 27, 34, 130, 141, 85, 102, 249, 113,
+			238, 121, 67, 116, 72, 176, 241, 202, 53, 254, 109, 242, 116, 97,
+			213, 3, 166, 64, 179, 157, 90, 105, 52, 132, 92, 137, 19, 195,
+			101, 196, 174, 206, 249, 40, 47, 65, 47, 37, 232, 102, 155, 133,
+			136, 96, 227, 227, 187, 44, 196, 4, 27, 223, 35, 249, 105, 28,
+			143, 8, 38, 42, 117, 255, 46, 156, 91, 80, 166, 242, 194, 154,
+			210, 28, 110, 199, 171, 166, 95, 121, 33, 128, 249, 93, 13, 211,
+			76, 37, 170, 37, 47, 132, 217, 26, 190, 114, 87, 174, 14, 221,
+			216, 164, 12, 93, 94, 99, 33, 24, 160, 182, 223, 66, 76, 48,
+			177, 112, 136, 159, 199, 177, 169, 96, 179, 149, 107, 252, 16, 199,
+			54, 35, 225, 82, 211, 194, 83, 196, 96, 111, 42, 173, 170, 146,
+			29, 149, 166, 193, 170, 170, 203, 37, 253, 150, 158, 173, 48, 149,
+			135, 94, 178, 192, 221, 119, 200, 148, 176, 221, 54, 29, 132, 209,
+			170, 195, 144, 150, 96, 224, 49, 11, 17, 193, 102, 183, 89, 238,
+			80, 38, 216, 236, 30, 201, 239, 5, 12, 217, 144, 240, 118, 208,
+			125, 204, 63, 34, 11, 42, 66, 54, 227, 40, 11, 194, 40, 149,
+			70, 243, 201, 150, 202, 130, 176, 157, 154, 233, 40, 226, 109, 199,
+			100, 48, 203, 59, 248, 12, 127, 21, 47, 3, 4, 243, 188, 211,
+			219, 225, 31, 67, 218, 181, 181, 146, 103, 178, 56, 9, 86, 149,
+			124, 160, 113, 18, 102, 33, 81, 3, 157, 237, 77, 13, 123, 66,
+			55, 116, 171, 206, 249, 54, 62, 172, 187, 44, 65, 159, 5, 152,
+			8, 182, 115, 100, 58, 135, 153, 96, 59, 183, 207, 241, 215, 26,
+			20, 136, 96, 243, 158, 239, 159, 188, 74, 20, 146, 224, 130, 1,
+			36, 40, 183, 45, 144, 33, 37, 232, 189, 0, 195, 104, 35, 51,
+			57, 204, 4, 155, 159, 219, 193, 31, 50, 200, 80, 193, 246, 120,
+			115, 254, 43, 174, 18, 153, 32, 77, 85, 103, 185, 173, 90, 151,
+			194, 5, 230, 123, 79, 1, 23, 74, 4, 219, 51, 50, 149, 195,
+			76, 176, 61, 179, 219, 249, 215, 137, 65, 134, 9, 118, 157, 55,
+			235, 127, 129, 160, 136, 37, 61, 181, 32, 131, 118, 27, 103, 2,
+			148, 116, 168, 82, 185, 172, 178, 11, 74, 69, 242, 70, 25, 68,
+			45, 39, 155, 218, 184, 202, 11, 128, 171, 67, 68, 46, 173, 112,
+			185, 18, 180, 65, 183, 225, 98, 13, 163, 86, 216, 12, 50, 5,
+			139, 58, 200, 6, 136, 194, 181, 22, 197, 153, 180, 230, 161, 189,
+			46, 219, 113, 208, 66, 93, 148, 197, 28, 254, 175, 146, 142, 106,
+			133, 160, 118, 82, 195, 34, 183, 104, 245, 168, 65, 91, 191, 118,
+			62, 104, 75, 117, 177, 27, 38, 125, 252, 96, 37, 160, 175, 146,
+			195, 68, 176, 235, 170, 147, 57, 12, 244, 79, 207, 240, 107, 13,
+			59, 60, 193, 246, 122, 187, 253, 105, 156, 155, 168, 215, 89, 86,
+			9, 172, 80, 96, 71, 222, 169, 87, 130, 183, 170, 57, 76, 4,
+			219, 203, 119, 228, 48, 19, 108, 239, 174, 121, 30, 192, 194, 130,
+			85, 118, 144, 250, 254, 89, 96, 112, 20, 71, 135, 162, 176, 189,
+			48, 200, 136, 194, 100, 46, 104, 46, 3, 243, 86, 66, 213, 110,
+			13, 46, 193, 160, 205, 237, 34, 116, 171, 156, 149, 97, 12, 187,
+			202, 25, 17, 236, 224, 182, 25, 11, 193, 248, 115, 59, 248, 155,
+			17, 25, 79, 176, 197, 202, 156, 159, 200, 165, 194, 196, 40, 169,
+			141, 166, 49, 9, 241, 138, 12, 96, 150, 234, 242, 40, 252, 163,
+			103, 110, 45, 0, 65, 80, 145, 125, 53, 76, 101, 28, 181, 215,
+			185, 12, 154, 224, 157, 182, 85, 11, 90, 179, 88, 6, 173, 78,
+			24, 133, 105, 150, 4, 25, 232, 139, 102, 59, 84, 81, 150, 163,
+			10, 188, 91, 172, 140, 90, 136, 8, 182, 56, 54, 101, 33, 38,
+			216, 226, 236, 118, 190, 4, 168, 50, 34, 188, 195, 244, 54, 230,
+			223, 46, 157, 39, 32, 91, 42, 109, 38, 225, 114, 17, 107, 135,
+			174, 225, 229, 222, 20, 145, 134, 53, 98, 7, 101, 132, 8, 118,
+			152, 79, 241, 227, 56, 203, 4, 52, 210, 205, 222, 126, 255, 150,
+			75, 90, 130, 66, 71, 40, 168, 154, 112, 39, 91, 132, 14, 149,
+			161, 155, 249, 28, 38, 130, 221, 188, 251, 186, 28, 102, 130, 221,
+			188, 119, 31, 191, 209, 140, 74, 4, 187, 213, 155, 245, 175, 193,
+			81, 181, 59, 34, 87, 195, 243, 42, 114, 202, 20, 135, 40, 140,
+			0, 154, 229, 86, 183, 154, 9, 90, 152, 91, 71, 172, 244, 18,
+			212, 44, 183, 78, 207, 240, 21, 96, 24, 44, 253, 35, 212, 247,
+			95, 115, 9, 65, 43, 210, 52, 48, 163, 90, 238, 96, 78, 101,
+			152, 165, 92, 58, 167, 84, 38, 170, 3, 38, 192, 77, 97, 169,
+			12, 3, 141, 88, 136, 8, 118, 100, 212, 74, 91, 137, 9, 118,
+			100, 110, 7, 127, 12, 49, 42, 11, 118, 39, 189, 198, 239, 12,
+			172, 38, 116, 106, 97, 222, 140, 244, 195, 92, 229, 250, 225, 88,
+			47, 107, 171, 68, 182, 194, 180, 25, 36, 160, 11, 150, 85, 51,
+			232, 165, 168, 17, 121, 129, 22, 169, 46, 54, 149, 130, 23, 2,
+			236, 81, 162, 123, 236, 176, 44, 151, 96, 240, 138, 133, 136, 96,
+			119, 86, 173, 229, 43, 51, 193, 238, 220, 35, 13, 150, 195, 130,
+			29, 165, 242, 95, 0, 75, 112, 200, 101, 59, 236, 132, 153, 195,
+			114, 184, 4, 131, 91, 44, 135, 137, 96, 71, 171, 59, 45, 196,
+			4, 59, 186, 123, 143, 139, 224, 254, 145, 240, 221, 131, 177, 86,
+			171, 7, 235, 44, 142, 182, 10, 97, 143, 240, 202, 113, 243, 202,
+			85, 71, 176, 255, 102, 139, 8, 118, 204, 246, 104, 3, 216, 151,
+			92, 97, 0, 107, 145, 125, 65, 241, 235, 167, 31, 224, 135, 175,
+			32, 126, 109, 199, 171, 221, 101, 136, 87, 13, 71, 74, 216, 112,
+			217, 32, 213, 191, 12, 103, 107, 127, 75, 249, 148, 243, 131, 143,
+			163, 26, 234, 102, 113, 130, 145, 96, 162, 86, 194, 139, 38, 128,
+			50, 144, 16, 220, 139, 130, 142, 50, 209, 7, 254, 45, 14, 243,
+			17, 19, 82, 101, 235, 93, 133, 113, 233, 182, 195, 147, 16, 31,
+			118, 151, 235, 103, 240, 201, 217, 245, 174, 106, 152, 192, 11, 254,
+			22, 215, 240, 81, 48, 0, 42, 202, 244, 71, 16, 80, 85, 27,
+			35, 166, 13, 95, 121, 41, 175, 58, 106, 230, 74, 151, 141, 144,
+			242, 151, 197, 75, 185, 151, 5, 171, 233, 92, 89, 178, 125, 35,
+			135, 175, 51, 152, 108, 66, 102, 253, 108, 176, 154, 98, 120, 214,
+			192, 47, 32, 142, 91, 14, 163, 32, 89, 63, 7, 65, 201, 57,
+			117, 49, 195, 32, 181, 218, 24, 211, 205, 119, 135, 109, 117, 226,
+			98, 230, 223, 198, 171, 238, 83, 49, 193, 217, 27, 212, 186, 97,
+			20, 252, 9, 130, 135, 46, 173, 97, 147, 6, 142, 208, 151, 146,
+			218, 35, 220, 59, 171, 46, 102, 226, 6, 94, 106, 135, 145, 2,
+			145, 5, 28, 39, 12, 142, 240, 172, 126, 50, 140, 84, 67, 63,
+			246, 143, 112, 15, 192, 188, 71, 82, 232, 81, 236, 226, 213, 150,
+			194, 149, 167, 18, 51, 86, 222, 80, 187, 153, 151, 143, 33, 214,
+			48, 155, 241, 202, 74, 170, 50, 68, 210, 107, 24, 8, 102, 19,
+			148, 20, 126, 58, 218, 192, 191, 107, 63, 69, 120, 229, 120, 144,
+			5, 171, 73, 208, 113, 47, 144, 252, 5, 241, 18, 62, 220, 13,
+			146, 44, 12, 218, 38, 127, 177, 221, 32, 111, 191, 170, 159, 214,
+			143, 27, 246, 61, 255, 30, 62, 108, 218, 128, 16, 244, 176, 16,
+			147, 177, 134, 6, 96, 156, 52, 124, 76, 139, 149, 215, 192, 191,
+			161, 173, 29, 164, 25, 202, 83, 165, 129, 127, 215, 62, 76, 121,
+			229, 164, 137, 168, 197, 17, 62, 2, 115, 126, 174, 64, 218, 200,
+			225, 29, 27, 68, 196, 46, 235, 6, 135, 183, 239, 199, 151, 65,
+			254, 180, 68, 155, 100, 138, 30, 120, 68, 183, 233, 84, 202, 53,
+			124, 212, 136, 117, 158, 111, 241, 26, 70, 212, 245, 43, 62, 175,
+			164, 16, 26, 70, 77, 157, 18, 240, 26, 14, 22, 215, 112, 47,
+			3, 249, 225, 136, 214, 72, 97, 130, 239, 29, 106, 224, 35, 177,
+			151, 151, 181, 88, 205, 141, 224, 75, 99, 230, 37, 61, 107, 247,
+			14, 53, 204, 99, 113, 72, 103, 37, 128, 185, 115, 163, 248, 234,
+			248, 0, 207, 239, 29, 106, 184, 87, 142, 85, 249, 176, 89, 72,
+			181, 159, 244, 144, 97, 26, 221, 58, 247, 192, 211, 48, 156, 242,
+			183, 94, 23, 13, 124, 79, 44, 242, 97, 227, 44, 207, 81, 92,
+			74, 51, 249, 39, 216, 99, 29, 39, 162, 97, 223, 18, 7, 248,
+			36, 76, 211, 185, 62, 214, 106, 190, 141, 195, 131, 211, 5, 246,
+			218, 119, 251, 120, 236, 229, 239, 158, 41, 240, 121, 139, 244, 138,
+			55, 144, 94, 17, 119, 240, 17, 240, 185, 19, 149, 66, 204, 141,
+			153, 170, 109, 135, 119, 14, 34, 125, 87, 254, 74, 163, 248, 190,
+			255, 9, 194, 75, 72, 209, 150, 11, 166, 56, 225, 116, 195, 132,
+			247, 139, 20, 187, 188, 72, 121, 27, 69, 106, 64, 168, 75, 87,
+			33, 212, 181, 67, 124, 164, 64, 155, 168, 112, 239, 212, 253, 167,
+			78, 76, 12, 193, 95, 247, 60, 180, 116, 122, 130, 8, 206, 203,
+			103, 78, 29, 61, 125, 250, 53, 19, 244, 192, 141, 156, 231, 218,
+			25, 222, 57, 123, 226, 255, 59, 59, 49, 4, 239, 28, 91, 58,
+			117, 180, 241, 154, 9, 34, 70, 121, 229, 248, 209, 179, 71, 239,
+			105, 28, 125, 229, 4, 189, 239, 221, 247, 242, 97, 81, 242, 134,
+			158, 165, 151, 76, 51, 221, 242, 175, 33, 205, 180, 173, 152, 102,
+			130, 63, 137, 96, 213, 161, 125, 92, 114, 90, 26, 18, 222, 232,
+			208, 4, 241, 167, 229, 209, 162, 207, 3, 150, 170, 46, 57, 231,
+			172, 4, 201, 128, 209, 210, 56, 31, 225, 94, 9, 83, 62, 99,
+			218, 115, 4, 0, 178, 65, 180, 108, 33, 42, 216, 88, 149, 155,
+			23, 137, 96, 219, 232, 152, 121, 17, 220, 222, 109, 180, 98, 33,
+			42, 216, 182, 145, 81, 243, 34, 21, 108, 156, 142, 155, 71, 16,
+			237, 142, 83, 110, 33, 120, 54, 182, 141, 63, 170, 51, 99, 179,
+			67, 175, 32, 190, 58, 128, 233, 44, 139, 104, 203, 173, 100, 116,
+			239, 234, 242, 44, 48, 216, 164, 160, 86, 122, 144, 82, 81, 25,
+			248, 128, 97, 180, 18, 39, 29, 116, 8, 144, 129, 188, 16, 138,
+			96, 244, 177, 26, 70, 150, 252, 66, 178, 107, 182, 178, 147, 255,
+			41, 177, 217, 174, 61, 116, 218, 255, 34, 225, 133, 28, 208, 222,
+			84, 234, 165, 32, 247, 65, 234, 12, 130, 215, 253, 38, 227, 150,
+			202, 56, 9, 87, 33, 225, 4, 61, 175, 36, 113, 7, 145, 74,
+			131, 142, 115, 62, 195, 40, 205, 130, 168, 169, 228, 5, 76, 254,
+			172, 5, 16, 138, 75, 173, 59, 160, 151, 163, 144, 221, 11, 91,
+			118, 8, 151, 60, 10, 164, 22, 231, 83, 65, 39, 15, 169, 32,
+			230, 62, 194, 229, 90, 150, 117, 211, 35, 139, 139, 91, 185, 90,
+			205, 184, 211, 137, 35, 235, 113, 193, 68, 167, 214, 151, 29, 130,
+			220, 131, 243, 101, 97, 226, 247, 84, 199, 45, 4, 121, 7, 49,
+			197, 255, 134, 216, 84, 220, 126, 42, 252, 111, 24, 102, 228, 162,
+			179, 55, 149, 224, 42, 13, 176, 195, 206, 10, 166, 41, 179, 88,
+			246, 162, 240, 209, 158, 130, 72, 165, 165, 162, 44, 92, 89, 239,
+			139, 0, 49, 103, 103, 100, 58, 109, 198, 93, 92, 57, 24, 211,
+			116, 55, 176, 6, 7, 251, 231, 102, 12, 41, 9, 182, 223, 49,
+			6, 164, 121, 127, 213, 6, 235, 16, 194, 237, 159, 152, 228, 47,
+			181, 105, 194, 5, 58, 239, 31, 220, 200, 21, 99, 167, 36, 112,
+			188, 200, 29, 105, 250, 161, 101, 248, 212, 198, 213, 176, 16, 22,
+			198, 230, 44, 196, 4, 91, 216, 185, 139, 127, 131, 216, 132, 196,
+			45, 212, 247, 255, 211, 160, 36, 110, 53, 132, 157, 128, 78, 47,
+			205, 32, 55, 29, 68, 242, 222, 179, 103, 79, 203, 187, 244, 251,
+			135, 206, 2, 74, 200, 195, 186, 92, 202, 96, 158, 58, 65, 75,
+			201, 224, 124, 16, 182, 49, 69, 156, 197, 176, 230, 142, 199, 171,
+			220, 166, 3, 32, 231, 23, 201, 71, 123, 42, 89, 207, 215, 141,
+			236, 168, 44, 208, 203, 112, 41, 211, 50, 29, 180, 211, 24, 135,
+			236, 118, 219, 161, 201, 47, 152, 60, 9, 151, 218, 230, 187, 128,
+			214, 197, 84, 172, 36, 216, 45, 142, 221, 144, 13, 185, 165, 90,
+			204, 134, 220, 50, 183, 131, 255, 58, 177, 233, 144, 59, 232, 1,
+			255, 67, 155, 201, 225, 114, 0, 49, 167, 245, 149, 55, 99, 72,
+			20, 219, 252, 73, 154, 5, 73, 134, 47, 111, 204, 231, 106, 149,
+			110, 156, 177, 80, 165, 144, 165, 2, 203, 3, 242, 25, 38, 188,
+			48, 68, 144, 202, 78, 216, 76, 98, 29, 165, 73, 109, 217, 82,
+			187, 246, 109, 66, 200, 209, 233, 149, 5, 187, 131, 218, 104, 209,
+			35, 130, 221, 177, 235, 122, 11, 49, 193, 238, 216, 183, 159, 255,
+			91, 98, 83, 3, 199, 233, 30, 255, 135, 128, 206, 0, 19, 198,
+			65, 36, 131, 100, 57, 204, 146, 32, 89, 151, 111, 80, 235, 139,
+			56, 129, 50, 11, 86, 101, 144, 166, 113, 19, 82, 110, 46, 251,
+			29, 166, 69, 122, 180, 126, 58, 30, 175, 186, 217, 4, 107, 130,
+			147, 137, 153, 140, 252, 85, 205, 68, 200, 42, 96, 199, 56, 68,
+			127, 30, 225, 56, 117, 153, 3, 34, 216, 241, 89, 223, 66, 76,
+			176, 227, 243, 187, 249, 79, 18, 155, 72, 184, 143, 206, 251, 63,
+			66, 184, 92, 90, 1, 157, 188, 96, 216, 110, 214, 123, 187, 13,
+			82, 242, 72, 28, 130, 29, 204, 226, 85, 149, 173, 65, 10, 161,
+			151, 128, 116, 185, 60, 97, 22, 203, 68, 233, 125, 66, 248, 156,
+			91, 13, 107, 19, 231, 152, 1, 25, 144, 221, 32, 147, 47, 211,
+			106, 227, 206, 197, 131, 139, 47, 3, 125, 113, 103, 29, 130, 15,
+			75, 5, 228, 25, 238, 115, 210, 86, 38, 130, 221, 87, 181, 11,
+			15, 242, 12, 247, 237, 220, 197, 107, 28, 50, 93, 222, 169, 161,
+			215, 19, 127, 86, 158, 85, 23, 51, 59, 162, 89, 115, 218, 88,
+			122, 160, 26, 78, 85, 70, 249, 237, 220, 243, 8, 100, 228, 79,
+			211, 215, 50, 255, 16, 174, 180, 112, 181, 23, 247, 96, 107, 224,
+			98, 38, 49, 228, 49, 121, 66, 21, 38, 210, 133, 50, 169, 209,
+			7, 4, 147, 240, 167, 249, 54, 126, 55, 47, 67, 87, 96, 126,
+			26, 222, 140, 127, 155, 150, 243, 48, 82, 123, 77, 95, 6, 131,
+			5, 76, 196, 134, 81, 179, 221, 107, 1, 211, 194, 44, 205, 187,
+			173, 75, 76, 57, 97, 63, 37, 232, 136, 231, 48, 17, 172, 49,
+			50, 145, 195, 76, 176, 198, 212, 52, 255, 40, 49, 3, 19, 193,
+			30, 242, 118, 248, 63, 107, 151, 152, 30, 218, 117, 13, 140, 208,
+			27, 22, 48, 179, 153, 81, 244, 65, 36, 85, 167, 155, 173, 155,
+			167, 38, 153, 12, 100, 195, 83, 64, 57, 140, 122, 202, 121, 46,
+			17, 16, 162, 3, 10, 8, 163, 56, 142, 98, 19, 169, 110, 76,
+			235, 128, 218, 28, 79, 43, 86, 184, 134, 101, 208, 58, 15, 102,
+			212, 36, 222, 136, 73, 233, 63, 84, 160, 18, 38, 230, 33, 179,
+			191, 64, 76, 74, 255, 161, 237, 115, 224, 131, 120, 152, 78, 124,
+			29, 213, 146, 75, 232, 144, 39, 216, 235, 180, 15, 2, 143, 202,
+			130, 189, 110, 100, 220, 66, 68, 176, 215, 77, 204, 88, 136, 9,
+			246, 186, 185, 29, 252, 58, 78, 61, 42, 188, 135, 135, 20, 241,
+			231, 164, 142, 105, 54, 151, 15, 80, 235, 15, 87, 182, 241, 123,
+			184, 231, 81, 24, 118, 153, 78, 251, 71, 144, 175, 152, 1, 211,
+			26, 195, 114, 197, 116, 97, 20, 210, 74, 152, 128, 250, 214, 175,
+			25, 111, 7, 17, 161, 184, 155, 178, 108, 68, 152, 162, 91, 182,
+			108, 12, 55, 165, 67, 76, 176, 101, 49, 197, 247, 225, 144, 68,
+			176, 22, 157, 244, 119, 234, 33, 139, 152, 238, 77, 251, 251, 4,
+			14, 182, 232, 176, 133, 224, 67, 147, 245, 165, 200, 189, 214, 248,
+			4, 63, 200, 65, 79, 121, 107, 67, 63, 72, 252, 61, 210, 70,
+			104, 3, 164, 23, 156, 73, 15, 116, 249, 90, 101, 130, 215, 184,
+			231, 49, 160, 255, 17, 58, 233, 207, 104, 101, 108, 131, 186, 34,
+			26, 12, 73, 123, 196, 160, 193, 144, 180, 71, 12, 26, 12, 73,
+			123, 100, 124, 130, 191, 5, 116, 12, 131, 229, 214, 165, 63, 192,
+			252, 164, 79, 18, 81, 62, 164, 137, 207, 221, 32, 70, 32, 117,
+			130, 30, 45, 149, 94, 57, 58, 175, 139, 251, 52, 235, 32, 126,
+			220, 164, 212, 7, 119, 205, 208, 181, 180, 157, 25, 85, 194, 112,
+			205, 118, 249, 36, 15, 120, 217, 99, 122, 205, 246, 188, 25, 191,
+			161, 87, 14, 6, 75, 11, 208, 97, 146, 193, 10, 69, 221, 252,
+			152, 74, 226, 5, 23, 25, 216, 30, 229, 74, 18, 172, 118, 84,
+			228, 68, 1, 198, 227, 14, 123, 35, 232, 204, 44, 231, 158, 17,
+			116, 102, 150, 115, 207, 44, 103, 102, 150, 115, 111, 106, 154, 215,
+			13, 74, 68, 176, 139, 222, 180, 191, 7, 49, 74, 195, 199, 76,
+			84, 50, 64, 145, 116, 223, 131, 24, 92, 44, 244, 15, 11, 233,
+			226, 200, 120, 14, 51, 193, 46, 138, 41, 126, 214, 244, 79, 5,
+			123, 163, 39, 252, 19, 249, 110, 148, 157, 8, 96, 91, 59, 72,
+			179, 13, 115, 97, 105, 132, 48, 40, 40, 178, 53, 199, 2, 82,
+			227, 111, 52, 187, 64, 204, 236, 138, 189, 177, 58, 150, 195, 76,
+			176, 55, 78, 76, 226, 114, 102, 240, 240, 77, 116, 214, 8, 9,
+			108, 241, 190, 137, 86, 45, 4, 207, 248, 164, 133, 152, 96, 111,
+			154, 158, 225, 159, 132, 189, 119, 79, 148, 223, 78, 134, 62, 78,
+			136, 255, 17, 114, 128, 203, 163, 17, 108, 96, 134, 231, 195, 86,
+			47, 200, 183, 211, 214, 157, 239, 224, 118, 117, 0, 245, 180, 215,
+			85, 137, 137, 52, 178, 36, 136, 210, 78, 152, 166, 33, 184, 78,
+			206, 185, 145, 75, 89, 238, 161, 161, 220, 165, 92, 166, 107, 113,
+			175, 13, 169, 103, 189, 5, 214, 77, 84, 150, 43, 69, 24, 1,
+			244, 162, 153, 160, 45, 189, 201, 58, 196, 92, 204, 3, 3, 245,
+			118, 82, 153, 224, 31, 132, 229, 224, 209, 33, 225, 253, 24, 161,
+			7, 253, 247, 25, 197, 109, 86, 165, 241, 113, 80, 207, 184, 45,
+			121, 211, 157, 35, 206, 234, 161, 84, 6, 173, 22, 90, 228, 141,
+			40, 128, 103, 32, 107, 206, 249, 169, 193, 75, 137, 74, 227, 246,
+			121, 99, 156, 221, 35, 179, 106, 96, 151, 187, 171, 154, 225, 74,
+			216, 180, 174, 103, 157, 243, 49, 94, 242, 60, 80, 179, 128, 173,
+			111, 65, 2, 200, 239, 188, 193, 130, 12, 192, 253, 7, 180, 3,
+			236, 81, 34, 188, 127, 79, 168, 239, 63, 111, 72, 51, 187, 236,
+			102, 199, 185, 16, 61, 156, 222, 44, 54, 179, 193, 136, 139, 26,
+			116, 52, 2, 248, 35, 51, 108, 218, 77, 6, 224, 202, 105, 193,
+			5, 187, 148, 40, 27, 76, 154, 44, 16, 204, 95, 128, 30, 74,
+			174, 173, 247, 166, 54, 126, 51, 174, 180, 13, 121, 90, 42, 13,
+			87, 35, 216, 64, 232, 69, 65, 103, 217, 120, 3, 109, 240, 169,
+			227, 164, 165, 140, 13, 213, 244, 146, 18, 18, 88, 49, 228, 19,
+			164, 183, 58, 99, 65, 6, 224, 220, 14, 254, 159, 53, 55, 168,
+			240, 158, 2, 110, 124, 238, 82, 220, 0, 119, 192, 148, 133, 108,
+			194, 141, 65, 86, 24, 202, 97, 53, 26, 90, 251, 73, 13, 58,
+			142, 183, 96, 163, 117, 199, 92, 66, 60, 122, 197, 116, 59, 178,
+			251, 194, 61, 235, 161, 106, 82, 105, 9, 105, 179, 140, 128, 137,
+			127, 42, 103, 4, 101, 0, 206, 237, 224, 95, 160, 200, 8, 38,
+			188, 103, 8, 157, 245, 63, 73, 141, 196, 15, 248, 11, 86, 211,
+			161, 33, 181, 43, 8, 232, 91, 215, 42, 168, 48, 247, 200, 25,
+			117, 49, 59, 210, 151, 80, 0, 63, 196, 176, 181, 175, 47, 99,
+			59, 90, 232, 168, 212, 229, 73, 243, 90, 216, 196, 205, 247, 213,
+			48, 226, 18, 254, 11, 50, 84, 247, 117, 110, 124, 132, 254, 206,
+			139, 14, 64, 95, 239, 248, 192, 240, 199, 141, 132, 58, 133, 59,
+			155, 219, 223, 85, 31, 138, 185, 58, 61, 235, 186, 180, 109, 184,
+			135, 136, 111, 107, 12, 53, 122, 134, 189, 172, 132, 252, 180, 204,
+			135, 253, 220, 103, 72, 117, 210, 130, 200, 237, 233, 25, 158, 1,
+			239, 43, 67, 162, 252, 17, 66, 127, 149, 48, 191, 165, 153, 111,
+			249, 107, 176, 48, 66, 105, 145, 0, 179, 11, 105, 24, 192, 184,
+			27, 119, 123, 109, 140, 86, 176, 142, 4, 162, 84, 46, 59, 65,
+			214, 92, 179, 74, 103, 111, 42, 31, 54, 233, 68, 112, 38, 30,
+			182, 40, 86, 134, 136, 240, 62, 66, 42, 227, 124, 17, 144, 160,
+			158, 240, 158, 37, 222, 148, 127, 141, 118, 209, 181, 88, 30, 193,
+			249, 72, 109, 21, 0, 248, 223, 117, 105, 136, 240, 202, 248, 133,
+			37, 17, 84, 232, 179, 164, 58, 102, 65, 6, 224, 132, 224, 11,
+			216, 123, 73, 120, 191, 76, 188, 237, 254, 238, 126, 23, 239, 8,
+			26, 50, 153, 42, 52, 216, 174, 235, 82, 25, 95, 183, 204, 44,
+			17, 0, 71, 44, 247, 74, 12, 192, 233, 89, 126, 16, 187, 46,
+			11, 239, 87, 136, 183, 211, 159, 31, 116, 162, 142, 184, 134, 212,
+			245, 92, 214, 111, 143, 90, 144, 0, 56, 102, 23, 69, 153, 1,
+			56, 231, 243, 191, 160, 156, 122, 37, 81, 254, 109, 2, 89, 76,
+			255, 203, 84, 103, 206, 150, 92, 85, 78, 100, 228, 36, 140, 178,
+			24, 160, 32, 59, 148, 168, 52, 51, 90, 30, 107, 53, 112, 25,
+			244, 41, 126, 112, 138, 16, 212, 223, 6, 137, 146, 171, 42, 82,
+			176, 51, 218, 146, 203, 235, 56, 99, 186, 254, 40, 76, 179, 193,
+			0, 14, 186, 59, 26, 25, 80, 181, 138, 221, 2, 66, 50, 85,
+			144, 92, 135, 153, 106, 230, 1, 147, 83, 199, 43, 73, 208, 81,
+			105, 61, 247, 165, 64, 74, 186, 38, 125, 167, 55, 198, 195, 166,
+			182, 213, 58, 207, 103, 212, 158, 230, 228, 130, 201, 162, 153, 168,
+			34, 236, 40, 88, 172, 160, 161, 48, 179, 132, 157, 239, 77, 173,
+			79, 108, 13, 96, 177, 80, 165, 31, 225, 229, 118, 188, 108, 44,
+			47, 204, 237, 111, 131, 229, 125, 30, 20, 114, 9, 44, 239, 231,
+			9, 221, 227, 255, 166, 81, 200, 155, 236, 59, 228, 38, 177, 208,
+			229, 160, 98, 182, 11, 25, 10, 103, 84, 218, 111, 100, 54, 235,
+			51, 5, 3, 22, 128, 187, 171, 227, 122, 168, 69, 228, 18, 170,
+			59, 114, 15, 207, 104, 23, 24, 213, 102, 107, 96, 218, 90, 241,
+			133, 8, 42, 119, 108, 228, 136, 3, 155, 101, 86, 66, 235, 252,
+			121, 66, 103, 44, 72, 128, 192, 89, 223, 130, 12, 192, 249, 221,
+			252, 63, 32, 249, 108, 72, 148, 191, 68, 232, 255, 32, 204, 127,
+			47, 225, 18, 213, 169, 153, 222, 48, 130, 82, 41, 236, 187, 232,
+			77, 217, 38, 116, 68, 58, 221, 24, 44, 102, 188, 210, 39, 15,
+			198, 10, 45, 72, 21, 52, 215, 100, 51, 78, 116, 129, 34, 70,
+			186, 32, 189, 188, 16, 61, 202, 52, 10, 186, 233, 90, 140, 132,
+			26, 245, 147, 115, 217, 18, 5, 206, 186, 247, 37, 194, 199, 249,
+			91, 33, 210, 45, 129, 175, 44, 188, 63, 38, 222, 172, 255, 40,
+			223, 42, 34, 83, 157, 48, 203, 250, 229, 192, 12, 208, 80, 205,
+			56, 105, 45, 221, 111, 236, 137, 137, 19, 184, 51, 40, 27, 113,
+			70, 123, 99, 141, 205, 56, 31, 6, 148, 32, 220, 1, 28, 10,
+			13, 4, 144, 26, 153, 204, 27, 24, 52, 128, 211, 74, 13, 218,
+			68, 120, 127, 78, 188, 57, 255, 67, 87, 109, 246, 94, 52, 43,
+			167, 173, 199, 178, 90, 13, 163, 127, 61, 86, 206, 114, 20, 188,
+			173, 63, 47, 242, 28, 252, 173, 63, 39, 35, 83, 121, 3, 131,
+			134, 217, 237, 252, 23, 172, 168, 80, 225, 253, 53, 241, 118, 249,
+			63, 109, 150, 120, 174, 17, 77, 129, 9, 212, 216, 194, 220, 186,
+			44, 116, 186, 133, 23, 138, 126, 210, 242, 186, 75, 197, 129, 66,
+			202, 147, 226, 206, 97, 118, 114, 100, 156, 165, 192, 172, 100, 110,
+			228, 48, 119, 208, 10, 27, 8, 22, 127, 112, 163, 254, 186, 72,
+			33, 56, 82, 127, 77, 70, 182, 231, 13, 12, 26, 252, 157, 252,
+			199, 45, 133, 76, 120, 223, 1, 10, 223, 98, 40, 44, 198, 13,
+			54, 92, 117, 81, 209, 139, 77, 27, 186, 197, 110, 189, 90, 36,
+			193, 33, 249, 78, 145, 12, 112, 73, 190, 83, 36, 131, 33, 214,
+			254, 78, 254, 109, 75, 134, 39, 188, 127, 36, 222, 33, 255, 235,
+			87, 66, 198, 2, 40, 252, 66, 6, 55, 45, 18, 211, 23, 9,
+			229, 251, 78, 123, 211, 190, 32, 200, 184, 54, 5, 66, 81, 13,
+			56, 90, 221, 171, 197, 209, 251, 124, 230, 173, 248, 197, 55, 97,
+			24, 24, 220, 176, 163, 10, 60, 2, 143, 230, 31, 137, 183, 43,
+			111, 32, 208, 48, 191, 47, 111, 96, 208, 112, 112, 129, 127, 29,
+			188, 230, 18, 136, 194, 59, 40, 157, 247, 127, 159, 194, 174, 74,
+			174, 114, 131, 180, 169, 80, 89, 29, 66, 71, 93, 181, 140, 42,
+			55, 158, 28, 20, 89, 195, 230, 50, 160, 23, 173, 58, 157, 139,
+			218, 26, 204, 206, 38, 54, 19, 184, 249, 160, 245, 245, 33, 26,
+			212, 115, 208, 223, 45, 36, 12, 148, 172, 233, 41, 170, 45, 200,
+			90, 113, 171, 185, 182, 192, 101, 173, 184, 177, 92, 211, 230, 188,
+			86, 216, 73, 54, 115, 144, 186, 172, 178, 35, 196, 90, 155, 21,
+			16, 86, 21, 53, 215, 55, 142, 110, 51, 70, 45, 181, 2, 169,
+			232, 219, 101, 168, 131, 184, 174, 157, 120, 231, 219, 112, 217, 77,
+			226, 38, 110, 3, 196, 178, 185, 22, 199, 41, 236, 221, 185, 174,
+			157, 237, 36, 30, 242, 215, 129, 101, 0, 71, 38, 44, 136, 220,
+			159, 156, 179, 32, 3, 112, 231, 46, 200, 72, 192, 220, 80, 225,
+			61, 65, 233, 30, 157, 145, 56, 235, 18, 40, 200, 17, 163, 111,
+			140, 202, 236, 231, 178, 149, 217, 184, 11, 142, 80, 208, 198, 250,
+			115, 80, 123, 200, 221, 4, 67, 61, 21, 194, 159, 50, 138, 251,
+			246, 70, 131, 229, 184, 103, 202, 124, 3, 240, 195, 139, 99, 45,
+			72, 109, 213, 96, 151, 50, 81, 168, 229, 93, 120, 104, 208, 112,
+			27, 116, 154, 30, 80, 60, 79, 80, 90, 49, 228, 129, 172, 61,
+			65, 171, 214, 113, 128, 248, 237, 9, 58, 191, 219, 82, 203, 132,
+			247, 228, 70, 106, 141, 157, 253, 23, 161, 182, 56, 214, 21, 80,
+			235, 80, 208, 244, 128, 126, 122, 50, 167, 22, 180, 211, 147, 57,
+			181, 160, 155, 158, 4, 106, 127, 75, 83, 235, 9, 239, 41, 88,
+			119, 31, 181, 212, 230, 230, 218, 42, 164, 205, 134, 122, 81, 168,
+			213, 67, 241, 129, 177, 174, 158, 98, 15, 226, 243, 156, 98, 136,
+			159, 158, 162, 85, 43, 205, 144, 27, 126, 138, 238, 220, 197, 127,
+			30, 53, 141, 55, 36, 202, 63, 71, 233, 135, 41, 243, 159, 68,
+			93, 83, 168, 72, 193, 98, 136, 102, 223, 54, 225, 166, 113, 66,
+			157, 23, 63, 188, 100, 8, 1, 2, 211, 85, 17, 216, 210, 246,
+			250, 161, 194, 39, 203, 237, 184, 249, 6, 56, 15, 196, 101, 164,
+			46, 104, 16, 22, 4, 38, 102, 85, 11, 28, 24, 244, 50, 161,
+			131, 139, 170, 229, 180, 237, 130, 76, 17, 45, 4, 246, 166, 78,
+			71, 201, 48, 229, 91, 249, 54, 131, 195, 106, 7, 29, 29, 37,
+			80, 80, 217, 26, 70, 40, 118, 4, 203, 87, 15, 124, 211, 159,
+			163, 195, 130, 223, 4, 102, 12, 54, 227, 133, 247, 12, 245, 198,
+			252, 107, 7, 195, 49, 163, 175, 242, 113, 234, 82, 107, 122, 248,
+			8, 34, 118, 234, 85, 242, 6, 10, 13, 35, 163, 252, 251, 76,
+			183, 68, 120, 31, 132, 110, 247, 15, 118, 139, 76, 129, 138, 117,
+			37, 87, 31, 11, 187, 178, 163, 64, 96, 210, 66, 231, 224, 36,
+			125, 176, 216, 57, 161, 208, 48, 50, 202, 143, 153, 206, 169, 240,
+			62, 68, 189, 113, 255, 240, 37, 58, 63, 19, 5, 221, 174, 9,
+			237, 90, 230, 121, 113, 20, 208, 24, 31, 162, 214, 228, 67, 167,
+			216, 235, 216, 54, 254, 114, 16, 41, 208, 47, 191, 72, 233, 110,
+			51, 198, 37, 5, 106, 64, 111, 72, 35, 164, 16, 167, 255, 34,
+			165, 99, 22, 36, 0, 110, 219, 97, 65, 6, 224, 174, 121, 87,
+			197, 250, 151, 191, 76, 248, 43, 182, 42, 33, 184, 226, 99, 152,
+			112, 28, 211, 212, 183, 218, 83, 152, 47, 234, 217, 78, 255, 5,
+			84, 218, 254, 211, 75, 108, 127, 132, 113, 126, 143, 202, 26, 96,
+			2, 211, 12, 202, 149, 187, 73, 252, 136, 106, 102, 166, 150, 211,
+			130, 80, 240, 216, 13, 178, 53, 83, 200, 137, 127, 67, 185, 36,
+			18, 96, 170, 32, 53, 144, 23, 81, 66, 1, 25, 179, 69, 148,
+			243, 156, 67, 28, 81, 40, 144, 43, 53, 170, 208, 162, 139, 227,
+			118, 242, 42, 156, 81, 212, 79, 203, 248, 180, 210, 142, 87, 245,
+			195, 235, 249, 182, 40, 142, 206, 229, 9, 6, 172, 123, 173, 52,
+			198, 162, 56, 202, 183, 105, 197, 18, 31, 95, 85, 217, 57, 200,
+			93, 170, 214, 185, 94, 210, 214, 167, 50, 71, 14, 95, 99, 143,
+			131, 230, 148, 214, 207, 132, 171, 209, 3, 141, 147, 6, 108, 140,
+			173, 170, 12, 154, 84, 235, 129, 164, 157, 250, 61, 190, 173, 255,
+			5, 113, 11, 175, 180, 195, 21, 5, 252, 189, 124, 49, 167, 123,
+			21, 106, 245, 180, 240, 34, 227, 42, 13, 3, 229, 76, 50, 172,
+			67, 160, 246, 42, 62, 114, 54, 8, 219, 47, 226, 108, 212, 254,
+			130, 242, 17, 36, 27, 226, 216, 84, 93, 162, 207, 5, 251, 61,
+			116, 58, 114, 120, 214, 50, 205, 165, 45, 240, 36, 162, 233, 215,
+			149, 107, 178, 43, 44, 215, 188, 150, 123, 32, 244, 115, 158, 100,
+			133, 10, 81, 171, 69, 27, 248, 80, 124, 63, 31, 41, 206, 158,
+			174, 47, 220, 221, 55, 123, 154, 140, 122, 62, 87, 13, 158, 230,
+			243, 118, 158, 243, 252, 137, 56, 194, 57, 158, 78, 194, 73, 113,
+			133, 165, 91, 87, 105, 23, 222, 30, 152, 184, 234, 230, 19, 87,
+			181, 19, 247, 100, 137, 143, 190, 170, 167, 146, 245, 23, 113, 234,
+			96, 40, 20, 45, 115, 160, 87, 3, 176, 16, 97, 235, 29, 151,
+			80, 181, 129, 127, 139, 61, 124, 164, 19, 92, 60, 151, 168, 180,
+			215, 206, 82, 179, 126, 120, 39, 184, 216, 208, 45, 27, 42, 218,
+			249, 198, 138, 246, 187, 251, 11, 229, 117, 209, 239, 245, 150, 247,
+			69, 226, 10, 101, 243, 119, 135, 237, 76, 37, 125, 197, 243, 55,
+			242, 82, 164, 46, 168, 100, 110, 244, 178, 252, 214, 47, 138, 27,
+			121, 41, 110, 183, 84, 50, 55, 118, 249, 47, 240, 197, 141, 71,
+			217, 183, 109, 114, 148, 253, 176, 41, 180, 31, 151, 172, 40, 69,
+			125, 148, 12, 150, 216, 223, 236, 78, 61, 79, 96, 121, 238, 174,
+			205, 191, 74, 48, 227, 104, 207, 61, 251, 183, 243, 137, 65, 150,
+			136, 189, 197, 154, 248, 77, 79, 28, 232, 231, 47, 188, 90, 255,
+			58, 62, 108, 16, 129, 242, 216, 99, 247, 159, 189, 119, 98, 72,
+			12, 115, 246, 154, 19, 103, 38, 136, 40, 115, 122, 234, 254, 9,
+			90, 251, 113, 202, 199, 12, 242, 151, 213, 0, 183, 242, 97, 99,
+			206, 77, 73, 245, 32, 249, 118, 241, 225, 75, 13, 251, 178, 19,
+			73, 150, 139, 164, 255, 147, 132, 151, 53, 177, 78, 226, 73, 65,
+			226, 255, 121, 149, 205, 60, 231, 160, 156, 206, 229, 203, 103, 180,
+			81, 133, 22, 60, 61, 83, 251, 59, 194, 71, 78, 134, 233, 21,
+			88, 189, 157, 188, 10, 168, 159, 131, 141, 83, 51, 3, 21, 104,
+			56, 22, 164, 106, 139, 85, 107, 153, 225, 229, 204, 16, 123, 220,
+			218, 130, 179, 94, 230, 254, 2, 179, 104, 238, 143, 218, 235, 112,
+			129, 130, 73, 160, 157, 51, 242, 7, 107, 184, 210, 24, 51, 173,
+			167, 221, 1, 123, 83, 122, 13, 6, 176, 228, 42, 191, 7, 214,
+			127, 101, 112, 253, 215, 254, 39, 229, 163, 154, 226, 203, 10, 193,
+			37, 73, 222, 100, 166, 197, 157, 156, 131, 19, 23, 71, 144, 255,
+			152, 243, 250, 87, 91, 113, 208, 250, 93, 246, 181, 70, 225, 11,
+			255, 207, 8, 175, 186, 39, 238, 12, 143, 17, 22, 248, 91, 220,
+			198, 61, 212, 90, 48, 1, 219, 14, 95, 123, 233, 190, 235, 184,
+			184, 240, 131, 92, 202, 216, 213, 72, 153, 119, 101, 82, 86, 219,
+			207, 61, 91, 156, 126, 250, 40, 174, 62, 40, 96, 63, 219, 56,
+			113, 244, 149, 19, 68, 140, 240, 225, 211, 141, 251, 239, 59, 113,
+			215, 217, 9, 90, 123, 39, 229, 99, 103, 20, 4, 71, 47, 204,
+			64, 192, 219, 65, 150, 169, 36, 50, 172, 183, 32, 8, 97, 162,
+			86, 141, 183, 85, 105, 104, 0, 4, 46, 92, 141, 226, 68, 157,
+			107, 6, 169, 178, 2, 167, 155, 238, 2, 217, 189, 150, 143, 153,
+			90, 133, 115, 144, 59, 182, 54, 99, 212, 52, 226, 246, 155, 91,
+			215, 195, 27, 77, 141, 213, 19, 185, 168, 157, 49, 202, 192, 188,
+			128, 155, 129, 42, 157, 171, 186, 23, 94, 169, 91, 106, 255, 149,
+			241, 109, 150, 23, 151, 149, 198, 219, 6, 85, 210, 188, 157, 195,
+			254, 46, 174, 72, 39, 61, 144, 159, 87, 202, 143, 249, 120, 198,
+			134, 111, 56, 255, 64, 55, 158, 127, 112, 202, 216, 24, 127, 84,
+			198, 254, 251, 9, 47, 33, 113, 98, 145, 123, 192, 77, 227, 106,
+			236, 220, 2, 85, 192, 161, 129, 47, 138, 155, 120, 121, 89, 173,
+			196, 137, 50, 212, 93, 242, 19, 243, 170, 120, 9, 47, 5, 43,
+			153, 74, 230, 216, 229, 191, 209, 111, 250, 201, 37, 149, 241, 173,
+			124, 216, 78, 215, 128, 222, 31, 232, 18, 169, 108, 216, 151, 197,
+			46, 94, 205, 146, 94, 212, 132, 141, 68, 163, 4, 243, 134, 195,
+			239, 162, 220, 131, 154, 122, 81, 231, 236, 30, 149, 9, 177, 209,
+			11, 247, 167, 250, 218, 140, 52, 220, 200, 61, 240, 130, 133, 123,
+			88, 240, 137, 55, 255, 226, 102, 94, 66, 27, 39, 166, 7, 12,
+			150, 254, 102, 102, 160, 213, 124, 245, 18, 16, 135, 52, 203, 199,
+			41, 216, 4, 127, 122, 51, 45, 35, 110, 227, 101, 205, 18, 49,
+			51, 200, 34, 253, 217, 236, 96, 179, 30, 235, 190, 175, 62, 73,
+			244, 233, 148, 63, 97, 255, 87, 93, 130, 242, 234, 252, 116, 202,
+			203, 240, 79, 42, 24, 55, 103, 86, 152, 96, 35, 67, 251, 248,
+			239, 192, 166, 246, 144, 240, 166, 135, 94, 69, 252, 95, 165, 50,
+			159, 126, 187, 43, 100, 110, 48, 49, 23, 151, 244, 18, 101, 182,
+			90, 21, 236, 70, 36, 240, 129, 180, 97, 180, 171, 89, 117, 95,
+			245, 39, 148, 212, 197, 48, 205, 210, 5, 25, 152, 179, 6, 133,
+			193, 48, 183, 156, 246, 154, 112, 52, 152, 195, 117, 35, 65, 210,
+			106, 67, 50, 56, 94, 129, 210, 120, 72, 196, 109, 210, 111, 18,
+			68, 112, 255, 65, 144, 230, 245, 201, 128, 195, 169, 56, 83, 125,
+			251, 70, 26, 61, 217, 9, 214, 101, 162, 178, 94, 18, 201, 21,
+			112, 110, 161, 15, 32, 50, 136, 10, 253, 182, 116, 229, 146, 206,
+			248, 113, 219, 113, 216, 14, 179, 117, 72, 231, 97, 93, 89, 20,
+			180, 161, 98, 12, 206, 244, 135, 81, 223, 101, 46, 211, 21, 193,
+			235, 246, 50, 151, 89, 58, 227, 14, 183, 35, 59, 164, 209, 156,
+			48, 128, 105, 50, 181, 142, 186, 240, 112, 214, 149, 77, 67, 217,
+			225, 108, 117, 194, 66, 112, 49, 201, 212, 52, 255, 101, 106, 15,
+			139, 236, 161, 194, 127, 154, 98, 223, 160, 37, 108, 122, 172, 192,
+			236, 44, 150, 171, 42, 115, 9, 78, 168, 72, 215, 52, 129, 32,
+			5, 182, 96, 221, 188, 172, 251, 208, 211, 122, 230, 222, 163, 135,
+			111, 185, 21, 246, 248, 176, 91, 251, 170, 75, 230, 194, 187, 208,
+			237, 153, 184, 163, 100, 47, 3, 206, 132, 10, 142, 56, 172, 203,
+			149, 48, 106, 201, 110, 144, 166, 176, 141, 28, 36, 120, 150, 42,
+			208, 251, 228, 102, 60, 248, 24, 168, 95, 86, 178, 137, 57, 211,
+			52, 238, 40, 110, 153, 14, 185, 187, 182, 138, 86, 179, 53, 220,
+			118, 92, 71, 185, 143, 187, 25, 124, 1, 221, 218, 62, 1, 77,
+			196, 15, 14, 252, 168, 160, 5, 123, 22, 32, 53, 144, 90, 61,
+			143, 92, 128, 189, 31, 64, 162, 112, 178, 156, 244, 157, 198, 33,
+			120, 26, 167, 120, 232, 100, 207, 196, 36, 95, 178, 135, 78, 106,
+			116, 210, 127, 89, 94, 115, 105, 38, 107, 211, 75, 59, 246, 166,
+			166, 166, 53, 76, 141, 116, 169, 252, 72, 2, 20, 90, 214, 104,
+			185, 112, 10, 165, 54, 236, 206, 164, 48, 193, 106, 227, 19, 230,
+			164, 11, 19, 236, 122, 42, 204, 73, 151, 48, 10, 177, 164, 179,
+			48, 159, 102, 55, 52, 118, 100, 186, 49, 224, 120, 199, 245, 166,
+			164, 23, 247, 240, 216, 245, 238, 130, 27, 56, 222, 113, 253, 196,
+			36, 255, 75, 106, 143, 119, 28, 162, 219, 253, 63, 214, 146, 211,
+			9, 46, 134, 157, 94, 167, 144, 40, 135, 156, 79, 106, 6, 233,
+			37, 81, 221, 222, 156, 160, 211, 225, 122, 239, 198, 166, 0, 97,
+			213, 241, 194, 50, 128, 207, 176, 134, 93, 102, 131, 201, 119, 195,
+			55, 200, 126, 22, 56, 100, 74, 35, 225, 130, 5, 221, 154, 186,
+			123, 79, 240, 163, 186, 60, 154, 166, 189, 14, 76, 35, 128, 152,
+			223, 52, 203, 177, 173, 16, 27, 208, 26, 220, 124, 12, 105, 230,
+			182, 130, 13, 14, 184, 162, 1, 24, 183, 79, 193, 85, 18, 225,
+			10, 188, 121, 62, 140, 219, 238, 114, 15, 44, 211, 205, 17, 223,
+			15, 226, 35, 131, 20, 202, 95, 162, 117, 168, 208, 12, 205, 141,
+			80, 122, 216, 20, 58, 0, 73, 132, 252, 50, 236, 230, 232, 27,
+			12, 0, 47, 91, 236, 105, 122, 114, 83, 2, 151, 122, 28, 114,
+			83, 2, 39, 81, 14, 85, 132, 133, 152, 96, 135, 102, 102, 249,
+			123, 168, 61, 137, 114, 51, 157, 245, 31, 223, 106, 74, 128, 146,
+			68, 53, 227, 164, 149, 246, 171, 13, 87, 148, 237, 138, 13, 245,
+			44, 69, 177, 196, 76, 93, 1, 175, 124, 235, 78, 207, 93, 189,
+			255, 91, 14, 211, 138, 218, 22, 117, 161, 235, 166, 184, 109, 98,
+			123, 112, 243, 151, 171, 149, 101, 115, 93, 22, 92, 80, 179, 162,
+			160, 204, 172, 189, 217, 129, 194, 212, 241, 15, 95, 2, 246, 5,
+			209, 122, 145, 62, 199, 190, 18, 50, 197, 178, 175, 4, 215, 136,
+			84, 38, 45, 4, 151, 136, 76, 207, 240, 183, 120, 246, 32, 204,
+			49, 234, 251, 223, 97, 249, 98, 13, 218, 237, 248, 130, 49, 94,
+			104, 32, 12, 207, 114, 185, 70, 153, 46, 148, 68, 229, 227, 227,
+			46, 74, 161, 93, 127, 184, 175, 165, 86, 130, 94, 59, 219, 111,
+			74, 219, 51, 172, 207, 2, 67, 120, 33, 72, 90, 238, 64, 18,
+			214, 45, 35, 131, 185, 212, 101, 97, 32, 88, 105, 22, 119, 101,
+			184, 98, 181, 47, 160, 165, 162, 86, 225, 30, 13, 52, 81, 56,
+			101, 120, 193, 150, 219, 51, 130, 253, 23, 46, 177, 242, 57, 63,
+			18, 134, 106, 0, 46, 162, 57, 85, 76, 175, 58, 76, 17, 191,
+			68, 117, 226, 243, 230, 194, 36, 140, 68, 112, 153, 106, 169, 6,
+			193, 185, 59, 78, 164, 186, 24, 192, 82, 91, 144, 105, 176, 62,
+			104, 58, 64, 112, 194, 52, 3, 227, 123, 132, 203, 215, 222, 180,
+			32, 111, 94, 144, 183, 46, 200, 219, 94, 191, 21, 131, 96, 102,
+			13, 201, 55, 89, 28, 128, 209, 71, 244, 215, 175, 135, 42, 253,
+			184, 219, 133, 57, 55, 215, 130, 112, 121, 11, 72, 157, 161, 14,
+			8, 218, 48, 39, 125, 20, 65, 111, 125, 168, 56, 97, 41, 151,
+			64, 4, 172, 138, 133, 243, 70, 199, 134, 237, 25, 42, 56, 111,
+			116, 108, 110, 7, 255, 99, 98, 175, 244, 186, 135, 222, 207, 252,
+			223, 197, 203, 157, 236, 100, 45, 24, 207, 194, 220, 208, 134, 3,
+			154, 106, 116, 56, 0, 233, 82, 150, 118, 83, 219, 93, 129, 198,
+			45, 146, 112, 83, 16, 190, 6, 215, 119, 165, 184, 186, 10, 48,
+			42, 46, 216, 4, 135, 1, 227, 164, 80, 216, 135, 117, 118, 92,
+			54, 123, 73, 2, 53, 68, 166, 72, 80, 166, 235, 105, 166, 58,
+			3, 104, 229, 131, 235, 133, 136, 199, 129, 44, 19, 160, 248, 138,
+			221, 195, 231, 248, 189, 249, 21, 99, 75, 222, 1, 255, 251, 204,
+			17, 35, 157, 27, 207, 173, 87, 142, 157, 235, 111, 25, 173, 117,
+			22, 215, 209, 244, 218, 43, 118, 134, 240, 82, 159, 37, 111, 87,
+			14, 19, 193, 150, 230, 175, 207, 97, 38, 216, 210, 190, 253, 252,
+			62, 51, 50, 17, 236, 164, 55, 237, 223, 46, 27, 70, 45, 23,
+			7, 179, 174, 35, 18, 158, 215, 120, 217, 100, 162, 61, 182, 104,
+			251, 6, 147, 125, 178, 112, 89, 21, 24, 237, 147, 213, 241, 28,
+			102, 130, 157, 20, 83, 252, 132, 25, 155, 10, 118, 202, 155, 242,
+			111, 189, 130, 177, 93, 229, 166, 75, 100, 230, 195, 130, 209, 62,
+			85, 24, 22, 14, 64, 156, 170, 110, 203, 97, 38, 216, 169, 73,
+			129, 167, 35, 134, 232, 176, 96, 167, 169, 61, 102, 56, 92, 6,
+			200, 250, 109, 112, 125, 205, 233, 73, 123, 34, 117, 152, 9, 118,
+			250, 218, 235, 248, 79, 193, 233, 8, 34, 188, 7, 134, 90, 196,
+			255, 49, 34, 11, 17, 212, 21, 58, 221, 240, 69, 238, 117, 67,
+			177, 139, 49, 160, 220, 237, 189, 155, 154, 82, 25, 152, 27, 149,
+			10, 203, 219, 200, 128, 169, 220, 41, 142, 103, 28, 89, 96, 243,
+			3, 149, 41, 116, 100, 241, 48, 215, 131, 87, 238, 200, 18, 116,
+			100, 31, 52, 126, 150, 62, 225, 245, 160, 113, 100, 245, 9, 175,
+			7, 173, 35, 75, 128, 175, 15, 255, 63, 71, 246, 234, 28, 89,
+			130, 171, 226, 97, 199, 96, 152, 172, 135, 141, 35, 75, 208, 145,
+			125, 216, 56, 178, 4, 28, 217, 230, 139, 226, 200, 18, 92, 19,
+			77, 163, 101, 9, 58, 178, 77, 227, 200, 18, 92, 15, 205, 241,
+			9, 126, 63, 158, 219, 43, 173, 14, 189, 147, 16, 255, 152, 44,
+			36, 1, 114, 185, 54, 240, 149, 69, 147, 246, 136, 223, 106, 5,
+			214, 184, 57, 226, 23, 210, 25, 255, 165, 112, 69, 38, 10, 160,
+			233, 216, 202, 99, 20, 20, 212, 92, 106, 56, 184, 172, 218, 49,
+			56, 107, 177, 161, 70, 31, 240, 11, 13, 11, 41, 202, 104, 104,
+			100, 84, 31, 240, 11, 167, 166, 181, 205, 64, 74, 99, 186, 211,
+			255, 28, 25, 220, 117, 207, 61, 27, 99, 230, 141, 75, 96, 162,
+			126, 87, 94, 177, 228, 194, 119, 195, 124, 173, 255, 83, 149, 101,
+			246, 252, 135, 121, 176, 23, 142, 148, 96, 47, 182, 232, 13, 38,
+			205, 222, 117, 201, 129, 230, 44, 54, 15, 195, 212, 29, 7, 131,
+			203, 18, 131, 76, 237, 77, 101, 158, 5, 53, 111, 161, 114, 7,
+			211, 179, 156, 23, 129, 58, 38, 192, 73, 174, 216, 232, 42, 10,
+			149, 83, 44, 158, 156, 181, 16, 19, 44, 222, 225, 243, 255, 174,
+			153, 64, 5, 187, 64, 175, 247, 191, 165, 153, 160, 46, 118, 131,
+			8, 138, 247, 54, 73, 191, 186, 123, 74, 109, 13, 30, 4, 204,
+			248, 50, 176, 230, 190, 51, 247, 159, 66, 103, 36, 237, 117, 186,
+			214, 29, 49, 41, 131, 60, 27, 176, 55, 29, 36, 181, 120, 131,
+			162, 53, 88, 238, 152, 195, 237, 92, 198, 160, 15, 46, 132, 169,
+			225, 7, 20, 221, 5, 237, 240, 49, 213, 202, 175, 77, 181, 159,
+			93, 72, 160, 228, 56, 178, 21, 12, 57, 230, 200, 93, 222, 119,
+			164, 156, 82, 240, 47, 47, 152, 35, 229, 20, 133, 225, 194, 46,
+			123, 162, 19, 196, 254, 194, 181, 215, 241, 239, 71, 22, 49, 193,
+			30, 163, 215, 250, 135, 65, 203, 228, 165, 124, 38, 224, 208, 5,
+			44, 118, 93, 183, 54, 113, 122, 41, 101, 30, 244, 224, 160, 178,
+			96, 143, 141, 236, 176, 16, 17, 236, 49, 127, 183, 133, 96, 172,
+			107, 106, 252, 21, 48, 48, 27, 18, 165, 55, 209, 31, 34, 204,
+			127, 153, 188, 55, 110, 183, 210, 173, 10, 178, 250, 214, 185, 54,
+			201, 224, 220, 175, 131, 113, 180, 72, 160, 31, 241, 38, 62, 205,
+			111, 231, 101, 128, 64, 249, 191, 217, 59, 228, 47, 108, 184, 24,
+			48, 76, 55, 56, 17, 184, 65, 107, 15, 13, 83, 227, 58, 188,
+			217, 220, 7, 72, 141, 235, 240, 230, 221, 251, 114, 152, 9, 246,
+			230, 131, 11, 252, 136, 25, 140, 8, 239, 109, 196, 155, 245, 15,
+			152, 195, 148, 136, 99, 97, 197, 61, 208, 56, 185, 0, 158, 180,
+			91, 71, 166, 78, 147, 154, 162, 227, 183, 217, 90, 86, 106, 138,
+			142, 223, 102, 11, 189, 169, 41, 58, 126, 27, 153, 158, 193, 98,
+			29, 104, 160, 194, 123, 156, 120, 51, 254, 254, 193, 225, 208, 199,
+			190, 228, 104, 80, 39, 243, 120, 113, 52, 168, 171, 121, 156, 140,
+			76, 228, 13, 12, 26, 166, 166, 121, 23, 38, 9, 78, 210, 188,
+			131, 208, 121, 127, 25, 206, 65, 218, 74, 179, 226, 152, 122, 42,
+			54, 122, 68, 27, 176, 144, 231, 195, 0, 138, 54, 195, 213, 200,
+			92, 107, 212, 75, 218, 231, 172, 139, 87, 51, 37, 79, 20, 11,
+			87, 223, 65, 232, 168, 5, 161, 48, 146, 140, 205, 89, 16, 10,
+			35, 201, 206, 93, 188, 129, 71, 142, 203, 63, 74, 134, 190, 73,
+			136, 127, 92, 22, 115, 179, 87, 232, 141, 224, 39, 69, 181, 13,
+			167, 39, 161, 88, 239, 71, 73, 101, 154, 223, 194, 61, 15, 178,
+			169, 229, 119, 19, 250, 239, 8, 243, 175, 151, 102, 99, 180, 184,
+			72, 2, 153, 153, 70, 12, 72, 13, 17, 120, 63, 178, 247, 110,
+			50, 172, 79, 237, 51, 200, 132, 9, 239, 9, 226, 141, 249, 183,
+			202, 99, 113, 182, 38, 187, 113, 26, 226, 173, 154, 160, 129, 35,
+			181, 170, 175, 216, 52, 91, 109, 78, 83, 20, 140, 25, 76, 15,
+			51, 165, 92, 79, 16, 83, 109, 197, 76, 41, 215, 19, 196, 148,
+			114, 49, 93, 202, 245, 30, 226, 141, 250, 251, 37, 108, 2, 230,
+			35, 93, 65, 231, 32, 122, 239, 33, 222, 112, 222, 64, 161, 129,
+			143, 184, 206, 169, 240, 222, 75, 188, 17, 219, 249, 213, 96, 14,
+			146, 246, 94, 226, 149, 243, 6, 236, 172, 202, 241, 204, 22, 28,
+			2, 247, 126, 130, 92, 153, 191, 54, 102, 79, 132, 195, 23, 21,
+			11, 18, 225, 253, 4, 169, 78, 88, 144, 1, 56, 53, 205, 255,
+			75, 197, 156, 5, 246, 158, 37, 84, 248, 95, 168, 96, 255, 250,
+			146, 139, 110, 144, 4, 29, 149, 169, 196, 86, 4, 131, 107, 101,
+			175, 245, 0, 53, 4, 201, 193, 180, 183, 156, 102, 97, 214, 203,
+			192, 107, 91, 109, 199, 203, 114, 95, 237, 64, 109, 63, 250, 229,
+			133, 226, 117, 248, 20, 76, 132, 221, 49, 148, 103, 193, 83, 9,
+			33, 109, 28, 217, 35, 98, 218, 123, 49, 59, 29, 70, 68, 225,
+			74, 78, 115, 117, 138, 17, 210, 71, 123, 65, 59, 92, 193, 115,
+			165, 253, 9, 229, 48, 115, 73, 19, 168, 178, 13, 178, 194, 232,
+			56, 205, 184, 56, 173, 33, 128, 21, 219, 139, 48, 60, 194, 235,
+			175, 219, 45, 184, 134, 19, 221, 196, 160, 219, 85, 65, 2, 25,
+			162, 32, 199, 88, 6, 89, 49, 200, 95, 142, 109, 176, 216, 205,
+			15, 120, 162, 155, 162, 121, 231, 190, 75, 235, 178, 118, 224, 64,
+			205, 145, 5, 135, 69, 115, 178, 10, 175, 229, 38, 211, 70, 176,
+			218, 27, 213, 253, 185, 75, 28, 242, 16, 22, 159, 166, 10, 102,
+			9, 44, 244, 190, 218, 193, 218, 254, 66, 254, 108, 89, 73, 176,
+			11, 160, 91, 224, 92, 199, 74, 161, 240, 24, 52, 0, 32, 5,
+			243, 122, 66, 103, 12, 210, 35, 112, 232, 227, 144, 60, 209, 233,
+			102, 235, 114, 95, 173, 182, 191, 47, 68, 7, 172, 205, 54, 95,
+			93, 191, 120, 224, 192, 226, 193, 197, 3, 7, 46, 243, 214, 74,
+			28, 47, 46, 7, 201, 37, 94, 116, 97, 183, 172, 153, 151, 107,
+			6, 203, 13, 93, 44, 30, 92, 92, 14, 30, 219, 178, 35, 44,
+			23, 141, 236, 73, 217, 254, 46, 161, 43, 57, 56, 85, 45, 89,
+			91, 14, 30, 171, 201, 125, 170, 190, 90, 95, 112, 47, 47, 62,
+			218, 187, 184, 216, 142, 219, 122, 184, 218, 254, 126, 52, 46, 69,
+			180, 166, 37, 184, 20, 37, 151, 35, 194, 244, 144, 93, 136, 15,
+			57, 217, 176, 120, 95, 88, 139, 83, 5, 93, 73, 115, 114, 199,
+			165, 8, 97, 192, 26, 138, 32, 190, 163, 239, 150, 130, 118, 160,
+			175, 159, 143, 91, 143, 220, 255, 165, 37, 193, 124, 125, 96, 241,
+			114, 51, 216, 135, 49, 32, 144, 58, 93, 4, 86, 252, 217, 92,
+			23, 145, 226, 249, 81, 6, 78, 41, 156, 31, 157, 228, 45, 84,
+			69, 84, 120, 207, 17, 58, 233, 191, 186, 24, 222, 0, 182, 133,
+			232, 198, 140, 188, 215, 28, 214, 27, 12, 111, 92, 220, 21, 175,
+			200, 71, 122, 80, 159, 15, 186, 225, 180, 222, 225, 208, 56, 128,
+			169, 127, 142, 208, 178, 5, 9, 128, 195, 163, 22, 100, 0, 142,
+			79, 240, 119, 128, 163, 204, 40, 19, 222, 199, 1, 167, 199, 114,
+			156, 48, 221, 213, 103, 72, 221, 197, 210, 89, 92, 84, 242, 160,
+			71, 54, 241, 92, 185, 185, 222, 60, 199, 180, 165, 10, 175, 129,
+			75, 157, 43, 189, 156, 149, 80, 60, 255, 241, 28, 111, 176, 199,
+			31, 207, 241, 134, 226, 249, 143, 147, 241, 9, 254, 131, 136, 182,
+			39, 188, 79, 128, 86, 239, 202, 83, 234, 98, 134, 110, 15, 68,
+			13, 152, 99, 90, 216, 120, 103, 121, 152, 26, 101, 99, 238, 91,
+			176, 247, 207, 88, 213, 135, 142, 0, 47, 220, 219, 222, 77, 212,
+			249, 16, 18, 134, 250, 179, 182, 90, 1, 135, 120, 197, 33, 11,
+			117, 239, 159, 200, 231, 221, 35, 0, 186, 121, 135, 186, 247, 79,
+			192, 188, 227, 1, 125, 6, 51, 242, 105, 66, 231, 32, 38, 123,
+			165, 43, 163, 177, 206, 202, 198, 12, 186, 30, 211, 154, 214, 124,
+			115, 67, 235, 209, 254, 30, 92, 238, 187, 215, 237, 66, 134, 1,
+			180, 190, 179, 198, 150, 15, 173, 186, 188, 55, 190, 160, 206, 171,
+			4, 195, 13, 187, 3, 161, 90, 102, 16, 147, 127, 119, 191, 66,
+			176, 12, 154, 122, 217, 218, 223, 45, 118, 16, 53, 229, 37, 77,
+			219, 176, 161, 28, 78, 194, 126, 154, 84, 166, 44, 200, 0, 156,
+			221, 206, 215, 144, 15, 101, 225, 253, 71, 66, 119, 250, 15, 217,
+			235, 31, 206, 174, 119, 213, 224, 228, 193, 17, 228, 36, 108, 102,
+			105, 145, 3, 253, 11, 178, 96, 73, 248, 224, 77, 24, 122, 224,
+			114, 9, 135, 178, 243, 83, 38, 0, 154, 123, 3, 24, 45, 51,
+			0, 231, 124, 237, 129, 192, 121, 213, 207, 17, 250, 5, 194, 236,
+			173, 40, 198, 102, 175, 119, 49, 55, 178, 130, 101, 137, 50, 142,
+			108, 239, 16, 116, 120, 159, 35, 220, 231, 55, 155, 75, 79, 134,
+			132, 247, 123, 196, 219, 227, 95, 135, 223, 231, 101, 121, 198, 106,
+			15, 116, 50, 110, 46, 45, 129, 83, 181, 191, 71, 188, 233, 188,
+			129, 64, 195, 140, 159, 55, 48, 104, 152, 135, 56, 10, 248, 55,
+			44, 188, 223, 39, 244, 58, 67, 197, 112, 25, 65, 97, 65, 2,
+			224, 212, 110, 11, 50, 0, 175, 185, 150, 159, 5, 26, 105, 69,
+			120, 127, 64, 232, 94, 255, 110, 121, 10, 119, 146, 47, 201, 101,
+			243, 75, 60, 18, 203, 61, 52, 187, 181, 191, 1, 219, 205, 65,
+			150, 179, 185, 82, 198, 110, 119, 90, 144, 0, 184, 235, 26, 11,
+			50, 0, 175, 187, 129, 63, 128, 40, 84, 133, 247, 37, 64, 225,
+			30, 121, 127, 187, 117, 165, 40, 232, 42, 149, 75, 225, 80, 45,
+			99, 191, 22, 135, 42, 158, 234, 117, 56, 84, 25, 128, 215, 221,
+			128, 55, 133, 51, 202, 133, 247, 21, 66, 119, 249, 109, 185, 212,
+			39, 116, 78, 180, 173, 222, 115, 8, 101, 104, 60, 180, 241, 217,
+			240, 147, 38, 64, 64, 180, 202, 251, 60, 59, 231, 227, 152, 151,
+			28, 162, 188, 132, 131, 91, 153, 228, 4, 192, 234, 172, 5, 25,
+			128, 59, 118, 242, 63, 131, 84, 35, 163, 35, 194, 251, 83, 66,
+			165, 255, 69, 42, 161, 162, 211, 106, 11, 123, 180, 4, 154, 178,
+			56, 199, 27, 209, 214, 138, 3, 214, 8, 248, 63, 71, 225, 67,
+			19, 167, 129, 243, 231, 146, 144, 71, 184, 60, 36, 143, 22, 238,
+			140, 195, 239, 64, 109, 202, 11, 107, 33, 28, 175, 134, 155, 93,
+			138, 124, 128, 13, 1, 55, 20, 204, 10, 110, 131, 165, 96, 64,
+			53, 103, 50, 184, 147, 78, 231, 107, 140, 210, 205, 123, 239, 6,
+			97, 82, 119, 67, 26, 63, 32, 178, 59, 41, 114, 95, 20, 182,
+			247, 235, 133, 114, 25, 20, 96, 56, 135, 69, 150, 90, 44, 236,
+			207, 27, 156, 183, 137, 180, 96, 21, 104, 91, 216, 202, 137, 118,
+			19, 50, 82, 70, 30, 91, 173, 48, 66, 0, 52, 167, 218, 25,
+			29, 97, 0, 206, 239, 225, 15, 226, 124, 140, 10, 239, 47, 224,
+			110, 145, 37, 169, 203, 39, 11, 226, 155, 179, 190, 32, 192, 57,
+			82, 113, 130, 255, 70, 123, 179, 226, 109, 253, 14, 139, 209, 50,
+			246, 92, 181, 32, 1, 144, 219, 112, 102, 148, 1, 56, 53, 195,
+			207, 234, 27, 138, 254, 138, 12, 189, 139, 18, 255, 110, 27, 247,
+			94, 93, 186, 114, 211, 200, 23, 140, 215, 95, 145, 202, 12, 230,
+			96, 241, 218, 160, 111, 65, 60, 118, 251, 229, 83, 150, 224, 42,
+			217, 33, 251, 179, 150, 230, 218, 158, 146, 240, 190, 101, 37, 222,
+			67, 245, 246, 45, 27, 169, 121, 168, 220, 190, 69, 166, 166, 249,
+			93, 48, 46, 104, 225, 191, 33, 244, 173, 148, 249, 55, 153, 139,
+			59, 250, 3, 110, 200, 42, 183, 45, 163, 139, 132, 230, 231, 209,
+			60, 212, 204, 127, 67, 248, 4, 94, 119, 229, 129, 6, 21, 222,
+			183, 137, 55, 237, 239, 70, 39, 201, 146, 82, 200, 209, 152, 164,
+			58, 104, 92, 207, 156, 191, 255, 182, 77, 148, 120, 70, 39, 127,
+			155, 140, 140, 231, 13, 12, 26, 196, 20, 255, 38, 49, 99, 16,
+			225, 253, 61, 241, 230, 253, 47, 17, 147, 14, 221, 56, 202, 191,
+			226, 220, 171, 165, 155, 148, 145, 76, 225, 24, 1, 206, 238, 223,
+			147, 169, 185, 188, 129, 65, 195, 206, 93, 252, 175, 168, 225, 12,
+			21, 222, 247, 136, 183, 215, 255, 35, 189, 97, 2, 174, 223, 161,
+			110, 208, 124, 131, 106, 109, 193, 28, 171, 97, 129, 23, 71, 139,
+			40, 170, 129, 59, 20, 140, 110, 86, 122, 90, 115, 183, 0, 157,
+			124, 115, 119, 133, 141, 58, 173, 200, 108, 198, 183, 48, 205, 47,
+			163, 44, 224, 97, 60, 40, 158, 39, 109, 45, 103, 183, 200, 244,
+			30, 223, 240, 237, 86, 249, 222, 252, 77, 221, 211, 134, 215, 11,
+			212, 228, 94, 115, 142, 27, 119, 102, 168, 48, 57, 180, 140, 156,
+			158, 207, 27, 8, 52, 236, 174, 229, 13, 12, 26, 174, 191, 129,
+			191, 220, 204, 13, 19, 222, 91, 168, 55, 231, 223, 40, 207, 246,
+			15, 85, 152, 153, 227, 155, 206, 140, 237, 18, 60, 246, 183, 80,
+			175, 154, 55, 16, 104, 224, 211, 121, 3, 14, 50, 187, 29, 157,
+			16, 188, 182, 235, 113, 56, 172, 119, 55, 14, 217, 14, 83, 188,
+			153, 185, 79, 99, 226, 175, 17, 153, 51, 239, 166, 104, 39, 183,
+			2, 118, 179, 13, 39, 212, 105, 25, 56, 96, 253, 184, 61, 96,
+			237, 161, 156, 62, 78, 221, 69, 59, 32, 165, 143, 83, 177, 195,
+			130, 144, 230, 164, 187, 230, 249, 87, 221, 221, 89, 239, 164, 84,
+			248, 159, 39, 114, 233, 210, 49, 132, 61, 21, 219, 137, 147, 92,
+			164, 140, 109, 202, 139, 249, 128, 178, 52, 183, 90, 155, 45, 230,
+			68, 117, 85, 224, 150, 243, 171, 138, 194, 233, 166, 158, 155, 43,
+			182, 64, 184, 181, 160, 66, 8, 140, 174, 197, 186, 171, 227, 41,
+			164, 132, 20, 198, 65, 110, 47, 192, 92, 143, 85, 18, 222, 59,
+			237, 225, 92, 216, 35, 1, 208, 4, 41, 144, 149, 7, 112, 98,
+			146, 63, 237, 233, 123, 130, 158, 164, 67, 191, 73, 137, 255, 164,
+			39, 11, 165, 171, 86, 99, 90, 4, 183, 48, 44, 240, 69, 209,
+			174, 0, 27, 250, 27, 165, 138, 128, 67, 160, 201, 245, 111, 216,
+			197, 201, 250, 161, 44, 81, 112, 229, 215, 58, 92, 49, 156, 4,
+			224, 48, 5, 109, 59, 201, 198, 220, 112, 119, 225, 144, 145, 201,
+			180, 27, 52, 213, 134, 170, 144, 112, 197, 217, 167, 90, 103, 29,
+			254, 172, 201, 181, 192, 157, 40, 149, 181, 192, 36, 61, 22, 100,
+			128, 146, 7, 92, 188, 0, 193, 160, 141, 134, 208, 43, 50, 84,
+			31, 177, 157, 221, 81, 171, 45, 96, 178, 10, 255, 176, 230, 246,
+			136, 124, 163, 30, 227, 77, 114, 159, 53, 146, 96, 21, 211, 253,
+			155, 247, 97, 16, 218, 188, 167, 0, 58, 129, 121, 118, 57, 134,
+			43, 236, 38, 232, 239, 231, 224, 134, 126, 174, 176, 155, 197, 131,
+			253, 29, 45, 7, 143, 189, 73, 238, 51, 38, 184, 208, 153, 187,
+			234, 232, 73, 90, 153, 226, 63, 96, 111, 58, 122, 31, 165, 51,
+			126, 132, 19, 110, 134, 0, 213, 108, 151, 168, 43, 220, 10, 83,
+			187, 178, 50, 187, 246, 237, 34, 49, 151, 159, 199, 23, 48, 107,
+			99, 58, 201, 215, 157, 118, 93, 240, 42, 163, 160, 9, 23, 46,
+			24, 1, 47, 193, 174, 168, 247, 190, 252, 244, 57, 152, 234, 247,
+			81, 227, 95, 232, 107, 137, 222, 71, 167, 166, 249, 179, 196, 222,
+			115, 241, 52, 165, 219, 253, 159, 33, 121, 202, 23, 206, 165, 244,
+			161, 219, 39, 84, 185, 212, 65, 18, 177, 152, 41, 91, 14, 30,
+			179, 13, 7, 33, 171, 134, 230, 202, 249, 128, 238, 200, 11, 38,
+			155, 106, 125, 233, 165, 218, 114, 144, 232, 132, 86, 13, 248, 142,
+			217, 214, 129, 20, 114, 78, 32, 164, 151, 158, 206, 9, 4, 93,
+			246, 52, 173, 10, 11, 50, 0, 103, 102, 249, 57, 123, 87, 196,
+			7, 40, 157, 244, 95, 165, 77, 60, 198, 213, 87, 147, 101, 234,
+			207, 44, 65, 73, 0, 47, 102, 150, 244, 101, 14, 31, 160, 38,
+			67, 83, 66, 125, 242, 1, 106, 50, 52, 250, 50, 135, 15, 208,
+			241, 9, 126, 209, 222, 229, 240, 97, 80, 172, 143, 200, 165, 23,
+			33, 55, 163, 83, 51, 252, 10, 114, 51, 250, 22, 134, 15, 231,
+			76, 131, 68, 210, 135, 173, 218, 43, 193, 94, 164, 247, 97, 58,
+			49, 201, 19, 123, 9, 195, 47, 129, 80, 180, 242, 252, 87, 113,
+			182, 224, 132, 149, 229, 86, 93, 222, 159, 91, 241, 190, 31, 182,
+			235, 246, 47, 95, 222, 127, 245, 185, 77, 182, 56, 4, 33, 121,
+			244, 75, 57, 31, 193, 255, 254, 37, 56, 235, 111, 64, 6, 224,
+			204, 44, 127, 130, 216, 19, 238, 31, 163, 212, 247, 223, 90, 248,
+			129, 191, 1, 38, 234, 83, 94, 22, 79, 203, 200, 116, 45, 190,
+			0, 23, 151, 24, 3, 97, 148, 158, 217, 230, 6, 181, 42, 85,
+			146, 196, 9, 136, 73, 128, 5, 134, 248, 195, 111, 122, 181, 25,
+			117, 15, 253, 187, 155, 38, 28, 254, 144, 243, 249, 88, 142, 63,
+			228, 124, 62, 70, 135, 237, 229, 96, 112, 179, 221, 199, 232, 220,
+			14, 254, 195, 26, 255, 178, 240, 126, 141, 210, 41, 127, 93, 234,
+			223, 238, 64, 185, 188, 243, 14, 121, 35, 48, 81, 155, 19, 227,
+			46, 131, 185, 136, 187, 80, 170, 8, 91, 48, 16, 75, 189, 33,
+			236, 246, 199, 212, 133, 123, 43, 140, 246, 48, 191, 248, 96, 12,
+			45, 214, 117, 130, 101, 234, 6, 171, 97, 20, 244, 161, 13, 57,
+			161, 95, 163, 38, 85, 85, 194, 107, 243, 126, 141, 86, 182, 89,
+			144, 193, 211, 73, 193, 127, 85, 163, 61, 44, 188, 223, 160, 116,
+			206, 127, 134, 108, 81, 231, 170, 151, 235, 38, 9, 186, 151, 33,
+			109, 87, 151, 146, 115, 66, 98, 60, 7, 254, 130, 83, 114, 37,
+			58, 92, 66, 212, 45, 157, 195, 4, 64, 147, 146, 43, 97, 94,
+			232, 55, 232, 236, 118, 220, 72, 45, 139, 242, 167, 233, 208, 127,
+			163, 176, 145, 90, 60, 154, 146, 91, 253, 75, 199, 147, 131, 102,
+			31, 44, 4, 240, 245, 211, 180, 50, 205, 127, 7, 24, 89, 6,
+			19, 241, 89, 48, 17, 31, 35, 253, 54, 98, 64, 23, 245, 133,
+			143, 214, 215, 182, 94, 7, 252, 122, 159, 29, 216, 117, 16, 230,
+			87, 155, 129, 108, 203, 181, 80, 37, 112, 154, 102, 189, 80, 119,
+			193, 93, 229, 161, 185, 246, 207, 92, 18, 161, 245, 44, 58, 2,
+			253, 245, 147, 182, 119, 213, 86, 157, 130, 42, 46, 163, 173, 249,
+			172, 213, 42, 101, 140, 101, 63, 107, 109, 77, 25, 109, 205, 103,
+			193, 214, 220, 138, 52, 67, 126, 16, 180, 202, 62, 36, 217, 33,
+			102, 126, 145, 0, 40, 7, 138, 12, 117, 78, 51, 148, 81, 223,
+			127, 46, 31, 4, 244, 253, 231, 172, 190, 47, 99, 124, 245, 57,
+			58, 51, 171, 43, 125, 202, 160, 240, 159, 7, 21, 251, 187, 87,
+			231, 187, 246, 217, 222, 127, 138, 235, 138, 179, 255, 207, 224, 185,
+			150, 209, 210, 60, 159, 243, 1, 44, 205, 243, 86, 133, 151, 209,
+			210, 60, 15, 42, 28, 153, 13, 137, 131, 47, 82, 250, 109, 202,
+			252, 27, 76, 40, 3, 246, 0, 231, 214, 41, 197, 194, 164, 219,
+			65, 48, 87, 240, 69, 202, 167, 248, 4, 47, 3, 8, 123, 254,
+			95, 162, 222, 87, 104, 9, 227, 23, 108, 129, 100, 34, 12, 60,
+			193, 43, 250, 21, 16, 231, 47, 211, 242, 56, 159, 228, 85, 219,
+			66, 176, 137, 23, 155, 40, 52, 141, 109, 43, 124, 71, 132, 247,
+			135, 180, 60, 89, 120, 9, 102, 247, 15, 105, 121, 180, 216, 68,
+			161, 105, 124, 162, 240, 29, 21, 222, 31, 209, 178, 40, 188, 4,
+			220, 248, 35, 90, 30, 43, 54, 225, 91, 19, 147, 160, 115, 145,
+			22, 64, 243, 107, 212, 155, 246, 47, 224, 157, 188, 118, 61, 131,
+			147, 234, 206, 121, 245, 91, 173, 186, 148, 15, 194, 79, 122, 52,
+			227, 206, 50, 220, 124, 85, 152, 80, 167, 5, 224, 212, 110, 97,
+			113, 129, 46, 232, 20, 110, 150, 220, 144, 80, 41, 155, 132, 202,
+			215, 236, 5, 46, 101, 147, 80, 249, 26, 53, 9, 149, 178, 73,
+			168, 124, 141, 138, 41, 190, 96, 112, 39, 194, 251, 58, 245, 132,
+			191, 11, 167, 19, 242, 250, 118, 157, 231, 216, 230, 253, 65, 188,
+			247, 117, 123, 15, 77, 217, 228, 37, 190, 14, 147, 230, 26, 24,
+			52, 76, 76, 242, 159, 166, 102, 4, 42, 188, 111, 82, 111, 222,
+			127, 23, 53, 201, 0, 195, 159, 66, 152, 113, 69, 25, 27, 251,
+			51, 39, 28, 127, 74, 21, 116, 205, 74, 216, 110, 195, 54, 93,
+			238, 245, 6, 82, 159, 226, 237, 67, 254, 255, 212, 68, 79, 217,
+			228, 18, 190, 73, 61, 145, 55, 16, 104, 48, 137, 158, 178, 201,
+			37, 124, 19, 238, 117, 250, 9, 203, 80, 38, 188, 191, 165, 222,
+			94, 255, 135, 105, 113, 56, 195, 213, 171, 72, 251, 188, 112, 134,
+			190, 104, 217, 34, 163, 30, 255, 105, 201, 162, 156, 155, 172, 140,
+			172, 153, 207, 27, 8, 52, 152, 204, 76, 217, 36, 73, 254, 150,
+			94, 127, 3, 110, 242, 148, 41, 19, 222, 223, 81, 179, 201, 83,
+			134, 34, 59, 0, 173, 98, 132, 222, 254, 142, 142, 76, 91, 144,
+			0, 56, 179, 199, 130, 248, 109, 237, 90, 48, 16, 212, 27, 22,
+			229, 127, 160, 67, 63, 205, 8, 28, 31, 232, 59, 88, 106, 39,
+			230, 50, 65, 189, 254, 166, 104, 223, 77, 55, 50, 109, 6, 230,
+			226, 123, 179, 251, 150, 226, 10, 213, 63, 44, 13, 41, 44, 56,
+			246, 93, 152, 235, 116, 193, 29, 138, 140, 225, 86, 178, 12, 61,
+			249, 117, 204, 139, 235, 164, 184, 189, 234, 107, 1, 215, 24, 28,
+			103, 134, 19, 122, 246, 216, 141, 52, 7, 212, 141, 139, 1, 46,
+			205, 63, 208, 202, 12, 238, 223, 13, 131, 174, 251, 46, 189, 242,
+			10, 162, 97, 180, 229, 223, 181, 230, 101, 24, 21, 248, 119, 173,
+			45, 31, 70, 91, 254, 93, 176, 229, 159, 1, 51, 59, 12, 234,
+			246, 123, 96, 102, 127, 165, 16, 55, 154, 136, 69, 181, 85, 211,
+			173, 215, 2, 185, 32, 110, 41, 178, 202, 149, 129, 187, 61, 117,
+			20, 186, 116, 61, 202, 130, 139, 176, 129, 94, 172, 95, 219, 155,
+			2, 161, 107, 245, 190, 212, 6, 119, 21, 11, 139, 80, 109, 3,
+			171, 195, 244, 125, 165, 197, 38, 134, 46, 112, 46, 190, 151, 83,
+			13, 106, 242, 123, 214, 168, 14, 163, 115, 241, 61, 48, 170, 119,
+			32, 209, 84, 120, 111, 101, 116, 198, 95, 68, 154, 113, 58, 29,
+			77, 48, 69, 24, 70, 192, 77, 221, 86, 145, 216, 227, 29, 186,
+			55, 48, 224, 111, 101, 110, 44, 224, 225, 91, 153, 227, 48, 200,
+			248, 91, 217, 212, 52, 127, 3, 142, 5, 41, 57, 70, 39, 253,
+			215, 231, 1, 142, 153, 111, 16, 212, 0, 246, 113, 122, 237, 32,
+			41, 214, 103, 237, 107, 156, 56, 108, 152, 184, 191, 63, 46, 3,
+			159, 217, 110, 200, 181, 100, 27, 126, 252, 38, 104, 183, 115, 204,
+			32, 58, 124, 156, 153, 224, 101, 24, 87, 208, 227, 204, 4, 177,
+			195, 184, 130, 30, 103, 227, 19, 184, 39, 49, 12, 209, 225, 219,
+			25, 221, 238, 223, 178, 41, 102, 118, 16, 123, 76, 76, 239, 55,
+			193, 220, 195, 61, 9, 110, 68, 8, 247, 222, 158, 143, 8, 225,
+			222, 219, 153, 9, 247, 134, 161, 152, 209, 123, 59, 155, 153, 229,
+			175, 197, 17, 75, 194, 123, 23, 163, 190, 255, 202, 129, 27, 1,
+			205, 85, 10, 230, 135, 131, 92, 200, 97, 247, 71, 193, 199, 210,
+			187, 181, 120, 115, 157, 93, 57, 250, 119, 116, 28, 38, 16, 184,
+			189, 139, 153, 8, 104, 24, 126, 248, 217, 123, 23, 171, 204, 88,
+			144, 193, 211, 185, 29, 250, 231, 84, 134, 193, 12, 188, 155, 81,
+			225, 39, 87, 92, 99, 97, 196, 99, 243, 64, 158, 23, 35, 249,
+			254, 64, 222, 124, 55, 16, 201, 15, 99, 196, 246, 238, 92, 138,
+			32, 178, 120, 55, 115, 18, 91, 102, 0, 78, 76, 218, 117, 58,
+			44, 188, 247, 50, 58, 231, 255, 202, 86, 17, 219, 192, 2, 133,
+			216, 41, 132, 141, 104, 123, 29, 105, 174, 38, 242, 27, 21, 175,
+			50, 140, 203, 7, 51, 3, 241, 60, 132, 187, 178, 248, 109, 24,
+			227, 183, 247, 230, 179, 4, 202, 238, 189, 204, 196, 111, 195, 24,
+			191, 189, 151, 205, 110, 231, 191, 173, 169, 174, 8, 239, 167, 128,
+			234, 231, 182, 162, 218, 237, 77, 59, 153, 1, 13, 139, 98, 146,
+			51, 228, 197, 161, 217, 12, 245, 2, 104, 174, 148, 144, 12, 75,
+			115, 133, 0, 232, 104, 174, 48, 0, 103, 183, 243, 87, 115, 234,
+			85, 68, 249, 41, 54, 244, 123, 140, 248, 247, 58, 171, 102, 252,
+			83, 103, 214, 46, 29, 181, 110, 180, 107, 16, 183, 194, 152, 79,
+			177, 202, 44, 110, 131, 86, 192, 168, 188, 159, 189, 24, 219, 160,
+			21, 52, 55, 239, 183, 98, 92, 65, 115, 243, 126, 171, 12, 43,
+			104, 110, 222, 15, 202, 240, 70, 24, 23, 162, 153, 159, 101, 244,
+			23, 24, 243, 165, 185, 97, 187, 176, 249, 9, 171, 25, 24, 13,
+			250, 216, 118, 143, 113, 204, 207, 50, 190, 141, 191, 158, 151, 1,
+			4, 212, 159, 102, 222, 140, 209, 33, 131, 183, 121, 187, 159, 225,
+			210, 151, 227, 23, 100, 160, 239, 70, 111, 119, 153, 55, 47, 94,
+			151, 93, 49, 30, 253, 211, 204, 120, 244, 21, 227, 209, 63, 205,
+			76, 45, 121, 197, 120, 244, 79, 3, 73, 239, 33, 6, 37, 34,
+			188, 103, 152, 183, 11, 146, 88, 133, 10, 155, 190, 11, 188, 243,
+			162, 242, 181, 184, 109, 14, 43, 43, 115, 213, 184, 115, 205, 34,
+			37, 211, 110, 16, 193, 47, 231, 128, 0, 22, 126, 152, 7, 175,
+			47, 205, 182, 186, 29, 92, 87, 21, 198, 145, 189, 41, 185, 98,
+			42, 240, 159, 41, 210, 1, 22, 241, 25, 102, 110, 147, 174, 152,
+			192, 225, 25, 230, 239, 228, 247, 24, 50, 224, 134, 75, 246, 194,
+			127, 132, 45, 31, 10, 236, 227, 7, 139, 99, 131, 133, 252, 96,
+			145, 135, 96, 35, 63, 8, 60, 60, 134, 98, 65, 68, 249, 35,
+			140, 126, 140, 49, 255, 176, 196, 139, 83, 128, 76, 216, 201, 136,
+			140, 16, 90, 99, 4, 164, 27, 125, 106, 76, 149, 19, 20, 160,
+			239, 35, 140, 143, 243, 235, 144, 28, 56, 41, 231, 61, 203, 60,
+			225, 79, 27, 213, 97, 220, 45, 99, 51, 52, 38, 4, 203, 148,
+			158, 101, 38, 192, 194, 6, 168, 114, 100, 38, 192, 194, 6, 168,
+			115, 100, 19, 147, 252, 148, 233, 152, 8, 239, 163, 204, 155, 247,
+			239, 52, 183, 114, 22, 109, 87, 55, 81, 77, 229, 230, 183, 111,
+			208, 5, 25, 183, 91, 224, 187, 225, 116, 21, 80, 128, 45, 190,
+			143, 50, 111, 52, 111, 40, 67, 195, 216, 100, 222, 128, 67, 138,
+			185, 188, 129, 65, 195, 206, 93, 252, 229, 6, 39, 168, 190, 4,
+			17, 188, 113, 19, 156, 86, 98, 56, 130, 189, 41, 78, 5, 44,
+			192, 29, 120, 174, 136, 5, 152, 200, 231, 138, 88, 192, 44, 62,
+			199, 132, 149, 32, 60, 105, 230, 61, 7, 18, 4, 167, 193, 42,
+			140, 138, 242, 199, 25, 253, 45, 198, 252, 91, 108, 141, 67, 104,
+			126, 191, 194, 174, 9, 83, 55, 146, 246, 95, 15, 128, 40, 185,
+			137, 132, 97, 62, 206, 76, 149, 67, 133, 193, 41, 51, 239, 215,
+			217, 21, 86, 57, 224, 251, 37, 252, 160, 208, 64, 160, 193, 4,
+			229, 216, 192, 160, 65, 76, 241, 67, 102, 8, 34, 188, 79, 50,
+			111, 143, 63, 159, 203, 10, 172, 184, 48, 66, 46, 233, 223, 242,
+			41, 140, 0, 51, 246, 201, 156, 87, 20, 103, 236, 147, 108, 76,
+			152, 25, 195, 243, 91, 222, 39, 217, 148, 159, 55, 48, 248, 100,
+			126, 55, 95, 50, 67, 82, 225, 125, 138, 121, 219, 225, 80, 112,
+			2, 233, 34, 83, 67, 174, 41, 130, 31, 174, 233, 196, 137, 67,
+			5, 86, 65, 164, 183, 143, 11, 9, 119, 219, 55, 172, 183, 79,
+			229, 50, 140, 39, 163, 188, 79, 177, 106, 142, 14, 204, 212, 167,
+			192, 15, 131, 90, 221, 10, 60, 254, 12, 163, 187, 253, 87, 15,
+			156, 162, 51, 142, 118, 145, 126, 231, 212, 35, 15, 236, 142, 117,
+			127, 169, 141, 221, 100, 196, 103, 182, 92, 165, 130, 92, 250, 12,
+			51, 209, 93, 5, 121, 244, 25, 102, 182, 174, 43, 200, 161, 207,
+			48, 179, 117, 93, 65, 15, 253, 51, 108, 215, 188, 254, 149, 145,
+			10, 164, 255, 62, 15, 254, 217, 111, 94, 101, 250, 111, 211, 48,
+			5, 200, 92, 231, 54, 39, 161, 27, 55, 203, 249, 25, 219, 105,
+			92, 165, 129, 80, 218, 29, 171, 72, 249, 213, 103, 253, 42, 152,
+			245, 251, 124, 110, 39, 97, 14, 62, 111, 221, 189, 10, 46, 165,
+			207, 107, 37, 67, 203, 67, 162, 252, 60, 27, 250, 19, 70, 252,
+			151, 195, 249, 53, 167, 244, 33, 110, 61, 180, 18, 52, 205, 29,
+			28, 102, 195, 16, 145, 121, 180, 111, 66, 160, 224, 225, 124, 136,
+			27, 201, 35, 156, 149, 193, 132, 61, 207, 42, 163, 240, 195, 140,
+			101, 172, 26, 250, 3, 70, 23, 252, 239, 195, 3, 158, 118, 234,
+			244, 173, 41, 118, 59, 26, 139, 156, 193, 149, 202, 173, 179, 227,
+			172, 38, 9, 58, 130, 138, 72, 86, 174, 90, 144, 2, 200, 167,
+			45, 8, 5, 146, 108, 207, 1, 40, 49, 43, 163, 145, 252, 50,
+			163, 117, 127, 9, 79, 88, 23, 37, 166, 239, 132, 244, 192, 210,
+			54, 105, 244, 205, 79, 71, 235, 113, 64, 144, 190, 204, 202, 14,
+			132, 236, 36, 27, 153, 181, 32, 3, 240, 154, 5, 126, 10, 177,
+			160, 194, 251, 10, 163, 135, 253, 151, 27, 17, 182, 104, 20, 134,
+			4, 109, 98, 132, 43, 255, 153, 38, 51, 168, 50, 156, 118, 131,
+			3, 89, 95, 97, 229, 17, 11, 98, 255, 163, 115, 22, 132, 178,
+			71, 118, 237, 141, 102, 112, 38, 188, 175, 50, 186, 8, 179, 170,
+			211, 48, 91, 140, 157, 196, 113, 214, 119, 37, 21, 160, 148, 239,
+			0, 184, 193, 33, 188, 251, 106, 78, 57, 163, 0, 58, 202, 33,
+			218, 251, 42, 187, 230, 16, 255, 255, 113, 112, 79, 120, 223, 96,
+			244, 22, 255, 116, 46, 229, 133, 25, 176, 191, 33, 117, 137, 44,
+			71, 145, 37, 1, 183, 161, 162, 67, 6, 34, 191, 111, 176, 242,
+			168, 5, 41, 128, 99, 190, 5, 25, 128, 215, 223, 180, 92, 238,
+			38, 113, 22, 223, 244, 191, 7, 0, 195, 217, 184, 91, 242, 148,
+			0, 0},
 	)
 }
 
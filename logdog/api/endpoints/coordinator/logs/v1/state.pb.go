@@ -31,6 +31,15 @@ type LogStreamState struct {
 	// Indicates the purged state of a log. A log that has been purged is only
 	// acknowledged to administrative clients.
 	Purged bool `protobuf:"varint,5,opt,name=purged" json:"purged,omitempty"`
+	// If non-nil, the log stream's log data has been purged, and only its
+	// tombstone remains.
+	Tombstone *LogStreamState_Tombstone `protobuf:"bytes,6,opt,name=tombstone" json:"tombstone,omitempty"`
+	// The number of bytes of stream data that the Butler discarded because the
+	// log stream exceeded a byte quota.
+	QuotaDroppedBytes uint64 `protobuf:"varint,7,opt,name=quota_dropped_bytes,json=quotaDroppedBytes" json:"quota_dropped_bytes,omitempty"`
+	// The number of bytes of stream data that the Butler discarded because the
+	// log stream exceeded a rate limit.
+	RateDroppedBytes uint64 `protobuf:"varint,8,opt,name=rate_dropped_bytes,json=rateDroppedBytes" json:"rate_dropped_bytes,omitempty"`
 }
 
 func (m *LogStreamState) Reset()                    { *m = LogStreamState{} }
@@ -73,6 +82,27 @@ func (m *LogStreamState) GetPurged() bool {
 	return false
 }
 
+func (m *LogStreamState) GetTombstone() *LogStreamState_Tombstone {
+	if m != nil {
+		return m.Tombstone
+	}
+	return nil
+}
+
+func (m *LogStreamState) GetQuotaDroppedBytes() uint64 {
+	if m != nil {
+		return m.QuotaDroppedBytes
+	}
+	return 0
+}
+
+func (m *LogStreamState) GetRateDroppedBytes() uint64 {
+	if m != nil {
+		return m.RateDroppedBytes
+	}
+	return 0
+}
+
 // ArchiveInfo contains archive details for the log stream.
 type LogStreamState_ArchiveInfo struct {
 	// The Google Storage URL where the log stream's index is archived.
@@ -129,9 +159,37 @@ func (m *LogStreamState_ArchiveInfo) GetLogEntryCount() int64 {
 	return 0
 }
 
+// Tombstone describes the purge of a log stream's log data.
+type LogStreamState_Tombstone struct {
+	// The time when the log stream's log data was purged.
+	Purged *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=purged" json:"purged,omitempty"`
+	// The reason given for the purge.
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *LogStreamState_Tombstone) Reset()                    { *m = LogStreamState_Tombstone{} }
+func (m *LogStreamState_Tombstone) String() string            { return proto.CompactTextString(m) }
+func (*LogStreamState_Tombstone) ProtoMessage()               {}
+func (*LogStreamState_Tombstone) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0, 1} }
+
+func (m *LogStreamState_Tombstone) GetPurged() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Purged
+	}
+	return nil
+}
+
+func (m *LogStreamState_Tombstone) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LogStreamState)(nil), "logdog.LogStreamState")
 	proto.RegisterType((*LogStreamState_ArchiveInfo)(nil), "logdog.LogStreamState.ArchiveInfo")
+	proto.RegisterType((*LogStreamState_Tombstone)(nil), "logdog.LogStreamState.Tombstone")
 }
 
 func init() {
@@ -139,28 +197,34 @@ func init() {
 }
 
 var fileDescriptor1 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x6b, 0xdb, 0x30,
-	0x14, 0xc6, 0x71, 0xbc, 0x25, 0x8e, 0xb2, 0x64, 0xa0, 0xc3, 0xf0, 0x3c, 0xc6, 0x4c, 0xc6, 0x86,
-	0x2f, 0x93, 0xd8, 0xd6, 0x63, 0x2f, 0xa5, 0xf4, 0x10, 0xc8, 0xc9, 0x69, 0x7b, 0x35, 0x8a, 0xad,
-	0x28, 0x02, 0x59, 0xcf, 0xc8, 0x72, 0x68, 0xff, 0x8e, 0xfe, 0x13, 0xfd, 0x33, 0x8b, 0xa4, 0xb8,
-	0xb4, 0x17, 0xc3, 0xfb, 0x7d, 0xdf, 0xd3, 0xf7, 0x3d, 0xa3, 0xad, 0x90, 0xf6, 0x38, 0xec, 0x49,
-	0x0d, 0x2d, 0x55, 0x43, 0x2d, 0xfd, 0xe7, 0x8f, 0x00, 0xaa, 0x40, 0x34, 0x20, 0x28, 0xeb, 0x24,
-	0xe5, 0xba, 0xe9, 0x40, 0x6a, 0xdb, 0xd3, 0x1a, 0xc0, 0x34, 0x52, 0x33, 0x0b, 0xc6, 0x19, 0x7a,
-	0x7a, 0xfa, 0x4b, 0x7b, 0xcb, 0x2c, 0x27, 0x9d, 0x01, 0x0b, 0x78, 0x1a, 0xb6, 0xb2, 0x1f, 0x02,
-	0x40, 0x28, 0x4e, 0x3d, 0xdd, 0x0f, 0x07, 0x6a, 0x65, 0xcb, 0x7b, 0xcb, 0xda, 0x2e, 0x18, 0xd7,
-	0x4f, 0x31, 0x5a, 0x6d, 0x41, 0xec, 0xac, 0xe1, 0xac, 0xdd, 0xb9, 0x17, 0xf0, 0x4f, 0xb4, 0xf4,
-	0x5a, 0x75, 0xe2, 0xa6, 0x97, 0xa0, 0xd3, 0x28, 0x8f, 0x8a, 0x79, 0xf9, 0xc9, 0xc3, 0xfb, 0xc0,
-	0xf0, 0x05, 0x9a, 0xd5, 0x86, 0x33, 0xcb, 0x9b, 0x74, 0x92, 0x47, 0xc5, 0xe2, 0x5f, 0x46, 0x42,
-	0x14, 0x19, 0xa3, 0xc8, 0xed, 0x18, 0x55, 0x8e, 0x56, 0xfc, 0x0b, 0xad, 0x2c, 0x37, 0xad, 0xd4,
-	0x4c, 0x55, 0x52, 0x37, 0xfc, 0x21, 0x8d, 0xf3, 0xa8, 0x88, 0xcb, 0xe5, 0x48, 0x37, 0x0e, 0xe2,
-	0x4b, 0x34, 0x63, 0xa6, 0x3e, 0xca, 0x13, 0x4f, 0x3f, 0xf8, 0xc7, 0xd7, 0x24, 0xdc, 0x43, 0xde,
-	0x57, 0x25, 0x57, 0xc1, 0xb5, 0xd1, 0x07, 0x28, 0xc7, 0x15, 0xfc, 0x05, 0x4d, 0xbb, 0xc1, 0x08,
-	0xde, 0xa4, 0x1f, 0xf3, 0xa8, 0x48, 0xca, 0xf3, 0x94, 0x3d, 0x47, 0x68, 0xf1, 0x66, 0x01, 0x7f,
-	0x43, 0x73, 0xdf, 0xa1, 0x1a, 0x8c, 0x3a, 0xdf, 0x98, 0x78, 0x70, 0x67, 0x14, 0xfe, 0x8e, 0x50,
-	0xef, 0x83, 0xbc, 0x3a, 0xf1, 0xea, 0x3c, 0x10, 0x27, 0x7f, 0x45, 0x49, 0xc3, 0x2c, 0xf3, 0x62,
-	0xec, 0xc5, 0x99, 0x9b, 0x9d, 0x94, 0xa1, 0xa4, 0x86, 0xb6, 0x53, 0xdc, 0x86, 0xf6, 0x49, 0xf9,
-	0x3a, 0xe3, 0xdf, 0xe8, 0xb3, 0x02, 0x51, 0x71, 0x6d, 0xcd, 0x63, 0x55, 0xc3, 0xa0, 0xad, 0xef,
-	0x18, 0x97, 0x4b, 0x05, 0xe2, 0xc6, 0xd1, 0x6b, 0x07, 0xf7, 0x53, 0xff, 0x13, 0xff, 0xbf, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0xce, 0xc7, 0x46, 0x15, 0x02, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x92, 0xdf, 0x6a, 0xd4, 0x40,
+	0x14, 0xc6, 0x49, 0xb7, 0xcd, 0x26, 0x53, 0xb7, 0xea, 0x08, 0x25, 0x46, 0xc4, 0x50, 0x51, 0x72,
+	0xa1, 0x09, 0x56, 0x2f, 0x45, 0xf0, 0xdf, 0x45, 0xa1, 0x57, 0x69, 0xd5, 0xcb, 0x30, 0x49, 0x4e,
+	0xa7, 0x03, 0xc9, 0x9c, 0x38, 0x39, 0x59, 0xec, 0x2b, 0xf8, 0x24, 0x3e, 0xa6, 0xcc, 0x64, 0xb3,
+	0xae, 0x82, 0xf4, 0x26, 0x70, 0xbe, 0xdf, 0x77, 0xce, 0x9c, 0xf9, 0x26, 0xec, 0x5c, 0x2a, 0xba,
+	0x1e, 0xab, 0xac, 0xc6, 0x2e, 0x6f, 0xc7, 0x5a, 0xb9, 0xcf, 0x4b, 0x89, 0x79, 0x8b, 0xb2, 0x41,
+	0x99, 0x8b, 0x5e, 0xe5, 0xa0, 0x9b, 0x1e, 0x95, 0xa6, 0x21, 0xaf, 0x11, 0x4d, 0xa3, 0xb4, 0x20,
+	0x34, 0xd6, 0x30, 0xe4, 0xeb, 0x57, 0xf9, 0x40, 0x82, 0x20, 0xeb, 0x0d, 0x12, 0x72, 0x7f, 0xea,
+	0x8a, 0x9f, 0x48, 0x44, 0xd9, 0x42, 0xee, 0xd4, 0x6a, 0xbc, 0xca, 0x49, 0x75, 0x30, 0x90, 0xe8,
+	0xfa, 0xc9, 0x78, 0xf2, 0xf3, 0x80, 0x1d, 0x9d, 0xa3, 0xbc, 0x20, 0x03, 0xa2, 0xbb, 0xb0, 0x13,
+	0xf8, 0x53, 0xb6, 0x72, 0xac, 0x5c, 0x83, 0x19, 0x14, 0xea, 0xc8, 0x4b, 0xbc, 0x34, 0x2c, 0xee,
+	0x38, 0xf1, 0xeb, 0xa4, 0xf1, 0x37, 0x6c, 0x59, 0x1b, 0x10, 0x04, 0x4d, 0xb4, 0x97, 0x78, 0xe9,
+	0xe1, 0x69, 0x9c, 0x4d, 0x47, 0x65, 0xf3, 0x51, 0xd9, 0xe5, 0x7c, 0x54, 0x31, 0x5b, 0xf9, 0x33,
+	0x76, 0x44, 0x60, 0x3a, 0xa5, 0x45, 0x5b, 0x2a, 0xdd, 0xc0, 0x8f, 0x68, 0x91, 0x78, 0xe9, 0xa2,
+	0x58, 0xcd, 0xea, 0x99, 0x15, 0xf9, 0x5b, 0xb6, 0x14, 0xa6, 0xbe, 0x56, 0x6b, 0x88, 0xf6, 0xdd,
+	0xf0, 0x93, 0x6c, 0xba, 0x4f, 0xf6, 0xf7, 0xaa, 0xd9, 0xfb, 0xc9, 0x75, 0xa6, 0xaf, 0xb0, 0x98,
+	0x5b, 0xf8, 0x31, 0xf3, 0xfb, 0xd1, 0x48, 0x68, 0xa2, 0x83, 0xc4, 0x4b, 0x83, 0x62, 0x53, 0xf1,
+	0x77, 0x2c, 0x24, 0xec, 0xaa, 0x81, 0x50, 0x43, 0xe4, 0xbb, 0xb9, 0xc9, 0x7f, 0xe6, 0x5e, 0xce,
+	0xbe, 0xe2, 0x4f, 0x0b, 0xcf, 0xd8, 0x83, 0xef, 0x23, 0x92, 0x28, 0x1b, 0x83, 0x7d, 0x0f, 0x4d,
+	0x59, 0xdd, 0x10, 0x0c, 0xd1, 0x32, 0xf1, 0xd2, 0xfd, 0xe2, 0xbe, 0x43, 0x9f, 0x26, 0xf2, 0xc1,
+	0x02, 0xfe, 0x82, 0x71, 0x23, 0x08, 0xfe, 0xb1, 0x07, 0xce, 0x7e, 0xcf, 0x92, 0x5d, 0x77, 0xfc,
+	0xcb, 0x63, 0x87, 0x3b, 0xd7, 0xe1, 0x8f, 0x58, 0xe8, 0x12, 0x2a, 0x47, 0xd3, 0x6e, 0x5e, 0x20,
+	0x70, 0xc2, 0x17, 0xd3, 0xf2, 0xc7, 0x8c, 0x0d, 0x6e, 0x5d, 0x47, 0xf7, 0x1c, 0x0d, 0x27, 0xc5,
+	0xe2, 0x87, 0x2c, 0x68, 0x04, 0x09, 0x07, 0x17, 0x0e, 0x2e, 0x6d, 0x6d, 0x51, 0xcc, 0x82, 0x1a,
+	0xbb, 0xbe, 0x05, 0x9a, 0xb2, 0x0d, 0x8a, 0x6d, 0xcd, 0x9f, 0xb3, 0xbb, 0x2d, 0xca, 0x12, 0x34,
+	0x99, 0x9b, 0xb2, 0xc6, 0x51, 0x93, 0x4b, 0x70, 0x51, 0xac, 0x5a, 0x94, 0x9f, 0xad, 0xfa, 0xd1,
+	0x8a, 0xf1, 0x37, 0x16, 0x6e, 0x03, 0xe2, 0xa7, 0xdb, 0xb4, 0xbd, 0x5b, 0xff, 0x83, 0xf9, 0x25,
+	0x8e, 0x99, 0x6f, 0x40, 0x0c, 0xa8, 0x37, 0xab, 0x6f, 0xaa, 0xca, 0x77, 0x3d, 0xaf, 0x7f, 0x0f,
+	0x00, 0xe5, 0x6e, 0x72, 0xe9, 0x0c, 0x03, 0x00, 0x00,
 }
@@ -12,588 +12,347 @@ func init() {
 			"logdog.Services",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 124, 93, 108, 28, 71,
-			147, 216, 206, 207, 46, 151, 45, 137, 63, 195, 31, 81, 67, 89,
-			106, 81, 178, 180, 180, 150, 75, 82, 180, 236, 147, 101, 249, 251,
-			86, 228, 82, 90, 125, 20, 73, 239, 46, 37, 217, 241, 89, 26,
-			238, 244, 238, 142, 53, 59, 179, 55, 51, 75, 138, 58, 31, 156,
-			47, 1, 14, 57, 224, 11, 252, 240, 1, 73, 46, 192, 225, 146,
-			32, 8, 238, 238, 33, 111, 7, 228, 37, 64, 130, 188, 6, 184,
-			183, 3, 242, 112, 47, 73, 128, 60, 6, 72, 16, 32, 64, 128,
-			32, 168, 234, 238, 217, 153, 37, 41, 203, 206, 247, 114, 119, 18,
-			44, 121, 107, 166, 187, 170, 186, 170, 186, 186, 186, 186, 122, 200,
-			31, 159, 33, 187, 109, 39, 234, 244, 247, 75, 77, 191, 187, 236,
-			246, 155, 14, 254, 179, 212, 246, 151, 93, 191, 109, 251, 237, 101,
-			171, 231, 44, 51, 207, 238, 249, 142, 23, 133, 203, 77, 223, 15,
-			108, 199, 179, 34, 63, 88, 14, 89, 112, 224, 52, 89, 184, 124,
-			176, 186, 28, 70, 86, 196, 74, 189, 192, 143, 124, 35, 199, 123,
-			46, 252, 137, 66, 198, 182, 252, 118, 61, 10, 152, 213, 173, 67,
-			3, 227, 42, 57, 135, 109, 158, 31, 176, 32, 116, 124, 111, 78,
-			161, 74, 97, 180, 118, 22, 31, 62, 225, 207, 140, 89, 146, 11,
-			89, 51, 96, 209, 156, 74, 149, 194, 217, 154, 128, 140, 247, 201,
-			88, 196, 130, 174, 227, 89, 238, 115, 199, 179, 217, 171, 57, 141,
-			42, 5, 173, 118, 78, 62, 173, 194, 67, 195, 36, 121, 43, 104,
-			118, 156, 3, 102, 207, 233, 84, 41, 228, 107, 49, 12, 168, 123,
-			253, 160, 205, 236, 185, 44, 190, 17, 208, 163, 239, 71, 73, 206,
-			208, 245, 204, 123, 10, 249, 115, 133, 40, 103, 13, 77, 207, 24,
-			183, 254, 76, 161, 235, 126, 239, 40, 112, 218, 157, 136, 222, 90,
-			89, 253, 136, 54, 58, 140, 110, 237, 173, 87, 105, 185, 31, 117,
-			252, 32, 44, 209, 178, 235, 82, 108, 16, 210, 128, 129, 68, 152,
-			93, 34, 116, 47, 100, 212, 111, 209, 168, 227, 132, 52, 244, 251,
-			65, 147, 209, 166, 111, 51, 234, 132, 180, 237, 31, 176, 192, 99,
-			54, 237, 123, 54, 11, 104, 212, 97, 180, 220, 179, 154, 128, 216,
-			105, 50, 47, 100, 69, 42, 4, 65, 111, 149, 86, 8, 141, 58,
-			86, 68, 155, 150, 71, 247, 25, 109, 249, 125, 207, 166, 142, 135,
-			189, 182, 170, 235, 149, 237, 122, 133, 182, 28, 151, 149, 8, 201,
-			19, 69, 53, 180, 92, 126, 140, 52, 136, 170, 103, 12, 125, 52,
-			243, 158, 98, 62, 164, 105, 13, 0, 7, 208, 217, 245, 219, 52,
-			196, 199, 20, 85, 71, 15, 44, 183, 15, 76, 118, 187, 125, 207,
-			105, 90, 17, 179, 105, 228, 83, 169, 226, 18, 33, 132, 104, 122,
-			70, 49, 180, 209, 252, 44, 217, 32, 186, 158, 81, 51, 134, 118,
-			70, 157, 55, 63, 166, 187, 9, 237, 73, 2, 168, 209, 253, 126,
-			139, 10, 77, 211, 150, 31, 8, 121, 32, 217, 18, 33, 231, 72,
-			22, 176, 232, 134, 126, 70, 29, 157, 35, 103, 57, 152, 5, 172,
-			121, 9, 41, 134, 118, 102, 116, 86, 66, 154, 161, 157, 185, 96,
-			146, 63, 82, 145, 190, 98, 104, 83, 234, 148, 249, 189, 138, 106,
-			25, 140, 232, 70, 72, 185, 197, 148, 8, 161, 219, 126, 196, 184,
-			12, 129, 43, 254, 28, 120, 12, 88, 212, 7, 53, 92, 161, 13,
-			96, 202, 9, 169, 255, 210, 58, 42, 210, 208, 241, 154, 140, 51,
-			42, 173, 30, 95, 122, 238, 17, 161, 86, 179, 201, 194, 208, 217,
-			119, 25, 8, 39, 10, 250, 33, 200, 41, 22, 18, 242, 33, 72,
-			8, 141, 53, 65, 179, 54, 221, 63, 138, 91, 209, 200, 39, 32,
-			109, 199, 6, 177, 11, 29, 56, 94, 203, 15, 186, 86, 4, 130,
-			58, 116, 162, 142, 223, 143, 168, 199, 152, 237, 120, 109, 160, 212,
-			195, 255, 119, 24, 93, 31, 76, 63, 234, 120, 132, 238, 179, 232,
-			144, 49, 143, 50, 171, 217, 161, 253, 30, 160, 140, 5, 171, 232,
-			134, 62, 165, 158, 153, 23, 194, 83, 178, 32, 174, 17, 9, 129,
-			240, 242, 99, 18, 210, 12, 109, 106, 210, 32, 7, 40, 87, 213,
-			208, 206, 171, 243, 166, 195, 135, 35, 25, 180, 217, 43, 110, 210,
-			67, 162, 150, 147, 143, 118, 89, 24, 90, 109, 86, 162, 85, 222,
-			138, 91, 148, 19, 210, 165, 213, 34, 137, 251, 161, 1, 56, 174,
-			43, 16, 56, 94, 59, 102, 88, 213, 13, 253, 188, 58, 53, 37,
-			152, 82, 179, 192, 135, 100, 24, 180, 125, 62, 47, 45, 65, 213,
-			12, 237, 252, 5, 147, 172, 33, 195, 154, 161, 153, 234, 180, 121,
-			93, 82, 78, 24, 119, 199, 10, 233, 62, 72, 72, 186, 128, 152,
-			154, 166, 27, 186, 169, 158, 151, 226, 209, 178, 128, 36, 39, 33,
-			197, 208, 204, 145, 113, 9, 1, 1, 99, 138, 172, 34, 53, 221,
-			208, 46, 170, 134, 121, 237, 77, 212, 184, 91, 137, 105, 233, 186,
-			161, 95, 84, 205, 105, 129, 79, 207, 2, 10, 73, 75, 87, 12,
-			237, 226, 200, 57, 9, 105, 134, 118, 113, 98, 114, 63, 135, 19,
-			104, 141, 252, 207, 43, 228, 82, 219, 247, 219, 46, 91, 150, 83,
-			106, 217, 238, 7, 104, 42, 194, 223, 142, 243, 247, 37, 249, 126,
-			225, 19, 146, 223, 16, 77, 140, 57, 50, 18, 178, 166, 239, 217,
-			33, 250, 90, 173, 38, 65, 99, 154, 100, 61, 203, 243, 67, 244,
-			178, 217, 26, 7, 238, 239, 146, 169, 166, 223, 45, 13, 161, 188,
-			127, 78, 34, 196, 185, 190, 171, 252, 51, 69, 249, 23, 170, 246,
-			96, 247, 254, 159, 169, 151, 30, 240, 182, 187, 162, 109, 233, 41,
-			115, 221, 95, 120, 254, 161, 215, 56, 234, 177, 240, 209, 159, 82,
-			240, 173, 151, 50, 207, 21, 242, 31, 207, 162, 111, 189, 148, 49,
-			110, 253, 251, 179, 220, 109, 52, 125, 151, 222, 239, 183, 90, 44,
-			8, 233, 18, 229, 168, 110, 132, 212, 182, 34, 139, 58, 94, 196,
-			130, 102, 199, 242, 218, 224, 250, 96, 126, 144, 148, 67, 94, 249,
-			45, 209, 129, 86, 189, 102, 137, 158, 226, 139, 59, 81, 212, 11,
-			63, 89, 94, 182, 217, 1, 115, 253, 30, 11, 66, 57, 58, 88,
-			242, 122, 130, 137, 165, 125, 206, 196, 50, 33, 180, 198, 108, 39,
-			140, 2, 103, 191, 143, 19, 210, 242, 108, 218, 15, 25, 248, 93,
-			225, 203, 225, 201, 190, 227, 89, 193, 17, 242, 21, 22, 113, 210,
-			82, 63, 144, 147, 151, 208, 174, 111, 59, 45, 112, 164, 142, 239,
-			21, 169, 21, 48, 218, 131, 137, 18, 129, 195, 232, 5, 254, 129,
-			99, 131, 135, 149, 126, 169, 229, 187, 174, 127, 8, 179, 28, 116,
-			227, 64, 167, 16, 58, 17, 218, 101, 209, 39, 132, 80, 248, 243,
-			193, 16, 99, 33, 204, 199, 228, 234, 210, 237, 135, 17, 56, 54,
-			75, 172, 16, 214, 190, 127, 0, 175, 132, 196, 8, 245, 252, 200,
-			105, 178, 34, 119, 111, 174, 19, 70, 128, 33, 73, 209, 179, 135,
-			216, 177, 157, 176, 233, 90, 78, 151, 5, 165, 211, 152, 112, 188,
-			164, 44, 36, 19, 189, 192, 183, 251, 232, 73, 5, 31, 100, 192,
-			200, 255, 23, 31, 68, 174, 127, 182, 223, 236, 119, 153, 23, 89,
-			82, 73, 203, 126, 64, 253, 168, 195, 2, 218, 181, 34, 22, 56,
-			150, 27, 14, 68, 141, 10, 138, 58, 140, 208, 36, 247, 241, 160,
-			182, 153, 131, 61, 1, 177, 103, 117, 113, 245, 78, 218, 150, 231,
-			15, 222, 161, 220, 157, 40, 132, 17, 121, 28, 149, 31, 132, 180,
-			107, 29, 129, 199, 239, 135, 124, 233, 100, 158, 237, 7, 16, 6,
-			4, 192, 68, 23, 150, 33, 46, 147, 40, 164, 54, 11, 192, 23,
-			209, 86, 224, 119, 137, 140, 18, 90, 209, 33, 152, 137, 116, 255,
-			97, 143, 53, 193, 130, 104, 47, 112, 192, 176, 2, 176, 29, 143,
-			91, 81, 24, 34, 239, 132, 54, 30, 86, 235, 180, 190, 179, 217,
-			120, 90, 174, 85, 104, 181, 78, 119, 107, 59, 79, 170, 27, 149,
-			13, 122, 255, 11, 218, 120, 88, 161, 235, 59, 187, 95, 212, 170,
-			15, 30, 54, 232, 195, 157, 173, 141, 74, 173, 78, 203, 219, 27,
-			116, 125, 103, 187, 81, 171, 222, 223, 107, 236, 212, 234, 132, 46,
-			148, 235, 180, 90, 95, 192, 55, 229, 237, 47, 104, 229, 217, 110,
-			173, 82, 175, 211, 157, 26, 173, 62, 222, 221, 170, 86, 54, 232,
-			211, 114, 173, 86, 222, 110, 84, 43, 245, 34, 173, 110, 175, 111,
-			237, 109, 84, 183, 31, 20, 233, 253, 189, 6, 221, 222, 105, 16,
-			186, 85, 125, 92, 109, 84, 54, 104, 99, 167, 136, 100, 143, 247,
-			163, 59, 155, 244, 113, 165, 182, 254, 176, 188, 221, 40, 223, 175,
-			110, 85, 27, 95, 32, 193, 205, 106, 99, 27, 136, 109, 238, 212,
-			8, 45, 211, 221, 114, 173, 81, 93, 223, 219, 42, 215, 232, 238,
-			94, 109, 119, 167, 94, 161, 48, 178, 141, 106, 125, 125, 171, 92,
-			125, 92, 217, 40, 209, 234, 54, 221, 222, 161, 149, 39, 149, 237,
-			6, 173, 63, 44, 111, 109, 165, 7, 74, 232, 206, 211, 237, 74,
-			13, 184, 79, 14, 147, 222, 175, 208, 173, 106, 249, 254, 86, 5,
-			72, 225, 56, 55, 170, 181, 202, 122, 3, 6, 52, 248, 181, 94,
-			221, 168, 108, 55, 202, 91, 69, 66, 235, 187, 149, 245, 106, 121,
-			171, 72, 43, 207, 42, 143, 119, 183, 202, 181, 47, 138, 2, 105,
-			189, 242, 249, 94, 101, 187, 81, 45, 111, 209, 141, 242, 227, 242,
-			131, 74, 157, 22, 126, 72, 42, 187, 181, 157, 245, 189, 90, 229,
-			49, 112, 189, 179, 73, 235, 123, 247, 235, 141, 106, 99, 175, 81,
-			161, 15, 118, 118, 54, 80, 216, 245, 74, 237, 73, 117, 189, 82,
-			191, 75, 183, 118, 234, 40, 176, 189, 122, 165, 72, 232, 70, 185,
-			81, 70, 210, 187, 181, 157, 205, 106, 163, 126, 23, 126, 223, 223,
-			171, 87, 81, 112, 213, 237, 70, 165, 86, 219, 219, 109, 84, 119,
-			182, 23, 233, 195, 157, 167, 149, 39, 149, 26, 93, 47, 239, 213,
-			43, 27, 40, 225, 157, 109, 24, 45, 216, 74, 101, 167, 246, 5,
-			160, 5, 57, 160, 6, 138, 244, 233, 195, 74, 227, 97, 165, 6,
-			66, 69, 105, 149, 65, 12, 245, 70, 173, 186, 222, 72, 54, 219,
-			169, 209, 198, 78, 173, 65, 18, 227, 164, 219, 149, 7, 91, 213,
-			7, 149, 237, 245, 10, 188, 222, 1, 52, 79, 171, 245, 202, 34,
-			45, 215, 170, 117, 104, 80, 69, 194, 244, 105, 249, 11, 186, 179,
-			135, 163, 6, 69, 237, 213, 43, 132, 255, 78, 152, 110, 17, 245,
-			73, 171, 155, 180, 188, 241, 164, 10, 156, 139, 214, 187, 59, 245,
-			122, 85, 152, 11, 138, 109, 253, 161, 144, 121, 28, 242, 210, 252,
-			121, 248, 149, 55, 180, 133, 204, 93, 114, 134, 232, 249, 255, 54,
-			146, 225, 192, 89, 146, 5, 64, 53, 180, 133, 145, 243, 228, 28,
-			201, 33, 148, 225, 224, 24, 25, 225, 160, 194, 97, 209, 120, 196,
-			208, 22, 204, 79, 4, 198, 171, 153, 162, 192, 168, 112, 128, 55,
-			2, 178, 87, 71, 166, 4, 70, 5, 48, 2, 200, 49, 42, 136,
-			17, 96, 209, 120, 196, 208, 174, 206, 222, 20, 24, 175, 101, 74,
-			2, 163, 202, 1, 222, 8, 130, 174, 107, 35, 243, 2, 163, 10,
-			24, 1, 228, 24, 85, 196, 8, 176, 104, 60, 98, 104, 215, 46,
-			45, 9, 140, 239, 103, 22, 4, 70, 141, 3, 188, 145, 166, 26,
-			218, 251, 35, 166, 192, 168, 1, 70, 0, 57, 70, 13, 49, 2,
-			44, 26, 67, 215, 247, 174, 8, 140, 215, 227, 81, 235, 28, 224,
-			141, 116, 213, 208, 174, 143, 92, 19, 24, 117, 192, 8, 32, 199,
-			168, 35, 70, 128, 69, 99, 205, 208, 174, 223, 144, 163, 190, 145,
-			185, 34, 48, 102, 57, 192, 27, 101, 85, 67, 187, 49, 50, 39,
-			48, 102, 1, 35, 128, 28, 99, 22, 49, 2, 44, 26, 143, 24,
-			218, 141, 121, 74, 254, 11, 225, 251, 155, 90, 230, 185, 98, 254,
-			37, 56, 13, 25, 155, 224, 178, 195, 66, 230, 69, 33, 181, 104,
-			232, 180, 61, 102, 23, 105, 203, 121, 197, 236, 37, 151, 121, 237,
-			168, 67, 195, 158, 229, 97, 24, 235, 116, 217, 160, 57, 179, 9,
-			181, 160, 79, 211, 239, 123, 184, 24, 137, 32, 9, 87, 162, 86,
-			96, 53, 7, 235, 173, 124, 17, 81, 12, 152, 16, 36, 16, 111,
-			248, 46, 95, 82, 104, 21, 119, 12, 16, 49, 247, 152, 103, 51,
-			142, 208, 242, 142, 104, 211, 114, 153, 103, 91, 1, 98, 109, 250,
-			94, 147, 245, 34, 88, 255, 94, 50, 186, 96, 91, 71, 11, 4,
-			22, 139, 133, 174, 239, 69, 157, 5, 137, 38, 96, 174, 220, 140,
-			53, 156, 46, 11, 35, 171, 219, 227, 43, 160, 8, 29, 108, 7,
-			226, 22, 6, 187, 22, 177, 27, 32, 52, 58, 76, 182, 198, 64,
-			28, 183, 56, 214, 64, 84, 192, 130, 19, 111, 83, 44, 27, 214,
-			72, 63, 160, 97, 127, 63, 130, 225, 130, 68, 96, 117, 162, 214,
-			0, 81, 137, 214, 48, 20, 3, 68, 189, 94, 224, 191, 114, 96,
-			157, 117, 143, 232, 205, 165, 213, 149, 226, 202, 202, 10, 61, 98,
-			86, 0, 123, 69, 90, 121, 101, 117, 123, 46, 163, 171, 159, 208,
-			117, 191, 219, 235, 71, 108, 64, 25, 209, 166, 56, 196, 24, 162,
-			23, 178, 190, 237, 99, 32, 83, 18, 1, 207, 96, 8, 97, 100,
-			5, 17, 189, 71, 75, 165, 210, 221, 225, 119, 204, 179, 83, 111,
-			98, 66, 50, 76, 150, 111, 249, 235, 56, 120, 150, 154, 188, 7,
-			24, 98, 104, 137, 211, 146, 240, 221, 161, 78, 168, 115, 209, 133,
-			255, 150, 29, 16, 146, 68, 156, 22, 45, 28, 35, 244, 41, 93,
-			161, 215, 175, 15, 227, 250, 140, 174, 44, 210, 223, 229, 221, 78,
-			224, 238, 230, 61, 186, 122, 247, 216, 91, 65, 250, 30, 93, 93,
-			145, 127, 68, 163, 223, 163, 204, 133, 88, 53, 193, 64, 24, 35,
-			251, 236, 68, 14, 62, 125, 51, 7, 75, 111, 224, 224, 230, 73,
-			28, 36, 244, 127, 107, 160, 255, 129, 194, 208, 0, 6, 224, 205,
-			129, 198, 126, 188, 25, 156, 170, 236, 211, 141, 132, 191, 74, 234,
-			252, 94, 90, 231, 244, 230, 49, 33, 220, 29, 116, 146, 22, 144,
-			208, 122, 178, 195, 49, 51, 24, 244, 73, 203, 57, 101, 116, 73,
-			17, 15, 58, 156, 40, 221, 129, 126, 7, 13, 63, 75, 54, 60,
-			133, 198, 205, 147, 105, 156, 104, 67, 100, 144, 238, 169, 229, 39,
-			72, 79, 166, 123, 158, 168, 211, 102, 147, 214, 209, 177, 198, 158,
-			80, 36, 5, 146, 158, 181, 68, 31, 195, 46, 96, 159, 113, 93,
-			47, 173, 173, 222, 46, 222, 254, 248, 35, 240, 17, 240, 151, 128,
-			55, 187, 57, 244, 144, 58, 94, 211, 237, 135, 206, 1, 75, 165,
-			134, 158, 168, 53, 35, 145, 26, 122, 18, 39, 4, 128, 185, 39,
-			249, 241, 68, 106, 232, 137, 49, 69, 254, 190, 38, 83, 67, 95,
-			171, 134, 249, 191, 84, 201, 108, 202, 135, 91, 130, 247, 180, 19,
-			79, 248, 240, 228, 152, 200, 96, 80, 210, 216, 66, 234, 178, 48,
-			4, 23, 236, 81, 223, 99, 49, 182, 32, 181, 164, 240, 45, 135,
-			69, 87, 8, 125, 33, 100, 245, 130, 182, 28, 230, 218, 232, 124,
-			45, 218, 243, 67, 39, 114, 14, 112, 139, 224, 177, 182, 133, 191,
-			95, 32, 67, 162, 97, 137, 110, 250, 65, 108, 91, 33, 178, 146,
-			32, 232, 7, 180, 235, 7, 172, 72, 45, 234, 249, 222, 210, 107,
-			22, 248, 34, 235, 210, 18, 219, 149, 20, 54, 190, 53, 219, 103,
-			36, 30, 30, 108, 116, 96, 153, 132, 197, 15, 155, 167, 249, 28,
-			86, 227, 157, 59, 119, 138, 226, 47, 87, 97, 226, 193, 9, 234,
-			83, 116, 67, 255, 90, 125, 50, 157, 72, 64, 125, 157, 74, 64,
-			125, 157, 63, 151, 72, 64, 125, 157, 200, 122, 252, 219, 25, 50,
-			63, 156, 245, 96, 221, 94, 116, 116, 90, 202, 99, 132, 100, 43,
-			240, 254, 180, 252, 5, 193, 183, 131, 228, 197, 255, 254, 17, 249,
-			139, 105, 158, 191, 88, 155, 120, 151, 191, 120, 151, 191, 120, 151,
-			191, 120, 151, 191, 120, 151, 191, 120, 151, 191, 248, 219, 155, 191,
-			184, 153, 204, 95, 220, 252, 233, 249, 139, 15, 254, 150, 230, 47,
-			56, 198, 66, 230, 178, 192, 152, 227, 0, 111, 148, 83, 13, 173,
-			16, 235, 58, 7, 24, 11, 177, 174, 115, 136, 177, 16, 235, 58,
-			167, 25, 90, 193, 188, 68, 254, 143, 138, 25, 17, 109, 45, 51,
-			97, 254, 119, 149, 150, 105, 155, 121, 44, 112, 154, 20, 67, 38,
-			121, 48, 199, 87, 235, 35, 191, 143, 155, 254, 128, 45, 65, 76,
-			16, 249, 212, 58, 240, 29, 155, 218, 172, 229, 120, 184, 82, 245,
-			123, 46, 63, 0, 38, 233, 254, 184, 82, 30, 249, 253, 128, 150,
-			119, 171, 97, 137, 150, 105, 116, 212, 115, 154, 150, 75, 153, 216,
-			237, 57, 33, 224, 195, 80, 35, 146, 33, 101, 192, 126, 167, 207,
-			194, 8, 83, 27, 28, 14, 123, 190, 7, 148, 143, 122, 140, 39,
-			70, 0, 31, 196, 9, 29, 95, 4, 188, 142, 23, 70, 150, 215,
-			100, 50, 112, 16, 71, 167, 116, 211, 247, 7, 123, 155, 160, 215,
-			164, 247, 173, 160, 48, 20, 233, 149, 48, 208, 91, 20, 231, 187,
-			33, 61, 229, 125, 98, 175, 218, 232, 48, 250, 168, 190, 179, 61,
-			136, 221, 69, 158, 194, 15, 232, 11, 108, 253, 2, 70, 198, 101,
-			129, 13, 253, 253, 111, 88, 51, 162, 47, 126, 247, 247, 94, 36,
-			14, 198, 215, 242, 231, 226, 248, 245, 63, 124, 70, 106, 191, 153,
-			186, 10, 254, 59, 93, 89, 97, 254, 198, 107, 54, 204, 31, 56,
-			99, 52, 223, 20, 141, 47, 252, 59, 133, 76, 62, 96, 209, 186,
-			239, 181, 156, 118, 77, 40, 216, 40, 18, 163, 137, 79, 158, 11,
-			162, 207, 251, 129, 43, 10, 62, 38, 248, 155, 58, 127, 177, 23,
-			184, 198, 123, 132, 196, 173, 121, 225, 199, 104, 109, 84, 182, 138,
-			140, 18, 153, 146, 88, 68, 179, 158, 21, 117, 176, 0, 100, 180,
-			54, 41, 94, 113, 6, 118, 173, 168, 3, 237, 135, 136, 119, 252,
-			48, 194, 122, 144, 209, 218, 100, 138, 250, 67, 63, 140, 22, 254,
-			84, 33, 51, 53, 214, 118, 194, 136, 5, 188, 92, 162, 198, 13,
-			215, 152, 35, 35, 189, 192, 7, 133, 11, 222, 37, 120, 106, 157,
-			202, 177, 34, 23, 237, 132, 34, 23, 131, 232, 54, 11, 155, 200,
-			209, 217, 26, 254, 62, 161, 192, 37, 123, 66, 129, 203, 194, 19,
-			50, 59, 204, 170, 16, 249, 24, 81, 29, 91, 176, 169, 58, 182,
-			81, 36, 89, 84, 50, 50, 120, 230, 214, 108, 137, 219, 71, 41,
-			93, 19, 82, 227, 141, 22, 62, 39, 147, 91, 190, 101, 191, 237,
-			240, 57, 49, 53, 38, 38, 71, 164, 97, 101, 13, 254, 94, 248,
-			87, 10, 49, 146, 56, 99, 211, 16, 124, 41, 111, 193, 87, 140,
-			88, 77, 136, 234, 38, 209, 172, 54, 67, 90, 103, 110, 93, 24,
-			222, 238, 149, 228, 78, 189, 6, 173, 140, 43, 228, 44, 63, 254,
-			183, 220, 231, 47, 217, 145, 144, 249, 25, 249, 236, 23, 236, 104,
-			225, 151, 10, 153, 109, 112, 41, 71, 236, 167, 74, 96, 96, 16,
-			218, 15, 20, 46, 233, 39, 233, 245, 63, 169, 100, 186, 204, 203,
-			20, 126, 42, 7, 215, 201, 184, 235, 183, 159, 51, 47, 10, 142,
-			158, 99, 50, 91, 214, 72, 185, 126, 187, 2, 79, 215, 225, 225,
-			91, 114, 100, 76, 147, 44, 11, 2, 63, 64, 59, 28, 173, 113,
-			0, 166, 42, 175, 119, 192, 9, 77, 248, 84, 229, 79, 96, 38,
-			95, 38, 103, 196, 235, 208, 121, 205, 230, 206, 32, 98, 209, 163,
-			238, 188, 102, 198, 60, 25, 69, 154, 216, 125, 26, 187, 231, 241,
-			129, 240, 3, 252, 37, 118, 158, 193, 206, 188, 57, 246, 189, 64,
-			242, 176, 181, 198, 174, 151, 184, 44, 0, 134, 158, 243, 100, 20,
-			95, 97, 199, 203, 216, 17, 219, 66, 191, 91, 255, 67, 37, 121,
-			49, 223, 67, 227, 51, 50, 26, 187, 43, 99, 246, 152, 241, 160,
-			207, 55, 47, 72, 163, 60, 238, 217, 118, 200, 88, 122, 2, 26,
-			239, 201, 198, 39, 250, 16, 243, 210, 105, 175, 5, 194, 117, 66,
-			6, 179, 196, 184, 48, 152, 14, 67, 179, 209, 52, 79, 122, 37,
-			144, 84, 201, 248, 144, 5, 27, 49, 221, 147, 77, 219, 60, 101,
-			236, 70, 133, 156, 75, 25, 162, 113, 81, 34, 58, 201, 62, 79,
-			67, 243, 232, 207, 87, 200, 136, 145, 213, 51, 127, 168, 252, 77,
-			40, 171, 27, 37, 170, 150, 49, 180, 252, 200, 23, 248, 83, 49,
-			180, 209, 145, 27, 248, 83, 53, 52, 50, 114, 141, 252, 54, 63,
-			151, 26, 203, 204, 43, 230, 231, 244, 152, 217, 200, 202, 184, 56,
-			2, 10, 163, 160, 223, 140, 250, 193, 32, 93, 215, 15, 89, 64,
-			232, 66, 220, 117, 33, 174, 65, 75, 196, 25, 99, 249, 11, 196,
-			147, 25, 217, 73, 149, 154, 22, 202, 15, 130, 168, 189, 218, 150,
-			204, 237, 237, 91, 33, 163, 11, 24, 23, 52, 5, 46, 25, 75,
-			208, 106, 139, 71, 51, 69, 158, 135, 96, 45, 171, 239, 70, 36,
-			14, 179, 0, 203, 161, 227, 186, 50, 27, 144, 202, 199, 78, 170,
-			99, 243, 137, 124, 236, 100, 170, 84, 111, 114, 116, 62, 145, 143,
-			157, 188, 116, 153, 124, 38, 211, 177, 211, 234, 156, 185, 138, 140,
-			202, 244, 4, 208, 230, 172, 201, 212, 125, 200, 34, 8, 32, 93,
-			223, 226, 169, 133, 84, 38, 113, 90, 157, 164, 137, 76, 226, 116,
-			76, 88, 1, 236, 163, 83, 137, 76, 226, 244, 236, 121, 178, 45,
-			75, 217, 102, 213, 43, 102, 25, 9, 67, 196, 32, 9, 71, 236,
-			85, 180, 20, 98, 146, 197, 121, 61, 168, 225, 27, 98, 40, 182,
-			231, 100, 137, 218, 172, 58, 61, 151, 40, 81, 155, 141, 25, 129,
-			97, 206, 142, 94, 76, 148, 168, 205, 94, 166, 164, 53, 40, 81,
-			187, 98, 126, 129, 140, 64, 40, 242, 70, 49, 8, 61, 201, 202,
-			196, 94, 192, 90, 44, 8, 48, 47, 67, 232, 194, 241, 168, 106,
-			97, 168, 170, 109, 246, 74, 170, 170, 45, 159, 172, 106, 139, 25,
-			196, 170, 182, 203, 148, 124, 75, 84, 93, 49, 244, 203, 153, 146,
-			98, 246, 232, 137, 14, 76, 154, 46, 40, 200, 111, 209, 166, 229,
-			186, 44, 88, 10, 251, 189, 158, 235, 48, 155, 39, 59, 133, 13,
-			147, 33, 12, 169, 146, 69, 41, 229, 33, 171, 6, 13, 94, 206,
-			191, 71, 10, 68, 215, 113, 51, 122, 69, 157, 49, 231, 143, 87,
-			117, 138, 85, 79, 12, 86, 65, 123, 188, 162, 94, 198, 189, 19,
-			128, 89, 232, 153, 151, 144, 98, 104, 87, 70, 39, 36, 164, 25,
-			218, 149, 169, 105, 114, 3, 73, 192, 254, 86, 157, 50, 205, 55,
-			20, 142, 114, 10, 96, 120, 87, 213, 43, 51, 2, 11, 24, 222,
-			85, 145, 194, 86, 208, 240, 174, 138, 26, 74, 5, 13, 239, 234,
-			164, 65, 238, 34, 5, 216, 106, 170, 243, 38, 47, 9, 61, 86,
-			11, 27, 70, 1, 108, 181, 78, 46, 137, 85, 208, 202, 174, 171,
-			87, 167, 4, 102, 176, 178, 235, 241, 184, 128, 249, 235, 162, 36,
-			86, 65, 43, 187, 126, 193, 36, 191, 64, 170, 176, 31, 84, 39,
-			205, 207, 68, 33, 106, 108, 221, 113, 92, 181, 193, 194, 102, 224,
-			244, 34, 158, 212, 227, 76, 157, 198, 5, 152, 82, 65, 189, 62,
-			47, 40, 129, 41, 21, 226, 177, 131, 41, 21, 242, 103, 37, 4,
-			132, 199, 39, 200, 159, 40, 200, 134, 110, 104, 75, 234, 188, 249,
-			79, 149, 68, 5, 105, 178, 82, 20, 151, 115, 116, 67, 159, 221,
-			163, 43, 69, 49, 33, 147, 47, 99, 255, 19, 178, 40, 78, 152,
-			6, 104, 88, 241, 81, 63, 90, 102, 145, 111, 95, 101, 125, 172,
-			199, 152, 141, 3, 178, 60, 106, 217, 60, 29, 107, 185, 68, 98,
-			199, 158, 181, 221, 245, 120, 140, 186, 110, 232, 75, 106, 97, 82,
-			140, 67, 207, 2, 231, 114, 140, 186, 98, 104, 75, 121, 41, 105,
-			93, 51, 180, 165, 11, 38, 249, 140, 168, 186, 106, 232, 171, 153,
-			143, 20, 243, 22, 14, 48, 118, 232, 114, 163, 45, 221, 249, 208,
-			76, 224, 132, 193, 224, 65, 135, 171, 249, 75, 228, 67, 162, 235,
-			152, 43, 89, 83, 39, 204, 27, 136, 44, 57, 93, 170, 27, 199,
-			43, 110, 5, 235, 42, 26, 255, 154, 186, 202, 125, 162, 138, 198,
-			191, 38, 140, 132, 231, 91, 214, 70, 207, 72, 72, 51, 180, 181,
-			177, 113, 114, 27, 201, 41, 134, 118, 91, 157, 55, 11, 66, 57,
-			86, 20, 187, 33, 33, 83, 102, 159, 68, 15, 166, 194, 109, 117,
-			109, 66, 224, 84, 114, 128, 38, 134, 0, 233, 228, 172, 132, 52,
-			67, 187, 125, 193, 36, 15, 9, 72, 77, 191, 147, 41, 43, 230,
-			167, 244, 88, 52, 131, 142, 158, 59, 150, 102, 63, 8, 152, 23,
-			13, 216, 177, 210, 44, 128, 208, 192, 228, 238, 228, 47, 160, 151,
-			192, 116, 208, 221, 183, 241, 18, 26, 10, 234, 174, 122, 135, 219,
-			177, 134, 130, 186, 43, 4, 197, 211, 72, 119, 133, 151, 208, 80,
-			80, 119, 167, 166, 81, 47, 26, 8, 234, 94, 172, 151, 52, 9,
-			88, 73, 210, 154, 138, 201, 129, 156, 238, 169, 119, 103, 4, 74,
-			112, 25, 247, 98, 114, 32, 167, 123, 66, 47, 26, 202, 233, 222,
-			216, 56, 249, 4, 201, 169, 134, 246, 115, 117, 194, 92, 194, 186,
-			226, 160, 207, 138, 252, 72, 205, 102, 195, 85, 198, 118, 60, 137,
-			99, 162, 224, 49, 126, 174, 222, 147, 227, 0, 143, 241, 115, 81,
-			96, 172, 161, 198, 127, 62, 34, 137, 130, 147, 248, 57, 18, 69,
-			235, 223, 200, 212, 20, 225, 166, 78, 181, 227, 129, 230, 18, 54,
-			12, 179, 99, 35, 111, 162, 81, 97, 46, 109, 243, 71, 27, 149,
-			142, 186, 217, 84, 55, 248, 146, 164, 171, 153, 28, 160, 153, 144,
-			144, 98, 104, 155, 194, 168, 116, 212, 205, 230, 5, 147, 252, 145,
-			130, 4, 21, 67, 123, 164, 78, 154, 223, 43, 32, 174, 152, 70,
-			81, 44, 83, 177, 231, 59, 89, 108, 39, 122, 101, 18, 135, 150,
-			131, 150, 220, 15, 117, 173, 168, 137, 135, 47, 116, 33, 181, 177,
-			95, 16, 231, 170, 142, 71, 23, 248, 22, 58, 30, 24, 88, 193,
-			35, 117, 115, 94, 48, 15, 86, 240, 72, 56, 22, 29, 173, 224,
-			145, 112, 158, 58, 90, 193, 163, 241, 9, 178, 136, 227, 82, 13,
-			237, 177, 122, 213, 188, 136, 44, 130, 18, 78, 243, 0, 58, 42,
-			253, 177, 250, 104, 82, 224, 81, 115, 208, 213, 148, 144, 98, 104,
-			143, 231, 47, 73, 72, 51, 180, 199, 87, 22, 200, 63, 231, 194,
-			211, 12, 237, 115, 245, 130, 249, 107, 238, 160, 229, 62, 152, 190,
-			100, 71, 39, 144, 227, 117, 238, 78, 136, 175, 109, 159, 133, 222,
-			141, 40, 33, 18, 120, 234, 120, 188, 192, 63, 198, 20, 123, 231,
-			132, 5, 96, 233, 127, 191, 199, 130, 150, 219, 247, 251, 252, 144,
-			44, 236, 248, 125, 215, 6, 71, 111, 51, 151, 69, 113, 172, 169,
-			227, 234, 243, 185, 250, 248, 170, 24, 0, 172, 62, 159, 199, 2,
-			4, 87, 240, 121, 126, 90, 66, 48, 156, 243, 115, 36, 32, 224,
-			192, 245, 39, 153, 23, 138, 217, 162, 39, 111, 121, 126, 84, 36,
-			51, 132, 226, 180, 240, 37, 139, 149, 8, 151, 208, 49, 97, 14,
-			248, 217, 219, 56, 166, 44, 26, 255, 51, 245, 9, 247, 224, 89,
-			116, 76, 207, 132, 167, 224, 185, 227, 103, 194, 49, 101, 209, 248,
-			159, 9, 199, 148, 5, 213, 126, 249, 99, 29, 83, 22, 77, 242,
-			75, 245, 217, 140, 64, 9, 38, 249, 101, 76, 14, 76, 242, 75,
-			225, 152, 178, 104, 146, 95, 142, 141, 99, 180, 148, 5, 147, 252,
-			234, 45, 162, 165, 44, 26, 228, 87, 234, 151, 146, 105, 240, 66,
-			95, 9, 157, 101, 209, 32, 191, 18, 209, 82, 22, 13, 242, 171,
-			73, 131, 172, 32, 5, 205, 208, 158, 171, 243, 230, 85, 164, 48,
-			20, 9, 200, 90, 133, 164, 237, 103, 209, 60, 158, 171, 95, 77,
-			9, 116, 96, 30, 207, 99, 82, 96, 30, 207, 197, 194, 157, 69,
-			243, 120, 126, 193, 36, 62, 81, 245, 156, 145, 181, 51, 127, 79,
-			81, 204, 125, 122, 210, 78, 246, 71, 88, 71, 186, 63, 57, 205,
-			54, 114, 138, 161, 217, 249, 139, 104, 27, 152, 205, 111, 189, 141,
-			109, 228, 208, 54, 90, 170, 205, 231, 111, 14, 109, 163, 37, 148,
-			197, 79, 1, 90, 194, 54, 114, 104, 27, 173, 169, 105, 178, 137,
-			36, 20, 67, 235, 168, 19, 230, 157, 183, 10, 38, 248, 46, 248,
-			208, 10, 135, 175, 199, 228, 208, 90, 58, 106, 107, 70, 16, 1,
-			107, 233, 196, 12, 128, 181, 116, 132, 181, 228, 208, 90, 58, 99,
-			227, 228, 83, 100, 64, 53, 180, 111, 212, 139, 230, 50, 223, 235,
-			245, 187, 251, 44, 0, 194, 64, 148, 121, 81, 224, 176, 80, 80,
-			101, 1, 59, 78, 22, 76, 232, 27, 181, 35, 199, 6, 38, 244,
-			141, 208, 107, 14, 77, 232, 155, 252, 121, 9, 105, 134, 246, 141,
-			57, 143, 91, 204, 28, 0, 174, 58, 47, 182, 152, 29, 167, 221,
-			225, 1, 70, 59, 125, 129, 233, 244, 241, 130, 65, 185, 234, 55,
-			23, 5, 114, 48, 40, 55, 38, 12, 6, 229, 10, 131, 202, 161,
-			65, 185, 23, 76, 242, 87, 10, 82, 214, 13, 45, 80, 167, 204,
-			191, 192, 149, 200, 243, 163, 196, 222, 58, 96, 156, 152, 55, 240,
-			140, 152, 66, 195, 163, 111, 112, 169, 124, 253, 192, 252, 6, 158,
-			157, 56, 158, 141, 103, 47, 156, 81, 203, 227, 205, 169, 223, 108,
-			246, 97, 251, 87, 216, 103, 16, 233, 122, 190, 183, 196, 248, 49,
-			135, 229, 217, 88, 138, 131, 54, 122, 4, 61, 120, 1, 16, 31,
-			40, 222, 179, 234, 248, 135, 180, 223, 147, 65, 116, 210, 36, 134,
-			76, 56, 190, 239, 230, 250, 237, 48, 22, 12, 4, 9, 129, 234,
-			206, 139, 193, 67, 136, 28, 196, 134, 0, 65, 64, 48, 58, 38,
-			33, 205, 208, 130, 73, 131, 252, 12, 229, 146, 53, 180, 190, 122,
-			65, 196, 200, 66, 222, 201, 12, 69, 218, 147, 136, 53, 218, 138,
-			172, 152, 114, 86, 55, 244, 190, 26, 76, 9, 236, 89, 68, 40,
-			41, 131, 211, 237, 143, 198, 239, 52, 67, 235, 207, 206, 145, 223,
-			66, 202, 57, 67, 59, 84, 47, 152, 55, 121, 48, 226, 188, 62,
-			97, 17, 61, 133, 100, 78, 55, 244, 67, 181, 127, 65, 160, 205,
-			101, 1, 147, 180, 2, 152, 203, 135, 121, 73, 50, 167, 25, 218,
-			225, 236, 156, 48, 191, 17, 67, 123, 29, 103, 56, 126, 104, 176,
-			220, 22, 83, 132, 71, 116, 67, 127, 173, 30, 74, 194, 35, 89,
-			192, 39, 199, 58, 162, 24, 218, 235, 81, 67, 66, 154, 161, 189,
-			158, 57, 79, 62, 70, 194, 121, 67, 251, 86, 157, 51, 63, 120,
-			243, 88, 79, 160, 152, 215, 13, 253, 91, 245, 245, 156, 192, 154,
-			207, 2, 34, 57, 212, 188, 98, 104, 223, 230, 37, 197, 188, 102,
-			104, 223, 206, 156, 23, 122, 29, 53, 180, 239, 212, 243, 111, 169,
-			87, 81, 89, 147, 162, 60, 170, 27, 250, 119, 234, 183, 146, 242,
-			104, 22, 16, 202, 177, 142, 42, 134, 246, 221, 232, 164, 132, 52,
-			67, 251, 110, 122, 150, 220, 65, 202, 196, 208, 127, 169, 168, 231,
-			127, 72, 177, 105, 154, 99, 136, 136, 232, 70, 246, 151, 138, 250,
-			221, 121, 193, 3, 201, 34, 174, 17, 9, 42, 0, 230, 39, 37,
-			168, 1, 56, 61, 75, 66, 162, 230, 50, 70, 238, 247, 149, 204,
-			31, 42, 138, 201, 168, 76, 33, 199, 238, 30, 139, 170, 183, 252,
-			246, 134, 223, 78, 77, 173, 120, 46, 225, 68, 196, 66, 176, 150,
-			5, 29, 15, 157, 168, 67, 100, 143, 94, 224, 227, 133, 82, 175,
-			157, 188, 105, 123, 134, 104, 185, 140, 98, 232, 191, 175, 228, 39,
-			8, 35, 122, 46, 163, 102, 12, 253, 15, 20, 117, 221, 124, 58,
-			72, 43, 82, 203, 117, 253, 67, 44, 116, 23, 188, 68, 62, 13,
-			24, 120, 216, 3, 150, 222, 84, 157, 148, 219, 34, 180, 103, 5,
-			86, 151, 69, 44, 16, 115, 61, 135, 57, 60, 253, 15, 148, 220,
-			184, 4, 85, 0, 39, 222, 151, 160, 6, 224, 74, 153, 236, 34,
-			79, 138, 161, 255, 74, 81, 31, 155, 247, 135, 247, 185, 14, 122,
-			60, 199, 102, 221, 158, 31, 241, 93, 93, 226, 162, 113, 32, 26,
-			83, 191, 199, 196, 17, 163, 36, 175, 32, 202, 220, 180, 4, 85,
-			0, 103, 62, 144, 160, 6, 224, 237, 95, 144, 117, 36, 175, 26,
-			250, 247, 138, 90, 54, 111, 39, 183, 39, 111, 191, 163, 228, 56,
-			97, 16, 223, 43, 185, 9, 9, 34, 210, 201, 5, 9, 106, 0,
-			46, 253, 140, 236, 35, 69, 205, 208, 127, 173, 168, 219, 102, 227,
-			88, 96, 120, 108, 196, 241, 216, 240, 64, 28, 239, 229, 38, 162,
-			152, 68, 46, 132, 136, 100, 136, 100, 72, 83, 128, 72, 110, 70,
-			130, 42, 128, 179, 69, 9, 34, 11, 31, 111, 145, 127, 173, 32,
-			71, 186, 161, 255, 19, 69, 125, 100, 254, 177, 146, 246, 229, 63,
-			192, 80, 192, 154, 126, 96, 167, 36, 114, 35, 140, 151, 168, 148,
-			101, 136, 91, 8, 88, 20, 182, 127, 148, 8, 124, 156, 112, 96,
-			88, 253, 158, 239, 209, 176, 143, 151, 163, 91, 125, 121, 181, 151,
-			196, 24, 227, 209, 233, 10, 48, 156, 155, 146, 160, 10, 224, 116,
-			65, 130, 26, 128, 107, 15, 227, 83, 244, 127, 112, 131, 92, 30,
-			62, 119, 142, 226, 219, 8, 167, 84, 130, 222, 37, 163, 113, 217,
-			247, 143, 190, 253, 218, 56, 185, 122, 116, 44, 198, 248, 147, 42,
-			72, 255, 205, 251, 188, 130, 180, 251, 238, 6, 236, 187, 10, 210,
-			119, 21, 164, 239, 42, 72, 223, 85, 144, 190, 171, 32, 253, 107,
-			83, 65, 122, 57, 89, 65, 122, 57, 85, 65, 122, 62, 93, 65,
-			122, 126, 168, 130, 84, 98, 196, 163, 49, 243, 82, 92, 65, 90,
-			76, 86, 144, 22, 83, 21, 164, 83, 233, 10, 210, 169, 161, 10,
-			210, 169, 100, 5, 105, 92, 147, 250, 126, 102, 57, 89, 65, 186,
-			156, 170, 32, 157, 79, 87, 144, 206, 15, 85, 144, 202, 154, 84,
-			109, 196, 208, 222, 191, 84, 138, 43, 72, 23, 146, 21, 164, 11,
-			169, 10, 82, 51, 93, 65, 106, 14, 85, 144, 154, 201, 10, 210,
-			184, 38, 245, 70, 60, 234, 44, 7, 146, 21, 164, 215, 210, 21,
-			164, 215, 134, 42, 72, 101, 77, 42, 236, 114, 111, 196, 53, 169,
-			133, 184, 38, 53, 199, 129, 100, 5, 233, 92, 186, 130, 116, 110,
-			168, 130, 84, 214, 164, 230, 70, 12, 173, 48, 79, 201, 63, 156,
-			224, 181, 11, 86, 166, 171, 152, 127, 119, 130, 150, 19, 87, 231,
-			82, 151, 106, 197, 7, 113, 60, 126, 129, 246, 132, 59, 174, 248,
-			252, 181, 239, 49, 172, 248, 148, 55, 94, 139, 169, 139, 81, 86,
-			248, 54, 247, 107, 201, 41, 119, 179, 28, 143, 238, 53, 214, 105,
-			165, 231, 55, 59, 226, 98, 22, 15, 82, 153, 7, 49, 0, 68,
-			42, 226, 56, 144, 64, 128, 229, 178, 94, 228, 52, 233, 131, 128,
-			181, 253, 192, 177, 60, 186, 46, 111, 225, 30, 118, 156, 102, 135,
-			178, 87, 17, 243, 196, 166, 97, 208, 72, 50, 78, 232, 190, 213,
-			124, 121, 104, 5, 54, 230, 103, 142, 152, 21, 80, 223, 59, 70,
-			210, 10, 195, 126, 23, 168, 90, 174, 75, 187, 142, 215, 143, 24,
-			70, 47, 244, 163, 21, 18, 15, 201, 245, 189, 118, 145, 58, 37,
-			86, 162, 46, 179, 122, 131, 161, 6, 140, 46, 132, 93, 102, 5,
-			204, 94, 160, 161, 207, 131, 34, 207, 79, 182, 34, 52, 178, 246,
-			121, 137, 173, 199, 152, 45, 78, 57, 49, 60, 236, 65, 188, 195,
-			111, 30, 199, 247, 115, 249, 178, 186, 178, 178, 178, 186, 132, 255,
-			53, 86, 86, 62, 193, 255, 190, 132, 81, 220, 185, 115, 231, 206,
-			210, 234, 173, 165, 181, 213, 198, 173, 181, 79, 110, 223, 249, 228,
-			246, 157, 210, 29, 249, 231, 203, 18, 161, 247, 143, 64, 224, 81,
-			224, 52, 35, 241, 5, 34, 100, 41, 0, 244, 69, 122, 200, 40,
-			243, 194, 126, 32, 179, 76, 12, 235, 104, 154, 190, 119, 192, 130,
-			8, 75, 20, 184, 86, 253, 46, 165, 181, 205, 117, 186, 182, 182,
-			118, 135, 202, 175, 29, 57, 94, 59, 44, 17, 90, 103, 140, 254,
-			29, 25, 151, 30, 30, 30, 150, 28, 22, 181, 74, 126, 208, 94,
-			14, 90, 77, 248, 11, 157, 74, 209, 171, 232, 183, 11, 111, 211,
-			106, 241, 148, 75, 199, 67, 151, 78, 119, 119, 234, 213, 103, 244,
-			5, 24, 77, 97, 241, 197, 241, 251, 165, 241, 230, 66, 148, 7,
-			15, 54, 27, 33, 139, 158, 11, 125, 21, 176, 251, 246, 222, 214,
-			214, 226, 226, 137, 237, 208, 108, 11, 43, 139, 119, 223, 234, 34,
-			172, 224, 169, 205, 34, 192, 226, 183, 108, 235, 40, 193, 27, 175,
-			17, 66, 2, 7, 150, 75, 163, 3, 65, 49, 213, 252, 122, 116,
-			80, 164, 200, 208, 221, 159, 58, 164, 131, 82, 116, 0, 208, 155,
-			70, 196, 27, 245, 67, 214, 164, 31, 224, 165, 209, 212, 8, 215,
-			78, 29, 225, 83, 199, 91, 187, 69, 95, 60, 96, 81, 253, 40,
-			140, 88, 23, 94, 151, 195, 77, 199, 101, 141, 180, 34, 54, 171,
-			91, 149, 70, 245, 113, 133, 182, 34, 193, 198, 105, 125, 174, 183,
-			34, 201, 233, 94, 117, 187, 241, 209, 135, 52, 114, 154, 47, 67,
-			122, 143, 22, 10, 5, 254, 100, 177, 21, 149, 236, 195, 135, 78,
-			187, 179, 97, 69, 216, 107, 145, 126, 250, 41, 93, 187, 181, 72,
-			191, 165, 248, 110, 203, 63, 148, 175, 164, 220, 150, 151, 105, 25,
-			248, 181, 253, 195, 16, 81, 194, 100, 90, 93, 89, 73, 184, 162,
-			176, 20, 55, 96, 232, 130, 86, 63, 58, 62, 203, 98, 108, 208,
-			125, 245, 163, 15, 63, 252, 240, 227, 181, 143, 86, 86, 226, 41,
-			191, 207, 90, 126, 192, 232, 158, 231, 188, 146, 88, 238, 124, 188,
-			50, 140, 165, 244, 211, 148, 89, 224, 227, 167, 133, 2, 23, 202,
-			114, 124, 195, 119, 145, 46, 37, 217, 249, 1, 11, 6, 60, 32,
-			46, 137, 231, 253, 4, 30, 52, 128, 197, 148, 1, 124, 120, 170,
-			1, 60, 178, 14, 44, 250, 130, 43, 178, 36, 18, 51, 208, 228,
-			177, 227, 186, 78, 152, 48, 0, 240, 144, 180, 139, 79, 233, 61,
-			122, 122, 135, 55, 152, 57, 189, 151, 248, 96, 129, 199, 14, 239,
-			247, 29, 215, 102, 65, 97, 17, 6, 86, 23, 18, 18, 36, 184,
-			96, 22, 229, 141, 2, 74, 161, 205, 54, 31, 187, 227, 69, 48,
-			114, 209, 146, 15, 93, 12, 27, 37, 176, 88, 218, 7, 204, 200,
-			203, 64, 6, 183, 79, 149, 129, 204, 71, 137, 117, 147, 238, 30,
-			69, 29, 190, 131, 129, 63, 158, 127, 72, 239, 241, 197, 140, 59,
-			39, 121, 241, 65, 94, 80, 119, 188, 168, 224, 249, 135, 226, 185,
-			188, 131, 14, 79, 225, 49, 93, 146, 77, 57, 139, 31, 124, 112,
-			103, 113, 72, 175, 73, 185, 20, 68, 227, 123, 226, 255, 69, 142,
-			240, 30, 254, 187, 72, 18, 183, 191, 173, 252, 36, 63, 51, 206,
-			240, 179, 171, 105, 243, 215, 10, 173, 13, 2, 130, 196, 29, 112,
-			88, 147, 113, 112, 252, 11, 122, 3, 211, 38, 39, 219, 118, 250,
-			110, 49, 254, 115, 202, 122, 69, 78, 90, 176, 190, 60, 229, 190,
-			120, 75, 181, 166, 18, 245, 137, 173, 212, 125, 241, 86, 234, 190,
-			120, 203, 152, 34, 255, 85, 145, 5, 138, 174, 106, 152, 127, 169,
-			208, 109, 223, 91, 138, 47, 99, 255, 184, 91, 227, 37, 186, 45,
-			58, 202, 53, 95, 126, 122, 3, 183, 222, 3, 100, 152, 32, 224,
-			95, 193, 235, 88, 7, 12, 207, 115, 98, 154, 92, 183, 188, 163,
-			44, 83, 197, 175, 146, 180, 252, 0, 226, 16, 25, 124, 13, 9,
-			112, 69, 44, 236, 131, 11, 218, 39, 95, 200, 118, 213, 86, 242,
-			66, 182, 155, 186, 144, 237, 166, 46, 100, 187, 137, 11, 217, 255,
-			120, 250, 55, 244, 161, 208, 200, 10, 95, 134, 67, 215, 89, 126,
-			232, 242, 201, 15, 37, 1, 23, 126, 165, 146, 51, 34, 1, 218,
-			176, 194, 151, 63, 162, 96, 126, 130, 104, 47, 217, 145, 168, 215,
-			135, 159, 198, 167, 228, 108, 200, 162, 200, 101, 207, 109, 230, 90,
-			252, 178, 192, 27, 175, 24, 156, 225, 205, 55, 160, 181, 113, 159,
-			140, 55, 125, 112, 5, 17, 123, 222, 99, 129, 227, 243, 47, 141,
-			190, 17, 193, 152, 236, 177, 139, 29, 140, 159, 145, 115, 182, 19,
-			246, 172, 168, 217, 97, 246, 115, 43, 154, 203, 33, 6, 243, 24,
-			134, 120, 66, 215, 206, 14, 58, 148, 163, 71, 255, 121, 130, 127,
-			205, 116, 245, 111, 84, 217, 245, 141, 65, 217, 117, 129, 216, 124,
-			191, 114, 46, 179, 170, 152, 207, 104, 66, 247, 252, 68, 6, 172,
-			140, 254, 78, 159, 245, 25, 255, 41, 43, 122, 6, 31, 34, 77,
-			20, 171, 240, 185, 237, 120, 109, 151, 145, 227, 101, 103, 224, 55,
-			206, 229, 167, 6, 223, 60, 29, 87, 103, 204, 143, 143, 85, 50,
-			11, 3, 147, 9, 205, 184, 156, 18, 248, 217, 199, 129, 70, 126,
-			202, 81, 141, 171, 231, 102, 18, 142, 106, 60, 85, 72, 61, 46,
-			78, 247, 185, 163, 26, 159, 154, 198, 147, 77, 244, 83, 147, 234,
-			132, 56, 0, 235, 88, 97, 231, 148, 99, 125, 95, 30, 204, 165,
-			230, 254, 164, 58, 62, 147, 152, 251, 147, 169, 18, 234, 73, 113,
-			158, 207, 231, 254, 228, 216, 184, 116, 252, 170, 161, 205, 168, 19,
-			191, 249, 98, 161, 225, 79, 208, 254, 228, 98, 33, 94, 150, 61,
-			163, 78, 78, 36, 202, 178, 103, 82, 95, 14, 157, 201, 159, 73,
-			148, 101, 207, 140, 141, 147, 95, 169, 178, 46, 251, 162, 90, 52,
-			255, 175, 66, 55, 124, 96, 245, 208, 10, 35, 198, 215, 49, 62,
-			82, 89, 89, 154, 224, 182, 239, 69, 142, 75, 29, 228, 209, 138,
-			96, 151, 22, 10, 149, 251, 174, 29, 31, 237, 59, 252, 171, 70,
-			174, 197, 79, 230, 122, 1, 59, 192, 109, 242, 1, 11, 220, 163,
-			37, 171, 221, 14, 88, 8, 222, 57, 150, 40, 223, 181, 33, 7,
-			72, 212, 233, 50, 66, 163, 224, 200, 129, 93, 99, 212, 97, 30,
-			109, 89, 142, 139, 208, 62, 107, 246, 173, 240, 120, 97, 32, 30,
-			25, 200, 15, 172, 242, 90, 129, 166, 239, 186, 140, 127, 207, 201,
-			241, 176, 194, 32, 98, 65, 151, 217, 14, 223, 143, 249, 129, 213,
-			102, 169, 234, 241, 139, 234, 140, 148, 163, 150, 3, 233, 152, 137,
-			234, 241, 139, 243, 55, 18, 213, 227, 23, 63, 184, 73, 254, 74,
-			149, 31, 69, 189, 166, 46, 155, 127, 193, 191, 197, 107, 117, 229,
-			119, 180, 184, 36, 91, 145, 240, 13, 56, 25, 15, 173, 144, 54,
-			3, 198, 63, 107, 5, 211, 37, 49, 4, 233, 10, 61, 22, 134,
-			36, 117, 11, 129, 127, 160, 75, 156, 50, 209, 102, 224, 96, 130,
-			59, 254, 218, 44, 96, 190, 17, 98, 197, 28, 87, 4, 82, 180,
-			60, 84, 12, 225, 235, 105, 49, 133, 158, 99, 247, 124, 92, 67,
-			153, 215, 242, 131, 38, 19, 234, 75, 161, 139, 191, 228, 235, 54,
-			251, 252, 91, 92, 251, 71, 241, 119, 178, 64, 196, 78, 20, 162,
-			17, 48, 202, 94, 245, 28, 121, 204, 134, 234, 43, 224, 243, 216,
-			79, 47, 114, 21, 67, 7, 230, 161, 123, 178, 19, 17, 90, 65,
-			60, 27, 52, 79, 125, 64, 246, 154, 122, 177, 40, 132, 175, 231,
-			64, 220, 82, 49, 186, 98, 104, 215, 230, 63, 32, 131, 15, 200,
-			94, 91, 42, 145, 127, 169, 163, 98, 178, 134, 182, 162, 150, 204,
-			127, 164, 243, 97, 129, 58, 14, 193, 150, 208, 94, 229, 233, 125,
-			172, 150, 193, 50, 18, 219, 49, 15, 88, 67, 234, 247, 120, 49,
-			117, 17, 231, 162, 212, 204, 190, 5, 170, 241, 143, 213, 150, 220,
-			8, 105, 211, 245, 155, 47, 75, 162, 40, 134, 208, 215, 44, 240,
-			139, 137, 79, 142, 157, 124, 164, 24, 223, 71, 78, 77, 254, 193,
-			201, 228, 224, 192, 124, 255, 136, 90, 97, 200, 2, 62, 89, 192,
-			140, 170, 155, 124, 84, 146, 227, 166, 235, 243, 27, 206, 41, 18,
-			55, 66, 234, 250, 77, 64, 134, 12, 34, 158, 193, 81, 67, 212,
-			9, 88, 216, 241, 93, 27, 89, 197, 81, 246, 250, 94, 148, 94,
-			48, 64, 92, 32, 159, 251, 172, 105, 245, 195, 196, 11, 39, 41,
-			65, 192, 220, 232, 119, 247, 93, 225, 217, 172, 102, 212, 183, 92,
-			158, 50, 2, 158, 97, 217, 57, 225, 200, 21, 101, 221, 138, 152,
-			135, 134, 65, 49, 8, 97, 32, 15, 116, 161, 226, 180, 44, 225,
-			37, 19, 4, 33, 84, 222, 237, 239, 47, 215, 251, 251, 114, 135,
-			137, 199, 68, 86, 100, 193, 76, 103, 131, 239, 19, 243, 35, 233,
-			129, 3, 205, 234, 134, 190, 162, 94, 91, 22, 54, 148, 205, 129,
-			213, 200, 187, 60, 89, 197, 208, 86, 46, 46, 74, 72, 51, 180,
-			149, 226, 146, 140, 12, 255, 95, 0, 0, 0, 255, 255, 234, 190,
-			171, 151, 67, 94, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 220, 58, 77, 143, 28, 199,
+			117, 211, 85, 221, 195, 153, 90, 114, 63, 106, 63, 200, 237, 165,
+			200, 226, 82, 38, 87, 242, 238, 44, 63, 34, 59, 50, 37, 74,
+			252, 208, 7, 41, 74, 161, 150, 43, 89, 33, 20, 44, 122, 186,
+			107, 102, 58, 236, 233, 26, 118, 87, 47, 181, 130, 34, 192, 48,
+			18, 56, 128, 13, 27, 48, 226, 131, 129, 64, 73, 16, 4, 142,
+			15, 185, 229, 152, 32, 135, 220, 125, 201, 37, 7, 35, 255, 33,
+			167, 0, 1, 146, 224, 189, 174, 234, 249, 216, 93, 114, 41, 40,
+			23, 95, 118, 231, 117, 85, 189, 247, 234, 125, 213, 123, 175, 138,
+			253, 27, 99, 247, 187, 177, 238, 21, 237, 86, 168, 250, 155, 73,
+			17, 198, 248, 103, 163, 171, 54, 19, 213, 141, 84, 119, 51, 24,
+			196, 155, 50, 141, 6, 42, 78, 117, 190, 25, 42, 149, 69, 113,
+			26, 104, 149, 109, 230, 50, 219, 141, 67, 153, 111, 238, 94, 222,
+			204, 117, 160, 101, 107, 144, 41, 173, 120, 189, 92, 185, 250, 43,
+			135, 77, 223, 83, 221, 7, 58, 147, 65, 255, 1, 76, 224, 231,
+			217, 9, 156, 179, 179, 43, 179, 60, 86, 233, 41, 71, 56, 107,
+			205, 173, 227, 248, 241, 227, 242, 27, 95, 98, 245, 92, 134, 153,
+			212, 167, 136, 112, 214, 142, 111, 25, 136, 127, 139, 77, 107, 153,
+			245, 227, 52, 72, 118, 226, 52, 146, 159, 157, 162, 194, 89, 163,
+			91, 39, 236, 215, 59, 240, 145, 251, 172, 17, 100, 97, 47, 222,
+			149, 209, 41, 87, 56, 107, 141, 173, 10, 6, 212, 131, 34, 235,
+			202, 232, 148, 135, 35, 6, 186, 251, 175, 13, 86, 231, 174, 91,
+			123, 193, 97, 255, 232, 48, 231, 56, 167, 110, 141, 95, 249, 181,
+			35, 110, 169, 193, 94, 22, 119, 123, 90, 92, 185, 116, 249, 59,
+			98, 187, 39, 197, 189, 143, 110, 221, 17, 55, 10, 221, 83, 89,
+			222, 18, 55, 146, 68, 224, 132, 92, 100, 18, 36, 34, 163, 22,
+			19, 31, 229, 82, 168, 142, 208, 189, 56, 23, 185, 42, 178, 80,
+			138, 80, 69, 82, 196, 185, 232, 170, 93, 153, 165, 50, 18, 69,
+			26, 201, 76, 232, 158, 20, 55, 6, 65, 8, 136, 227, 80, 166,
+			185, 92, 23, 70, 16, 226, 74, 235, 18, 19, 186, 23, 104, 17,
+			6, 169, 104, 75, 209, 81, 69, 26, 137, 56, 197, 85, 247, 238,
+			220, 122, 235, 131, 7, 111, 137, 78, 156, 200, 22, 99, 13, 230,
+			16, 78, 235, 181, 25, 182, 205, 136, 91, 227, 110, 179, 246, 130,
+			227, 191, 43, 198, 53, 0, 28, 192, 226, 68, 117, 69, 142, 159,
+			5, 170, 78, 236, 6, 73, 1, 76, 246, 251, 69, 26, 135, 129,
+			150, 145, 208, 74, 88, 21, 183, 24, 99, 140, 186, 53, 135, 211,
+			102, 99, 137, 221, 102, 174, 91, 35, 53, 78, 167, 200, 138, 255,
+			93, 113, 127, 68, 123, 150, 0, 106, 180, 93, 116, 132, 209, 180,
+			232, 168, 204, 200, 3, 201, 182, 24, 59, 206, 60, 192, 226, 1,
+			154, 134, 133, 28, 78, 167, 154, 75, 22, 162, 156, 78, 45, 251,
+			236, 47, 9, 18, 116, 56, 157, 39, 243, 254, 79, 137, 216, 30,
+			219, 194, 197, 92, 148, 38, 210, 98, 76, 124, 160, 180, 44, 133,
+			6, 108, 148, 223, 129, 169, 76, 234, 2, 228, 126, 78, 108, 131,
+			86, 226, 92, 168, 71, 193, 222, 186, 200, 227, 52, 132, 5, 113,
+			46, 172, 153, 195, 116, 149, 38, 123, 76, 4, 97, 40, 243, 60,
+			110, 39, 18, 164, 161, 179, 34, 7, 193, 84, 82, 17, 219, 67,
+			18, 70, 69, 33, 168, 50, 18, 237, 189, 106, 150, 208, 138, 129,
+			120, 227, 8, 228, 108, 132, 30, 167, 29, 149, 245, 3, 13, 146,
+			121, 18, 235, 158, 42, 180, 72, 165, 140, 226, 180, 11, 148, 6,
+			248, 191, 39, 197, 173, 161, 191, 137, 56, 101, 162, 45, 245, 19,
+			41, 83, 33, 131, 176, 39, 138, 1, 160, 172, 36, 233, 120, 32,
+			159, 99, 22, 2, 105, 53, 166, 45, 68, 57, 157, 159, 227, 108,
+			23, 5, 73, 56, 61, 73, 86, 252, 184, 228, 223, 114, 20, 201,
+			207, 74, 163, 157, 144, 173, 117, 47, 209, 151, 121, 30, 116, 101,
+			75, 220, 41, 103, 149, 54, 19, 231, 98, 227, 242, 58, 171, 214,
+			161, 138, 227, 36, 49, 8, 226, 180, 91, 113, 72, 60, 32, 108,
+			57, 36, 14, 167, 39, 27, 86, 215, 132, 114, 122, 114, 217, 103,
+			87, 145, 67, 202, 169, 79, 22, 252, 11, 226, 206, 36, 67, 162,
+			23, 228, 162, 13, 50, 176, 94, 93, 161, 167, 30, 172, 170, 91,
+			200, 225, 212, 63, 54, 99, 33, 192, 200, 231, 217, 101, 68, 239,
+			114, 122, 154, 112, 255, 197, 167, 161, 47, 67, 67, 133, 220, 245,
+			96, 141, 69, 238, 58, 156, 158, 62, 118, 194, 66, 148, 211, 211,
+			179, 115, 237, 58, 90, 253, 85, 246, 223, 14, 59, 211, 85, 170,
+			155, 200, 77, 235, 7, 155, 81, 145, 161, 186, 77, 144, 156, 41,
+			199, 91, 118, 124, 245, 123, 172, 113, 219, 76, 225, 167, 216, 177,
+			92, 134, 42, 141, 114, 12, 144, 116, 203, 130, 124, 129, 121, 105,
+			144, 170, 28, 67, 163, 183, 85, 2, 55, 127, 232, 176, 249, 80,
+			245, 91, 19, 56, 111, 158, 176, 24, 209, 67, 239, 59, 15, 47,
+			155, 25, 93, 149, 4, 105, 183, 165, 178, 238, 144, 65, 189, 55,
+			144, 249, 230, 163, 84, 61, 73, 43, 102, 7, 237, 255, 114, 156,
+			191, 33, 244, 157, 251, 55, 127, 77, 206, 188, 83, 46, 190, 111,
+			86, 180, 190, 47, 147, 228, 61, 152, 191, 13, 75, 171, 221, 255,
+			179, 195, 86, 38, 119, 47, 251, 3, 189, 119, 216, 214, 143, 49,
+			239, 45, 24, 191, 249, 39, 7, 111, 131, 225, 168, 221, 67, 235,
+			136, 123, 64, 146, 95, 107, 3, 63, 184, 206, 182, 190, 153, 83,
+			177, 252, 61, 126, 46, 250, 223, 248, 137, 235, 63, 195, 216, 252,
+			167, 169, 99, 245, 95, 28, 54, 247, 142, 212, 183, 84, 218, 137,
+			187, 91, 50, 31, 168, 52, 151, 124, 157, 241, 16, 191, 236, 24,
+			162, 59, 69, 150, 152, 227, 122, 182, 28, 121, 80, 14, 124, 148,
+			37, 252, 5, 198, 170, 217, 229, 177, 221, 220, 106, 218, 89, 154,
+			183, 216, 188, 197, 98, 166, 13, 2, 221, 195, 227, 187, 185, 53,
+			103, 134, 74, 6, 238, 7, 186, 7, 243, 43, 116, 56, 182, 211,
+			83, 185, 198, 211, 188, 185, 53, 55, 70, 253, 93, 149, 235, 213,
+			191, 119, 216, 226, 150, 236, 198, 185, 150, 89, 153, 110, 108, 201,
+			199, 133, 204, 53, 120, 210, 32, 83, 127, 44, 67, 109, 120, 183,
+			224, 161, 89, 198, 190, 20, 133, 30, 144, 162, 112, 230, 70, 50,
+			15, 145, 163, 227, 91, 248, 251, 128, 244, 196, 59, 32, 61, 89,
+			253, 152, 45, 77, 178, 106, 68, 62, 205, 72, 28, 25, 54, 73,
+			28, 241, 117, 230, 161, 146, 145, 193, 169, 43, 75, 173, 210, 126,
+			90, 227, 39, 250, 86, 57, 105, 245, 67, 54, 119, 79, 5, 209,
+			81, 183, 95, 18, 35, 21, 49, 187, 35, 216, 109, 163, 220, 209,
+			234, 223, 57, 140, 143, 226, 172, 76, 195, 240, 229, 28, 129, 175,
+			10, 49, 25, 17, 213, 183, 25, 13, 186, 18, 245, 63, 117, 101,
+			121, 210, 223, 91, 54, 106, 109, 193, 44, 126, 142, 29, 47, 35,
+			125, 144, 236, 60, 146, 123, 70, 230, 83, 246, 219, 123, 114, 111,
+			245, 63, 28, 182, 180, 93, 74, 89, 203, 175, 43, 129, 161, 65,
+			208, 103, 164, 157, 238, 65, 105, 103, 139, 205, 63, 46, 148, 14,
+			118, 162, 76, 13, 6, 50, 218, 105, 239, 105, 153, 99, 158, 233,
+			110, 205, 225, 208, 237, 114, 228, 38, 12, 128, 131, 101, 129, 150,
+			19, 211, 235, 56, 125, 22, 70, 70, 103, 175, 254, 59, 97, 11,
+			55, 112, 199, 95, 123, 127, 23, 216, 76, 162, 186, 59, 50, 213,
+			217, 222, 78, 168, 138, 84, 219, 252, 57, 81, 221, 183, 224, 235,
+			45, 248, 120, 212, 253, 46, 48, 79, 102, 153, 202, 112, 135, 205,
+			173, 18, 128, 64, 80, 158, 163, 24, 46, 24, 14, 53, 203, 47,
+			16, 39, 206, 178, 41, 51, 156, 199, 159, 203, 83, 83, 136, 216,
+			172, 120, 16, 127, 46, 249, 10, 107, 162, 140, 113, 249, 2, 46,
+			111, 224, 7, 19, 101, 240, 119, 185, 120, 17, 23, 151, 211, 113,
+			237, 50, 107, 68, 129, 14, 112, 233, 25, 92, 122, 12, 96, 88,
+			185, 194, 154, 240, 179, 92, 120, 22, 23, 226, 92, 88, 119, 229,
+			63, 9, 107, 152, 104, 146, 243, 235, 172, 89, 5, 67, 190, 180,
+			207, 52, 241, 36, 242, 151, 173, 201, 239, 143, 155, 127, 192, 166,
+			199, 221, 155, 191, 96, 39, 143, 127, 55, 10, 244, 207, 28, 54,
+			108, 16, 222, 98, 108, 232, 131, 188, 162, 60, 234, 151, 37, 34,
+			255, 160, 33, 131, 228, 14, 155, 153, 240, 15, 94, 209, 61, 216,
+			113, 252, 67, 246, 206, 223, 98, 39, 198, 12, 145, 159, 182, 136,
+			14, 178, 207, 195, 208, 220, 253, 139, 77, 118, 140, 123, 110, 237,
+			175, 156, 223, 133, 146, 171, 201, 8, 173, 113, 218, 168, 61, 196,
+			159, 80, 38, 213, 214, 240, 39, 225, 148, 213, 190, 197, 254, 168,
+			172, 201, 166, 107, 43, 142, 255, 161, 216, 103, 54, 182, 106, 202,
+			44, 156, 235, 172, 8, 117, 145, 73, 83, 55, 73, 81, 228, 50,
+			99, 98, 181, 90, 186, 90, 149, 43, 35, 197, 217, 116, 99, 153,
+			165, 182, 56, 155, 35, 194, 15, 80, 126, 55, 238, 223, 17, 31,
+			109, 221, 179, 217, 125, 59, 200, 165, 88, 133, 212, 99, 35, 52,
+			184, 204, 57, 139, 169, 61, 38, 78, 235, 184, 225, 72, 118, 130,
+			34, 209, 204, 142, 35, 150, 39, 144, 220, 183, 145, 163, 97, 122,
+			92, 243, 128, 224, 104, 25, 55, 215, 92, 177, 16, 229, 116, 238,
+			204, 89, 118, 221, 86, 113, 11, 228, 148, 127, 25, 57, 75, 131,
+			190, 81, 27, 168, 11, 120, 49, 177, 95, 228, 82, 67, 37, 148,
+			168, 32, 18, 157, 76, 245, 43, 74, 80, 230, 44, 84, 148, 28,
+			135, 211, 133, 230, 188, 133, 40, 167, 11, 75, 39, 217, 7, 182,
+			204, 89, 34, 231, 252, 27, 72, 9, 50, 14, 75, 73, 203, 207,
+			244, 70, 46, 179, 56, 72, 226, 207, 135, 5, 221, 4, 7, 149,
+			197, 90, 202, 80, 190, 44, 85, 148, 97, 35, 75, 205, 211, 22,
+			162, 156, 46, 157, 21, 172, 51, 44, 95, 206, 249, 127, 136, 148,
+			33, 119, 121, 234, 70, 141, 232, 109, 93, 58, 200, 100, 71, 102,
+			25, 86, 224, 76, 172, 78, 100, 66, 69, 150, 172, 86, 178, 40,
+			43, 30, 203, 17, 86, 60, 21, 71, 88, 241, 156, 21, 236, 11,
+			70, 92, 135, 187, 103, 107, 45, 199, 31, 136, 3, 131, 144, 53,
+			63, 144, 185, 234, 136, 48, 72, 18, 153, 109, 228, 197, 96, 144,
+			196, 50, 18, 16, 41, 173, 29, 178, 9, 12, 99, 21, 170, 225,
+			113, 210, 50, 65, 71, 103, 27, 47, 176, 53, 230, 186, 14, 88,
+			230, 57, 178, 232, 175, 236, 47, 226, 205, 201, 101, 118, 231, 96,
+			107, 224, 156, 217, 157, 131, 173, 129, 115, 205, 89, 11, 81, 78,
+			207, 205, 47, 176, 139, 136, 211, 225, 244, 60, 153, 247, 253, 167,
+			52, 6, 202, 101, 96, 60, 231, 77, 5, 234, 96, 141, 124, 222,
+			212, 200, 14, 26, 207, 249, 57, 206, 174, 33, 74, 194, 233, 5,
+			178, 226, 183, 16, 229, 190, 110, 70, 174, 51, 40, 209, 15, 110,
+			106, 56, 104, 41, 23, 42, 206, 193, 82, 46, 152, 166, 134, 131,
+			150, 114, 97, 217, 103, 239, 33, 25, 202, 233, 26, 153, 243, 175,
+			155, 86, 66, 101, 146, 85, 50, 117, 91, 230, 97, 22, 15, 180,
+			202, 134, 61, 149, 195, 200, 130, 57, 172, 85, 187, 3, 115, 88,
+			107, 28, 183, 16, 80, 154, 153, 101, 191, 114, 144, 174, 203, 233,
+			6, 89, 241, 127, 233, 140, 244, 0, 70, 107, 125, 60, 86, 49,
+			28, 92, 127, 93, 92, 90, 55, 110, 51, 58, 88, 197, 129, 92,
+			106, 102, 35, 100, 134, 198, 97, 44, 59, 43, 207, 128, 117, 17,
+			236, 170, 56, 178, 45, 13, 104, 115, 160, 53, 5, 169, 8, 162,
+			40, 134, 169, 65, 194, 44, 118, 0, 197, 214, 253, 91, 213, 166,
+			160, 240, 222, 168, 54, 5, 133, 247, 134, 105, 26, 56, 88, 120,
+			111, 44, 251, 236, 58, 35, 46, 225, 238, 229, 218, 119, 28, 255,
+			138, 216, 30, 141, 164, 166, 105, 97, 100, 38, 39, 205, 183, 164,
+			4, 86, 10, 90, 186, 220, 56, 195, 126, 143, 185, 46, 1, 43,
+			189, 74, 102, 253, 139, 98, 123, 162, 11, 115, 231, 182, 117, 227,
+			161, 157, 25, 94, 9, 90, 236, 85, 163, 119, 130, 22, 123, 181,
+			57, 101, 33, 202, 233, 213, 233, 25, 246, 10, 226, 119, 56, 125,
+			133, 172, 248, 107, 70, 252, 129, 174, 162, 131, 145, 154, 140, 14,
+			34, 224, 212, 97, 221, 172, 133, 0, 203, 220, 146, 133, 40, 167,
+			175, 44, 251, 236, 93, 6, 114, 113, 95, 173, 221, 112, 252, 215,
+			196, 190, 68, 1, 67, 106, 233, 239, 97, 145, 101, 50, 213, 67,
+			250, 193, 56, 77, 16, 11, 88, 209, 171, 141, 101, 116, 94, 10,
+			98, 185, 118, 20, 231, 165, 40, 138, 107, 70, 20, 20, 69, 113,
+			205, 56, 47, 69, 81, 92, 155, 95, 64, 81, 83, 144, 251, 235,
+			149, 168, 199, 113, 66, 204, 30, 23, 126, 133, 31, 60, 249, 245,
+			10, 63, 120, 242, 235, 70, 212, 20, 61, 249, 245, 233, 25, 246,
+			61, 196, 79, 56, 125, 147, 204, 250, 27, 96, 204, 58, 43, 228,
+			186, 136, 211, 48, 41, 34, 57, 217, 250, 137, 42, 87, 171, 168,
+			128, 35, 191, 105, 186, 62, 148, 0, 171, 111, 30, 179, 84, 192,
+			145, 223, 68, 42, 196, 117, 185, 123, 187, 182, 229, 248, 173, 167,
+			27, 223, 80, 25, 35, 134, 7, 38, 125, 187, 225, 163, 97, 184,
+			32, 225, 183, 159, 219, 48, 92, 82, 171, 195, 186, 89, 11, 57,
+			156, 190, 109, 12, 195, 69, 113, 191, 13, 109, 84, 112, 125, 23,
+			54, 113, 151, 204, 249, 63, 117, 64, 32, 21, 210, 117, 161, 199,
+			35, 208, 193, 130, 57, 48, 28, 178, 42, 243, 26, 206, 44, 195,
+			67, 63, 208, 97, 15, 49, 175, 142, 85, 213, 171, 162, 19, 203,
+			4, 19, 171, 85, 180, 62, 123, 166, 185, 168, 216, 187, 198, 223,
+			93, 12, 209, 119, 77, 16, 115, 81, 177, 119, 103, 102, 217, 75,
+			184, 17, 194, 233, 251, 228, 188, 127, 26, 121, 10, 186, 149, 160,
+			14, 16, 15, 169, 195, 92, 223, 66, 14, 167, 239, 175, 156, 177,
+			16, 229, 244, 253, 115, 171, 236, 175, 75, 241, 80, 78, 63, 36,
+			203, 254, 207, 203, 200, 104, 203, 76, 241, 72, 238, 29, 128, 31,
+			68, 136, 27, 135, 225, 72, 201, 60, 189, 168, 71, 54, 13, 95,
+			161, 109, 171, 71, 49, 25, 145, 175, 143, 42, 21, 78, 224, 188,
+			24, 200, 172, 147, 20, 170, 200, 69, 144, 70, 34, 239, 169, 34,
+			137, 32, 194, 70, 50, 145, 186, 234, 69, 186, 24, 231, 63, 172,
+			68, 4, 30, 250, 97, 99, 193, 66, 192, 255, 201, 83, 44, 99,
+			196, 245, 184, 251, 113, 173, 235, 248, 29, 113, 112, 146, 255, 92,
+			231, 254, 4, 138, 195, 14, 123, 207, 225, 244, 227, 198, 25, 140,
+			23, 30, 88, 243, 39, 71, 137, 23, 30, 198, 139, 79, 140, 63,
+			123, 24, 47, 62, 49, 241, 194, 67, 3, 254, 196, 196, 11, 15,
+			236, 247, 225, 243, 198, 11, 15, 205, 234, 97, 133, 31, 204, 234,
+			161, 137, 23, 30, 154, 213, 195, 233, 25, 76, 38, 60, 72, 27,
+			63, 61, 66, 50, 225, 225, 41, 255, 169, 81, 131, 135, 70, 245,
+			169, 73, 38, 60, 52, 170, 79, 231, 56, 187, 132, 40, 41, 167,
+			59, 100, 197, 63, 47, 182, 247, 31, 163, 198, 168, 198, 12, 214,
+			67, 21, 239, 84, 184, 65, 197, 59, 230, 212, 243, 80, 197, 59,
+			203, 62, 54, 243, 61, 226, 114, 26, 146, 115, 166, 153, 159, 22,
+			253, 182, 204, 192, 80, 177, 253, 0, 63, 172, 15, 131, 50, 171,
+			123, 145, 155, 133, 78, 100, 38, 162, 56, 15, 131, 44, 146, 96,
+			101, 97, 80, 228, 24, 18, 153, 93, 34, 63, 11, 165, 132, 193,
+			0, 177, 9, 108, 98, 84, 28, 194, 185, 28, 86, 2, 133, 32,
+			22, 154, 220, 211, 131, 243, 135, 134, 103, 133, 225, 208, 227, 180,
+			67, 196, 255, 51, 135, 208, 50, 17, 73, 220, 143, 135, 250, 241,
+			144, 176, 229, 16, 12, 179, 99, 106, 18, 143, 120, 148, 211, 206,
+			153, 179, 76, 49, 226, 214, 185, 247, 168, 246, 231, 142, 227, 183,
+			197, 65, 53, 236, 115, 120, 201, 248, 122, 118, 152, 143, 212, 29,
+			78, 31, 53, 78, 163, 143, 212, 193, 71, 250, 71, 241, 145, 58,
+			250, 72, 223, 136, 188, 142, 62, 210, 55, 62, 82, 71, 31, 233,
+			207, 47, 176, 183, 17, 167, 195, 169, 34, 179, 254, 171, 71, 74,
+			95, 202, 235, 178, 39, 65, 110, 98, 84, 21, 105, 234, 232, 53,
+			170, 162, 8, 94, 163, 140, 215, 212, 209, 107, 212, 244, 12, 123,
+			13, 41, 18, 78, 31, 147, 211, 254, 230, 132, 146, 33, 86, 66,
+			203, 41, 150, 185, 33, 35, 51, 185, 159, 14, 184, 210, 99, 99,
+			238, 117, 116, 165, 199, 141, 147, 22, 162, 156, 62, 246, 87, 176,
+			124, 172, 131, 95, 229, 100, 197, 148, 143, 189, 184, 219, 131, 232,
+			57, 178, 151, 210, 169, 14, 223, 17, 56, 86, 94, 81, 2, 199,
+			202, 141, 99, 213, 209, 177, 242, 101, 159, 253, 22, 78, 130, 58,
+			120, 214, 30, 153, 247, 127, 131, 7, 101, 170, 244, 72, 101, 156,
+			201, 18, 187, 189, 133, 10, 18, 129, 13, 48, 184, 129, 196, 58,
+			174, 60, 222, 64, 255, 176, 113, 5, 41, 51, 94, 171, 150, 66,
+			8, 210, 114, 186, 80, 97, 88, 64, 165, 183, 214, 150, 144, 31,
+			167, 42, 221, 64, 34, 47, 193, 9, 192, 96, 33, 218, 217, 30,
+			80, 42, 175, 219, 112, 61, 158, 173, 121, 79, 61, 17, 197, 192,
+			166, 222, 163, 90, 158, 48, 67, 107, 126, 160, 243, 188, 146, 4,
+			56, 240, 94, 165, 91, 112, 224, 189, 230, 180, 133, 40, 167, 123,
+			115, 156, 189, 129, 130, 240, 56, 253, 130, 44, 155, 204, 218, 72,
+			116, 180, 161, 48, 20, 255, 69, 91, 147, 160, 91, 84, 164, 192,
+			19, 191, 168, 72, 129, 39, 126, 97, 106, 246, 58, 122, 226, 23,
+			75, 167, 216, 239, 35, 169, 58, 167, 95, 146, 101, 255, 219, 72,
+			10, 218, 117, 71, 166, 81, 247, 96, 169, 85, 44, 184, 216, 151,
+			13, 75, 163, 78, 57, 253, 114, 233, 148, 217, 206, 49, 238, 254,
+			192, 169, 90, 16, 207, 218, 79, 105, 80, 134, 212, 9, 36, 117,
+			204, 67, 12, 13, 11, 58, 0, 54, 185, 5, 41, 128, 139, 39,
+			205, 142, 26, 220, 253, 33, 80, 123, 249, 233, 91, 58, 128, 76,
+			195, 195, 165, 199, 44, 232, 0, 216, 176, 100, 26, 20, 192, 197,
+			147, 236, 77, 36, 211, 228, 238, 159, 57, 228, 228, 17, 149, 212,
+			142, 211, 32, 219, 27, 39, 215, 244, 16, 133, 221, 85, 211, 1,
+			176, 57, 103, 65, 10, 224, 194, 18, 123, 21, 201, 49, 238, 254,
+			8, 200, 61, 67, 81, 7, 209, 97, 30, 174, 181, 219, 98, 14,
+			128, 13, 75, 135, 81, 0, 23, 150, 88, 206, 72, 189, 198, 235,
+			63, 113, 160, 71, 232, 75, 97, 91, 180, 85, 80, 141, 115, 17,
+			192, 243, 134, 219, 170, 59, 102, 252, 149, 181, 163, 171, 196, 169,
+			150, 89, 39, 128, 139, 120, 184, 104, 103, 118, 197, 32, 83, 120,
+			183, 159, 118, 45, 66, 240, 140, 41, 70, 235, 53, 135, 187, 63,
+			113, 26, 179, 76, 50, 183, 14, 207, 28, 220, 159, 57, 228, 150,
+			255, 253, 97, 219, 78, 4, 73, 162, 158, 0, 121, 203, 139, 86,
+			34, 147, 16, 229, 118, 229, 120, 101, 117, 80, 103, 137, 137, 65,
+			144, 5, 125, 169, 101, 6, 52, 79, 48, 15, 200, 56, 220, 253,
+			153, 83, 159, 177, 32, 1, 112, 246, 91, 22, 164, 0, 94, 186,
+			193, 238, 35, 79, 14, 119, 127, 238, 144, 247, 253, 155, 147, 229,
+			44, 200, 36, 21, 113, 36, 251, 3, 165, 145, 129, 209, 71, 30,
+			153, 153, 44, 212, 64, 154, 11, 66, 75, 222, 65, 148, 245, 5,
+			11, 18, 0, 23, 95, 182, 32, 5, 240, 149, 247, 216, 45, 36,
+			79, 184, 251, 11, 135, 220, 240, 95, 25, 45, 104, 142, 94, 86,
+			150, 56, 137, 3, 88, 234, 179, 22, 68, 164, 115, 171, 22, 164,
+			0, 110, 188, 193, 218, 72, 145, 114, 247, 151, 14, 249, 192, 223,
+			158, 204, 100, 247, 239, 184, 218, 27, 68, 207, 242, 137, 132, 208,
+			7, 245, 56, 152, 105, 114, 88, 134, 168, 3, 68, 234, 139, 22,
+			36, 0, 46, 173, 91, 16, 89, 248, 238, 61, 246, 15, 14, 114,
+			228, 114, 247, 43, 135, 220, 245, 191, 114, 198, 163, 237, 51, 24,
+			202, 100, 168, 178, 104, 76, 34, 23, 237, 41, 21, 36, 99, 150,
+			33, 238, 104, 64, 6, 109, 86, 120, 79, 50, 76, 47, 226, 124,
+			104, 88, 197, 0, 186, 81, 5, 190, 83, 233, 20, 246, 209, 5,
+			171, 48, 86, 187, 131, 30, 224, 87, 78, 125, 222, 130, 4, 192,
+			133, 53, 11, 82, 0, 175, 190, 91, 221, 129, 255, 175, 195, 206,
+			78, 222, 26, 235, 184, 47, 115, 29, 244, 7, 135, 93, 228, 95,
+			99, 205, 109, 59, 231, 185, 31, 49, 252, 233, 33, 143, 24, 166,
+			43, 148, 246, 5, 192, 149, 35, 190, 0, 168, 248, 253, 90, 175,
+			0, 254, 105, 254, 27, 122, 27, 167, 131, 252, 81, 62, 241, 6,
+			224, 89, 55, 246, 207, 146, 253, 234, 143, 9, 155, 50, 118, 183,
+			29, 228, 143, 158, 227, 30, 112, 150, 81, 184, 70, 165, 120, 141,
+			10, 63, 249, 107, 236, 120, 46, 181, 78, 228, 78, 36, 147, 160,
+			188, 97, 125, 234, 189, 236, 84, 57, 253, 54, 204, 230, 55, 217,
+			76, 168, 250, 3, 168, 80, 119, 6, 50, 139, 85, 249, 184, 238,
+			169, 8, 166, 237, 138, 251, 184, 128, 191, 193, 78, 68, 113, 62,
+			128, 186, 89, 70, 59, 129, 198, 123, 208, 169, 43, 254, 62, 12,
+			149, 45, 108, 29, 31, 46, 184, 161, 239, 254, 104, 182, 124, 192,
+			119, 249, 119, 234, 54, 105, 109, 120, 155, 244, 18, 139, 202, 43,
+			164, 19, 181, 203, 142, 255, 137, 141, 57, 160, 123, 96, 42, 16,
+			96, 101, 226, 113, 33, 11, 89, 254, 180, 157, 152, 225, 219, 59,
+			155, 21, 4, 9, 108, 45, 128, 151, 111, 221, 68, 178, 241, 216,
+			108, 111, 146, 78, 52, 230, 135, 207, 252, 102, 200, 162, 255, 221,
+			125, 247, 53, 198, 192, 108, 201, 86, 181, 163, 129, 159, 54, 190,
+			84, 212, 202, 100, 103, 229, 253, 208, 140, 201, 0, 241, 176, 163,
+			51, 166, 116, 193, 195, 141, 206, 204, 47, 96, 190, 84, 131, 220,
+			127, 142, 204, 154, 196, 162, 23, 228, 189, 67, 106, 22, 101, 182,
+			51, 254, 0, 110, 120, 7, 229, 224, 29, 212, 148, 133, 224, 14,
+			106, 122, 166, 108, 242, 212, 160, 198, 95, 36, 179, 223, 124, 147,
+			103, 132, 65, 60, 253, 190, 118, 147, 167, 188, 109, 90, 52, 249,
+			44, 158, 150, 116, 177, 97, 119, 3, 85, 208, 226, 244, 12, 251,
+			49, 177, 215, 77, 167, 201, 186, 255, 63, 142, 184, 173, 128, 183,
+			39, 65, 14, 135, 94, 220, 183, 91, 179, 189, 247, 17, 246, 138,
+			84, 199, 137, 136, 145, 169, 64, 139, 68, 6, 185, 209, 162, 74,
+			162, 170, 140, 129, 27, 169, 84, 12, 146, 0, 222, 72, 42, 49,
+			200, 228, 46, 30, 105, 187, 50, 75, 246, 54, 130, 110, 55, 131,
+			28, 106, 119, 40, 194, 28, 47, 235, 160, 56, 210, 72, 52, 238,
+			75, 38, 116, 182, 23, 167, 93, 148, 69, 42, 58, 65, 156, 32,
+			212, 150, 97, 1, 55, 145, 19, 140, 65, 78, 58, 124, 68, 88,
+			214, 69, 161, 74, 18, 25, 106, 25, 193, 153, 141, 213, 20, 28,
+			226, 50, 138, 33, 193, 200, 181, 202, 130, 238, 208, 12, 104, 29,
+			94, 234, 249, 230, 165, 30, 184, 207, 233, 149, 139, 22, 2, 81,
+			189, 252, 109, 246, 91, 98, 223, 1, 190, 72, 54, 253, 223, 148,
+			47, 74, 131, 62, 60, 128, 64, 3, 64, 209, 117, 180, 241, 111,
+			116, 40, 168, 247, 194, 76, 150, 79, 99, 193, 228, 71, 120, 182,
+			225, 44, 149, 121, 206, 170, 139, 17, 60, 185, 161, 74, 180, 7,
+			180, 8, 179, 88, 195, 181, 99, 245, 132, 18, 48, 195, 249, 223,
+			197, 119, 148, 42, 41, 159, 4, 7, 80, 209, 197, 57, 62, 27,
+			133, 126, 245, 40, 250, 18, 59, 84, 163, 109, 232, 43, 116, 84,
+			22, 74, 163, 175, 49, 116, 38, 226, 132, 65, 18, 22, 9, 50,
+			13, 111, 82, 139, 182, 206, 130, 16, 85, 19, 235, 28, 181, 46,
+			133, 252, 108, 16, 219, 12, 5, 245, 181, 134, 223, 171, 88, 251,
+			82, 169, 83, 88, 32, 83, 12, 49, 145, 168, 206, 35, 177, 102,
+			190, 13, 167, 87, 154, 112, 235, 156, 190, 88, 105, 2, 42, 204,
+			23, 87, 94, 182, 16, 229, 244, 197, 141, 22, 251, 91, 23, 53,
+			225, 113, 122, 137, 180, 252, 95, 184, 229, 62, 64, 254, 79, 122,
+			178, 148, 131, 49, 174, 17, 61, 12, 99, 127, 101, 169, 192, 16,
+			202, 16, 67, 94, 144, 172, 163, 123, 89, 85, 192, 149, 119, 36,
+			212, 190, 74, 249, 98, 46, 194, 68, 133, 143, 90, 166, 196, 103,
+			226, 115, 153, 169, 117, 17, 87, 33, 251, 224, 244, 75, 171, 242,
+			82, 107, 220, 159, 141, 15, 176, 209, 226, 162, 189, 39, 130, 60,
+			151, 25, 202, 28, 67, 229, 157, 183, 133, 30, 229, 56, 76, 20,
+			120, 129, 26, 39, 113, 49, 23, 137, 10, 33, 37, 68, 6, 129,
+			133, 64, 228, 3, 25, 198, 157, 56, 20, 186, 151, 201, 188, 167,
+			146, 8, 89, 197, 93, 14, 192, 118, 199, 162, 60, 136, 11, 228,
+			115, 211, 116, 206, 170, 129, 120, 84, 130, 128, 121, 187, 232, 183,
+			19, 137, 14, 42, 130, 80, 23, 208, 206, 72, 67, 133, 119, 118,
+			170, 83, 45, 28, 77, 79, 129, 115, 213, 129, 26, 3, 244, 14,
+			209, 43, 216, 131, 171, 244, 34, 236, 13, 155, 120, 35, 129, 111,
+			132, 32, 60, 136, 190, 95, 180, 55, 31, 20, 109, 209, 150, 29,
+			149, 149, 49, 0, 28, 31, 124, 89, 14, 223, 224, 150, 233, 251,
+			48, 38, 122, 117, 48, 19, 251, 174, 0, 250, 8, 151, 78, 191,
+			100, 33, 202, 233, 165, 245, 141, 118, 125, 144, 41, 173, 174, 254,
+			223, 0, 61, 224, 230, 164, 220, 48, 0, 0},
 	)
 }
 
@@ -273,6 +273,12 @@ type TerminateStreamRequest struct {
 	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	// The terminal index of the stream.
 	TerminalIndex int64 `protobuf:"varint,4,opt,name=terminal_index,json=terminalIndex" json:"terminal_index,omitempty"`
+	// The number of bytes of stream data that the Butler discarded because the
+	// stream exceeded a byte quota.
+	QuotaDroppedBytes uint64 `protobuf:"varint,5,opt,name=quota_dropped_bytes,json=quotaDroppedBytes" json:"quota_dropped_bytes,omitempty"`
+	// The number of bytes of stream data that the Butler discarded because the
+	// stream exceeded a rate limit.
+	RateDroppedBytes uint64 `protobuf:"varint,6,opt,name=rate_dropped_bytes,json=rateDroppedBytes" json:"rate_dropped_bytes,omitempty"`
 }
 
 func (m *TerminateStreamRequest) Reset()                    { *m = TerminateStreamRequest{} }
@@ -308,6 +314,20 @@ func (m *TerminateStreamRequest) GetTerminalIndex() int64 {
 	return 0
 }
 
+func (m *TerminateStreamRequest) GetQuotaDroppedBytes() uint64 {
+	if m != nil {
+		return m.QuotaDroppedBytes
+	}
+	return 0
+}
+
+func (m *TerminateStreamRequest) GetRateDroppedBytes() uint64 {
+	if m != nil {
+		return m.RateDroppedBytes
+	}
+	return 0
+}
+
 // ArchiveStreamRequest is the set of caller-supplied data for the ArchiveStream
 // service endpoint.
 type ArchiveStreamRequest struct {
@@ -710,51 +730,53 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xce, 0xb6, 0x80, 0xed, 0x69, 0x0b, 0x32, 0x42, 0xd3, 0x16, 0xf9, 0xb1, 0x46, 0x43, 0x22,
-	0xee, 0x46, 0xbc, 0x37, 0x31, 0x48, 0x94, 0x48, 0x22, 0x6e, 0x95, 0xdb, 0x66, 0xd8, 0x1d, 0xb6,
-	0xa3, 0xdb, 0x9d, 0x75, 0x66, 0xb6, 0xb1, 0xdc, 0xf9, 0x32, 0x26, 0xbe, 0x85, 0xaf, 0xe1, 0x6b,
-	0xf8, 0x04, 0x66, 0xcf, 0xcc, 0x16, 0x5a, 0x4a, 0x42, 0x88, 0x37, 0x4d, 0xe7, 0x9c, 0xef, 0x3b,
-	0xe7, 0x9b, 0xb3, 0xdf, 0x19, 0xf0, 0x23, 0xae, 0x07, 0xd9, 0x99, 0x1b, 0x88, 0xa1, 0x17, 0x67,
-	0x01, 0xc7, 0x9f, 0xe7, 0x91, 0xf0, 0x62, 0x11, 0x85, 0x22, 0xf2, 0x68, 0xca, 0x3d, 0x96, 0x84,
-	0xa9, 0xe0, 0x89, 0x56, 0x5e, 0x20, 0x84, 0x0c, 0x79, 0x42, 0xb5, 0x90, 0x9e, 0x62, 0x72, 0xc4,
-	0x03, 0xa6, 0xbc, 0xd1, 0x8b, 0xe2, 0xbf, 0x9b, 0x4a, 0xa1, 0x05, 0x59, 0x32, 0xdc, 0xce, 0xc9,
-	0xff, 0xa9, 0xad, 0xa9, 0xb6, 0x95, 0x3b, 0x5b, 0x91, 0x10, 0x51, 0xcc, 0x3c, 0x3c, 0x9d, 0x65,
-	0xe7, 0x5e, 0x98, 0x49, 0xaa, 0xb9, 0x48, 0x6c, 0x7e, 0x63, 0x36, 0xcf, 0x86, 0xa9, 0x1e, 0x9b,
-	0x64, 0xf7, 0xb7, 0x03, 0xab, 0x6f, 0x99, 0x3e, 0x10, 0xc9, 0x39, 0x8f, 0x7c, 0xa6, 0x52, 0x91,
-	0x28, 0x46, 0xf6, 0x80, 0x04, 0x18, 0xe9, 0xdb, 0xa6, 0xfd, 0x4c, 0xc6, 0x2d, 0x67, 0xc7, 0xd9,
-	0xad, 0xfa, 0xf7, 0x4d, 0xa6, 0x67, 0x12, 0x9f, 0x65, 0x4c, 0x36, 0x01, 0x26, 0x68, 0xdd, 0x2a,
-	0x21, 0xaa, 0x5a, 0xa0, 0x34, 0x71, 0xe1, 0x41, 0x51, 0xc5, 0xc2, 0x52, 0xaa, 0x07, 0xad, 0x32,
-	0xe2, 0x56, 0x6d, 0xca, 0x08, 0x38, 0xa1, 0x7a, 0x90, 0xe3, 0x67, 0x9a, 0x0f, 0x84, 0xd2, 0xad,
-	0x05, 0x83, 0x9f, 0xea, 0xfe, 0x4e, 0x28, 0xdd, 0xfd, 0xe5, 0xc0, 0xba, 0xcf, 0x22, 0xae, 0x34,
-	0x93, 0x3d, 0x2d, 0x19, 0x1d, 0xfa, 0xec, 0x5b, 0xc6, 0x94, 0x26, 0x2d, 0xb8, 0x97, 0x4a, 0xf1,
-	0x85, 0x05, 0xda, 0x6a, 0x2f, 0x8e, 0xa4, 0x09, 0x4b, 0x8a, 0x05, 0xd2, 0xca, 0xad, 0xfb, 0xf6,
-	0x44, 0x1e, 0x43, 0x03, 0xe7, 0xd2, 0x1f, 0x31, 0xa9, 0xb8, 0x48, 0xac, 0xca, 0x3a, 0x06, 0x4f,
-	0x4d, 0x8c, 0x10, 0x58, 0x08, 0x99, 0x0a, 0x50, 0x51, 0xdd, 0xc7, 0xff, 0xe4, 0x09, 0x2c, 0x6b,
-	0x26, 0x87, 0x3c, 0xa1, 0x71, 0x9f, 0x27, 0x21, 0xfb, 0xde, 0x5a, 0xdc, 0x71, 0x76, 0xcb, 0x7e,
-	0xa3, 0x88, 0x1e, 0xe5, 0xc1, 0xee, 0x29, 0x34, 0x67, 0xa5, 0xda, 0x91, 0x2f, 0x43, 0x89, 0x87,
-	0x56, 0x66, 0x89, 0x87, 0x64, 0x0f, 0x16, 0xf1, 0x23, 0xa3, 0xc0, 0xda, 0x7e, 0xd3, 0x35, 0xfe,
-	0x70, 0x8f, 0x45, 0x64, 0x98, 0xbd, 0x3c, 0xeb, 0x1b, 0x50, 0xf7, 0x23, 0xac, 0x1e, 0x0b, 0x1a,
-	0xde, 0xf6, 0xfa, 0xa6, 0x59, 0x69, 0xd2, 0xac, 0xb8, 0x51, 0x7e, 0xdb, 0x8a, 0xb9, 0x51, 0xf7,
-	0xa7, 0x03, 0xe4, 0x6a, 0xcd, 0x89, 0x35, 0xac, 0x2e, 0xe7, 0x16, 0xba, 0x26, 0x85, 0x4b, 0x57,
-	0x46, 0xf5, 0x0c, 0xca, 0x34, 0x62, 0xd8, 0xab, 0xb6, 0xdf, 0x76, 0x8d, 0x3b, 0xdd, 0xc2, 0x9d,
-	0xee, 0x1b, 0xeb, 0x5e, 0x3f, 0x47, 0x91, 0x47, 0x50, 0xa7, 0x32, 0x18, 0xf0, 0x11, 0x8d, 0xfb,
-	0x5f, 0xd9, 0xd8, 0xce, 0xbc, 0x56, 0xc4, 0xde, 0xb3, 0x71, 0xf7, 0x87, 0x03, 0xcd, 0x4f, 0x66,
-	0xca, 0x9a, 0xdd, 0x75, 0x02, 0x97, 0x86, 0x28, 0x4f, 0x19, 0xe2, 0xfa, 0x77, 0x5d, 0x98, 0xf7,
-	0x5d, 0xff, 0x94, 0x60, 0xed, 0x35, 0x6a, 0xba, 0xb3, 0x82, 0xa7, 0xb0, 0x12, 0x8b, 0xa8, 0xcf,
-	0x12, 0x2d, 0xc7, 0xfd, 0x40, 0x64, 0x89, 0x91, 0x52, 0xf6, 0x1b, 0xb1, 0x88, 0x0e, 0xf3, 0xe8,
-	0x41, 0x1e, 0xbc, 0xa5, 0x22, 0xb2, 0x06, 0x8b, 0x4c, 0x4a, 0x21, 0xd1, 0x87, 0x55, 0xdf, 0x1c,
-	0xf2, 0x55, 0x55, 0xa8, 0x0f, 0x17, 0x1a, 0xcc, 0xaa, 0x9a, 0x48, 0xbe, 0xc9, 0xdb, 0x50, 0xb3,
-	0x69, 0xc5, 0x2f, 0x58, 0xab, 0x86, 0x85, 0x2d, 0xa3, 0xc7, 0x2f, 0x18, 0xd9, 0x80, 0x2a, 0xf6,
-	0x44, 0xfa, 0x1a, 0xd2, 0x2b, 0x18, 0xb0, 0xef, 0x80, 0x49, 0x22, 0x79, 0x1d, 0xc9, 0x06, 0x8e,
-	0xdc, 0x36, 0x54, 0x42, 0xaa, 0x29, 0x52, 0xb7, 0xcc, 0x2c, 0xf2, 0x73, 0xce, 0xdc, 0x80, 0x2a,
-	0xa6, 0x90, 0xb8, 0x8d, 0x44, 0xc4, 0xe6, 0xbc, 0xfd, 0xbf, 0x25, 0xa8, 0xd8, 0x7d, 0x57, 0xe4,
-	0x15, 0x54, 0x27, 0xcf, 0x15, 0x69, 0x5e, 0x33, 0xcf, 0x61, 0xfe, 0xb4, 0x75, 0xda, 0x85, 0x29,
-	0xaf, 0xbf, 0x6c, 0x1f, 0x60, 0x79, 0x7a, 0x01, 0xc9, 0x66, 0x01, 0x9e, 0xfb, 0x86, 0x74, 0xb6,
-	0x6e, 0x4a, 0xdb, 0x82, 0x07, 0x00, 0x97, 0x5b, 0x42, 0xda, 0x97, 0xeb, 0x30, 0xb3, 0x8d, 0x9d,
-	0xce, 0xbc, 0x94, 0x2d, 0x72, 0x04, 0x2b, 0x33, 0x0e, 0x26, 0x93, 0xbe, 0xf3, 0xad, 0xdd, 0xb9,
-	0xe1, 0xee, 0xe4, 0x10, 0x1a, 0x53, 0x46, 0x24, 0x0f, 0x8b, 0x42, 0xf3, 0xfc, 0x79, 0x53, 0x99,
-	0xb3, 0x25, 0x3c, 0xbf, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x56, 0xa5, 0xea, 0x47, 0x0b, 0x07,
-	0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0x93, 0x34, 0x24, 0x27, 0x49, 0x77, 0x33, 0x74, 0xa3, 0x24, 0x65, 0xbb, 0x4b, 0x10,
+	0x68, 0x25, 0x16, 0x5b, 0x94, 0x7b, 0x24, 0x68, 0x2b, 0xa8, 0xa8, 0x44, 0x71, 0xa0, 0xb7, 0xd6,
+	0xc4, 0x9e, 0x3a, 0x03, 0x8e, 0xc7, 0x9d, 0x19, 0x47, 0xa4, 0x0f, 0x84, 0xc4, 0x5b, 0xf0, 0x1a,
+	0x5c, 0xf1, 0x0e, 0x3c, 0x01, 0x9a, 0x33, 0xe3, 0xb4, 0x49, 0x53, 0xa9, 0xaa, 0xb8, 0x89, 0x32,
+	0xe7, 0xfb, 0xce, 0xaf, 0xbf, 0x73, 0x20, 0x4c, 0xb9, 0x9e, 0x97, 0x33, 0x3f, 0x16, 0x8b, 0x20,
+	0x2b, 0x63, 0x8e, 0x3f, 0x5f, 0xa4, 0x22, 0xc8, 0x44, 0x9a, 0x88, 0x34, 0xa0, 0x05, 0x0f, 0x58,
+	0x9e, 0x14, 0x82, 0xe7, 0x5a, 0x05, 0xb1, 0x10, 0x32, 0xe1, 0x39, 0xd5, 0x42, 0x06, 0x8a, 0xc9,
+	0x25, 0x8f, 0x99, 0x0a, 0x96, 0x5f, 0x56, 0xff, 0xfd, 0x42, 0x0a, 0x2d, 0x48, 0xd3, 0xfa, 0x8e,
+	0x2f, 0xff, 0x9f, 0xd8, 0x9a, 0x6a, 0x17, 0x79, 0x7c, 0x94, 0x0a, 0x91, 0x66, 0x2c, 0xc0, 0xd7,
+	0xac, 0xbc, 0x0e, 0x92, 0x52, 0x52, 0xcd, 0x45, 0xee, 0xf0, 0xc3, 0x6d, 0x9c, 0x2d, 0x0a, 0xbd,
+	0xb2, 0xe0, 0xe4, 0x2f, 0x0f, 0xfa, 0xdf, 0x31, 0x7d, 0x22, 0xf2, 0x6b, 0x9e, 0x86, 0x4c, 0x15,
+	0x22, 0x57, 0x8c, 0xbc, 0x07, 0x12, 0xa3, 0x25, 0x72, 0x49, 0xa3, 0x52, 0x66, 0x43, 0xef, 0xad,
+	0xf7, 0xae, 0x1d, 0xbe, 0xb4, 0xc8, 0xd4, 0x02, 0xbf, 0xc8, 0x8c, 0xbc, 0x06, 0x58, 0xb3, 0xf5,
+	0xb0, 0x86, 0xac, 0x76, 0xc5, 0xd2, 0xc4, 0x87, 0x0f, 0xab, 0x28, 0x8e, 0x56, 0x50, 0x3d, 0x1f,
+	0xd6, 0x91, 0xd7, 0x77, 0x90, 0x2d, 0xe0, 0x92, 0xea, 0xb9, 0xe1, 0x6f, 0x25, 0x9f, 0x0b, 0xa5,
+	0x87, 0x0d, 0xcb, 0xdf, 0xc8, 0xfe, 0xbd, 0x50, 0x7a, 0xf2, 0xa7, 0x07, 0xaf, 0x42, 0x96, 0x72,
+	0xa5, 0x99, 0x9c, 0x6a, 0xc9, 0xe8, 0x22, 0x64, 0x37, 0x25, 0x53, 0x9a, 0x0c, 0xe1, 0x83, 0x42,
+	0x8a, 0x5f, 0x59, 0xac, 0x5d, 0xed, 0xd5, 0x93, 0x0c, 0xa0, 0xa9, 0x58, 0x2c, 0x5d, 0xb9, 0xdd,
+	0xd0, 0xbd, 0xc8, 0x27, 0xd0, 0xc3, 0xb9, 0x44, 0x4b, 0x26, 0x15, 0x17, 0xb9, 0xab, 0xb2, 0x8b,
+	0xc6, 0x2b, 0x6b, 0x23, 0x04, 0x1a, 0x09, 0x53, 0x31, 0x56, 0xd4, 0x0d, 0xf1, 0x3f, 0xf9, 0x14,
+	0xf6, 0x35, 0x93, 0x0b, 0x9e, 0xd3, 0x2c, 0xe2, 0x79, 0xc2, 0x7e, 0x1f, 0xee, 0xbd, 0xf5, 0xde,
+	0xd5, 0xc3, 0x5e, 0x65, 0x3d, 0x37, 0xc6, 0xc9, 0x15, 0x0c, 0xb6, 0x4b, 0x75, 0x23, 0xdf, 0x87,
+	0x1a, 0x4f, 0x5c, 0x99, 0x35, 0x9e, 0x90, 0xf7, 0xb0, 0x87, 0x1f, 0x19, 0x0b, 0xec, 0x1c, 0x0f,
+	0x7c, 0xab, 0x0f, 0xff, 0x42, 0xa4, 0xd6, 0x73, 0x6a, 0xd0, 0xd0, 0x92, 0x26, 0x3f, 0x41, 0xff,
+	0x42, 0xd0, 0xe4, 0xa9, 0xed, 0xdb, 0x64, 0xb5, 0x75, 0xb2, 0xaa, 0x23, 0xd3, 0x6d, 0xcb, 0x76,
+	0x34, 0xf9, 0xc3, 0x03, 0x72, 0x3f, 0xe6, 0x5a, 0x1a, 0xae, 0x2e, 0xef, 0x09, 0x75, 0xad, 0x03,
+	0xd7, 0xee, 0x8d, 0xea, 0x73, 0xa8, 0xd3, 0x94, 0x61, 0xae, 0xce, 0xf1, 0xc8, 0xb7, 0xea, 0xf4,
+	0x2b, 0x75, 0xfa, 0xa7, 0x4e, 0xbd, 0xa1, 0x61, 0x91, 0x8f, 0xa1, 0x4b, 0x65, 0x3c, 0xe7, 0x4b,
+	0x9a, 0x45, 0xbf, 0xb1, 0x95, 0x9b, 0x79, 0xa7, 0xb2, 0xfd, 0xc0, 0x56, 0x93, 0x7f, 0x3c, 0x18,
+	0xfc, 0x6c, 0xa7, 0xac, 0xd9, 0x73, 0x27, 0x70, 0x27, 0x88, 0xfa, 0x86, 0x20, 0x1e, 0x7e, 0xd7,
+	0xc6, 0x8e, 0xef, 0x6a, 0x34, 0x7b, 0x53, 0x0a, 0x4d, 0xa3, 0x44, 0x8a, 0xa2, 0x60, 0x49, 0x34,
+	0x5b, 0x69, 0xa6, 0x50, 0x03, 0x8d, 0xb0, 0x8f, 0xd0, 0xa9, 0x45, 0xbe, 0x35, 0x80, 0x59, 0x30,
+	0x49, 0x35, 0xdb, 0xa2, 0x37, 0x91, 0xfe, 0xd2, 0x20, 0xf7, 0xd9, 0x93, 0xbf, 0x6b, 0x70, 0xf0,
+	0x0d, 0x76, 0xfc, 0xec, 0xfe, 0x3e, 0x83, 0x17, 0x99, 0x48, 0x23, 0x96, 0x6b, 0xb9, 0x8a, 0x62,
+	0x51, 0xe6, 0xb6, 0xd1, 0x7a, 0xd8, 0xcb, 0x44, 0x7a, 0x66, 0xac, 0x27, 0xc6, 0xf8, 0xd4, 0x7e,
+	0x0f, 0x60, 0x8f, 0x49, 0x29, 0x24, 0x76, 0xd8, 0x0e, 0xed, 0xc3, 0x1c, 0x02, 0x85, 0xf5, 0xe1,
+	0xb9, 0x00, 0x84, 0xda, 0xd6, 0x62, 0xee, 0xc4, 0x1b, 0xe8, 0x38, 0x58, 0xf1, 0x5b, 0x36, 0xec,
+	0x60, 0x60, 0xe7, 0x31, 0xe5, 0xb7, 0x8c, 0x1c, 0x42, 0x1b, 0x73, 0xa2, 0xfb, 0x01, 0xba, 0xb7,
+	0xd0, 0xe0, 0xae, 0x8c, 0x05, 0xd1, 0xf9, 0x15, 0x3a, 0x5b, 0x3a, 0xfa, 0x8e, 0xa0, 0x95, 0x50,
+	0x4d, 0xd1, 0xf5, 0xc8, 0xce, 0xc2, 0xbc, 0x8d, 0xe7, 0x21, 0xb4, 0x11, 0x42, 0xc7, 0x37, 0xe8,
+	0x88, 0x5c, 0xe3, 0x77, 0xfc, 0x6f, 0x0d, 0x5a, 0xee, 0x9a, 0x28, 0xf2, 0x35, 0xb4, 0xd7, 0xc7,
+	0x90, 0x0c, 0x1e, 0x48, 0xf3, 0xcc, 0x1c, 0xce, 0xf1, 0xa8, 0x92, 0xfc, 0xc3, 0xbb, 0xf9, 0x23,
+	0xec, 0x6f, 0xae, 0x37, 0x79, 0x5d, 0x91, 0x77, 0x5e, 0xa8, 0xf1, 0xd1, 0x63, 0xb0, 0x0b, 0x78,
+	0x02, 0x70, 0xb7, 0x83, 0x64, 0x74, 0xb7, 0x6c, 0x5b, 0xbb, 0x3e, 0x1e, 0xef, 0x82, 0x5c, 0x90,
+	0x73, 0x78, 0xb1, 0xb5, 0x1f, 0x64, 0x9d, 0x77, 0xf7, 0xe2, 0x8c, 0x1f, 0xe9, 0x9d, 0x9c, 0x41,
+	0x6f, 0x43, 0x88, 0xe4, 0xa3, 0x2a, 0xd0, 0x2e, 0x7d, 0x3e, 0x16, 0x66, 0xd6, 0xc4, 0xf7, 0x57,
+	0xff, 0x0d, 0x00, 0x42, 0x78, 0x70, 0x80, 0x69, 0x07, 0x00, 0x00,
 }
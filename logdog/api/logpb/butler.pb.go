@@ -251,6 +251,14 @@ type ButlerLogBundle_Entry struct {
 	//
 	// This is the main log entry content.
 	Logs []*LogEntry `protobuf:"bytes,5,rep,name=logs" json:"logs,omitempty"`
+	//
+	// If terminal is true, this is the number of bytes of stream data that the
+	// Butler discarded because the stream exceeded a byte quota.
+	QuotaDroppedBytes uint64 `protobuf:"varint,6,opt,name=quota_dropped_bytes,json=quotaDroppedBytes" json:"quota_dropped_bytes,omitempty"`
+	//
+	// If terminal is true, this is the number of bytes of stream data that the
+	// Butler discarded because the stream exceeded a rate limit.
+	RateDroppedBytes uint64 `protobuf:"varint,7,opt,name=rate_dropped_bytes,json=rateDroppedBytes" json:"rate_dropped_bytes,omitempty"`
 }
 
 func (m *ButlerLogBundle_Entry) Reset()                    { *m = ButlerLogBundle_Entry{} }
@@ -293,6 +301,20 @@ func (m *ButlerLogBundle_Entry) GetLogs() []*LogEntry {
 	return nil
 }
 
+func (m *ButlerLogBundle_Entry) GetQuotaDroppedBytes() uint64 {
+	if m != nil {
+		return m.QuotaDroppedBytes
+	}
+	return 0
+}
+
+func (m *ButlerLogBundle_Entry) GetRateDroppedBytes() uint64 {
+	if m != nil {
+		return m.RateDroppedBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ButlerMetadata)(nil), "logpb.ButlerMetadata")
 	proto.RegisterType((*ButlerLogBundle)(nil), "logpb.ButlerLogBundle")
@@ -306,37 +328,39 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
+	// 538 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x51, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x8d, 0x62, 0xf9, 0x6b, 0x9c, 0x38, 0xee, 0x96, 0xb6, 0xc2, 0x14, 0xea, 0x38, 0x14, 0x0c,
-	0xa5, 0x12, 0xb8, 0x24, 0xf4, 0xec, 0x34, 0x07, 0x83, 0x9b, 0xc2, 0x3a, 0xf4, 0xd0, 0x8b, 0x91,
-	0xa5, 0xc9, 0x76, 0x8b, 0xa4, 0x5d, 0x56, 0xab, 0x10, 0xff, 0x80, 0xfe, 0xc3, 0x42, 0xff, 0x4e,
-	0xd1, 0xc8, 0xb2, 0x1d, 0x0a, 0xa5, 0x17, 0xb1, 0xf3, 0xe6, 0xcd, 0xbc, 0x37, 0x4f, 0x70, 0x29,
-	0xa4, 0xfd, 0x5e, 0xac, 0xfd, 0x48, 0xa5, 0x41, 0x52, 0x44, 0x92, 0x3e, 0xef, 0x85, 0x0a, 0x12,
-	0x25, 0x62, 0x25, 0x82, 0x50, 0xcb, 0xf2, 0xa9, 0xd7, 0xc1, 0xba, 0xb0, 0x09, 0x1a, 0x5f, 0x1b,
-	0x65, 0x15, 0x6b, 0x12, 0x36, 0x9c, 0xfe, 0xf7, 0x74, 0xa2, 0x44, 0x35, 0x3a, 0x7c, 0x23, 0x94,
-	0x12, 0x09, 0x06, 0x54, 0xad, 0x8b, 0xfb, 0xc0, 0xca, 0x14, 0x73, 0x1b, 0xa6, 0xba, 0x22, 0x8c,
-	0x7f, 0x1e, 0x43, 0x7f, 0x46, 0x62, 0x9f, 0xd1, 0x86, 0x71, 0x68, 0x43, 0x76, 0x09, 0xae, 0xdd,
-	0x68, 0xf4, 0x9c, 0x91, 0x33, 0xe9, 0x4f, 0xcf, 0x7d, 0xda, 0xe9, 0x3f, 0x25, 0xf9, 0xd7, 0x2a,
-	0xb3, 0x98, 0xd9, 0xbb, 0x8d, 0x46, 0x4e, 0x74, 0x76, 0x0d, 0xbd, 0x48, 0xa5, 0xda, 0x60, 0x9e,
-	0x4b, 0x95, 0x79, 0xc7, 0xff, 0x9e, 0xde, 0x11, 0xf9, 0xe1, 0x14, 0xbb, 0x80, 0x53, 0xf2, 0xb5,
-	0x7a, 0x40, 0x43, 0x6b, 0x1a, 0x23, 0x67, 0xd2, 0xe5, 0x27, 0x04, 0x7e, 0xad, 0xb0, 0x71, 0x00,
-	0xbd, 0x03, 0x79, 0xd6, 0x83, 0xf6, 0x3c, 0x7b, 0x08, 0x13, 0x19, 0x0f, 0x8e, 0xd8, 0x73, 0x38,
-	0xab, 0xb4, 0x16, 0x4a, 0xcc, 0x8a, 0x2c, 0x4e, 0x70, 0xe0, 0x8c, 0xcf, 0xcb, 0x81, 0xbd, 0x48,
-	0x07, 0xdc, 0xdb, 0x2f, 0xb7, 0x37, 0x83, 0xa3, 0xf2, 0xf5, 0x6d, 0x31, 0x9f, 0x0d, 0x9c, 0xf1,
-	0xaf, 0xc6, 0x5f, 0x83, 0xec, 0x1d, 0x3c, 0x8b, 0x51, 0x1b, 0x8c, 0x42, 0x8b, 0xf1, 0x2a, 0x57,
-	0x85, 0x89, 0xaa, 0x54, 0xba, 0x7c, 0xb0, 0x6f, 0x2c, 0x09, 0x67, 0x1f, 0xa1, 0xbb, 0xcb, 0x96,
-	0x8e, 0xef, 0x4d, 0x87, 0x7e, 0x95, 0xbe, 0x5f, 0xa7, 0xef, 0xdf, 0xd5, 0x0c, 0xbe, 0x27, 0xb3,
-	0x2b, 0x68, 0x63, 0x66, 0x8d, 0xc4, 0xdc, 0x6b, 0x8c, 0x1a, 0x93, 0xde, 0xf4, 0xf5, 0x93, 0xd0,
-	0x76, 0x7e, 0xfc, 0x9b, 0xcc, 0x9a, 0x0d, 0xaf, 0xc9, 0xcc, 0x83, 0xb6, 0x36, 0xea, 0x07, 0x46,
-	0xd6, 0x73, 0xc9, 0x54, 0x5d, 0xb2, 0x97, 0xd0, 0xd2, 0x06, 0xef, 0xe5, 0xa3, 0xd7, 0xa4, 0xc6,
-	0xb6, 0x2a, 0xf1, 0x1c, 0x23, 0x83, 0xd6, 0x6b, 0x8d, 0x9c, 0xc9, 0x09, 0xdf, 0x56, 0xc3, 0xdf,
-	0x0e, 0x34, 0x69, 0x39, 0xf3, 0xc1, 0x8d, 0x31, 0x8f, 0xe8, 0xca, 0xf2, 0x80, 0xca, 0xc8, 0x42,
-	0x89, 0xa5, 0x35, 0x18, 0xa6, 0x9f, 0x30, 0x8f, 0x8c, 0xd4, 0x56, 0x19, 0x4e, 0x3c, 0x76, 0x05,
-	0xaf, 0x0e, 0x22, 0x2a, 0x9d, 0x6d, 0x56, 0x5b, 0x89, 0x63, 0x92, 0x78, 0xb1, 0x6f, 0x93, 0xc2,
-	0x92, 0x9a, 0x6c, 0x08, 0x1d, 0x8b, 0x26, 0x95, 0x59, 0x98, 0xd0, 0x2f, 0xee, 0xf0, 0x5d, 0xcd,
-	0xde, 0x42, 0xbf, 0x7e, 0xaf, 0x64, 0x16, 0xe3, 0x23, 0x9d, 0xe7, 0xf2, 0xd3, 0x1a, 0x9d, 0x97,
-	0x20, 0xbb, 0x00, 0x37, 0x51, 0x22, 0xf7, 0x9a, 0x94, 0xd9, 0xd9, 0xde, 0x6a, 0x15, 0x13, 0x35,
-	0xd7, 0x2d, 0x8a, 0xfe, 0xc3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x2a, 0xf9, 0x60, 0x7a,
-	0x03, 0x00, 0x00,
+	0x10, 0x8d, 0x1c, 0x39, 0xb6, 0xc7, 0x89, 0xa3, 0x6c, 0x68, 0x2b, 0x4c, 0xa1, 0x8e, 0x43, 0xc1,
+	0xd0, 0x56, 0x02, 0x97, 0x84, 0x9e, 0x9d, 0xe4, 0x60, 0x70, 0x53, 0x90, 0x43, 0x0f, 0xbd, 0x08,
+	0x7d, 0x4c, 0xd4, 0x2d, 0x92, 0x76, 0xbb, 0x5a, 0x85, 0xf8, 0x07, 0xf4, 0x0f, 0x15, 0xfa, 0xff,
+	0x8a, 0x46, 0x96, 0xed, 0xb4, 0x50, 0x7a, 0x11, 0x3b, 0xef, 0xbd, 0x99, 0x37, 0xf3, 0x04, 0x17,
+	0x09, 0xd7, 0x5f, 0xcb, 0xd0, 0x89, 0x44, 0xe6, 0xa6, 0x65, 0xc4, 0xe9, 0xf3, 0x2e, 0x11, 0x6e,
+	0x2a, 0x92, 0x58, 0x24, 0x6e, 0x20, 0x79, 0xf5, 0x94, 0xa1, 0x1b, 0x96, 0x3a, 0x45, 0xe5, 0x48,
+	0x25, 0xb4, 0x60, 0x6d, 0xc2, 0x86, 0xd3, 0xff, 0xee, 0x4e, 0x45, 0x52, 0xb7, 0x0e, 0x5f, 0x25,
+	0x42, 0x24, 0x29, 0xba, 0x54, 0x85, 0xe5, 0xbd, 0xab, 0x79, 0x86, 0x85, 0x0e, 0x32, 0x59, 0x0b,
+	0xc6, 0x3f, 0x5a, 0x30, 0x98, 0x91, 0xd9, 0x47, 0xd4, 0x41, 0x1c, 0xe8, 0x80, 0x5d, 0x80, 0xa9,
+	0x57, 0x12, 0x6d, 0x63, 0x64, 0x4c, 0x06, 0xd3, 0x33, 0x87, 0x66, 0x3a, 0x4f, 0x45, 0xce, 0x95,
+	0xc8, 0x35, 0xe6, 0xfa, 0x6e, 0x25, 0xd1, 0x23, 0x39, 0xbb, 0x82, 0x7e, 0x24, 0x32, 0xa9, 0xb0,
+	0x28, 0xb8, 0xc8, 0xed, 0xd6, 0xbf, 0xbb, 0x37, 0x42, 0x6f, 0xb7, 0x8b, 0x9d, 0xc3, 0x11, 0xed,
+	0xe5, 0x3f, 0xa0, 0xa2, 0x31, 0xfb, 0x23, 0x63, 0xd2, 0xf3, 0x0e, 0x09, 0xfc, 0x5c, 0x63, 0x63,
+	0x17, 0xfa, 0x3b, 0xf6, 0xac, 0x0f, 0x9d, 0x79, 0xfe, 0x10, 0xa4, 0x3c, 0xb6, 0xf6, 0xd8, 0x29,
+	0x1c, 0xd7, 0x5e, 0x0b, 0x91, 0xcc, 0xca, 0x3c, 0x4e, 0xd1, 0x32, 0xc6, 0x67, 0x55, 0xc3, 0xd6,
+	0xa4, 0x0b, 0xe6, 0xed, 0xa7, 0xdb, 0x1b, 0x6b, 0xaf, 0x7a, 0x7d, 0x59, 0xcc, 0x67, 0x96, 0x31,
+	0xfe, 0x69, 0xfe, 0xd5, 0xc8, 0xde, 0xc0, 0x49, 0x8c, 0x52, 0x61, 0x14, 0x68, 0x8c, 0xfd, 0x42,
+	0x94, 0x2a, 0xaa, 0x53, 0xe9, 0x79, 0xd6, 0x96, 0x58, 0x12, 0xce, 0x3e, 0x40, 0x6f, 0x93, 0x2d,
+	0x1d, 0xdf, 0x9f, 0x0e, 0x9d, 0x3a, 0x7d, 0xa7, 0x49, 0xdf, 0xb9, 0x6b, 0x14, 0xde, 0x56, 0xcc,
+	0x2e, 0xa1, 0x83, 0xb9, 0x56, 0x1c, 0x0b, 0x7b, 0x7f, 0xb4, 0x3f, 0xe9, 0x4f, 0x5f, 0x3e, 0x09,
+	0x6d, 0xb3, 0x8f, 0x73, 0x93, 0x6b, 0xb5, 0xf2, 0x1a, 0x31, 0xb3, 0xa1, 0x23, 0x95, 0xf8, 0x86,
+	0x91, 0xb6, 0x4d, 0x5a, 0xaa, 0x29, 0xd9, 0x73, 0x38, 0x90, 0x0a, 0xef, 0xf9, 0xa3, 0xdd, 0x26,
+	0x62, 0x5d, 0x55, 0x78, 0x81, 0x91, 0x42, 0x6d, 0x1f, 0x8c, 0x8c, 0xc9, 0xa1, 0xb7, 0xae, 0x86,
+	0xbf, 0x5a, 0xd0, 0xa6, 0xe1, 0xcc, 0x01, 0x33, 0xc6, 0x22, 0xb2, 0x8d, 0xf5, 0x01, 0xf5, 0x22,
+	0x0b, 0x91, 0x2c, 0xb5, 0xc2, 0x20, 0xbb, 0xc6, 0x22, 0x52, 0x5c, 0x6a, 0xa1, 0x3c, 0xd2, 0xb1,
+	0x4b, 0x78, 0xb1, 0x13, 0x51, 0xb5, 0xd9, 0xca, 0x5f, 0x5b, 0xb4, 0xc8, 0xe2, 0xd9, 0x96, 0x26,
+	0x87, 0x25, 0x91, 0x6c, 0x08, 0x5d, 0x8d, 0x2a, 0xe3, 0x79, 0x90, 0xd2, 0x2f, 0xee, 0x7a, 0x9b,
+	0x9a, 0xbd, 0x86, 0x41, 0xf3, 0xf6, 0x79, 0x1e, 0xe3, 0x23, 0x9d, 0x67, 0x7a, 0x47, 0x0d, 0x3a,
+	0xaf, 0x40, 0x76, 0x0e, 0x66, 0x2a, 0x92, 0xc2, 0x6e, 0x53, 0x66, 0xc7, 0xdb, 0x55, 0xeb, 0x98,
+	0x88, 0x64, 0x0e, 0x9c, 0x7e, 0x2f, 0x85, 0x0e, 0xfc, 0x58, 0x09, 0x29, 0x31, 0xf6, 0xc3, 0x95,
+	0xc6, 0x82, 0xce, 0x37, 0xbd, 0x13, 0xa2, 0xae, 0x6b, 0x66, 0x56, 0x11, 0xec, 0x2d, 0x30, 0x15,
+	0x68, 0xfc, 0x43, 0xde, 0x21, 0xb9, 0x55, 0x31, 0xbb, 0xea, 0xf0, 0x80, 0x7e, 0xec, 0xfb, 0xdf,
+	0x03, 0x00, 0x26, 0x50, 0xb7, 0x8d, 0xd8, 0x03, 0x00, 0x00,
 }
@@ -40,6 +40,38 @@ func (x StreamType) String() string {
 }
 func (StreamType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
 
+// A compression codec applied to an archived log stream.
+//
+// A compressed log stream is a series of independently-compressed blocks. A
+// new block is started at each indexed LogEntry, so an Entry's "offset" is
+// the byte offset of the compressed block that begins with its LogEntry.
+type LogIndex_Compression int32
+
+const (
+	// The log stream is not compressed.
+	LogIndex_NONE LogIndex_Compression = 0
+	// The log stream blocks are gzip members.
+	LogIndex_GZIP LogIndex_Compression = 1
+	// The log stream blocks are Snappy framed streams.
+	LogIndex_SNAPPY LogIndex_Compression = 2
+)
+
+var LogIndex_Compression_name = map[int32]string{
+	0: "NONE",
+	1: "GZIP",
+	2: "SNAPPY",
+}
+var LogIndex_Compression_value = map[string]int32{
+	"NONE":   0,
+	"GZIP":   1,
+	"SNAPPY": 2,
+}
+
+func (x LogIndex_Compression) String() string {
+	return proto.EnumName(LogIndex_Compression_name, int32(x))
+}
+func (LogIndex_Compression) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{5, 0} }
+
 // *
 // Log stream descriptor data. This is the full set of information that
 // describes a logging stream.
@@ -542,6 +574,8 @@ type LogIndex struct {
 	// This is optional. If zero, there is either no information about the number
 	// of log entries, or there are zero entries in the stream.
 	LogEntryCount uint64 `protobuf:"varint,5,opt,name=log_entry_count,json=logEntryCount" json:"log_entry_count,omitempty"`
+	// The compression codec applied to the log stream.
+	Compression LogIndex_Compression `protobuf:"varint,6,opt,name=compression,enum=logpb.LogIndex_Compression" json:"compression,omitempty"`
 }
 
 func (m *LogIndex) Reset()                    { *m = LogIndex{} }
@@ -584,6 +618,13 @@ func (m *LogIndex) GetLogEntryCount() uint64 {
 	return 0
 }
 
+func (m *LogIndex) GetCompression() LogIndex_Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return LogIndex_NONE
+}
+
 //
 // Entry is a single index entry.
 //
@@ -675,59 +716,64 @@ func init() {
 	proto.RegisterType((*LogIndex)(nil), "logpb.LogIndex")
 	proto.RegisterType((*LogIndex_Entry)(nil), "logpb.LogIndex.Entry")
 	proto.RegisterEnum("logpb.StreamType", StreamType_name, StreamType_value)
+	proto.RegisterEnum("logpb.LogIndex_Compression", LogIndex_Compression_name, LogIndex_Compression_value)
 }
 
 func init() { proto.RegisterFile("github.com/luci/luci-go/logdog/api/logpb/log.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x25, 0xea, 0x36, 0x94, 0x2a, 0x79, 0x7b, 0x63, 0x89, 0xa2, 0x95, 0x84, 0xc2, 0x15,
-	0x0c, 0x98, 0x6a, 0xd5, 0x02, 0x31, 0xfc, 0x26, 0x47, 0x8e, 0x6d, 0xc0, 0x49, 0x8c, 0xb5, 0x1e,
-	0x92, 0x27, 0x62, 0x25, 0xad, 0x98, 0x4d, 0x28, 0x2e, 0x43, 0xae, 0x02, 0x29, 0x9f, 0x92, 0x5f,
-	0x08, 0x90, 0x3f, 0xc8, 0x27, 0xe5, 0x1f, 0x82, 0xbd, 0xe8, 0xe2, 0x5b, 0x80, 0xbc, 0x10, 0xb3,
-	0x33, 0x67, 0x66, 0x67, 0xce, 0x9c, 0x25, 0xf4, 0x43, 0x26, 0x5e, 0x2d, 0xc6, 0xfe, 0x84, 0xcf,
-	0x7b, 0xd1, 0x62, 0xc2, 0xd4, 0xe7, 0x30, 0xe4, 0xbd, 0x88, 0x87, 0x53, 0x1e, 0xf6, 0x48, 0xc2,
-	0xa4, 0x99, 0x8c, 0xe5, 0xd7, 0x4f, 0x52, 0x2e, 0x38, 0x2a, 0x2a, 0x87, 0xf7, 0x67, 0xc8, 0x79,
-	0x18, 0xd1, 0x9e, 0x72, 0x8e, 0x17, 0xb3, 0x9e, 0x60, 0x73, 0x9a, 0x09, 0x32, 0x4f, 0x34, 0xce,
-	0xfb, 0xe3, 0x36, 0x60, 0xba, 0x48, 0x89, 0x60, 0x3c, 0xd6, 0xf1, 0xce, 0x97, 0x3c, 0xfc, 0x78,
-	0xc9, 0xc3, 0x6b, 0x91, 0x52, 0x32, 0x1f, 0xd2, 0x6c, 0x92, 0xb2, 0x44, 0xf0, 0x14, 0xfd, 0x02,
-	0xa5, 0x24, 0xa5, 0x33, 0xb6, 0x74, 0xad, 0x96, 0xd5, 0xad, 0x62, 0x73, 0x42, 0x08, 0xec, 0x98,
-	0xcc, 0xa9, 0x9b, 0x57, 0x5e, 0x65, 0xa3, 0x3e, 0x38, 0x99, 0xca, 0x0f, 0xc4, 0x2a, 0xa1, 0x6e,
-	0xa1, 0x65, 0x75, 0x7f, 0xe8, 0xef, 0xf9, 0xaa, 0x43, 0x5f, 0x57, 0x1e, 0xad, 0x12, 0x8a, 0x21,
-	0xdb, 0xd8, 0xa8, 0x0d, 0xb5, 0x09, 0x8f, 0x05, 0x8d, 0x85, 0x4e, 0xb2, 0x55, 0x3d, 0xc7, 0xf8,
-	0x14, 0xe4, 0x08, 0xaa, 0x9b, 0x69, 0xdc, 0x62, 0xcb, 0xea, 0x3a, 0x7d, 0xcf, 0xd7, 0xe3, 0xf8,
-	0xeb, 0x71, 0xfc, 0xd1, 0x1a, 0x81, 0xb7, 0x60, 0x74, 0x04, 0xb6, 0x20, 0x61, 0xe6, 0x96, 0x5a,
-	0x85, 0xae, 0xd3, 0xff, 0xcb, 0x74, 0x72, 0xcf, 0x98, 0xfe, 0x88, 0x84, 0xd9, 0x69, 0x2c, 0xd2,
-	0x15, 0x56, 0x19, 0x68, 0x1f, 0x1a, 0x63, 0x16, 0x93, 0x74, 0x15, 0xcc, 0x58, 0x44, 0x03, 0xba,
-	0x14, 0x6e, 0x59, 0x75, 0x56, 0xd7, 0xee, 0x27, 0x2c, 0xa2, 0xa7, 0x4b, 0xe1, 0x3d, 0x82, 0xea,
-	0x26, 0x15, 0x35, 0xa1, 0xf0, 0x86, 0xae, 0x0c, 0x51, 0xd2, 0x44, 0x3f, 0x41, 0xf1, 0x1d, 0x89,
-	0x16, 0x6b, 0x9a, 0xf4, 0xe1, 0x38, 0x7f, 0x64, 0x75, 0x5e, 0x83, 0x3d, 0xa2, 0x4b, 0x81, 0xf6,
-	0xa1, 0x18, 0xb1, 0x98, 0x66, 0xae, 0xa5, 0x7a, 0x6c, 0x9a, 0x1e, 0x65, 0xcc, 0xbf, 0x64, 0x31,
-	0xc5, 0x3a, 0xec, 0x1d, 0x83, 0x2d, 0x8f, 0xdb, 0x8a, 0xd6, 0x4e, 0x45, 0xf4, 0x3b, 0x54, 0xa7,
-	0x34, 0x62, 0x73, 0x26, 0x68, 0x6a, 0xee, 0xda, 0x3a, 0x3a, 0xff, 0x43, 0xe9, 0x44, 0x75, 0x2d,
-	0xb7, 0xc9, 0x67, 0xb3, 0x8c, 0x0a, 0x95, 0x6e, 0x63, 0x73, 0x92, 0xdb, 0x9c, 0x12, 0x41, 0x54,
-	0x6a, 0x0d, 0x2b, 0xbb, 0xf3, 0xc1, 0x82, 0xca, 0x90, 0x08, 0x12, 0xa6, 0x64, 0xbe, 0x01, 0x58,
-	0x5b, 0x00, 0xfa, 0x17, 0xca, 0x09, 0x49, 0x05, 0x23, 0x91, 0xca, 0x73, 0xfa, 0xbf, 0x9a, 0xe6,
-	0xd7, 0x59, 0xfe, 0x95, 0x0e, 0xe3, 0x35, 0xce, 0x3b, 0x83, 0xb2, 0xf1, 0xc9, 0x41, 0x58, 0x3c,
-	0xa5, 0x5a, 0x57, 0x75, 0xac, 0x0f, 0xf2, 0x9e, 0x8c, 0xbd, 0xd7, 0x7c, 0xd9, 0x58, 0xd9, 0xd2,
-	0x17, 0x91, 0x4c, 0x28, 0x3d, 0x55, 0xb0, 0xb2, 0x3b, 0x9f, 0xf2, 0x50, 0xb9, 0xe4, 0xa1, 0xe6,
-	0xfd, 0x18, 0x1c, 0xb9, 0xf3, 0x60, 0x67, 0x34, 0xa7, 0xff, 0xdb, 0x1d, 0x89, 0x0c, 0x8d, 0xe2,
-	0x31, 0x48, 0xf4, 0x73, 0x3d, 0x79, 0x1b, 0x6a, 0x5a, 0xd1, 0x81, 0xee, 0x46, 0x5f, 0xec, 0x68,
-	0xdf, 0x85, 0xea, 0xa9, 0x0d, 0x35, 0x23, 0x6b, 0x0d, 0x29, 0x68, 0x88, 0xf6, 0x69, 0x88, 0x07,
-	0x95, 0x8c, 0xbe, 0x5d, 0xd0, 0x78, 0xa2, 0x15, 0x6c, 0xe3, 0xcd, 0x19, 0xb5, 0xc1, 0x16, 0x52,
-	0x3f, 0xa0, 0xda, 0x72, 0x76, 0x16, 0x7c, 0x9e, 0xc3, 0x2a, 0x84, 0xfe, 0x86, 0x92, 0x96, 0x95,
-	0xeb, 0x28, 0x50, 0xdd, 0x80, 0xf4, 0xd6, 0xce, 0x73, 0xd8, 0x84, 0xd1, 0x21, 0x54, 0xa6, 0x86,
-	0x5c, 0xb7, 0xa6, 0xa0, 0x8d, 0x5b, 0x9c, 0x9f, 0xe7, 0xf0, 0x06, 0x72, 0x52, 0x85, 0xb2, 0x79,
-	0x48, 0x9d, 0x8f, 0x05, 0x45, 0x98, 0x6e, 0xd7, 0x07, 0x7b, 0x4a, 0xb3, 0x89, 0x61, 0xca, 0x7b,
-	0xf8, 0x5d, 0x60, 0x85, 0x43, 0x3d, 0x28, 0xd3, 0x58, 0xa4, 0x8c, 0x66, 0x6e, 0x5e, 0xc9, 0xf4,
-	0xe7, 0x6d, 0x8a, 0xaa, 0xe8, 0xeb, 0xb7, 0xb3, 0x46, 0xa1, 0x03, 0xd8, 0x93, 0x6b, 0x0a, 0x6e,
-	0x50, 0xab, 0x79, 0x6b, 0xc8, 0xc0, 0xd5, 0x0e, 0xbd, 0x6b, 0xec, 0x0d, 0x8e, 0xed, 0x2d, 0xf6,
-	0x7a, 0x87, 0xe7, 0x7d, 0x68, 0x44, 0x3c, 0x0c, 0xe4, 0x35, 0xab, 0x60, 0xc2, 0x17, 0xb1, 0x50,
-	0x3f, 0x04, 0x1b, 0xd7, 0x23, 0x23, 0x86, 0xc7, 0xd2, 0xe9, 0x7d, 0xb6, 0xa0, 0xa8, 0xb5, 0xf1,
-	0x90, 0xe2, 0x77, 0x37, 0x96, 0xbf, 0xb3, 0xb1, 0xda, 0x3d, 0x8d, 0x7f, 0x53, 0x13, 0xf6, 0x5d,
-	0x4d, 0xdc, 0x52, 0x65, 0xf1, 0x3b, 0x54, 0x79, 0xf0, 0x0f, 0xc0, 0xf6, 0x7f, 0x89, 0x2a, 0x60,
-	0x8f, 0x4e, 0x5f, 0x8c, 0x9a, 0x39, 0x04, 0x50, 0x3a, 0xb9, 0x78, 0x36, 0xc0, 0x2f, 0x9b, 0x16,
-	0xaa, 0x41, 0x65, 0x38, 0x18, 0x0d, 0xce, 0xf0, 0xe0, 0x69, 0x33, 0x3f, 0x2e, 0xa9, 0x82, 0xff,
-	0x7d, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x12, 0x93, 0xb0, 0x44, 0x06, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x53, 0xe7, 0xef, 0x38, 0xa5, 0xd9, 0xe1, 0xcf, 0x18, 0x04, 0x6d, 0x84, 0x4a, 0xb5,
+	0x52, 0x1d, 0x08, 0x48, 0x54, 0x95, 0xb8, 0x48, 0xb7, 0xa5, 0xad, 0x54, 0xba, 0xd1, 0x34, 0x17,
+	0x2c, 0x37, 0xd1, 0x24, 0x99, 0x98, 0x01, 0xdb, 0x63, 0xec, 0x09, 0x6a, 0x78, 0x13, 0x78, 0x08,
+	0xde, 0x80, 0x47, 0xe2, 0x1d, 0xd0, 0x9c, 0x99, 0xc4, 0xde, 0x76, 0x8b, 0xb4, 0x37, 0xd6, 0xf9,
+	0xf9, 0xce, 0xcc, 0x39, 0xdf, 0xf9, 0xc6, 0x30, 0x8c, 0x84, 0xfa, 0x79, 0x35, 0x0b, 0xe7, 0x32,
+	0x19, 0xc4, 0xab, 0xb9, 0xc0, 0xcf, 0x71, 0x24, 0x07, 0xb1, 0x8c, 0x16, 0x32, 0x1a, 0xb0, 0x4c,
+	0x68, 0x33, 0x9b, 0xe9, 0x6f, 0x98, 0xe5, 0x52, 0x49, 0xd2, 0xc0, 0x40, 0xf0, 0x59, 0x24, 0x65,
+	0x14, 0xf3, 0x01, 0x06, 0x67, 0xab, 0xe5, 0x40, 0x89, 0x84, 0x17, 0x8a, 0x25, 0x99, 0xc1, 0x05,
+	0x9f, 0x3e, 0x04, 0x2c, 0x56, 0x39, 0x53, 0x42, 0xa6, 0x26, 0xdf, 0xff, 0xb7, 0x0e, 0xef, 0xde,
+	0xc8, 0xe8, 0x4e, 0xe5, 0x9c, 0x25, 0xe7, 0xbc, 0x98, 0xe7, 0x22, 0x53, 0x32, 0x27, 0x1f, 0x40,
+	0x33, 0xcb, 0xf9, 0x52, 0xdc, 0xfb, 0xce, 0xbe, 0x73, 0xd4, 0xa1, 0xd6, 0x23, 0x04, 0xdc, 0x94,
+	0x25, 0xdc, 0xaf, 0x63, 0x14, 0x6d, 0x32, 0x04, 0xaf, 0xc0, 0xfa, 0xa9, 0x5a, 0x67, 0xdc, 0xdf,
+	0xd9, 0x77, 0x8e, 0xde, 0x19, 0x3e, 0x0b, 0xb1, 0xc3, 0xd0, 0x9c, 0x3c, 0x59, 0x67, 0x9c, 0x42,
+	0xb1, 0xb5, 0xc9, 0x01, 0x74, 0xe7, 0x32, 0x55, 0x3c, 0x55, 0xa6, 0xc8, 0xc5, 0xf3, 0x3c, 0x1b,
+	0x43, 0xc8, 0x09, 0x74, 0xb6, 0xd3, 0xf8, 0x8d, 0x7d, 0xe7, 0xc8, 0x1b, 0x06, 0xa1, 0x19, 0x27,
+	0xdc, 0x8c, 0x13, 0x4e, 0x36, 0x08, 0x5a, 0x82, 0xc9, 0x09, 0xb8, 0x8a, 0x45, 0x85, 0xdf, 0xdc,
+	0xdf, 0x39, 0xf2, 0x86, 0x9f, 0xdb, 0x4e, 0xde, 0x30, 0x66, 0x38, 0x61, 0x51, 0x71, 0x91, 0xaa,
+	0x7c, 0x4d, 0xb1, 0x82, 0x1c, 0xc2, 0xde, 0x4c, 0xa4, 0x2c, 0x5f, 0x4f, 0x97, 0x22, 0xe6, 0x53,
+	0x7e, 0xaf, 0xfc, 0x16, 0x76, 0xb6, 0x6b, 0xc2, 0xdf, 0x8b, 0x98, 0x5f, 0xdc, 0xab, 0xe0, 0x5b,
+	0xe8, 0x6c, 0x4b, 0x49, 0x0f, 0x76, 0x7e, 0xe5, 0x6b, 0x4b, 0x94, 0x36, 0xc9, 0x7b, 0xd0, 0xf8,
+	0x9d, 0xc5, 0xab, 0x0d, 0x4d, 0xc6, 0x39, 0xad, 0x9f, 0x38, 0xfd, 0x5f, 0xc0, 0x9d, 0xf0, 0x7b,
+	0x45, 0x0e, 0xa1, 0x11, 0x8b, 0x94, 0x17, 0xbe, 0x83, 0x3d, 0xf6, 0x6c, 0x8f, 0x3a, 0x17, 0xde,
+	0x88, 0x94, 0x53, 0x93, 0x0e, 0x4e, 0xc1, 0xd5, 0x6e, 0x79, 0xa2, 0x53, 0x39, 0x91, 0x7c, 0x02,
+	0x9d, 0x05, 0x8f, 0x45, 0x22, 0x14, 0xcf, 0xed, 0x5d, 0x65, 0xa0, 0xff, 0x0d, 0x34, 0xcf, 0xb0,
+	0x6b, 0xbd, 0x4d, 0xb9, 0x5c, 0x16, 0x5c, 0x61, 0xb9, 0x4b, 0xad, 0xa7, 0xb7, 0xb9, 0x60, 0x8a,
+	0x61, 0x69, 0x97, 0xa2, 0xdd, 0xff, 0xcb, 0x81, 0xf6, 0x39, 0x53, 0x2c, 0xca, 0x59, 0xb2, 0x05,
+	0x38, 0x25, 0x80, 0x7c, 0x05, 0xad, 0x8c, 0xe5, 0x4a, 0xb0, 0x18, 0xeb, 0xbc, 0xe1, 0x87, 0xb6,
+	0xf9, 0x4d, 0x55, 0x38, 0x36, 0x69, 0xba, 0xc1, 0x05, 0x97, 0xd0, 0xb2, 0x31, 0x3d, 0x88, 0x48,
+	0x17, 0xdc, 0xe8, 0x6a, 0x97, 0x1a, 0x47, 0xdf, 0x53, 0x88, 0x3f, 0x0c, 0x5f, 0x2e, 0x45, 0x5b,
+	0xc7, 0x62, 0x56, 0x28, 0xd4, 0x53, 0x9b, 0xa2, 0xdd, 0xff, 0xbb, 0x0e, 0xed, 0x1b, 0x19, 0x19,
+	0xde, 0x4f, 0xc1, 0xd3, 0x3b, 0x9f, 0x56, 0x46, 0xf3, 0x86, 0x1f, 0x3d, 0x92, 0xc8, 0xb9, 0x55,
+	0x3c, 0x05, 0x8d, 0x7e, 0x69, 0x26, 0x3f, 0x80, 0xae, 0x51, 0xf4, 0xd4, 0x74, 0x63, 0x2e, 0xf6,
+	0x4c, 0xec, 0x1a, 0x7b, 0x3a, 0x80, 0xae, 0x95, 0xb5, 0x81, 0xec, 0x18, 0x88, 0x89, 0x19, 0x48,
+	0x00, 0xed, 0x82, 0xff, 0xb6, 0xe2, 0xe9, 0xdc, 0x28, 0xd8, 0xa5, 0x5b, 0x9f, 0x1c, 0x80, 0xab,
+	0xb4, 0x7e, 0x00, 0xdb, 0xf2, 0x2a, 0x0b, 0xbe, 0xaa, 0x51, 0x4c, 0x91, 0x2f, 0xa0, 0x69, 0x64,
+	0xe5, 0x7b, 0x08, 0xda, 0xb5, 0x20, 0xb3, 0xb5, 0xab, 0x1a, 0xb5, 0x69, 0x72, 0x0c, 0xed, 0x85,
+	0x25, 0xd7, 0xef, 0x22, 0x74, 0xef, 0x01, 0xe7, 0x57, 0x35, 0xba, 0x85, 0x9c, 0x75, 0xa0, 0x65,
+	0x1f, 0x52, 0xff, 0x4f, 0x17, 0x09, 0x33, 0xed, 0x86, 0xe0, 0x2e, 0x78, 0x31, 0xb7, 0x4c, 0x05,
+	0x4f, 0xbf, 0x0b, 0x8a, 0x38, 0x32, 0x80, 0x16, 0x4f, 0x55, 0x2e, 0x78, 0xe1, 0xd7, 0x51, 0xa6,
+	0xef, 0x97, 0x25, 0x78, 0x62, 0x68, 0xde, 0xce, 0x06, 0x45, 0x9e, 0xc3, 0x33, 0xbd, 0xa6, 0xe9,
+	0x6b, 0xd4, 0x1a, 0xde, 0xf6, 0x74, 0x62, 0x5c, 0xa1, 0x77, 0x83, 0x7d, 0x8d, 0x63, 0xb7, 0xc4,
+	0xde, 0x55, 0x78, 0x3e, 0x84, 0xbd, 0x58, 0x46, 0x53, 0x7d, 0xcd, 0x7a, 0x3a, 0x97, 0xab, 0x54,
+	0xe1, 0x0f, 0xc1, 0xa5, 0xbb, 0xb1, 0x15, 0xc3, 0x0b, 0x1d, 0x24, 0xdf, 0x81, 0x37, 0x97, 0x49,
+	0x96, 0xf3, 0xa2, 0x10, 0x32, 0xf5, 0x9b, 0xf8, 0x27, 0xfa, 0xf8, 0x61, 0xd3, 0x2f, 0x4a, 0x08,
+	0xad, 0xe2, 0x83, 0x7f, 0x1c, 0x68, 0x18, 0x69, 0x3d, 0xf5, 0x60, 0xaa, 0x0b, 0xaf, 0x3f, 0x5a,
+	0x78, 0xf7, 0x0d, 0x73, 0xff, 0xaf, 0xa4, 0xdc, 0xc7, 0x92, 0x7a, 0x20, 0xea, 0xc6, 0x5b, 0x88,
+	0xba, 0x7f, 0x0c, 0x5e, 0x65, 0x36, 0xd2, 0x06, 0xf7, 0xf6, 0xe5, 0xed, 0x45, 0xaf, 0xa6, 0xad,
+	0xcb, 0x9f, 0xae, 0xc7, 0x3d, 0x87, 0x00, 0x34, 0xef, 0x6e, 0x47, 0xe3, 0xf1, 0xab, 0x5e, 0xfd,
+	0xf9, 0x97, 0x00, 0xe5, 0xdf, 0x59, 0x63, 0x26, 0x17, 0x3f, 0x4e, 0x7a, 0x35, 0x8d, 0x39, 0xbb,
+	0xbe, 0x1d, 0xd1, 0x57, 0x3d, 0x87, 0x74, 0xa1, 0x7d, 0x3e, 0x9a, 0x8c, 0x2e, 0xe9, 0xe8, 0x87,
+	0x5e, 0x7d, 0xd6, 0xc4, 0xfb, 0xbf, 0xfe, 0x6f, 0x00, 0x28, 0x88, 0x25, 0x7a, 0xb2, 0x06, 0x00,
+	0x00,
 }
@@ -28,5 +28,7 @@ func init() {
 	tmb.InstallHandlers(r, base)
 	gaemiddleware.InstallHandlersWithMiddleware(r, base)
 
+	r.GET("/internal/cron/purge-expired-streams", base.Extend(gaemiddleware.RequireCron), purgeExpiredStreamsCron)
+
 	http.Handle("/", r)
 }
@@ -0,0 +1,104 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package module
+
+import (
+	"net/http"
+
+	"github.com/luci/gae/service/info"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/appengine/coordinator/config"
+	"github.com/luci/luci-go/server/auth/identity"
+	"github.com/luci/luci-go/server/router"
+)
+
+// retentionPurgeBatchSize is the maximum number of expired log streams that
+// will be purged from each project by a single cron invocation. Any remaining
+// expired log streams will be purged by subsequent invocations.
+const retentionPurgeBatchSize = 100
+
+// dataPurgedBackfillBatchSize is the maximum number of log streams that will be
+// backfilled with the DataPurged property in each project by a single cron
+// invocation.
+const dataPurgedBackfillBatchSize = 500
+
+// purgeExpiredStreamsCron is the handler for the
+// /internal/cron/purge-expired-streams cron task.
+//
+// It purges the log data of the log streams in each project that are older
+// than the project's configured log retention period. Along the way, it
+// backfills the DataPurged property of older log streams (see
+// coordinator.BackfillDataPurged).
+func purgeExpiredStreamsCron(c *router.Context) {
+	projects, err := config.ActiveProjects(c.Context)
+	if err != nil {
+		log.WithError(err).Errorf(c.Context, "Failed to list active projects.")
+		c.Writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	requester := identity.Identity(string(identity.Service) + ":" + info.AppID(c.Context))
+	svc := coordinator.GetServices(c.Context)
+
+	failed := false
+	for _, proj := range projects {
+		pcfg, err := svc.ProjectConfig(c.Context, proj)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"project":    proj,
+			}.Errorf(c.Context, "Failed to load project configuration.")
+			failed = true
+			continue
+		}
+
+		retention := google.DurationFromProto(pcfg.LogRetention)
+		if retention <= 0 {
+			continue
+		}
+
+		pc := c.Context
+		if err := coordinator.WithProjectNamespace(&pc, proj, coordinator.NamespaceAccessNoAuth); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"project":    proj,
+			}.Errorf(c.Context, "Failed to enter project namespace.")
+			failed = true
+			continue
+		}
+
+		// Log streams that predate the DataPurged property are invisible to the
+		// expired log stream query until they have been backfilled.
+		if _, err := coordinator.BackfillDataPurged(pc, dataPurgedBackfillBatchSize); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"project":    proj,
+			}.Errorf(c.Context, "Failed to backfill DataPurged.")
+			failed = true
+		}
+
+		purged, err := coordinator.PurgeExpiredLogStreams(pc, retention, retentionPurgeBatchSize, requester)
+		fields := log.Fields{
+			"project":   proj,
+			"retention": retention,
+			"purged":    len(purged),
+		}
+		if err != nil {
+			fields[log.ErrorKey] = err
+			fields.Errorf(c.Context, "Failed to purge expired log streams.")
+			failed = true
+			continue
+		}
+		fields.Infof(c.Context, "Purged expired log streams.")
+	}
+
+	if failed {
+		c.Writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	c.Writer.WriteHeader(http.StatusOK)
+}
@@ -177,6 +177,7 @@ func Install() (context.Context, *Environment) {
 		{"Name", "-Created"},
 		{"State", "-Created"},
 		{"Purged", "-Created"},
+		{"DataPurged", "-Created"},
 		{"ProtoVersion", "-Created"},
 		{"ContentType", "-Created"},
 		{"StreamType", "-Created"},
@@ -310,6 +311,14 @@ func Install() (context.Context, *Environment) {
 				Opts:    opts,
 			}, nil
 		},
+		IS: func() (coordinator.Storage, error) {
+			return &BigTableStorage{
+				Testing: e.BigTable,
+			}, nil
+		},
+		GS: func() (gs.Client, error) {
+			return &e.GSClient, nil
+		},
 		AP: func() (coordinator.ArchivalPublisher, error) {
 			return &e.ArchivalPublisher, nil
 		},
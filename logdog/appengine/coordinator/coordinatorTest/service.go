@@ -5,6 +5,7 @@
 package coordinatorTest
 
 import (
+	"github.com/luci/luci-go/common/gcloud/gs"
 	"github.com/luci/luci-go/logdog/api/config/svcconfig"
 	"github.com/luci/luci-go/logdog/appengine/coordinator"
 	"github.com/luci/luci-go/logdog/appengine/coordinator/config"
@@ -34,6 +35,19 @@ type Services struct {
 	// if the stream is archived.
 	ST func(*coordinator.LogStreamState) (coordinator.Storage, error)
 
+	// IS returns an intermediate storage instance for use by this service.
+	//
+	// The caller must close the returned instance if successful.
+	//
+	// By default, this will return a *BigTableStorage instance bound to the
+	// Environment's BigTable instance.
+	IS func() (coordinator.Storage, error)
+
+	// GS returns a Google Storage client for use by this service.
+	//
+	// By default, this will return the Environment's GSClient instance.
+	GS func() (gs.Client, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	AP func() (coordinator.ArchivalPublisher, error)
 }
@@ -64,6 +78,22 @@ func (s *Services) StorageForStream(c context.Context, lst *coordinator.LogStrea
 	panic("not implemented")
 }
 
+// IntermediateStorage implements coordinator.Services.
+func (s *Services) IntermediateStorage(context.Context) (coordinator.Storage, error) {
+	if s.IS != nil {
+		return s.IS()
+	}
+	panic("not implemented")
+}
+
+// GSClient implements coordinator.Services.
+func (s *Services) GSClient(context.Context) (gs.Client, error) {
+	if s.GS != nil {
+		return s.GS()
+	}
+	panic("not implemented")
+}
+
 // ArchivalPublisher implements coordinator.Services.
 func (s *Services) ArchivalPublisher(context.Context) (coordinator.ArchivalPublisher, error) {
 	if s.AP != nil {
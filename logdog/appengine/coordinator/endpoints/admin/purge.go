@@ -0,0 +1,89 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package admin
+
+import (
+	ds "github.com/luci/gae/service/datastore"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/admin/v1"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+	"github.com/luci/luci-go/server/auth"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// PurgeStream deletes a log stream's log data, leaving a tombstone in its
+// place.
+func (s *server) PurgeStream(c context.Context, req *logdog.PurgeStreamRequest) (*logdog.PurgeResponse, error) {
+	log.Fields{
+		"project": req.Project,
+		"path":    req.Path,
+		"reason":  req.Reason,
+	}.Infof(c, "Request to purge log stream.")
+
+	path := types.StreamPath(req.Path)
+	if err := path.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "Invalid path (%s): %s", path, err)
+	}
+	if req.Reason == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "A purge reason is required.")
+	}
+	if err := coordinator.WithProjectNamespace(&c, cfgtypes.ProjectName(req.Project), coordinator.NamespaceAccessNoAuth); err != nil {
+		return nil, err
+	}
+
+	err := coordinator.PurgeLogStream(c, coordinator.LogStreamID(path), auth.CurrentIdentity(c), req.Reason)
+	switch err {
+	case nil:
+		return &logdog.PurgeResponse{Paths: []string{string(path)}}, nil
+
+	case ds.ErrNoSuchEntity:
+		return nil, grpcutil.Errf(codes.NotFound, "Log stream %q does not exist.", path)
+
+	default:
+		log.WithError(err).Errorf(c, "Failed to purge log stream.")
+		return nil, grpcutil.Internal
+	}
+}
+
+// PurgePrefix purges all of the log streams registered under a prefix.
+func (s *server) PurgePrefix(c context.Context, req *logdog.PurgePrefixRequest) (*logdog.PurgeResponse, error) {
+	log.Fields{
+		"project": req.Project,
+		"prefix":  req.Prefix,
+		"reason":  req.Reason,
+	}.Infof(c, "Request to purge log stream prefix.")
+
+	prefix := types.StreamName(req.Prefix)
+	if err := prefix.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "Invalid prefix (%s): %s", prefix, err)
+	}
+	if req.Reason == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "A purge reason is required.")
+	}
+	if err := coordinator.WithProjectNamespace(&c, cfgtypes.ProjectName(req.Project), coordinator.NamespaceAccessNoAuth); err != nil {
+		return nil, err
+	}
+
+	paths, err := coordinator.PurgeLogStreamsWithPrefix(c, prefix, auth.CurrentIdentity(c), req.Reason)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"purged":     len(paths),
+		}.Errorf(c, "Failed to purge log stream prefix.")
+		return nil, grpcutil.Internal
+	}
+
+	resp := logdog.PurgeResponse{
+		Paths: make([]string, len(paths)),
+	}
+	for i, p := range paths {
+		resp.Paths[i] = string(p)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package admin
+
+import (
+	"testing"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/admin/v1"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	ct "github.com/luci/luci-go/logdog/appengine/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration`, t, func() {
+		c, env := ct.Install()
+		svr := New()
+
+		// Register a log stream with intermediate log data, and one with archived
+		// log data, under the same prefix.
+		tls := ct.MakeStream(c, "proj-foo", "testing/+/foo/bar")
+		for i := 0; i < 3; i++ {
+			err := env.BigTable.Put(storage.PutRequest{
+				Project: tls.Project,
+				Path:    tls.Path,
+				Index:   types.MessageIndex(i),
+				Values:  [][]byte{[]byte("log data")},
+			})
+			if err != nil {
+				panic(err)
+			}
+		}
+		if err := tls.Put(c); err != nil {
+			panic(err)
+		}
+
+		archived := ct.MakeStream(c, "proj-foo", "testing/+/baz")
+		now := env.Clock.Now().UTC()
+		archived.State.TerminatedTime = now
+		archived.State.ArchivedTime = now
+		archived.State.ArchiveStreamURL = "gs://testbucket/stream"
+		archived.State.ArchiveIndexURL = "gs://testbucket/index"
+		env.GSClient.Put("gs://testbucket/stream", []byte("stream"))
+		env.GSClient.Put("gs://testbucket/index", []byte("index"))
+		if err := archived.Put(c); err != nil {
+			panic(err)
+		}
+
+		other := ct.MakeStream(c, "proj-foo", "other/+/foo/bar")
+		if err := other.Put(c); err != nil {
+			panic(err)
+		}
+
+		getAudit := func() (recs []*coordinator.LogStreamPurge) {
+			tls.WithProjectNamespace(c, func(c context.Context) {
+				if err := ds.GetAll(c, ds.NewQuery("LogStreamPurge"), &recs); err != nil {
+					panic(err)
+				}
+			})
+			return
+		}
+
+		Convey(`Will fail with PermissionDenied if the user is not an administrator.`, func() {
+			_, err := svr.PurgeStream(c, &logdog.PurgeStreamRequest{
+				Project: "proj-foo",
+				Path:    string(tls.Path),
+				Reason:  "testing",
+			})
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+
+		Convey(`When the user is an administrator`, func() {
+			env.JoinGroup("admin")
+
+			Convey(`A PurgeStream request`, func() {
+				req := logdog.PurgeStreamRequest{
+					Project: "proj-foo",
+					Path:    string(tls.Path),
+					Reason:  "testing",
+				}
+
+				Convey(`Will fail with InvalidArgument if the path is invalid.`, func() {
+					req.Path = "not/a/stream/path"
+					_, err := svr.PurgeStream(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Invalid path")
+				})
+
+				Convey(`Will fail with InvalidArgument if no reason is supplied.`, func() {
+					req.Reason = ""
+					_, err := svr.PurgeStream(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "reason is required")
+				})
+
+				Convey(`Will fail with NotFound if the log stream does not exist.`, func() {
+					req.Path = "testing/+/does/not/exist"
+					_, err := svr.PurgeStream(c, &req)
+					So(err, ShouldBeRPCNotFound)
+				})
+
+				Convey(`Will purge the log stream's intermediate log data.`, func() {
+					resp, err := svr.PurgeStream(c, &req)
+					So(err, ShouldBeRPCOK)
+					So(resp.Paths, ShouldResemble, []string{string(tls.Path)})
+
+					So(tls.Get(c), ShouldBeNil)
+					So(tls.Stream.Purged, ShouldBeTrue)
+					So(tls.Stream.DataPurged, ShouldBeTrue)
+					So(tls.Stream.PurgeReason, ShouldEqual, "testing")
+
+					_, err = env.BigTable.Tail(tls.Project, tls.Path)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+					recs := getAudit()
+					So(recs, ShouldHaveLength, 1)
+					So(recs[0].Path, ShouldEqual, string(tls.Path))
+					So(recs[0].Reason, ShouldEqual, "testing")
+
+					Convey(`Will succeed without doing anything if purged again.`, func() {
+						_, err := svr.PurgeStream(c, &req)
+						So(err, ShouldBeRPCOK)
+						So(getAudit(), ShouldHaveLength, 1)
+					})
+				})
+
+				Convey(`Will purge the log stream's archived log data.`, func() {
+					req.Path = string(archived.Path)
+
+					_, err := svr.PurgeStream(c, &req)
+					So(err, ShouldBeRPCOK)
+					So(env.GSClient.Get("gs://testbucket/stream"), ShouldBeNil)
+					So(env.GSClient.Get("gs://testbucket/index"), ShouldBeNil)
+
+					So(archived.Get(c), ShouldBeNil)
+					So(archived.Stream.DataPurged, ShouldBeTrue)
+					So(archived.State.ArchiveStreamURL, ShouldEqual, "")
+					So(archived.State.ArchiveIndexURL, ShouldEqual, "")
+				})
+
+				Convey(`Will fail with Internal if the archived log data can't be deleted.`, func() {
+					req.Path = string(archived.Path)
+					env.GSClient["error"] = []byte("test error")
+
+					_, err := svr.PurgeStream(c, &req)
+					So(err, ShouldBeRPCInternal)
+
+					So(archived.Get(c), ShouldBeNil)
+					So(archived.Stream.Purged, ShouldBeTrue)
+					So(archived.Stream.DataPurged, ShouldBeFalse)
+				})
+			})
+
+			Convey(`A PurgePrefix request`, func() {
+				req := logdog.PurgePrefixRequest{
+					Project: "proj-foo",
+					Prefix:  "testing",
+					Reason:  "testing",
+				}
+
+				Convey(`Will fail with InvalidArgument if the prefix is invalid.`, func() {
+					req.Prefix = "!!! invalid !!!"
+					_, err := svr.PurgePrefix(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Invalid prefix")
+				})
+
+				Convey(`Will purge all of the log streams under the prefix.`, func() {
+					resp, err := svr.PurgePrefix(c, &req)
+					So(err, ShouldBeRPCOK)
+					So(resp.Paths, ShouldHaveLength, 2)
+					So(getAudit(), ShouldHaveLength, 2)
+
+					So(other.Get(c), ShouldBeNil)
+					So(other.Stream.Purged, ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
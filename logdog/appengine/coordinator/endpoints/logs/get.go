@@ -86,6 +86,26 @@ func (s *server) getImpl(c context.Context, req *logdog.GetRequest, tail bool) (
 		return nil, grpcutil.Internal
 	}
 
+	// If this log stream's data has been purged, its tombstone is all that
+	// remains. Return that to everyone, regardless of the request.
+	if ls.DataPurged {
+		log.Fields{
+			"purgedTime": ls.PurgedTime,
+		}.Infof(c, "Log stream data has been purged; returning tombstone.")
+
+		resp := logdog.GetResponse{
+			State: buildLogStreamState(ls, lst),
+		}
+
+		var err error
+		resp.Desc, err = ls.DescriptorValue()
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to deserialize descriptor protobuf.")
+			return nil, grpcutil.Internal
+		}
+		return &resp, nil
+	}
+
 	// If this log entry is Purged and we're not admin, pretend it doesn't exist.
 	if ls.Purged {
 		if authErr := coordinator.IsAdminUser(c); authErr != nil {
@@ -358,6 +358,37 @@ func testGetImpl(t *testing.T, archived bool) {
 					})
 				})
 
+				Convey(`When the log stream's data is purged, will return its tombstone.`, func() {
+					tls.Stream.Purged = true
+					tls.Stream.PurgedTime = env.Clock.Now().UTC()
+					tls.Stream.DataPurged = true
+					tls.Stream.PurgeReason = "testing purge"
+					putLogStream(c)
+
+					resp, err := svr.Get(c, &req)
+					So(err, ShouldBeRPCOK)
+					So(resp, shouldHaveLogs)
+					So(resp.State.Purged, ShouldBeTrue)
+					So(resp.State.Archive, ShouldBeNil)
+					So(resp.State.Tombstone, ShouldResemble, &logdog.LogStreamState_Tombstone{
+						Purged: google.NewTimestamp(tls.Stream.PurgedTime),
+						Reason: "testing purge",
+					})
+				})
+
+				Convey(`When the Butler dropped data, will return the dropped byte counts.`, func() {
+					tls.State.TerminalIndex = 7
+					tls.State.QuotaDroppedBytes = 10
+					tls.State.RateDroppedBytes = 20
+					putLogStream(c)
+
+					req.State = true
+					resp, err := svr.Get(c, &req)
+					So(err, ShouldBeRPCOK)
+					So(resp.State.QuotaDroppedBytes, ShouldEqual, 10)
+					So(resp.State.RateDroppedBytes, ShouldEqual, 20)
+				})
+
 				Convey(`Will return empty if no records were requested.`, func() {
 					req.LogCount = -1
 					req.State = false
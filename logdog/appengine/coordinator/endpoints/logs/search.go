@@ -0,0 +1,382 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"regexp"
+	"time"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// searchStreamLimit is the maximum number of log streams that will be
+	// considered in a single search. If the user requests more, it will be
+	// automatically capped at this value.
+	searchStreamLimit = 50
+
+	// searchMatchLimit is the maximum number of matches that will be returned
+	// for a single log stream.
+	searchMatchLimit = 100
+
+	// searchContextLimit is the maximum number of context lines that will be
+	// returned around each match.
+	searchContextLimit = 10
+
+	// searchBytesLimit is the amount of log data after which a search stops
+	// considering additional log streams. The stream that exceeds it is always
+	// searched in full.
+	searchBytesLimit = 64 * 1024 * 1024
+)
+
+// Search returns the lines of terminated text log streams that match a
+// pattern.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	log.Fields{
+		"path":    req.Path,
+		"pattern": req.Pattern,
+		"regex":   req.Regex,
+	}.Debugf(c, "Received search request.")
+
+	if req.Pattern == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "a search `pattern` is required")
+	}
+	expr := req.Pattern
+	if !req.Regex {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if req.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Invalid search pattern.")
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid search `pattern`: %s", err)
+	}
+
+	if req.ContextLines < 0 || req.ContextLines > searchContextLimit {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "`context_lines` must be between 0 and %d", searchContextLimit)
+	}
+
+	maxMatches := searchMatchLimit
+	if req.MaxMatches > 0 && int(req.MaxMatches) < maxMatches {
+		maxMatches = int(req.MaxMatches)
+	}
+
+	// Non-admin users may not search purged streams.
+	canSeePurged := coordinator.IsAdminUser(c) == nil
+
+	candidates, err := s.searchCandidates(c, req, canSeePurged)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := logdog.SearchResponse{}
+	budget := searchBytesLimit
+	for i, cand := range candidates {
+		if !cand.state.Terminated() {
+			// Only streams whose contents are final are searched.
+			continue
+		}
+
+		rs, size, err := searchStream(c, cand.stream, cand.state, &lineMatcher{
+			re:         re,
+			context:    int(req.ContextLines),
+			maxMatches: maxMatches,
+		})
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       cand.stream.Path(),
+			}.Errorf(c, "Failed to search log stream.")
+			return nil, grpcutil.Internal
+		}
+		if rs != nil {
+			resp.Streams = append(resp.Streams, rs)
+		}
+
+		// Stop early if we've exhausted our data budget, resuming after this
+		// stream.
+		if budget -= size; budget <= 0 && i < len(candidates)-1 {
+			resp.Next = cand.cursor.String()
+			return &resp, nil
+		}
+	}
+
+	if n := len(candidates); n > 0 && candidates[n-1].cursor != nil {
+		resp.Next = candidates[n-1].cursor.String()
+	}
+	return &resp, nil
+}
+
+// searchCandidate is a log stream that is considered by a search.
+type searchCandidate struct {
+	stream *coordinator.LogStream
+	state  *coordinator.LogStreamState
+
+	// cursor, if not nil, is the datastore cursor positioned after this stream.
+	cursor ds.Cursor
+}
+
+// searchCandidates returns the text log streams that match the search path,
+// along with their state.
+//
+// Since a search can stop early to honor its data budget, each candidate has a
+// cursor to resume after it. The last candidate's cursor is nil if there are no
+// more streams to search.
+func (s *server) searchCandidates(c context.Context, req *logdog.SearchRequest, canSeePurged bool) ([]*searchCandidate, error) {
+	limit := s.limit(int(req.MaxStreams), searchStreamLimit)
+
+	q := ds.NewQuery("LogStream").Order("-Created")
+	if req.Next != "" {
+		cursor, err := ds.DecodeCursor(c, req.Next)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"cursor":     req.Next,
+			}.Errorf(c, "Failed to decode cursor.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
+		}
+		q = q.Start(cursor)
+	}
+
+	if req.Path != "" {
+		var err error
+		if q, err = coordinator.AddLogStreamPathFilter(q, req.Path); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       req.Path,
+			}.Errorf(c, "Invalid search path.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid search `path`")
+		}
+	}
+
+	q = q.Eq("StreamType", logpb.StreamType_TEXT)
+	if !canSeePurged {
+		q = q.Eq("Purged", false)
+	}
+	q = q.Limit(int32(limit)).KeysOnly(true)
+
+	var candidates []*searchCandidate
+	err := ds.Run(c, q, func(sk *ds.Key, cb ds.CursorCB) error {
+		cand := searchCandidate{stream: &coordinator.LogStream{}}
+		ds.PopulateKey(cand.stream, sk)
+
+		var err error
+		if cand.cursor, err = cb(); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"count":      len(candidates),
+			}.Errorf(c, "Failed to get cursor value.")
+			return err
+		}
+		candidates = append(candidates, &cand)
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to execute search query.")
+		return nil, grpcutil.Internal
+	}
+	if len(candidates) < limit && len(candidates) > 0 {
+		// The query was exhausted, so there is nothing left to resume.
+		candidates[len(candidates)-1].cursor = nil
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	entities := make([]interface{}, 0, 2*len(candidates))
+	for _, cand := range candidates {
+		cand.state = cand.stream.State(c)
+		entities = append(entities, cand.stream, cand.state)
+	}
+	if err := ds.Get(c, entities); err != nil {
+		log.WithError(err).Errorf(c, "Failed to load log streams and their state.")
+		return nil, grpcutil.Internal
+	}
+	return candidates, nil
+}
+
+// searchStream searches the contents of a log stream.
+//
+// It returns the stream's matches, or nil if it has none, and the amount of
+// log data that was read.
+func searchStream(c context.Context, ls *coordinator.LogStream, lst *coordinator.LogStreamState, m *lineMatcher) (
+	*logdog.SearchResponse_Stream, int, error) {
+
+	svc := coordinator.GetServices(c)
+	st, err := svc.StorageForStream(c, lst)
+	if err != nil {
+		return nil, 0, errors.Annotate(err).InternalReason("failed to create storage instance").Err()
+	}
+	defer st.Close()
+
+	sreq := storage.GetRequest{
+		Project: coordinator.Project(c),
+		Path:    ls.Path(),
+	}
+	terminalIndex := types.MessageIndex(lst.TerminalIndex)
+
+	size := 0
+	for sreq.Index <= terminalIndex && !m.done() {
+		var ierr error
+		count := 0
+		err := retry.Retry(c, retry.TransientOnly(retry.Default), func() error {
+			return st.Get(sreq, func(e *storage.Entry) bool {
+				var le *logpb.LogEntry
+				if le, ierr = e.GetLogEntry(); ierr != nil {
+					return false
+				}
+
+				// Stop at the first gap, as Get does for contiguous requests.
+				sidx, _ := e.GetStreamIndex() // GetLogEntry succeeded, so this must.
+				if sidx != sreq.Index {
+					return false
+				}
+
+				m.addEntry(le)
+				sreq.Index = sidx + 1
+				size += len(e.D)
+				count++
+				return sreq.Index <= terminalIndex && !m.done()
+			})
+		}, func(err error, delay time.Duration) {
+			log.Fields{
+				log.ErrorKey: err,
+				"delay":      delay,
+				"nextIndex":  sreq.Index,
+			}.Warningf(c, "Transient error while searching logs; retrying.")
+		})
+		switch {
+		case err == storage.ErrDoesNotExist:
+			// The stream has no log data.
+		case err != nil:
+			return nil, size, err
+		case ierr != nil:
+			return nil, size, errors.Annotate(ierr).InternalReason("bad log entry data").Err()
+		}
+		if count == 0 {
+			// No progress was made, so the rest of the stream is not available.
+			break
+		}
+	}
+	m.flush()
+
+	if len(m.matches) == 0 {
+		return nil, size, nil
+	}
+	return &logdog.SearchResponse_Stream{
+		Path:      string(ls.Path()),
+		Matches:   m.matches,
+		Truncated: m.truncated,
+	}, size, nil
+}
+
+// lineMatcher matches the lines of a text log stream against a pattern,
+// collecting matches along with their context lines.
+type lineMatcher struct {
+	re         *regexp.Regexp
+	context    int
+	maxMatches int
+
+	matches   []*logdog.SearchResponse_Match
+	truncated bool
+
+	// before holds the last context lines.
+	before []*logdog.SearchResponse_Line
+	// pending is the set of matches that are still missing context lines after
+	// them.
+	pending []*logdog.SearchResponse_Match
+
+	// partial is the line being assembled from partial lines, which spans log
+	// entries.
+	partial *logdog.SearchResponse_Line
+}
+
+// done returns true if no more lines need to be looked at.
+func (m *lineMatcher) done() bool { return m.truncated && len(m.pending) == 0 }
+
+// addEntry adds the lines of a log entry.
+//
+// A line without a delimiter continues in the next line, and doesn't advance
+// the line index.
+func (m *lineMatcher) addEntry(le *logpb.LogEntry) {
+	text := le.GetText()
+	if text == nil {
+		return
+	}
+
+	index := le.Sequence
+	for _, l := range text.Lines {
+		if m.partial == nil {
+			m.partial = &logdog.SearchResponse_Line{Index: index, StreamIndex: le.StreamIndex}
+		}
+		m.partial.Value += l.Value
+		if l.Delimiter == "" {
+			continue
+		}
+
+		m.addLine(m.partial)
+		m.partial = nil
+		index++
+	}
+}
+
+// flush adds the last line, if it had no delimiter.
+func (m *lineMatcher) flush() {
+	if m.partial != nil {
+		m.addLine(m.partial)
+		m.partial = nil
+	}
+}
+
+func (m *lineMatcher) addLine(l *logdog.SearchResponse_Line) {
+	if m.done() {
+		return
+	}
+
+	// Complete the context of the pending matches.
+	pending := m.pending[:0]
+	for _, pm := range m.pending {
+		pm.After = append(pm.After, l)
+		if len(pm.After) < m.context {
+			pending = append(pending, pm)
+		}
+	}
+	m.pending = pending
+
+	if !m.truncated && m.re.MatchString(l.Value) {
+		if len(m.matches) >= m.maxMatches {
+			m.truncated = true
+		} else {
+			match := &logdog.SearchResponse_Match{
+				Line:   l,
+				Before: append([]*logdog.SearchResponse_Line(nil), m.before...),
+			}
+			m.matches = append(m.matches, match)
+			if m.context > 0 {
+				m.pending = append(m.pending, match)
+			}
+		}
+	}
+
+	if m.context > 0 {
+		if len(m.before) == m.context {
+			m.before = append(m.before[:0], m.before[1:]...)
+		}
+		m.before = append(m.before, l)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	ct "github.com/luci/luci-go/logdog/appengine/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/logdog/common/archive"
+	"github.com/luci/luci-go/logdog/common/renderer"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"github.com/luci/gae/filter/featureBreaker"
+	ds "github.com/luci/gae/service/datastore"
+
+	"github.com/golang/protobuf/proto"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// searchMatches renders the matches of a search response as
+// "path:index:value" strings.
+func searchMatches(resp *logdog.SearchResponse) []string {
+	var matches []string
+	for _, s := range resp.Streams {
+		for _, m := range s.Matches {
+			matches = append(matches, fmt.Sprintf("%s:%d:%s", s.Path, m.Line.Index, m.Line.Value))
+		}
+	}
+	return matches
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install()
+		c, fb := featureBreaker.FilterRDS(c, nil)
+
+		ds.GetTestable(c).Consistent(true)
+
+		var svrBase server
+		svr := newService(&svrBase)
+
+		const project = cfgtypes.ProjectName("proj-foo")
+
+		// textEntries builds one log entry per element of entries, each holding
+		// the supplied lines. A line ending with "\" has no delimiter.
+		textEntries := func(entries ...[]string) []*logpb.LogEntry {
+			var les []*logpb.LogEntry
+			seq := uint64(0)
+			for i, lines := range entries {
+				le := logpb.LogEntry{
+					StreamIndex: uint64(i),
+					Sequence:    seq,
+				}
+				text := logpb.Text{}
+				for _, l := range lines {
+					line := logpb.Text_Line{Value: l, Delimiter: "\n"}
+					if n := len(l); n > 0 && l[n-1] == '\\' {
+						line.Value, line.Delimiter = l[:n-1], ""
+					} else {
+						seq++
+					}
+					text.Lines = append(text.Lines, &line)
+				}
+				le.Content = &logpb.LogEntry_Text{&text}
+				les = append(les, &le)
+			}
+			return les
+		}
+
+		// addStream registers a log stream with the supplied log entries. If
+		// terminate is true, the stream is terminated. If archived is also true,
+		// the stream is archived instead of being held in intermediate storage.
+		addStream := func(path types.StreamPath, terminate, archived bool, entries []*logpb.LogEntry) *ct.TestStream {
+			tls := ct.MakeStream(c, project, path)
+
+			now := env.Clock.Now().UTC()
+			if terminate {
+				tls.State.TerminalIndex = int64(len(entries) - 1)
+				tls.State.TerminatedTime = now
+			}
+
+			if archived {
+				src := renderer.StaticSource(entries)
+				var lbuf, ibuf bytes.Buffer
+				m := archive.Manifest{
+					Desc:        tls.Desc,
+					Source:      &src,
+					LogWriter:   &lbuf,
+					IndexWriter: &ibuf,
+				}
+				if err := archive.Archive(m); err != nil {
+					panic(err)
+				}
+
+				base := "gs://testbucket/" + tls.Stream.Name
+				env.GSClient.Put(gs.Path(base+"/stream"), lbuf.Bytes())
+				env.GSClient.Put(gs.Path(base+"/index"), ibuf.Bytes())
+				tls.State.ArchivedTime = now
+				tls.State.ArchiveStreamURL = base + "/stream"
+				tls.State.ArchiveIndexURL = base + "/index"
+				So(tls.State.ArchivalState().Archived(), ShouldBeTrue)
+			} else {
+				for _, le := range entries {
+					d, err := proto.Marshal(le)
+					if err != nil {
+						panic(err)
+					}
+					err = env.BigTable.Put(storage.PutRequest{
+						Project: project,
+						Path:    tls.Path,
+						Index:   types.MessageIndex(le.StreamIndex),
+						Values:  [][]byte{d},
+					})
+					if err != nil {
+						panic(err)
+					}
+				}
+			}
+
+			if err := tls.Put(c); err != nil {
+				panic(err)
+			}
+			env.Clock.Add(time.Second)
+			return tls
+		}
+
+		addStream("testing/+/terminated", true, false, textEntries(
+			[]string{"ok", "FAILED: step 1"},
+			[]string{"ok", "ok", "failed: step 2"},
+		))
+		addStream("testing/+/archived", true, true, textEntries(
+			[]string{"starting", "FAI\\"},
+			[]string{"LED: step 3", "done"},
+		))
+		addStream("testing/+/streaming", false, false, textEntries(
+			[]string{"FAILED: still streaming"},
+		))
+		addStream("other/+/terminated", true, false, textEntries(
+			[]string{"FAILED: elsewhere"},
+		))
+		purged := addStream("testing/+/purged", true, false, textEntries(
+			[]string{"FAILED: purged"},
+		))
+		purged.Stream.Purged = true
+		if err := purged.Put(c); err != nil {
+			panic(err)
+		}
+		ds.GetTestable(c).CatchupIndexes()
+
+		req := logdog.SearchRequest{
+			Project: string(project),
+			Path:    "testing/**",
+			Pattern: "FAILED",
+		}
+
+		Convey(`Will find literal matches in terminated and archived streams.`, func() {
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(searchMatches(resp), ShouldResemble, []string{
+				"testing/+/archived:1:FAILED: step 3",
+				"testing/+/terminated:1:FAILED: step 1",
+			})
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Will ignore case if requested.`, func() {
+			req.Path = "testing/+/terminated"
+			req.IgnoreCase = true
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(searchMatches(resp), ShouldResemble, []string{
+				"testing/+/terminated:1:FAILED: step 1",
+				"testing/+/terminated:4:failed: step 2",
+			})
+		})
+
+		Convey(`Will match regular expressions.`, func() {
+			req.Pattern = `step [23]$`
+			req.Regex = true
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(searchMatches(resp), ShouldResemble, []string{
+				"testing/+/archived:1:FAILED: step 3",
+				"testing/+/terminated:4:failed: step 2",
+			})
+		})
+
+		Convey(`Will return context lines.`, func() {
+			req.Path = "testing/+/terminated"
+			req.ContextLines = 2
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Streams, ShouldHaveLength, 1)
+			So(resp.Streams[0].Matches, ShouldResemble, []*logdog.SearchResponse_Match{
+				{
+					Line: &logdog.SearchResponse_Line{Index: 1, StreamIndex: 0, Value: "FAILED: step 1"},
+					Before: []*logdog.SearchResponse_Line{
+						{Index: 0, StreamIndex: 0, Value: "ok"},
+					},
+					After: []*logdog.SearchResponse_Line{
+						{Index: 2, StreamIndex: 1, Value: "ok"},
+						{Index: 3, StreamIndex: 1, Value: "ok"},
+					},
+				},
+			})
+		})
+
+		Convey(`Will truncate the matches of a stream.`, func() {
+			req.Path = "testing/+/terminated"
+			req.Pattern = "ok"
+			req.MaxMatches = 2
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(searchMatches(resp), ShouldResemble, []string{
+				"testing/+/terminated:0:ok",
+				"testing/+/terminated:2:ok",
+			})
+			So(resp.Streams[0].Truncated, ShouldBeTrue)
+		})
+
+		Convey(`Will search purged streams if the user is an administrator.`, func() {
+			env.JoinGroup("admin")
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(searchMatches(resp), ShouldResemble, []string{
+				"testing/+/purged:0:FAILED: purged",
+				"testing/+/archived:1:FAILED: step 3",
+				"testing/+/terminated:1:FAILED: step 1",
+			})
+		})
+
+		Convey(`With a stream limit of 1, can iteratively search all streams.`, func() {
+			svrBase.resultLimit = 1
+
+			var matches []string
+			for {
+				resp, err := svr.Search(c, &req)
+				So(err, ShouldBeRPCOK)
+				matches = append(matches, searchMatches(resp)...)
+
+				if resp.Next == "" {
+					break
+				}
+				req.Next = resp.Next
+			}
+			So(matches, ShouldResemble, []string{
+				"testing/+/archived:1:FAILED: step 3",
+				"testing/+/terminated:1:FAILED: step 1",
+			})
+		})
+
+		Convey(`Will fail with InvalidArgument if the pattern is empty.`, func() {
+			req.Pattern = ""
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "a search `pattern` is required")
+		})
+
+		Convey(`Will fail with InvalidArgument if the regular expression is invalid.`, func() {
+			req.Pattern = "("
+			req.Regex = true
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid search `pattern`")
+		})
+
+		Convey(`Will fail with InvalidArgument if too many context lines are requested.`, func() {
+			req.ContextLines = searchContextLimit + 1
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument)
+		})
+
+		Convey(`Will fail with InvalidArgument if the path is invalid.`, func() {
+			req.Path = "***"
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid search `path`")
+		})
+
+		Convey(`Will fail with Unauthenticated if the user can't access the project.`, func() {
+			req.Project = "proj-exclusive"
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCUnauthenticated)
+		})
+
+		Convey(`A datastore query error will return InternalServer error.`, func() {
+			fb.BreakFeatures(errors.New("testing error"), "Run")
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInternal)
+		})
+	})
+}
@@ -18,6 +18,17 @@ func buildLogStreamState(ls *coordinator.LogStream, lst *coordinator.LogStreamSt
 		Created:       google.NewTimestamp(ls.Created),
 		TerminalIndex: lst.TerminalIndex,
 		Purged:        ls.Purged,
+
+		QuotaDroppedBytes: uint64(lst.QuotaDroppedBytes),
+		RateDroppedBytes:  uint64(lst.RateDroppedBytes),
+	}
+
+	if ls.DataPurged {
+		lss.Tombstone = &logdog.LogStreamState_Tombstone{
+			Purged: google.NewTimestamp(ls.PurgedTime),
+			Reason: ls.PurgeReason,
+		}
+		return &lss
 	}
 
 	if ast := lst.ArchivalState(); ast.Archived() {
@@ -39,22 +39,33 @@ func (b *server) ArchiveStream(c context.Context, req *logdog.ArchiveStreamReque
 		return nil, grpcutil.Errf(codes.InvalidArgument, "missing required stream archive URL")
 	}
 
+	ls := &coordinator.LogStream{ID: id}
 	lst := coordinator.NewLogStreamState(c, id)
 
 	// Post the archival results to the Coordinator.
 	now := clock.Now(c).UTC()
 	var ierr error
+	purged := false
 	err := ds.RunInTransaction(c, func(c context.Context) error {
 		ierr = nil
+		purged = false
 
 		// Note that within this transaction, we have two return values:
 		// - Non-nil to abort the transaction.
 		// - Specific error via "ierr".
-		if err := ds.Get(c, lst); err != nil {
+		if err := ds.Get(c, ls, lst); err != nil {
 			return err
 		}
 
 		switch as := lst.ArchivalState(); {
+		case ls.Purged || ls.DataPurged:
+			// The log stream was purged while it was being archived. Its archived
+			// data must not be recorded.
+			log.Warningf(c, "Log stream is purged. Refusing archival.")
+			purged = true
+			ierr = grpcutil.Errf(codes.FailedPrecondition, "Log stream is purged.")
+			return ierr
+
 		case as.Archived():
 			// Return nil if the log stream is already archived (idempotent).
 			log.Warningf(c, "Log stream is already archived.")
@@ -110,6 +121,13 @@ func (b *server) ArchiveStream(c context.Context, req *logdog.ArchiveStreamReque
 		log.Infof(c, "Successfully marked stream as archived.")
 		return nil
 	}, nil)
+	if purged {
+		// Delete the purged log stream's newly-archived objects.
+		if err := coordinator.DeleteArchiveObjects(c, req.IndexUrl, req.StreamUrl, req.DataUrl); err != nil {
+			log.WithError(err).Errorf(c, "Failed to delete purged log stream's archive objects.")
+			return nil, grpcutil.Internal
+		}
+	}
 	if ierr != nil {
 		log.WithError(ierr).Errorf(c, "Failed to mark stream as archived.")
 		return nil, ierr
@@ -161,6 +161,31 @@ func TestArchiveStream(t *testing.T) {
 				So(tls.State.ArchiveLogEntryCount, ShouldEqual, 0)
 			})
 
+			Convey(`If the stream is purged during archival, will refuse and delete the archive.`, func() {
+				env.GSClient.Put("gs://fake.stream", []byte("stream"))
+				env.GSClient.Put("gs://fake.index", []byte("index"))
+				env.GSClient.Put("gs://fake.data", []byte("data"))
+
+				tls.Stream.Purged = true
+				tls.Stream.DataPurged = true
+				So(tls.Put(c), ShouldBeNil)
+
+				_, err := svr.ArchiveStream(c, req)
+				So(err, ShouldBeRPCFailedPrecondition, "Log stream is purged.")
+
+				So(tls.Get(c), ShouldBeNil)
+				So(tls.State.ArchivalState(), ShouldEqual, coordinator.ArchiveTasked)
+				So(tls.State.ArchiveStreamURL, ShouldEqual, "")
+				So(env.GSClient, ShouldBeEmpty)
+
+				Convey(`If the archive could not be deleted, returns internal error.`, func() {
+					env.GSClient["error"] = []byte("test error")
+
+					_, err := svr.ArchiveStream(c, req)
+					So(err, ShouldBeRPCInternal)
+				})
+			})
+
 			Convey(`When datastore Get fails, returns internal error.`, func() {
 				c, fb := featureBreaker.FilterRDS(c, nil)
 				fb.BreakFeatures(errors.New("test error"), "GetMulti")
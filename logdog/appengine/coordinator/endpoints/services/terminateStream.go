@@ -30,6 +30,8 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 		"project":       req.Project,
 		"id":            req.Id,
 		"terminalIndex": req.TerminalIndex,
+		"quotaDropped":  req.QuotaDroppedBytes,
+		"rateDropped":   req.RateDroppedBytes,
 	}.Infof(c, "Request to terminate log stream.")
 
 	if req.TerminalIndex < 0 {
@@ -98,6 +100,8 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 			lst.Updated = now
 			lst.TerminalIndex = req.TerminalIndex
 			lst.TerminatedTime = now
+			lst.QuotaDroppedBytes = int64(req.QuotaDroppedBytes)
+			lst.RateDroppedBytes = int64(req.RateDroppedBytes)
 
 			if err := ds.Put(c, lst); err != nil {
 				log.Fields{
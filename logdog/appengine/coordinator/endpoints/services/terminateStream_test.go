@@ -51,6 +51,9 @@ func TestTerminateStream(t *testing.T) {
 			Id:            string(tls.Stream.ID),
 			Secret:        tls.Prefix.Secret,
 			TerminalIndex: 1337,
+
+			QuotaDroppedBytes: 10,
+			RateDroppedBytes:  20,
 		}
 
 		Convey(`Returns Forbidden error if not a service.`, func() {
@@ -91,6 +94,8 @@ func TestTerminateStream(t *testing.T) {
 						So(ds.Get(c, tls.State), ShouldBeNil)
 					})
 					So(tls.State.TerminalIndex, ShouldEqual, 1337)
+					So(tls.State.QuotaDroppedBytes, ShouldEqual, 10)
+					So(tls.State.RateDroppedBytes, ShouldEqual, 20)
 					So(tls.State.Terminated(), ShouldBeTrue)
 					So(tls.State.ArchivalState(), ShouldEqual, coordinator.NotArchived)
 
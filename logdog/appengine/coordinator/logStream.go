@@ -81,6 +81,14 @@ type LogStream struct {
 	Purged bool
 	// PurgedTime is the time when this stream was purged.
 	PurgedTime time.Time `gae:",noindex"`
+	// DataPurged, if true, indicates that this log stream's log data has been
+	// deleted. The LogStream remains as the stream's tombstone.
+	//
+	// A log stream whose data has been purged is also Purged.
+	DataPurged bool
+	// PurgeReason is the reason given for the purge that deleted this log
+	// stream's log data.
+	PurgeReason string `gae:",noindex"`
 
 	// ProtoVersion is the version string of the protobuf, as reported by the
 	// Collector (and ultimately self-identified by the Butler).
@@ -84,6 +84,15 @@ type LogStreamState struct {
 	// archived with no log entries.
 	TerminalIndex int64 `gae:",noindex"`
 
+	// QuotaDroppedBytes is the number of bytes of stream data that the Butler
+	// discarded because the log stream exceeded a byte quota. It is reported
+	// when the log stream is terminated.
+	QuotaDroppedBytes int64 `gae:",noindex"`
+	// RateDroppedBytes is the number of bytes of stream data that the Butler
+	// discarded because the log stream exceeded a rate limit. It is reported
+	// when the log stream is terminated.
+	RateDroppedBytes int64 `gae:",noindex"`
+
 	// ArchivedTime is the Coordinator's record of when this log stream was
 	// archived. If this is non-zero, it means that the log entry has been
 	// archived.
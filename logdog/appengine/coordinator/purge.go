@@ -0,0 +1,327 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"fmt"
+	"time"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	"golang.org/x/net/context"
+)
+
+// LogStreamPurge is an audit record of a log stream whose log data was purged.
+//
+// It is written to the log stream's project namespace.
+type LogStreamPurge struct {
+	// ID is the datastore-assigned ID of this record.
+	ID int64 `gae:"$id"`
+
+	// Path is the path of the purged log stream.
+	Path string
+	// Created is the time when the log stream was purged.
+	Created time.Time
+	// Requester is the identity that requested the purge.
+	Requester string
+	// Reason is the reason given for the purge.
+	Reason string `gae:",noindex"`
+
+	// extra causes datastore to ignore unrecognized fields and strip them in
+	// future writes.
+	extra ds.PropertyMap `gae:"-,extra"`
+}
+
+// PurgeLogStream deletes the log data of the log stream with the supplied ID
+// in the current project, leaving its LogStream as a tombstone.
+//
+// The stream's intermediate storage rows and archived Google Storage objects
+// are deleted, and a LogStreamPurge audit record is written. Purging a stream
+// whose data was already purged does nothing.
+//
+// If the log stream does not exist, ds.ErrNoSuchEntity will be returned.
+func PurgeLogStream(c context.Context, id HashID, requester identity.Identity, reason string) error {
+	ls := &LogStream{ID: id}
+	lst := ls.State(c)
+
+	// Mark the stream as purged before deleting its data. This stops the
+	// Collector and Archivist from adding to it, and hides it from
+	// non-administrative users.
+	err := ds.RunInTransaction(c, func(c context.Context) error {
+		if err := ds.Get(c, ls, lst); err != nil {
+			return err
+		}
+		if ls.Purged {
+			return nil
+		}
+
+		ls.Purged = true
+		ls.PurgedTime = clock.Now(c).UTC()
+		return ds.Put(c, ls)
+	}, nil)
+	if err != nil {
+		if errors.Filter(err, ds.ErrNoSuchEntity) == nil {
+			return ds.ErrNoSuchEntity
+		}
+		return errors.Annotate(err).Reason("failed to mark log stream as purged").Err()
+	}
+	if ls.DataPurged {
+		log.Fields{
+			"id":   id,
+			"path": ls.Path(),
+		}.Infof(c, "Log stream data is already purged.")
+		return nil
+	}
+
+	log.Fields{
+		"id":        id,
+		"path":      ls.Path(),
+		"requester": requester,
+		"reason":    reason,
+	}.Infof(c, "Purging log stream data.")
+
+	if err := purgeLogStreamData(c, ls, lst); err != nil {
+		return err
+	}
+
+	// Record the purge in the tombstone, and forget about the archive objects
+	// that we just deleted.
+	now := clock.Now(c).UTC()
+	err = ds.RunInTransaction(c, func(c context.Context) error {
+		if err := ds.Get(c, ls, lst); err != nil {
+			return err
+		}
+
+		ls.DataPurged = true
+		ls.PurgeReason = reason
+
+		lst.Updated = now
+		lst.ArchiveIndexURL, lst.ArchiveIndexSize = "", 0
+		lst.ArchiveStreamURL, lst.ArchiveStreamSize = "", 0
+		lst.ArchiveDataURL, lst.ArchiveDataSize = "", 0
+		return ds.Put(c, ls, lst)
+	}, nil)
+	if err != nil {
+		return errors.Annotate(err).Reason("failed to record log stream tombstone").Err()
+	}
+
+	audit := LogStreamPurge{
+		Path:      string(ls.Path()),
+		Created:   now,
+		Requester: string(requester),
+		Reason:    reason,
+	}
+	if err := ds.Put(c, &audit); err != nil {
+		// The purge has already happened, and its tombstone records it.
+		log.WithError(err).Errorf(c, "Failed to write purge audit record.")
+		return errors.Annotate(err).Reason("failed to write purge audit record").Err()
+	}
+	return nil
+}
+
+// purgeLogStreamData deletes the log stream's data from intermediate storage
+// and from Google Storage.
+func purgeLogStreamData(c context.Context, ls *LogStream, lst *LogStreamState) error {
+	svc := GetServices(c)
+
+	st, err := svc.IntermediateStorage(c)
+	if err != nil {
+		return errors.Annotate(err).Reason("failed to create intermediate storage").Err()
+	}
+	defer st.Close()
+
+	if err := st.Purge(Project(c), ls.Path()); err != nil {
+		return errors.Annotate(err).Reason("failed to purge intermediate storage").Err()
+	}
+
+	return DeleteArchiveObjects(c, lst.ArchiveIndexURL, lst.ArchiveStreamURL, lst.ArchiveDataURL)
+}
+
+// DeleteArchiveObjects deletes the supplied archived Google Storage objects.
+// Empty URLs are ignored.
+func DeleteArchiveObjects(c context.Context, urls ...string) error {
+	var gsClient gs.Client
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+		if gsClient == nil {
+			var err error
+			if gsClient, err = GetServices(c).GSClient(c); err != nil {
+				return errors.Annotate(err).Reason("failed to create Google Storage client").Err()
+			}
+			defer gsClient.Close()
+		}
+		if err := gsClient.Delete(gs.Path(u)); err != nil {
+			return errors.Annotate(err).Reason("failed to delete archive object %(url)q").
+				D("url", u).Err()
+		}
+	}
+	return nil
+}
+
+// PurgeLogStreamsWithPrefix purges the log data of each log stream in the
+// current project whose prefix is the supplied prefix. See PurgeLogStream.
+//
+// The paths of the purged log streams are returned. If an error is
+// encountered, the paths of the log streams purged before it are returned
+// along with it.
+func PurgeLogStreamsWithPrefix(c context.Context, prefix types.StreamName, requester identity.Identity,
+	reason string) ([]types.StreamPath, error) {
+
+	q := ds.NewQuery("LogStream").Eq("Prefix", string(prefix))
+
+	var streams []*LogStream
+	if err := ds.GetAll(c, q, &streams); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to query log streams").Err()
+	}
+
+	var purged []types.StreamPath
+	for _, ls := range streams {
+		if ls.DataPurged {
+			continue
+		}
+		if err := PurgeLogStream(c, ls.ID, requester, reason); err != nil {
+			return purged, errors.Annotate(err).Reason("failed to purge log stream %(path)q").
+				D("path", ls.Path()).Err()
+		}
+		purged = append(purged, ls.Path())
+	}
+	return purged, nil
+}
+
+// PurgeExpiredLogStreams purges the log data of up to limit log streams in
+// the current project that were created longer than retention ago. See
+// PurgeLogStream.
+//
+// Log streams written before the DataPurged property existed are only found
+// once BackfillDataPurged has visited them.
+//
+// The paths of the purged log streams are returned.
+func PurgeExpiredLogStreams(c context.Context, retention time.Duration, limit int32,
+	requester identity.Identity) ([]types.StreamPath, error) {
+
+	cutoff := clock.Now(c).UTC().Add(-retention)
+	q := ds.NewQuery("LogStream").Eq("DataPurged", false).Lt("Created", cutoff).Order("-Created").
+		KeysOnly(true).Limit(limit)
+
+	var keys []*ds.Key
+	if err := ds.GetAll(c, q, &keys); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to query expired log streams").Err()
+	}
+
+	reason := fmt.Sprintf("project retention period (%s) expired", retention)
+	var purged []types.StreamPath
+	for _, k := range keys {
+		id := HashID(k.StringID())
+		if err := PurgeLogStream(c, id, requester, reason); err != nil {
+			return purged, errors.Annotate(err).Reason("failed to purge log stream %(id)q").
+				D("id", id).Err()
+		}
+
+		// Load the purged stream's path for the caller.
+		ls := LogStream{ID: id}
+		if err := ds.Get(c, &ls); err != nil {
+			return purged, errors.Annotate(err).Reason("failed to load purged log stream").Err()
+		}
+		purged = append(purged, ls.Path())
+	}
+	return purged, nil
+}
+
+// dataPurgedBackfill records the progress of BackfillDataPurged in a project.
+//
+// There is a single dataPurgedBackfill in each project namespace.
+type dataPurgedBackfill struct {
+	_kind string `gae:"$kind,DataPurgedBackfill"`
+	// ID is always dataPurgedBackfillID.
+	ID string `gae:"$id"`
+
+	// Cursor is the LogStream query cursor to resume the backfill from.
+	Cursor string `gae:",noindex"`
+	// Done is true once every log stream has been backfilled.
+	Done bool `gae:",noindex"`
+
+	// extra causes datastore to ignore unrecognized fields and strip them in
+	// future writes.
+	extra ds.PropertyMap `gae:"-,extra"`
+}
+
+const dataPurgedBackfillID = "DataPurged"
+
+// BackfillDataPurged rewrites up to limit log streams in the current project,
+// so that the ones written before the DataPurged property existed get it and
+// can be found by PurgeExpiredLogStreams.
+//
+// Each call resumes where the previous one stopped. It returns true once every
+// log stream has been rewritten, after which it does nothing.
+func BackfillDataPurged(c context.Context, limit int32) (bool, error) {
+	bf := dataPurgedBackfill{ID: dataPurgedBackfillID}
+	switch err := ds.Get(c, &bf); err {
+	case nil, ds.ErrNoSuchEntity:
+	default:
+		return false, errors.Annotate(err).Reason("failed to load backfill state").Err()
+	}
+	if bf.Done {
+		return true, nil
+	}
+
+	q := ds.NewQuery("LogStream").KeysOnly(true).Limit(limit)
+	if bf.Cursor != "" {
+		cursor, err := ds.DecodeCursor(c, bf.Cursor)
+		if err != nil {
+			return false, errors.Annotate(err).Reason("failed to decode backfill cursor").Err()
+		}
+		q = q.Start(cursor)
+	}
+
+	var ids []HashID
+	var cursor ds.Cursor
+	err := ds.Run(c, q, func(k *ds.Key, cb ds.CursorCB) error {
+		ids = append(ids, HashID(k.StringID()))
+		if int32(len(ids)) < limit {
+			return nil
+		}
+		var err error
+		cursor, err = cb()
+		return err
+	})
+	if err != nil {
+		return false, errors.Annotate(err).Reason("failed to query log streams").Err()
+	}
+
+	for _, id := range ids {
+		// Rewrite the log stream transactionally, so that concurrent changes to
+		// it (e.g. a purge) aren't lost.
+		err := ds.RunInTransaction(c, func(c context.Context) error {
+			ls := LogStream{ID: id}
+			if err := ds.Get(c, &ls); err != nil {
+				return err
+			}
+			return ds.Put(c, &ls)
+		}, nil)
+		if err != nil {
+			return false, errors.Annotate(err).Reason("failed to backfill log stream %(id)q").
+				D("id", id).Err()
+		}
+	}
+
+	// If the query returned fewer than limit log streams, it is exhausted.
+	if cursor == nil {
+		bf.Done = true
+	} else {
+		bf.Cursor = cursor.String()
+	}
+	if err := ds.Put(c, &bf); err != nil {
+		return false, errors.Annotate(err).Reason("failed to save backfill state").Err()
+	}
+	return bf.Done, nil
+}
@@ -81,6 +81,17 @@ type Services interface {
 	// The caller must close the returned instance if successful.
 	StorageForStream(context.Context, *LogStreamState) (Storage, error)
 
+	// IntermediateStorage returns a Storage instance bound to the intermediate
+	// storage, regardless of any log stream's archival state.
+	//
+	// The caller must close the returned instance if successful.
+	IntermediateStorage(context.Context) (Storage, error)
+
+	// GSClient returns a Google Storage client with read/write access.
+	//
+	// The caller must close the returned instance if successful.
+	GSClient(context.Context) (gs.Client, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	ArchivalPublisher(context.Context) (ArchivalPublisher, error)
 }
@@ -214,6 +225,10 @@ func (s *prodServicesInst) StorageForStream(c context.Context, lst *LogStreamSta
 	return s.newGoogleStorage(c, gs.Path(lst.ArchiveIndexURL), gs.Path(lst.ArchiveStreamURL))
 }
 
+func (s *prodServicesInst) IntermediateStorage(c context.Context) (Storage, error) {
+	return s.newBigTableStorage(c)
+}
+
 func (s *prodServicesInst) newBigTableStorage(c context.Context) (Storage, error) {
 	cfg, err := s.Config(c)
 	if err != nil {
@@ -317,10 +332,28 @@ func (s *prodServicesInst) newGoogleStorage(c context.Context, index, stream gs.
 	return rv, nil
 }
 
+func (s *prodServicesInst) GSClient(c context.Context) (gs.Client, error) {
+	return s.newProdGSClient(c, gs.ReadWriteScopes)
+}
+
 func (s *prodServicesInst) newGSClient(c context.Context) (gs.Client, error) {
+	prodClient, err := s.newProdGSClient(c, gs.ReadOnlyScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the GS client in a limiter. This prevents large requests from
+	// exceeding the urlfetch threshold.
+	return &gs.LimitedClient{
+		Client:       prodClient,
+		MaxReadBytes: maxGSFetchSize,
+	}, nil
+}
+
+func (s *prodServicesInst) newProdGSClient(c context.Context, scopes []string) (gs.Client, error) {
 	// Get an Authenticator bound to the token scopes that we need for
 	// authenticated Cloud Storage access.
-	transport, err := auth.GetRPCTransport(c, auth.AsSelf, auth.WithScopes(gs.ReadOnlyScopes...))
+	transport, err := auth.GetRPCTransport(c, auth.AsSelf, auth.WithScopes(scopes...))
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to create Cloud Storage transport.")
 		return nil, errors.New("failed to create Cloud Storage transport")
@@ -330,13 +363,7 @@ func (s *prodServicesInst) newGSClient(c context.Context) (gs.Client, error) {
 		log.WithError(err).Errorf(c, "Failed to create GS client.")
 		return nil, err
 	}
-
-	// Wrap the GS client in a limiter. This prevents large requests from
-	// exceeding the urlfetch threshold.
-	return &gs.LimitedClient{
-		Client:       prodClient,
-		MaxReadBytes: maxGSFetchSize,
-	}, nil
+	return prodClient, nil
 }
 
 func (s *prodServicesInst) ArchivalPublisher(c context.Context) (ArchivalPublisher, error) {
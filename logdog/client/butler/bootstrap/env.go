@@ -6,8 +6,11 @@
 package bootstrap
 
 import (
+	"encoding/hex"
+
 	"github.com/luci/luci-go/common/system/environ"
 	"github.com/luci/luci-go/logdog/client/butlerlib/bootstrap"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
 )
@@ -27,6 +30,9 @@ type Environment struct {
 	// StreamServerURI is the streamserver URI. If not empty, this will be
 	// exported to subprocesses.
 	StreamServerURI string
+	// StreamServerCredentials are the credentials required by the stream server
+	// at StreamServerURI. If not nil, these will be exported to subprocesses.
+	StreamServerCredentials *streamproto.Credentials
 }
 
 // Augment augments the supplied base environment with LogDog Butler bootstrap
@@ -42,4 +48,8 @@ func (e *Environment) Augment(base environ.Env) {
 	exportIf(bootstrap.EnvStreamPrefix, string(e.Prefix))
 	exportIf(bootstrap.EnvStreamProject, string(e.Project))
 	exportIf(bootstrap.EnvStreamServerPath, e.StreamServerURI)
+	if creds := e.StreamServerCredentials; creds != nil {
+		exportIf(bootstrap.EnvStreamServerToken, hex.EncodeToString(creds.Token))
+		exportIf(bootstrap.EnvStreamServerCertFingerprint, creds.CertFingerprint)
+	}
 }
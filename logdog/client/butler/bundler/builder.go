@@ -86,6 +86,21 @@ func (b *builder) setStreamTerminal(template *logpb.ButlerLogBundle_Entry, tidx
 		(sizeOfTerminalIndexTag + varintLength(bs.TerminalIndex)))
 }
 
+// setStreamDropped records the number of bytes that a terminal stream
+// discarded because of its byte and rate limits.
+func (b *builder) setStreamDropped(template *logpb.ButlerLogBundle_Entry, quota, rate uint64) {
+	bs := b.getCreateBuilderStream(template)
+
+	// Pay the cost of the additional dropped fields.
+	if quota > 0 && bs.QuotaDroppedBytes == 0 {
+		bs.size += sizeOfQuotaDroppedBytesTag + varintLength(quota)
+	}
+	if rate > 0 && bs.RateDroppedBytes == 0 {
+		bs.size += sizeOfRateDroppedBytesTag + varintLength(rate)
+	}
+	bs.QuotaDroppedBytes, bs.RateDroppedBytes = quota, rate
+}
+
 func (b *builder) bundle() *logpb.ButlerLogBundle {
 	bundle := b.template
 
@@ -46,6 +46,13 @@ type Config struct {
 	// bundled data. Other factors can cause the bundle to be sent before this,
 	// but it is an upper bound.
 	MaxBufferDelay time.Duration
+
+	// StreamLimits are the Limits applied to each stream. A stream may request
+	// stricter limits through its Properties.
+	StreamLimits Limits
+	// GlobalLimits are the Limits applied to the Bundler's streams
+	// collectively.
+	GlobalLimits Limits
 }
 
 type bundlerStream interface {
@@ -77,6 +84,9 @@ type Bundler struct {
 
 	// prefixCounter is a global counter for Prefix-wide streams.
 	prefixCounter counter
+
+	// limits is the state used to enforce stream Limits.
+	limits limitState
 }
 
 // New instantiates a new Bundler instance.
@@ -88,6 +98,7 @@ func New(c Config) *Bundler {
 		streams:   map[string]bundlerStream{},
 	}
 	b.streamsCond = cancelcond.New(&b.streamsLock)
+	b.limits.global = newLimiter(c.GlobalLimits)
 
 	go b.makeBundles()
 	return &b
@@ -122,6 +133,16 @@ func (b *Bundler) Register(p *streamproto.Properties) (Stream, error) {
 		},
 	}
 
+	// Datagram streams are exempt from Limits, since discarding part of their
+	// data would corrupt their framing.
+	if p.StreamType != logpb.StreamType_DATAGRAM {
+		limits := b.c.StreamLimits.min(Limits{ByteLimit: p.ByteLimit, RateLimit: p.RateLimit})
+		if !(limits.isZero() && b.c.GlobalLimits.isZero()) {
+			c.limiter = newLimiter(limits)
+			c.limits = &b.limits
+		}
+	}
+
 	err := error(nil)
 	c.parser, err = newParser(p, &b.prefixCounter)
 	if err != nil {
@@ -163,6 +184,13 @@ func (b *Bundler) GetStreamDescs() map[string]*logpb.LogStreamDescriptor {
 	return streams
 }
 
+// LimitStats returns the Bundler's current LimitStats.
+func (b *Bundler) LimitStats() LimitStats {
+	b.limits.Lock()
+	defer b.limits.Unlock()
+	return b.limits.stats
+}
+
 // CloseAndFlush closes the Bundler, alerting it that no more streams will be
 // added and that existing data may be aggressively output.
 //
@@ -30,6 +30,11 @@
 //   - Stream data may be added during the bundling process, and should be
 //     acknowledged if possible.
 //
+// Streams may be subject to byte and rate Limits, both individually and
+// collectively. Data that exceeds a limit is discarded: text streams note this
+// with a marker line, and each stream's terminal bundle entry records how many
+// bytes it discarded.
+//
 // When a Stream is finished, its Close method should be called. This alerts the
 // Stream that it will receive no more data, causing it to export a terminal
 // ButlerLogBundle and unregister from the Bundler.
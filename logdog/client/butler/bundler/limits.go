@@ -0,0 +1,238 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"sync"
+	"time"
+)
+
+// Limits is a set of limits on the amount of stream data that is accepted.
+//
+// Data that exceeds a limit is discarded. A zero Limits imposes no limits.
+type Limits struct {
+	// ByteLimit is the maximum number of bytes that will be accepted. Once it is
+	// reached, the stream is truncated and all further data is discarded.
+	//
+	// If <= 0, there is no byte limit.
+	ByteLimit int64
+
+	// RateLimit is the maximum rate, in bytes per second, at which data will be
+	// accepted. Data that arrives faster is discarded until the rate subsides.
+	//
+	// If <= 0, there is no rate limit.
+	RateLimit int64
+}
+
+func (l Limits) isZero() bool {
+	return l.ByteLimit <= 0 && l.RateLimit <= 0
+}
+
+// min returns the Limits composed of the stricter of each of l's and o's
+// limits.
+func (l Limits) min(o Limits) Limits {
+	return Limits{
+		ByteLimit: minLimit(l.ByteLimit, o.ByteLimit),
+		RateLimit: minLimit(l.RateLimit, o.RateLimit),
+	}
+}
+
+func minLimit(a, b int64) int64 {
+	switch {
+	case a <= 0:
+		return b
+	case b <= 0, a < b:
+		return a
+	default:
+		return b
+	}
+}
+
+// LimitStats counts the stream data that was discarded because of Limits.
+type LimitStats struct {
+	// TruncatedStreams is the number of streams that were truncated because
+	// they, or the Bundler, reached a byte limit.
+	TruncatedStreams int64
+	// QuotaDroppedBytes is the number of bytes that were discarded because of a
+	// byte limit.
+	QuotaDroppedBytes int64
+
+	// RateLimitEvents is the number of times that a stream began discarding data
+	// because it, or the Bundler, exceeded a rate limit.
+	RateLimitEvents int64
+	// RateDroppedBytes is the number of bytes that were discarded because of a
+	// rate limit.
+	RateDroppedBytes int64
+}
+
+// limiter enforces a single set of Limits.
+type limiter struct {
+	Limits
+
+	// used is the number of bytes that have been accepted.
+	used int64
+
+	// tokens is the number of bytes that the rate limit currently permits.
+	tokens float64
+	// last is the time when tokens was last refilled.
+	last time.Time
+
+	// truncated is true if the stream has reached a byte limit.
+	truncated bool
+	// rateDropping is the number of bytes that have been discarded because of a
+	// rate limit since data was last accepted.
+	rateDropping int64
+
+	// quotaDropped is the number of bytes discarded because of a byte limit.
+	quotaDropped int64
+	// rateDropped is the number of bytes discarded because of a rate limit.
+	rateDropped int64
+}
+
+func newLimiter(l Limits) *limiter {
+	lim := limiter{Limits: l}
+	lim.tokens = lim.burst()
+	return &lim
+}
+
+// burst is the maximum number of tokens that the rate limit can accumulate.
+//
+// It is at least a single data buffer, so that any Data can be accepted.
+func (l *limiter) burst() float64 {
+	if b := float64(l.RateLimit); b > float64(dataBufferSize) {
+		return b
+	}
+	return float64(dataBufferSize)
+}
+
+// quota returns the number of bytes, up to n, that the byte limit permits.
+func (l *limiter) quota(n int64) int64 {
+	if l.ByteLimit <= 0 {
+		return n
+	}
+	if remaining := l.ByteLimit - l.used; remaining < n {
+		if remaining < 0 {
+			return 0
+		}
+		return remaining
+	}
+	return n
+}
+
+// refill adds the rate limit tokens that accumulated between the last refill
+// and now.
+func (l *limiter) refill(now time.Time) {
+	if l.RateLimit <= 0 {
+		return
+	}
+
+	if !l.last.IsZero() {
+		elapsed := now.Sub(l.last)
+		if elapsed <= 0 {
+			return
+		}
+
+		l.tokens += elapsed.Seconds() * float64(l.RateLimit)
+		if burst := l.burst(); l.tokens > burst {
+			l.tokens = burst
+		}
+	}
+	l.last = now
+}
+
+// allowRate returns true if the rate limit permits n bytes.
+func (l *limiter) allowRate(n int64) bool {
+	return l.RateLimit <= 0 || l.tokens >= float64(n)
+}
+
+// take accounts for n accepted bytes.
+func (l *limiter) take(n int64) {
+	l.used += n
+	if l.RateLimit > 0 {
+		l.tokens -= float64(n)
+	}
+}
+
+// limitState is the Bundler-wide state used to enforce Limits.
+type limitState struct {
+	sync.Mutex
+
+	// global is the limiter for the Bundler's data as a whole.
+	global *limiter
+	// stats is the Bundler's aggregate LimitStats.
+	stats LimitStats
+}
+
+// limitResult is the result of a limitState acquire call.
+type limitResult struct {
+	// accepted is the number of bytes that were accepted.
+	accepted int64
+	// truncated is true if the stream was truncated by this acquisition.
+	truncated bool
+	// rateDropped is the number of bytes that were discarded because of a rate
+	// limit prior to this acquisition, if it ended a run of discards.
+	rateDropped int64
+}
+
+// acquire determines how many of n bytes, received by the stream whose limiter
+// is sl at time now, may be accepted and accounts for them.
+//
+// If not all of the bytes are accepted, the accepted bytes are the first bytes.
+func (ls *limitState) acquire(sl *limiter, now time.Time, n int64) (r limitResult) {
+	ls.Lock()
+	defer ls.Unlock()
+
+	if sl.truncated {
+		sl.quotaDropped += n
+		ls.stats.QuotaDroppedBytes += n
+		return
+	}
+
+	accepted := ls.global.quota(sl.quota(n))
+
+	sl.refill(now)
+	ls.global.refill(now)
+	if accepted > 0 && !(sl.allowRate(accepted) && ls.global.allowRate(accepted)) {
+		if sl.rateDropping == 0 {
+			ls.stats.RateLimitEvents++
+		}
+		sl.rateDropping += n
+		sl.rateDropped += n
+		ls.stats.RateDroppedBytes += n
+		return
+	}
+
+	r.accepted = accepted
+	r.rateDropped, sl.rateDropping = sl.rateDropping, 0
+	sl.take(accepted)
+	ls.global.take(accepted)
+
+	if dropped := n - accepted; dropped > 0 {
+		r.truncated = true
+		sl.truncated = true
+		sl.quotaDropped += dropped
+		ls.stats.QuotaDroppedBytes += dropped
+		ls.stats.TruncatedStreams++
+	}
+	return
+}
+
+// takeRateDropping returns the number of bytes that the stream whose limiter
+// is sl has discarded because of a rate limit since data was last accepted,
+// and resets it.
+func (ls *limitState) takeRateDropping(sl *limiter) (n int64) {
+	ls.Lock()
+	defer ls.Unlock()
+	n, sl.rateDropping = sl.rateDropping, 0
+	return
+}
+
+// dropped returns the number of bytes that the stream whose limiter is sl
+// discarded because of byte and rate limits, respectively.
+func (ls *limitState) dropped(sl *limiter) (quota, rate int64) {
+	ls.Lock()
+	defer ls.Unlock()
+	return sl.quotaDropped, sl.rateDropped
+}
@@ -0,0 +1,235 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Limits`, t, func() {
+		Convey(`Can compose the stricter of two Limits.`, func() {
+			So(Limits{}.min(Limits{ByteLimit: 10}), ShouldResemble, Limits{ByteLimit: 10})
+			So(Limits{ByteLimit: 5, RateLimit: 100}.min(Limits{ByteLimit: 10, RateLimit: 50}),
+				ShouldResemble, Limits{ByteLimit: 5, RateLimit: 50})
+		})
+	})
+
+	Convey(`A limitState`, t, func() {
+		now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+		ls := limitState{global: newLimiter(Limits{})}
+
+		Convey(`With a stream byte limit`, func() {
+			sl := newLimiter(Limits{ByteLimit: 10})
+
+			So(ls.acquire(sl, now, 6), ShouldResemble, limitResult{accepted: 6})
+
+			Convey(`Truncates the stream at the limit, and discards further data.`, func() {
+				So(ls.acquire(sl, now, 6), ShouldResemble, limitResult{accepted: 4, truncated: true})
+				So(ls.acquire(sl, now, 6), ShouldResemble, limitResult{})
+
+				quota, rate := ls.dropped(sl)
+				So(quota, ShouldEqual, 8)
+				So(rate, ShouldEqual, 0)
+				So(ls.stats, ShouldResemble, LimitStats{TruncatedStreams: 1, QuotaDroppedBytes: 8})
+			})
+
+			Convey(`Truncates the stream when data arrives after the limit is reached.`, func() {
+				So(ls.acquire(sl, now, 4), ShouldResemble, limitResult{accepted: 4})
+				So(ls.acquire(sl, now, 1), ShouldResemble, limitResult{truncated: true})
+			})
+		})
+
+		Convey(`With a global byte limit, truncates each stream that reaches it.`, func() {
+			ls.global = newLimiter(Limits{ByteLimit: 10})
+			a, b := newLimiter(Limits{}), newLimiter(Limits{})
+
+			So(ls.acquire(a, now, 8), ShouldResemble, limitResult{accepted: 8})
+			So(ls.acquire(b, now, 8), ShouldResemble, limitResult{accepted: 2, truncated: true})
+			So(ls.acquire(a, now, 8), ShouldResemble, limitResult{truncated: true})
+			So(ls.stats, ShouldResemble, LimitStats{TruncatedStreams: 2, QuotaDroppedBytes: 14})
+		})
+
+		Convey(`With a stream rate limit`, func() {
+			sl := newLimiter(Limits{RateLimit: int64(dataBufferSize)})
+
+			Convey(`Accepts a burst, then discards data until the rate subsides.`, func() {
+				So(ls.acquire(sl, now, int64(dataBufferSize)).accepted, ShouldEqual, dataBufferSize)
+				So(ls.acquire(sl, now, 100), ShouldResemble, limitResult{})
+				So(ls.acquire(sl, now.Add(time.Millisecond), 100), ShouldResemble, limitResult{})
+
+				// After a second, the full rate is available again. The discarded
+				// data is reported once.
+				now = now.Add(time.Second)
+				So(ls.acquire(sl, now, 100), ShouldResemble, limitResult{accepted: 100, rateDropped: 200})
+				So(ls.acquire(sl, now, 100), ShouldResemble, limitResult{accepted: 100})
+
+				quota, rate := ls.dropped(sl)
+				So(quota, ShouldEqual, 0)
+				So(rate, ShouldEqual, 200)
+				So(ls.stats, ShouldResemble, LimitStats{RateLimitEvents: 1, RateDroppedBytes: 200})
+			})
+
+			Convey(`Does not accumulate more than its burst.`, func() {
+				now = now.Add(time.Hour)
+				So(ls.acquire(sl, now, int64(dataBufferSize)).accepted, ShouldEqual, dataBufferSize)
+				So(ls.acquire(sl, now, 1).accepted, ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestBundlerLimits(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Bundler with stream limits`, t, func() {
+		tc := testclock.New(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC))
+		cfg := Config{
+			Clock:            tc,
+			Project:          "test",
+			Prefix:           "prefix",
+			MaxBufferedBytes: 1024,
+			MaxBundleSize:    1024,
+			StreamLimits:     Limits{ByteLimit: 16},
+		}
+
+		// The Bundler is created when the first stream is registered, so that
+		// cfg can be adjusted first.
+		var b *Bundler
+		register := func(name string, st logpb.StreamType, limits Limits) Stream {
+			if b == nil {
+				b = New(cfg)
+			}
+			s, err := b.Register(&streamproto.Properties{
+				LogStreamDescriptor: &logpb.LogStreamDescriptor{
+					Name:        name,
+					StreamType:  st,
+					ContentType: "application/octet-stream",
+					Timestamp:   google.NewTimestamp(tc.Now()),
+				},
+				ByteLimit: limits.ByteLimit,
+				RateLimit: limits.RateLimit,
+			})
+			So(err, ShouldBeNil)
+			return s
+		}
+
+		appendData := func(s Stream, v string) {
+			So(s.Append(data(tc.Now(), []byte(v)...)), ShouldBeNil)
+		}
+
+		// flush closes the Bundler and returns the bundle entries that it emitted
+		// for each stream.
+		flush := func() map[string][]*logpb.ButlerLogBundle_Entry {
+			entries := map[string][]*logpb.ButlerLogBundle_Entry{}
+			doneC := make(chan struct{})
+			go func() {
+				defer close(doneC)
+				for bundle := b.Next(); bundle != nil; bundle = b.Next() {
+					for _, be := range bundle.Entries {
+						entries[be.Desc.Name] = append(entries[be.Desc.Name], be)
+					}
+				}
+			}()
+			b.CloseAndFlush()
+			<-doneC
+			return entries
+		}
+
+		textOf := func(entries []*logpb.ButlerLogBundle_Entry) string {
+			var lines []string
+			for _, be := range entries {
+				for _, le := range be.Logs {
+					for _, l := range le.GetText().GetLines() {
+						lines = append(lines, l.Value)
+					}
+				}
+			}
+			return strings.Join(lines, "|")
+		}
+
+		terminal := func(entries []*logpb.ButlerLogBundle_Entry) *logpb.ButlerLogBundle_Entry {
+			be := entries[len(entries)-1]
+			So(be.Terminal, ShouldBeTrue)
+			return be
+		}
+
+		Convey(`Truncates a text stream with a marker, and records the dropped bytes.`, func() {
+			s := register("text", logpb.StreamType_TEXT, Limits{})
+			appendData(s, "0123456789\n")
+			appendData(s, "abcdefghij\n")
+			appendData(s, "discarded\n")
+			s.Close()
+
+			entries := flush()
+			So(textOf(entries["text"]), ShouldEqual,
+				"0123456789|abcde|[LogDog Butler: stream truncated; byte limit reached]")
+			So(terminal(entries["text"]).QuotaDroppedBytes, ShouldEqual, 16)
+			So(b.LimitStats(), ShouldResemble, LimitStats{TruncatedStreams: 1, QuotaDroppedBytes: 16})
+		})
+
+		Convey(`Applies a stricter stream-requested limit.`, func() {
+			s := register("text", logpb.StreamType_TEXT, Limits{ByteLimit: 11})
+			appendData(s, "0123456789\n")
+			appendData(s, "abcdefghij\n")
+			s.Close()
+
+			entries := flush()
+			So(textOf(entries["text"]), ShouldEqual,
+				"0123456789|[LogDog Butler: stream truncated; byte limit reached]")
+			So(terminal(entries["text"]).QuotaDroppedBytes, ShouldEqual, 11)
+		})
+
+		Convey(`Truncates a binary stream without a marker.`, func() {
+			s := register("binary", logpb.StreamType_BINARY, Limits{})
+			appendData(s, "0123456789abcdefghij")
+			s.Close()
+
+			entries := flush()
+			var got []byte
+			for _, be := range entries["binary"] {
+				for _, le := range be.Logs {
+					got = append(got, le.GetBinary().Data...)
+				}
+			}
+			So(string(got), ShouldEqual, "0123456789abcdef")
+			So(terminal(entries["binary"]).QuotaDroppedBytes, ShouldEqual, 4)
+		})
+
+		Convey(`Ignores empty data.`, func() {
+			s := register("text", logpb.StreamType_TEXT, Limits{})
+			appendData(s, "abc")
+			appendData(s, "")
+			appendData(s, "def\n")
+			s.Close()
+
+			So(textOf(flush()["text"]), ShouldEqual, "abcdef")
+		})
+
+		Convey(`Notes data discarded because of a rate limit when the stream is closed.`, func() {
+			cfg.StreamLimits = Limits{RateLimit: 1}
+			s := register("text", logpb.StreamType_TEXT, Limits{})
+			appendData(s, strings.Repeat("x", dataBufferSize-1)+"\n")
+			appendData(s, "discarded\n")
+			s.Close()
+
+			entries := flush()
+			So(textOf(entries["text"]), ShouldEndWith, "|[LogDog Butler: discarded 10 byte(s); rate limit exceeded]")
+			So(terminal(entries["text"]).RateDroppedBytes, ShouldEqual, 10)
+			So(b.LimitStats(), ShouldResemble, LimitStats{RateLimitEvents: 1, RateDroppedBytes: 10})
+		})
+	})
+}
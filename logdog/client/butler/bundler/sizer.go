@@ -21,6 +21,9 @@ var (
 	sizeOfTerminalTag      int
 	sizeOfTerminalIndexTag int
 
+	sizeOfQuotaDroppedBytesTag int
+	sizeOfRateDroppedBytesTag  int
+
 	errMalformedProtobufField = errors.New("malformed protobuf field")
 )
 
@@ -37,6 +40,8 @@ func init() {
 	sizeOfLogEntryTag = mustCalculateTagSize(be, "Logs")
 	sizeOfTerminalTag = mustCalculateTagSize(be, "Terminal")
 	sizeOfTerminalIndexTag = mustCalculateTagSize(be, "TerminalIndex")
+	sizeOfQuotaDroppedBytesTag = mustCalculateTagSize(be, "QuotaDroppedBytes")
+	sizeOfRateDroppedBytesTag = mustCalculateTagSize(be, "RateDroppedBytes")
 }
 
 func mustCalculateTagSize(i interface{}, field string) int {
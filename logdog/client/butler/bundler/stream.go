@@ -61,6 +61,13 @@ type streamConfig struct {
 	// template is the minimally-populated Butler log bundle entry.
 	template logpb.ButlerLogBundle_Entry
 
+	// limiter, if not nil, enforces the stream's Limits. It is protected by
+	// limits.
+	limiter *limiter
+	// limits is the Bundler-wide limit state. It must not be nil if limiter is
+	// not nil.
+	limits *limitState
+
 	// onAppend, if not nil, is invoked when an attempt to append data to the
 	// stream occurs. If true is passed, the data was successfully appended. If
 	// false was passed, the data could not be appended immediately and the stream
@@ -109,6 +116,14 @@ type streamImpl struct {
 	// stream content processing hits a fatal state.
 	appendErr error
 
+	// lineOpen is true if the last data appended to a text stream did not end
+	// with a newline. It is used to place limit markers on their own lines.
+	lineOpen bool
+
+	// lastLimitedTime is the timestamp of the last data that was checked against
+	// the stream's Limits.
+	lastLimitedTime time.Time
+
 	// testAppendWaitCallback, if not nil, is called before Append blocks.
 	// This callback is used for testing coordination.
 	testAppendWaitCallback func()
@@ -127,6 +142,20 @@ func (s *streamImpl) LeaseData() Data {
 }
 
 func (s *streamImpl) Append(d Data) error {
+	if s.c.limiter != nil {
+		ts := d.Timestamp()
+		s.lastLimitedTime = ts
+
+		var truncated bool
+		d, truncated = s.applyLimits(d)
+		if truncated {
+			defer s.appendMarker("[LogDog Butler: stream truncated; byte limit reached]", ts)
+		}
+		if d == nil {
+			return s.appendError()
+		}
+	}
+
 	// Block/loop until we've successfully appended the data.
 	for {
 		dLen := int64(len(d.Bytes()))
@@ -138,6 +167,9 @@ func (s *streamImpl) Append(d Data) error {
 		s.withParserLock(func() error {
 			if s.c.parser.bufferedBytes() == 0 ||
 				s.c.parser.bufferedBytes()+dLen <= s.c.maximumBufferedBytes {
+				if dLen > 0 {
+					s.lineOpen = d.Bytes()[dLen-1] != '\n'
+				}
 				s.c.parser.appendData(d)
 				d = nil
 			}
@@ -162,6 +194,64 @@ func (s *streamImpl) Append(d Data) error {
 	return nil
 }
 
+// applyLimits enforces the stream's Limits on d.
+//
+// It returns the portion of d that was accepted, or nil if none of it was, and
+// true if accepting it truncated the stream.
+func (s *streamImpl) applyLimits(d Data) (Data, bool) {
+	n := int64(len(d.Bytes()))
+	if n == 0 {
+		return d, false
+	}
+
+	r := s.c.limits.acquire(s.c.limiter, d.Timestamp(), n)
+	if r.rateDropped > 0 {
+		s.appendMarker(rateLimitMarker(r.rateDropped), d.Timestamp())
+	}
+
+	if r.accepted == 0 {
+		d.Release()
+		return nil, r.truncated
+	}
+	if r.accepted < n {
+		d.Bind(int(r.accepted), d.Timestamp())
+	}
+	return d, r.truncated
+}
+
+// rateLimitMarker returns the marker noting that n bytes were discarded because
+// of a rate limit.
+func rateLimitMarker(n int64) string {
+	return fmt.Sprintf("[LogDog Butler: discarded %d byte(s); rate limit exceeded]", n)
+}
+
+// appendMarker adds a marker line to a text stream, noting that stream data
+// was discarded. For other stream types, this does nothing; their data is
+// discarded silently.
+//
+// Markers are added regardless of the stream's buffer size.
+func (s *streamImpl) appendMarker(msg string, ts time.Time) {
+	if s.c.template.Desc.StreamType != logpb.StreamType_TEXT || s.appendError() != nil {
+		return
+	}
+
+	if s.lineOpen {
+		msg = "\n" + msg
+	}
+	msg += "\n"
+
+	d := s.LeaseData()
+	d.Bind(copy(d.Bytes(), msg), ts)
+	s.withParserLock(func() error {
+		s.c.parser.appendData(d)
+		s.lineOpen = false
+		return nil
+	})
+	if s.c.onAppend != nil {
+		s.c.onAppend(true)
+	}
+}
+
 // Signals our Append loop that data has been consumed.
 func (s *streamImpl) signalDataConsumed() {
 	select {
@@ -174,6 +264,14 @@ func (s *streamImpl) signalDataConsumed() {
 }
 
 func (s *streamImpl) Close() {
+	// Data discarded because of a rate limit is noted when later data is
+	// accepted. No more data will follow, so note it now.
+	if s.c.limiter != nil {
+		if n := s.c.limits.takeRateDropping(s.c.limiter); n > 0 {
+			s.appendMarker(rateLimitMarker(n), s.lastLimitedTime)
+		}
+	}
+
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
 	s.closeLocked()
@@ -270,6 +368,10 @@ func (s *streamImpl) nextBundleEntry(bb *builder, aggressive bool) bool {
 	if s.noMoreDataLocked() {
 		if s.lastLogEntry != nil {
 			bb.setStreamTerminal(&s.c.template, s.lastLogEntry.StreamIndex)
+			if s.c.limiter != nil {
+				quota, rate := s.c.limits.dropped(s.c.limiter)
+				bb.setStreamDropped(&s.c.template, uint64(quota), uint64(rate))
+			}
 		}
 		s.setDrained()
 	}
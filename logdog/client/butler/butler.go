@@ -91,6 +91,13 @@ type Config struct {
 	// IOKeepAliveWriter is an io.Writer to send keep-alive updates through. This
 	// must be set for I/O keep-alive to be active.
 	IOKeepAliveWriter io.Writer
+
+	// StreamLimits are the byte and rate limits applied to each stream. Data
+	// that exceeds them is discarded. A stream may request stricter limits.
+	StreamLimits bundler.Limits
+	// GlobalLimits are the byte and rate limits applied to all of the Butler's
+	// streams collectively.
+	GlobalLimits bundler.Limits
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -181,6 +188,8 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		Prefix:           config.Prefix,
 		MaxBufferedBytes: streamBufferSize,
 		MaxBundleSize:    config.Output.MaxSize(),
+		StreamLimits:     config.StreamLimits,
+		GlobalLimits:     config.GlobalLimits,
 	}
 	if config.BufferLogs {
 		bc.MaxBufferDelay = config.MaxBufferAge
@@ -310,11 +319,18 @@ func (b *Butler) Wait() error {
 	log.Debugf(b.ctx, "Output queue has shut down.")
 
 	log.Fields{
-		"stats": b.c.Output.Stats(),
+		"stats":  b.c.Output.Stats(),
+		"limits": b.LimitStats(),
 	}.Infof(b.ctx, "Message output has closed")
 	return b.getRunErr()
 }
 
+// LimitStats returns statistics about the stream data that the Butler has
+// discarded because of its stream limits.
+func (b *Butler) LimitStats() bundler.LimitStats {
+	return b.bundler.LimitStats()
+}
+
 // Streams returns a sorted list of stream names that have been registered to
 // the Butler.
 func (b *Butler) Streams() []types.StreamName {
@@ -18,6 +18,7 @@ import (
 	"github.com/luci/luci-go/common/proto/google"
 	. "github.com/luci/luci-go/common/testing/assertions"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/bundler"
 	"github.com/luci/luci-go/logdog/client/butler/output"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -404,6 +405,28 @@ func TestButler(t *testing.T) {
 				So(to.isTerminal("stderr"), ShouldBeTrue)
 			})
 
+			Convey(`Can apply stream limits, and reports them in its stats.`, func() {
+				conf.StreamLimits = bundler.Limits{ByteLimit: 5}
+
+				s := newTestStream(func(p *streamproto.Properties) {
+					p.Name = "stdout"
+				})
+
+				b := mkb(c, conf)
+				So(b.AddStream(s, s.properties), ShouldBeNil)
+				s.data([]byte("Hello, STDOUT"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("stdout"), shouldHaveTextLogs,
+					"Hello", "[LogDog Butler: stream truncated; byte limit reached]")
+				So(b.LimitStats(), ShouldResemble, bundler.LimitStats{
+					TruncatedStreams:  1,
+					QuotaDroppedBytes: 8,
+				})
+			})
+
 			Convey(`Can apply global tags.`, func() {
 				conf.GlobalTags = streamproto.TagMap{
 					"foo": "bar",
@@ -0,0 +1,18 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package localhttp implements the "local-http" Output.
+//
+// This is a debugging Output that retains received log streams in memory and
+// serves them from a local HTTP server, so that a Butler's streams can be
+// viewed without a Coordinator. The server offers:
+//   - "/", a small web UI that lists the registered streams, tails any of them
+//     live, and renders annotation streams as a step tree.
+//   - "/api/streams", a JSON list of the registered streams.
+//   - "/api/logs?path=PATH&index=N", a JSON list of a stream's retained log
+//     entries, starting at stream index N.
+//   - "/api/steps?path=PATH", the JSON step tree of an annotation stream.
+//   - "/api/tail?path=PATH&index=N", a WebSocket that sends a stream's log
+//     entries, starting at stream index N, as they are received.
+package localhttp
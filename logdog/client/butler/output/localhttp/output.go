@@ -0,0 +1,309 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localhttp
+
+import (
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/milo"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+	"github.com/luci/luci-go/logdog/common/types"
+	"golang.org/x/net/context"
+)
+
+// DefaultMaxStreamEntries is the default maximum number of log entries that
+// are retained for each stream.
+const DefaultMaxStreamEntries = 100000
+
+// Options is the set of configuration options for the Output.
+type Options struct {
+	// Addr is the TCP address that the HTTP server listens on.
+	Addr string
+
+	// MaxStreamEntries is the maximum number of log entries that are retained
+	// for each stream. Once a stream exceeds it, its oldest log entries are
+	// discarded. If <= 0, DefaultMaxStreamEntries will be used.
+	MaxStreamEntries int
+}
+
+// New creates a new local HTTP Output from the specified Options, and starts
+// its HTTP server. The server is stopped when the Output is closed.
+func (opt Options) New(c context.Context) (output.Output, error) {
+	if opt.MaxStreamEntries <= 0 {
+		opt.MaxStreamEntries = DefaultMaxStreamEntries
+	}
+
+	l, err := net.Listen("tcp", opt.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	o := newOutput(c, &opt)
+	o.listener = l
+	o.serveFinishedC = make(chan struct{})
+	go func() {
+		defer close(o.serveFinishedC)
+
+		err := http.Serve(l, o.handler())
+		o.Lock()
+		defer o.Unlock()
+		if !o.closed {
+			log.WithError(err).Errorf(o, "Local HTTP server stopped unexpectedly.")
+		}
+	}()
+
+	log.Fields{
+		"url": "http://" + l.Addr().String() + "/",
+	}.Infof(o, "Serving local log stream viewer.")
+	return o, nil
+}
+
+// localHTTPOutput is an output.Output implementation that retains log stream
+// data in memory and serves it over HTTP.
+type localHTTPOutput struct {
+	// Context is the context to use for logging.
+	context.Context
+	// Options are the configuration options.
+	*Options
+	// Mutex protects all other members.
+	sync.Mutex
+
+	// listener is the HTTP server's listener, or nil if no server was started.
+	listener net.Listener
+	// serveFinishedC is closed when the HTTP server has stopped.
+	serveFinishedC chan struct{}
+
+	// streams is a map of stream path to stream state.
+	streams map[types.StreamPath]*stream
+	// stats is the streaming stats for this instance.
+	stats output.StatsBase
+	// et tracks which log entries have been received for each stream.
+	et output.EntryTracker
+
+	// closed is true if the Output has been closed.
+	closed bool
+	// closedC is closed when the Output is closed.
+	closedC chan struct{}
+}
+
+var _ output.Output = (*localHTTPOutput)(nil)
+
+func newOutput(c context.Context, opt *Options) *localHTTPOutput {
+	return &localHTTPOutput{
+		Context: c,
+		Options: opt,
+		streams: map[types.StreamPath]*stream{},
+		closedC: make(chan struct{}),
+	}
+}
+
+func (o *localHTTPOutput) SendBundle(b *logpb.ButlerLogBundle) error {
+	o.et.Track(b)
+
+	o.Lock()
+	defer o.Unlock()
+
+	for _, be := range b.GetEntries() {
+		desc := be.GetDesc()
+		if desc == nil {
+			continue
+		}
+		path := desc.Path()
+
+		s, ok := o.streams[path]
+		if !ok {
+			s = newStream(desc)
+			o.streams[path] = s
+		}
+		s.ingest(o, be, o.MaxStreamEntries)
+
+		o.stats.F.SentMessages += int64(len(be.GetLogs()))
+	}
+	o.stats.F.SentBytes += int64(proto.Size(b))
+	return nil
+}
+
+func (o *localHTTPOutput) MaxSize() int {
+	return 1024 * 1024 * 1024
+}
+
+func (o *localHTTPOutput) Stats() output.Stats {
+	o.Lock()
+	defer o.Unlock()
+
+	out := o.stats
+	return &out
+}
+
+func (o *localHTTPOutput) Record() *output.EntryRecord {
+	return o.et.Record()
+}
+
+func (o *localHTTPOutput) Close() {
+	func() {
+		o.Lock()
+		defer o.Unlock()
+
+		if o.closed {
+			panic("already closed")
+		}
+		o.closed = true
+		close(o.closedC)
+	}()
+
+	if o.listener != nil {
+		if err := o.listener.Close(); err != nil {
+			log.WithError(err).Warningf(o, "Failed to close local HTTP listener.")
+		}
+		<-o.serveFinishedC
+	}
+}
+
+// getStream returns the state of the stream at path, or nil if the stream has
+// not been registered.
+func (o *localHTTPOutput) getStream(path types.StreamPath) *stream {
+	o.Lock()
+	defer o.Unlock()
+	return o.streams[path]
+}
+
+// streamPaths returns the paths of all registered streams, sorted.
+func (o *localHTTPOutput) streamPaths() []types.StreamPath {
+	o.Lock()
+	defer o.Unlock()
+
+	names := make([]string, 0, len(o.streams))
+	for path := range o.streams {
+		names = append(names, string(path))
+	}
+	sort.Strings(names)
+
+	paths := make([]types.StreamPath, len(names))
+	for i, n := range names {
+		paths[i] = types.StreamPath(n)
+	}
+	return paths
+}
+
+// stream is the retained state of a single log stream.
+type stream struct {
+	// Mutex protects all other members.
+	sync.Mutex
+
+	// desc is the stream's descriptor.
+	desc *logpb.LogStreamDescriptor
+	// terminalIndex is the stream's terminal index, or -1 if the stream has
+	// not terminated.
+	terminalIndex int64
+
+	// logs is the retained log entries, ordered by stream index.
+	logs []*logpb.LogEntry
+	// dropped is the number of log entries that were discarded to keep within
+	// the retention limit.
+	dropped int64
+
+	// step is the latest step of an annotation stream, or nil if none has been
+	// received.
+	step *milo.Step
+	// dgBuf accumulates a partial annotation datagram.
+	dgBuf []byte
+
+	// changedC is closed, and replaced, whenever the stream changes.
+	changedC chan struct{}
+}
+
+func newStream(desc *logpb.LogStreamDescriptor) *stream {
+	return &stream{
+		desc:          desc,
+		terminalIndex: -1,
+		changedC:      make(chan struct{}),
+	}
+}
+
+func (s *stream) isAnnotation() bool {
+	return s.desc.StreamType == logpb.StreamType_DATAGRAM && s.desc.ContentType == milo.ContentTypeAnnotations
+}
+
+func (s *stream) ingest(c context.Context, be *logpb.ButlerLogBundle_Entry, maxEntries int) {
+	s.Lock()
+	defer s.Unlock()
+
+	if be.Terminal {
+		s.terminalIndex = int64(be.TerminalIndex)
+	}
+
+	for _, le := range be.GetLogs() {
+		s.insertLocked(le)
+
+		if s.isAnnotation() {
+			if dg := le.GetDatagram(); dg != nil {
+				s.ingestAnnotationLocked(c, dg)
+			}
+		}
+	}
+
+	if over := len(s.logs) - maxEntries; over > 0 {
+		s.logs = append([]*logpb.LogEntry(nil), s.logs[over:]...)
+		s.dropped += int64(over)
+	}
+
+	close(s.changedC)
+	s.changedC = make(chan struct{})
+}
+
+// insertLocked adds le to the stream's logs, keeping them in stream index
+// order. Log entries usually arrive in order, so they are appended.
+func (s *stream) insertLocked(le *logpb.LogEntry) {
+	idx := len(s.logs)
+	for idx > 0 && s.logs[idx-1].StreamIndex >= le.StreamIndex {
+		idx--
+	}
+	if idx < len(s.logs) && s.logs[idx].StreamIndex == le.StreamIndex {
+		// Duplicate log entry.
+		return
+	}
+
+	s.logs = append(s.logs, nil)
+	copy(s.logs[idx+1:], s.logs[idx:])
+	s.logs[idx] = le
+}
+
+func (s *stream) ingestAnnotationLocked(c context.Context, dg *logpb.Datagram) {
+	data := dg.Data
+	if p := dg.Partial; p != nil {
+		s.dgBuf = append(s.dgBuf, data...)
+		if !p.Last {
+			return
+		}
+		data, s.dgBuf = s.dgBuf, nil
+	}
+
+	var step milo.Step
+	if err := proto.Unmarshal(data, &step); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       s.desc.Path(),
+		}.Warningf(c, "Failed to unmarshal annotation datagram.")
+		return
+	}
+	s.step = &step
+}
+
+// logsFrom returns the retained log entries whose stream index is at least
+// index, along with the stream's terminal index and a channel that is closed
+// when the stream next changes.
+func (s *stream) logsFrom(index uint64) ([]*logpb.LogEntry, int64, <-chan struct{}) {
+	s.Lock()
+	defer s.Unlock()
+
+	i := sort.Search(len(s.logs), func(i int) bool { return s.logs[i].StreamIndex >= index })
+	return append([]*logpb.LogEntry(nil), s.logs[i:]...), s.terminalIndex, s.changedC
+}
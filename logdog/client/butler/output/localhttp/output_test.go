@@ -0,0 +1,245 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/proto/milo"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func textEntry(idx uint64, lines ...string) *logpb.LogEntry {
+	le := logpb.LogEntry{
+		StreamIndex: idx,
+		Content: &logpb.LogEntry_Text{
+			Text: &logpb.Text{},
+		},
+	}
+	for _, l := range lines {
+		le.GetText().Lines = append(le.GetText().Lines, &logpb.Text_Line{Value: l, Delimiter: "\n"})
+	}
+	return &le
+}
+
+func bundleEntry(desc *logpb.LogStreamDescriptor, logs ...*logpb.LogEntry) *logpb.ButlerLogBundle_Entry {
+	return &logpb.ButlerLogBundle_Entry{
+		Desc: desc,
+		Logs: logs,
+	}
+}
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local HTTP Output with a test server`, t, func() {
+		o := newOutput(context.Background(), &Options{MaxStreamEntries: 4})
+		srv := httptest.NewServer(o.handler())
+		defer srv.Close()
+		defer o.Close()
+
+		text := &logpb.LogStreamDescriptor{
+			Prefix:      "foo",
+			Name:        "bar",
+			StreamType:  logpb.StreamType_TEXT,
+			ContentType: "text/plain",
+		}
+
+		getJSON := func(path string, v interface{}) int {
+			resp, err := http.Get(srv.URL + path)
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+
+			if resp.StatusCode == http.StatusOK {
+				So(json.NewDecoder(resp.Body).Decode(v), ShouldBeNil)
+			}
+			return resp.StatusCode
+		}
+
+		getLogs := func(query string) (resp logsResponse, lines []string) {
+			So(getJSON("/api/logs?"+query, &resp), ShouldEqual, http.StatusOK)
+			for _, d := range resp.Entries {
+				var le logpb.LogEntry
+				So(jsonpb.UnmarshalString(string(d), &le), ShouldBeNil)
+				for _, l := range le.GetText().GetLines() {
+					lines = append(lines, l.Value)
+				}
+			}
+			return
+		}
+
+		Convey(`Serves the web UI.`, func() {
+			resp, err := http.Get(srv.URL + "/")
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			So(resp.Header.Get("Content-Type"), ShouldStartWith, "text/html")
+		})
+
+		Convey(`Lists no streams before any are received.`, func() {
+			var streams []*streamInfo
+			So(getJSON("/api/streams", &streams), ShouldEqual, http.StatusOK)
+			So(streams, ShouldHaveLength, 0)
+		})
+
+		Convey(`With received text log entries`, func() {
+			So(o.SendBundle(&logpb.ButlerLogBundle{
+				Entries: []*logpb.ButlerLogBundle_Entry{
+					bundleEntry(text, textEntry(0, "a"), textEntry(1, "b"), textEntry(3, "d")),
+				},
+			}), ShouldBeNil)
+
+			Convey(`Lists the stream and its received ranges.`, func() {
+				var streams []*streamInfo
+				So(getJSON("/api/streams", &streams), ShouldEqual, http.StatusOK)
+				So(streams, ShouldResemble, []*streamInfo{
+					{
+						Path:          "foo/+/bar",
+						ContentType:   "text/plain",
+						StreamType:    "TEXT",
+						TerminalIndex: -1,
+						Received:      []rangeInfo{{0, 1}, {3, 3}},
+					},
+				})
+			})
+
+			Convey(`Serves the stream's log entries.`, func() {
+				resp, lines := getLogs("path=foo/%2B/bar")
+				So(resp.TerminalIndex, ShouldEqual, -1)
+				So(lines, ShouldResemble, []string{"a", "b", "d"})
+
+				_, lines = getLogs("path=foo/%2B/bar&index=2")
+				So(lines, ShouldResemble, []string{"d"})
+			})
+
+			Convey(`Inserts out-of-order log entries in order, and drops the oldest.`, func() {
+				So(o.SendBundle(&logpb.ButlerLogBundle{
+					Entries: []*logpb.ButlerLogBundle_Entry{
+						bundleEntry(text, textEntry(4, "e"), textEntry(2, "c"), textEntry(1, "b")),
+					},
+				}), ShouldBeNil)
+
+				_, lines := getLogs("path=foo/%2B/bar")
+				So(lines, ShouldResemble, []string{"b", "c", "d", "e"})
+			})
+
+			Convey(`Returns an error for a missing or invalid stream.`, func() {
+				var resp logsResponse
+				So(getJSON("/api/logs?path=foo/%2B/missing", &resp), ShouldEqual, http.StatusNotFound)
+				So(getJSON("/api/logs?path=foo", &resp), ShouldEqual, http.StatusBadRequest)
+				So(getJSON("/api/logs?path=foo/%2B/bar&index=x", &resp), ShouldEqual, http.StatusBadRequest)
+			})
+
+			Convey(`Can tail the stream over a WebSocket.`, func() {
+				wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/tail?path=" + url.QueryEscape("foo/+/bar")
+				ws, err := websocket.Dial(wsURL, "", srv.URL)
+				So(err, ShouldBeNil)
+				defer ws.Close()
+
+				var resp logsResponse
+				So(websocket.JSON.Receive(ws, &resp), ShouldBeNil)
+				So(resp.Entries, ShouldHaveLength, 3)
+
+				be := bundleEntry(text, textEntry(2, "c"), textEntry(4, "e"))
+				be.Terminal, be.TerminalIndex = true, 4
+				So(o.SendBundle(&logpb.ButlerLogBundle{
+					Entries: []*logpb.ButlerLogBundle_Entry{be},
+				}), ShouldBeNil)
+
+				// Entry #2 was inserted behind the tail, so only #4 is sent.
+				So(websocket.JSON.Receive(ws, &resp), ShouldBeNil)
+				So(resp.TerminalIndex, ShouldEqual, 4)
+				So(resp.Entries, ShouldHaveLength, 1)
+
+				// The stream is complete, so the server hangs up.
+				So(websocket.JSON.Receive(ws, &resp), ShouldNotBeNil)
+			})
+
+			Convey(`Refuses cross-origin WebSocket connections.`, func() {
+				wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/tail?path=" + url.QueryEscape("foo/+/bar")
+				_, err := websocket.Dial(wsURL, "", "http://example.com")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey(`With a received annotation stream`, func() {
+			annotations := &logpb.LogStreamDescriptor{
+				Prefix:      "foo",
+				Name:        "annotations",
+				StreamType:  logpb.StreamType_DATAGRAM,
+				ContentType: milo.ContentTypeAnnotations,
+			}
+
+			step := milo.Step{
+				Name:   "steps",
+				Status: milo.Status_SUCCESS,
+				Substep: []*milo.Step_Substep{
+					{Substep: &milo.Step_Substep_Step{Step: &milo.Step{Name: "compile"}}},
+				},
+			}
+			data, err := proto.Marshal(&step)
+			So(err, ShouldBeNil)
+
+			// Send the step as two partial datagrams.
+			half := len(data) / 2
+			So(o.SendBundle(&logpb.ButlerLogBundle{
+				Entries: []*logpb.ButlerLogBundle_Entry{
+					bundleEntry(annotations,
+						&logpb.LogEntry{
+							StreamIndex: 0,
+							Content: &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{
+								Data:    data[:half],
+								Partial: &logpb.Datagram_Partial{Index: 0, Size: uint64(len(data))},
+							}},
+						},
+						&logpb.LogEntry{
+							StreamIndex: 1,
+							Content: &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{
+								Data:    data[half:],
+								Partial: &logpb.Datagram_Partial{Index: 1, Size: uint64(len(data)), Last: true},
+							}},
+						}),
+				},
+			}), ShouldBeNil)
+
+			Convey(`Serves its step tree.`, func() {
+				var got map[string]interface{}
+				So(getJSON("/api/steps?path=foo/%2B/annotations", &got), ShouldEqual, http.StatusOK)
+				So(got["name"], ShouldEqual, "steps")
+				So(got["status"], ShouldEqual, "SUCCESS")
+				So(got["substep"], ShouldHaveLength, 1)
+			})
+
+			Convey(`Is listed as an annotation stream.`, func() {
+				var streams []*streamInfo
+				So(getJSON("/api/streams", &streams), ShouldEqual, http.StatusOK)
+				So(streams, ShouldHaveLength, 1)
+				So(streams[0].Annotation, ShouldBeTrue)
+			})
+		})
+
+		Convey(`Tracks sent messages in its stats and record.`, func() {
+			So(o.SendBundle(&logpb.ButlerLogBundle{
+				Entries: []*logpb.ButlerLogBundle_Entry{
+					bundleEntry(text, textEntry(0, "a"), textEntry(1, "b")),
+				},
+			}), ShouldBeNil)
+
+			So(o.Stats().SentMessages(), ShouldEqual, 2)
+			So(o.Record().Streams["foo/+/bar"].Ranges, ShouldHaveLength, 1)
+		})
+	})
+}
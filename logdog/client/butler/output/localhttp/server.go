@@ -0,0 +1,277 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/types"
+	"golang.org/x/net/websocket"
+)
+
+// streamInfo is the JSON description of a registered stream.
+type streamInfo struct {
+	Path        string            `json:"path"`
+	ContentType string            `json:"contentType"`
+	StreamType  string            `json:"streamType"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Annotation  bool              `json:"annotation,omitempty"`
+
+	// TerminalIndex is the stream's terminal index, or -1 if the stream has not
+	// terminated.
+	TerminalIndex int64 `json:"terminalIndex"`
+	// Received is the ranges of stream indexes that have been received.
+	Received []rangeInfo `json:"received"`
+	// Dropped is the number of log entries that were received, but are no
+	// longer retained.
+	Dropped int64 `json:"dropped,omitempty"`
+}
+
+// rangeInfo is the JSON description of an output.Range.
+type rangeInfo struct {
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+}
+
+// logsResponse is the JSON response of the logs and tail endpoints.
+type logsResponse struct {
+	Path          string `json:"path"`
+	TerminalIndex int64  `json:"terminalIndex"`
+
+	// Entries is the log entries, each in the protobuf JSON encoding of a
+	// LogEntry.
+	Entries []json.RawMessage `json:"entries"`
+}
+
+func (o *localHTTPOutput) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", o.handleIndex)
+	mux.HandleFunc("/api/streams", o.handleStreams)
+	mux.HandleFunc("/api/logs", o.handleLogs)
+	mux.HandleFunc("/api/steps", o.handleSteps)
+	mux.Handle("/api/tail", websocket.Server{
+		Handshake: checkSameOrigin,
+		Handler:   o.handleTail,
+	})
+	return mux
+}
+
+func (o *localHTTPOutput) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	io.WriteString(w, indexHTML)
+}
+
+func (o *localHTTPOutput) handleStreams(w http.ResponseWriter, r *http.Request) {
+	rec := o.et.Record()
+
+	paths := o.streamPaths()
+	infos := make([]*streamInfo, 0, len(paths))
+	for _, path := range paths {
+		s := o.getStream(path)
+
+		s.Lock()
+		info := streamInfo{
+			Path:          string(path),
+			ContentType:   s.desc.ContentType,
+			StreamType:    s.desc.StreamType.String(),
+			Tags:          s.desc.Tags,
+			Annotation:    s.isAnnotation(),
+			TerminalIndex: s.terminalIndex,
+			Received:      []rangeInfo{},
+			Dropped:       s.dropped,
+		}
+		s.Unlock()
+
+		if sr := rec.Streams[path]; sr != nil {
+			for _, rng := range sr.Ranges {
+				info.Received = append(info.Received, rangeInfo{rng.Start, rng.End})
+			}
+		}
+		infos = append(infos, &info)
+	}
+
+	writeJSON(w, infos)
+}
+
+func (o *localHTTPOutput) handleLogs(w http.ResponseWriter, r *http.Request) {
+	s, index, err := o.streamForRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	logs, tidx, _ := s.logsFrom(index)
+	resp, err := newLogsResponse(s.desc.Path(), tidx, logs)
+	if err != nil {
+		log.WithError(err).Errorf(o, "Failed to encode log entries.")
+		http.Error(w, "failed to encode log entries", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+func (o *localHTTPOutput) handleSteps(w http.ResponseWriter, r *http.Request) {
+	s, _, err := o.streamForRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.Lock()
+	step := s.step
+	s.Unlock()
+
+	if step == nil {
+		http.Error(w, "no annotation step has been received", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := (&jsonpb.Marshaler{}).Marshal(w, step); err != nil {
+		log.WithError(err).Errorf(o, "Failed to encode annotation step.")
+	}
+}
+
+// handleTail sends a stream's log entries over a WebSocket as they are
+// received. It returns when the stream's terminal log entry has been sent,
+// when the client disconnects, or when the Output is closed.
+func (o *localHTTPOutput) handleTail(ws *websocket.Conn) {
+	defer ws.Close()
+
+	s, next, err := o.streamForRequest(ws.Request())
+	if err != nil || s == nil {
+		if err == nil {
+			err = fmt.Errorf("stream %q is not registered", ws.Request().FormValue("path"))
+		}
+		websocket.JSON.Send(ws, map[string]string{"error": err.Error()})
+		return
+	}
+
+	// We don't expect anything from the client, but reading lets us notice
+	// when it disconnects.
+	goneC := make(chan struct{})
+	go func() {
+		defer close(goneC)
+		io.Copy(ioutil.Discard, ws)
+	}()
+
+	sentTidx := int64(-1)
+	for {
+		logs, tidx, changedC := s.logsFrom(next)
+		if len(logs) > 0 || tidx != sentTidx {
+			resp, err := newLogsResponse(s.desc.Path(), tidx, logs)
+			if err != nil {
+				log.WithError(err).Errorf(o, "Failed to encode log entries.")
+				return
+			}
+			if err := websocket.JSON.Send(ws, resp); err != nil {
+				return
+			}
+
+			if len(logs) > 0 {
+				next = logs[len(logs)-1].StreamIndex + 1
+			}
+			sentTidx = tidx
+		}
+
+		if tidx >= 0 && next > uint64(tidx) {
+			// The stream is complete.
+			return
+		}
+
+		select {
+		case <-changedC:
+		case <-goneC:
+			return
+		case <-o.closedC:
+			return
+		}
+	}
+}
+
+// streamForRequest returns the stream named by the request's "path" parameter,
+// or nil if it has not been registered, and the request's "index" parameter.
+func (o *localHTTPOutput) streamForRequest(r *http.Request) (*stream, uint64, error) {
+	path := types.StreamPath(r.FormValue("path"))
+	if err := path.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("invalid path %q: %s", path, err)
+	}
+
+	var index uint64
+	if v := r.FormValue("index"); v != "" {
+		var err error
+		if index, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return nil, 0, fmt.Errorf("invalid index %q: %s", v, err)
+		}
+	}
+	return o.getStream(path), index, nil
+}
+
+func newLogsResponse(path types.StreamPath, tidx int64, logs []*logpb.LogEntry) (*logsResponse, error) {
+	resp := logsResponse{
+		Path:          string(path),
+		TerminalIndex: tidx,
+		Entries:       make([]json.RawMessage, len(logs)),
+	}
+	for i, le := range logs {
+		d, err := marshalJSONPB(le)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entries[i] = d
+	}
+	return &resp, nil
+}
+
+func marshalJSONPB(msg proto.Message) (json.RawMessage, error) {
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(s), nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// checkSameOrigin is a WebSocket handshake function that rejects connections
+// from pages that were not served by this server. Without it, any web page
+// could read the local streams.
+func checkSameOrigin(cfg *websocket.Config, r *http.Request) error {
+	origin, err := url.Parse(r.Header.Get("Origin"))
+	if err != nil {
+		return err
+	}
+	if origin.Host != r.Host {
+		return fmt.Errorf("cross-origin request from %q", origin)
+	}
+	cfg.Origin = origin
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localhttp
+
+// indexHTML is the local stream viewer web UI.
+//
+// It polls the stream list, tails the selected stream over a WebSocket, and
+// renders the step tree of annotation streams.
+const indexHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>LogDog Butler</title>
+<style>
+body { margin: 0; font-family: sans-serif; display: flex; height: 100vh; }
+#streams { width: 30em; overflow: auto; border-right: 1px solid #ccc; padding: 0.5em; }
+#streams div { cursor: pointer; padding: 0.2em; word-break: break-all; }
+#streams div.selected { background: #def; }
+#streams .meta { color: #888; font-size: small; }
+#view { flex: 1; overflow: auto; padding: 0.5em; }
+#steps ul { list-style: none; padding-left: 1.2em; }
+#steps .SUCCESS { color: #080; }
+#steps .FAILURE { color: #c00; }
+#steps .RUNNING { color: #c80; }
+#steps a { margin-left: 0.5em; font-size: small; }
+#logs { font-family: monospace; white-space: pre-wrap; margin: 0; }
+</style>
+</head>
+<body>
+<div id="streams"></div>
+<div id="view">
+<h3 id="title">Select a stream.</h3>
+<div id="steps"></div>
+<pre id="logs"></pre>
+</div>
+<script>
+"use strict";
+
+var selected = null;
+var socket = null;
+
+function el(tag, cls, text) {
+  var e = document.createElement(tag);
+  if (cls) { e.className = cls; }
+  if (text !== undefined) { e.textContent = text; }
+  return e;
+}
+
+function describe(s) {
+  var parts = [s.streamType.toLowerCase(), s.contentType];
+  if (s.terminalIndex >= 0) {
+    parts.push("complete (" + (s.terminalIndex + 1) + " entries)");
+  } else {
+    parts.push("streaming");
+  }
+  if (s.dropped) { parts.push(s.dropped + " dropped"); }
+  return parts.join(", ");
+}
+
+function refreshStreams() {
+  fetch("/api/streams").then(function(r) { return r.json(); }).then(function(streams) {
+    var list = document.getElementById("streams");
+    list.textContent = "";
+    streams.forEach(function(s) {
+      var d = el("div", s.path === selected ? "selected" : "");
+      d.appendChild(el("span", "", s.path));
+      d.appendChild(el("div", "meta", describe(s)));
+      d.onclick = function() { select(s.path); };
+      list.appendChild(d);
+    });
+  });
+}
+
+function select(path) {
+  selected = path;
+  if (socket) { socket.close(); }
+  document.getElementById("title").textContent = path;
+  document.getElementById("steps").textContent = "";
+  var logs = document.getElementById("logs");
+  logs.textContent = "";
+  refreshStreams();
+
+  var proto = location.protocol === "https:" ? "wss://" : "ws://";
+  socket = new WebSocket(proto + location.host + "/api/tail?path=" + encodeURIComponent(path));
+  var ws = socket;
+  ws.onmessage = function(ev) {
+    if (ws !== socket) { return; }
+    var msg = JSON.parse(ev.data);
+    if (msg.error) {
+      logs.appendChild(document.createTextNode("Error: " + msg.error + "\n"));
+      return;
+    }
+    var annotation = false;
+    msg.entries.forEach(function(le) {
+      if (le.text) {
+        (le.text.lines || []).forEach(function(line) {
+          logs.appendChild(document.createTextNode((line.value || "") + (line.delimiter ? "\n" : "")));
+        });
+      } else if (le.binary) {
+        logs.appendChild(document.createTextNode("[binary: " + atob(le.binary.data || "").length + " bytes]\n"));
+      } else if (le.datagram) {
+        annotation = true;
+      }
+    });
+    if (annotation) { refreshSteps(path); }
+  };
+}
+
+function refreshSteps(path) {
+  fetch("/api/steps?path=" + encodeURIComponent(path)).then(function(r) {
+    return r.ok ? r.json() : null;
+  }).then(function(step) {
+    if (!step || path !== selected) { return; }
+    var steps = document.getElementById("steps");
+    steps.textContent = "";
+    var prefix = path.split("/+/")[0];
+    var ul = el("ul");
+    ul.appendChild(renderStep(step, prefix));
+    steps.appendChild(ul);
+  });
+}
+
+function streamLink(name, stream, prefix) {
+  var a = el("a", "", name);
+  a.href = "#";
+  a.onclick = function() {
+    select((stream.prefix || prefix) + "/+/" + stream.name);
+    return false;
+  };
+  return a;
+}
+
+function renderStep(step, prefix) {
+  var li = el("li");
+  var status = step.status || "RUNNING";
+  li.appendChild(el("span", status, "[" + status + "] " + (step.name || "(root)")));
+  if (step.stdoutStream) { li.appendChild(streamLink("stdout", step.stdoutStream, prefix)); }
+  if (step.stderrStream) { li.appendChild(streamLink("stderr", step.stderrStream, prefix)); }
+  var subs = (step.substep || []).filter(function(s) { return s.step; });
+  if (subs.length) {
+    var ul = el("ul");
+    subs.forEach(function(s) { ul.appendChild(renderStep(s.step, prefix)); });
+    li.appendChild(ul);
+  }
+  return li;
+}
+
+refreshStreams();
+setInterval(refreshStreams, 2000);
+</script>
+</body>
+</html>
+`
@@ -44,6 +44,10 @@ type listenerStreamServer struct {
 	l     net.Listener
 	laddr string
 
+	// token, if not empty, is the secret token that clients must present
+	// during their handshake.
+	token []byte
+
 	streamParamsC   chan *streamParams
 	closedC         chan struct{}
 	acceptFinishedC chan struct{}
@@ -141,6 +145,7 @@ func (s *listenerStreamServer) serve() {
 			closedC: s.closedC,
 			id:      nextID,
 			conn:    &iotools.DeadlineReader{conn, 0},
+			token:   s.token,
 		}
 		client.Context = log.SetFields(s, log.Fields{
 			"id":     client.id,
@@ -206,6 +211,7 @@ type streamClient struct {
 	closedC chan struct{} // Signal channel to indicate that the server has closed.
 	id      int           // Client ID, used for debugging correlation.
 	conn    net.Conn      // The underlying client connection.
+	token   []byte        // The token that the client must present, if any.
 
 	// decoupleMu is used to ensure that decoupleConn is called at most one time.
 	decoupleMu sync.Mutex
@@ -228,7 +234,7 @@ func (c *streamClient) handle() (*streamParams, error) {
 
 	// Perform our handshake. We pass the connection explicitly into this method
 	// because it can get decoupled during operation.
-	p, err := handshake(c, c.conn)
+	p, err := handshake(c, c.conn, c.token)
 	if err != nil {
 		return nil, err
 	}
@@ -242,10 +248,11 @@ func (c *streamClient) handle() (*streamParams, error) {
 // supplied to the local streamParamsC; otherwise, it will be closed.
 //
 // The client connection opens with a handshake protocol. Once complete, the
-// connection itself becomes the stream.
-func handshake(ctx context.Context, conn net.Conn) (*streamproto.Properties, error) {
+// connection itself becomes the stream. If token is not empty, the client must
+// present it during the handshake.
+func handshake(ctx context.Context, conn net.Conn, token []byte) (*streamproto.Properties, error) {
 	log.Infof(ctx, "Beginning handshake.")
-	hs := handshakeProtocol{}
+	hs := handshakeProtocol{token: token}
 	return hs.Handshake(ctx, conn)
 }
 
@@ -20,6 +20,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -255,6 +256,71 @@ func TestTCPServer(t *testing.T) {
 	})
 }
 
+func TestTLSTCPServer(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A TLS TCP/IP4 server`, t, func() {
+		ctx := context.Background()
+
+		svr, err := NewTLSTCP4Server(ctx, "")
+		So(err, ShouldBeNil)
+
+		s := svr.(*tlsStreamServer)
+		s.discardC = make(chan *streamClient, 1)
+
+		So(svr.Listen(), ShouldBeNil)
+		defer svr.Close()
+
+		So(svr.Address(), ShouldStartWith, "tcp4+tls:127.0.0.1:")
+		creds := svr.Credentials()
+		So(creds.Token, ShouldHaveLength, tokenSize)
+		So(creds.CertFingerprint, ShouldNotEqual, "")
+
+		reg := streamclient.GetDefaultRegistry()
+		flags := streamproto.Flags{
+			Name:      "foo",
+			Timestamp: clockflag.Time(testclock.TestTimeLocal),
+		}
+
+		Convey(`Accepts a client with its credentials.`, func() {
+			client, err := reg.NewAuthClient(svr.Address(), creds)
+			So(err, ShouldBeNil)
+
+			testClientServer(t, svr, client)
+		})
+
+		Convey(`Rejects a client with the wrong token.`, func() {
+			client, err := reg.NewAuthClient(svr.Address(), &streamproto.Credentials{
+				Token:           []byte("wrong"),
+				CertFingerprint: creds.CertFingerprint,
+			})
+			So(err, ShouldBeNil)
+
+			stream, err := client.NewStream(flags)
+			So(err, ShouldBeNil)
+			defer stream.Close()
+
+			So(<-s.discardC, ShouldNotBeNil)
+		})
+
+		Convey(`Client refuses a server with a different certificate.`, func() {
+			client, err := reg.NewAuthClient(svr.Address(), &streamproto.Credentials{
+				Token:           creds.Token,
+				CertFingerprint: "0123",
+			})
+			So(err, ShouldBeNil)
+
+			_, err = client.NewStream(flags)
+			So(err, ShouldErrLike, "does not match the expected fingerprint")
+		})
+
+		Convey(`Client requires credentials.`, func() {
+			_, err := reg.NewClient(svr.Address())
+			So(err, ShouldErrLike, "must be provided")
+		})
+	})
+}
+
 // testClientServer tests to ensure that a client can create streams with a
 // server.
 //
@@ -6,6 +6,7 @@ package streamserver
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,7 +25,13 @@ import (
 // handshakeProtocol is an implementation of a Butler handshake protocol V1
 // reader. It identifies with streamproto.ProtocolFrameHeaderMagic, and uses a
 // JSON blob to describe the stream.
+//
+// If token is not empty, the client must present it in a recordio frame that
+// immediately follows the magic number.
 type handshakeProtocol struct {
+	// token, if not empty, is the secret token that clients must present.
+	token []byte
+
 	forceVerbose bool // (Testing) force verbose code path.
 }
 
@@ -58,6 +65,13 @@ func (p *handshakeProtocol) Handshake(ctx context.Context, r io.Reader) (*stream
 		return nil, errors.New("handshake: Unknown protocol magic in frame header")
 	}
 
+	// Authenticate the client, if required.
+	if len(p.token) > 0 {
+		if err := p.checkToken(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+
 	// Load the JSON into our descriptor field.
 	flags, err := p.loadFlags(ctx, r)
 	if err != nil {
@@ -75,6 +89,20 @@ func (p *handshakeProtocol) Handshake(ctx context.Context, r io.Reader) (*stream
 	return props, nil
 }
 
+func (p *handshakeProtocol) checkToken(ctx context.Context, r io.Reader) error {
+	token, err := recordio.NewReader(r, streamproto.MaxTokenSize).ReadFrameAll()
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to read token frame.")
+		return errors.New("handshake: failed to read token frame")
+	}
+
+	if subtle.ConstantTimeCompare(token, p.token) != 1 {
+		log.Errorf(ctx, "Client presented an invalid token.")
+		return errors.New("handshake: invalid token")
+	}
+	return nil
+}
+
 func (p *handshakeProtocol) loadFlags(ctx context.Context, r io.Reader) (*streamproto.Flags, error) {
 	fr := recordio.NewReader(r, maxHeaderSize)
 
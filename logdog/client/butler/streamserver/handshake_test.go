@@ -13,10 +13,13 @@ import (
 	"time"
 
 	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/data/recordio"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 )
@@ -33,6 +36,7 @@ func writePanic(w io.Writer, d []byte) {
 
 type handshakeBuilder struct {
 	magic []byte // The frame header. If empty, don't include a frame header.
+	token []byte // The token. If empty, don't include a token frame.
 	size  uint64 // The size. If zero, calculate the size.
 }
 
@@ -42,6 +46,13 @@ func (b *handshakeBuilder) writeTo(w io.Writer, handshake string, data []byte) {
 		writePanic(w, b.magic)
 	}
 
+	// Token
+	if len(b.token) > 0 {
+		if _, err := recordio.WriteFrame(w, b.token); err != nil {
+			panic(err)
+		}
+	}
+
 	// Size
 	size := b.size
 	if size == 0 {
@@ -120,6 +131,37 @@ func testHandshakeProtocol(t *testing.T, verbose bool) {
 			})
 		})
 
+		Convey(`When a token is required`, func() {
+			p.token = []byte("secret")
+
+			Convey(`Will succeed if the client presents it.`, func() {
+				hb.token = []byte("secret")
+
+				props, err := p.Handshake(ctx, hb.reader(`{"name": "test"}`, nil))
+				So(err, ShouldBeNil)
+				So(props.Name, ShouldEqual, "test")
+			})
+
+			Convey(`Will fail if the client presents a different token.`, func() {
+				hb.token = []byte("guess")
+
+				_, err := p.Handshake(ctx, hb.reader(`{"name": "test"}`, nil))
+				So(err, ShouldErrLike, "invalid token")
+			})
+
+			Convey(`Will fail if the client presents no token.`, func() {
+				_, err := p.Handshake(ctx, hb.reader(`{"name": "test"}`, nil))
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey(`Will fail if the token frame is too large.`, func() {
+				hb.token = make([]byte, streamproto.MaxTokenSize+1)
+
+				_, err := p.Handshake(ctx, hb.reader(`{"name": "test"}`, nil))
+				So(err, ShouldErrLike, "failed to read token frame")
+			})
+		})
+
 		Convey(`Loading a fully-specified configuration`, func() {
 			data := `{
 				"name": "test", "tee": "stdout", "timestamp": "2015-05-07T01:29:51+00:00",
@@ -32,3 +32,15 @@ type StreamServer interface {
 	// Closes the stream server, cleaning up resources.
 	Close()
 }
+
+// AuthenticatedStreamServer is a StreamServer that only accepts clients that
+// present its Credentials.
+type AuthenticatedStreamServer interface {
+	StreamServer
+
+	// Credentials returns the credentials that clients must present to connect
+	// to this StreamServer.
+	//
+	// The Token is a secret, and must only be shared with trusted clients.
+	Credentials() *streamproto.Credentials
+}
@@ -5,21 +5,32 @@
 package streamserver
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
+	"math/big"
 	"net"
+	"time"
 
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/errors"
 	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 
 	"golang.org/x/net/context"
 )
 
-// tcpStreamServer is a StreamServer implementation that binds to a TCP/IP
-// socket.
-type tcpStreamServer struct {
-	net  string
-	addr *net.TCPAddr
-}
+const (
+	// tokenSize is the size, in bytes, of a generated stream server token.
+	tokenSize = 32
+
+	// certValidity is the validity period of a generated TLS certificate.
+	certValidity = 365 * 24 * time.Hour
+)
 
 // NewTCP4Server creates a new TCP/IP4 stream server.
 //
@@ -53,8 +64,82 @@ func NewTCP6Server(ctx context.Context, spec string) (StreamServer, error) {
 	return newTCPServerImpl(ctx, "tcp6", spec, net.IPv6loopback)
 }
 
-// Listen implements StreamServer.
+// NewTLSTCP4Server creates a new TCP/IP4 stream server that serves TLS and
+// only accepts clients that present a secret token.
+//
+// spec is interpreted as it is by NewTCP4Server.
+//
+// The server generates a self-signed certificate and a random token. Clients
+// must be given the server's Credentials in order to connect to it.
+func NewTLSTCP4Server(ctx context.Context, spec string) (AuthenticatedStreamServer, error) {
+	return newTLSTCPServerImpl(ctx, "tcp4", spec, net.IPv4(127, 0, 0, 1))
+}
+
+// NewTLSTCP6Server creates a new TCP/IP6 stream server that serves TLS and
+// only accepts clients that present a secret token.
+//
+// spec is interpreted as it is by NewTCP6Server.
+//
+// The server generates a self-signed certificate and a random token. Clients
+// must be given the server's Credentials in order to connect to it.
+func NewTLSTCP6Server(ctx context.Context, spec string) (AuthenticatedStreamServer, error) {
+	return newTLSTCPServerImpl(ctx, "tcp6", spec, net.IPv6loopback)
+}
+
+// tlsStreamServer is an AuthenticatedStreamServer implementation that binds to
+// a TCP/IP socket and serves TLS.
+type tlsStreamServer struct {
+	*listenerStreamServer
+
+	creds streamproto.Credentials
+}
+
+// Credentials implements AuthenticatedStreamServer.
+func (s *tlsStreamServer) Credentials() *streamproto.Credentials {
+	creds := s.creds
+	return &creds
+}
+
 func newTCPServerImpl(ctx context.Context, netType, spec string, loopback net.IP) (StreamServer, error) {
+	tcpAddr, err := resolveTCPAddr(netType, spec, loopback)
+	if err != nil {
+		return nil, err
+	}
+	return newTCPListenerStreamServer(ctx, netType, netType, tcpAddr, nil), nil
+}
+
+func newTLSTCPServerImpl(ctx context.Context, netType, spec string, loopback net.IP) (AuthenticatedStreamServer, error) {
+	tcpAddr, err := resolveTCPAddr(netType, spec, loopback)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, tokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to generate token").Err()
+	}
+
+	cert, err := generateCertificate(ctx, tcpAddr.IP)
+	if err != nil {
+		return nil, errors.Annotate(err).Reason("failed to generate TLS certificate").Err()
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	s := &tlsStreamServer{
+		listenerStreamServer: newTCPListenerStreamServer(ctx, netType+"+tls", netType, tcpAddr, tlsConfig),
+		creds: streamproto.Credentials{
+			Token:           token,
+			CertFingerprint: streamproto.CertFingerprint(cert.Certificate[0]),
+		},
+	}
+	s.token = token
+	return s, nil
+}
+
+func resolveTCPAddr(netType, spec string, loopback net.IP) (*net.TCPAddr, error) {
 	tcpAddr, err := net.ResolveTCPAddr(netType, spec)
 	if err != nil {
 		return nil, errors.Annotate(err).Reason("could not resolve %(net)q address %(addr)q").
@@ -66,12 +151,21 @@ func newTCPServerImpl(ctx context.Context, netType, spec string, loopback net.IP
 	if tcpAddr.IP == nil {
 		tcpAddr.IP = loopback
 	}
+	return tcpAddr, nil
+}
+
+// newTCPListenerStreamServer returns a listenerStreamServer that listens on
+// tcpAddr. Its address is prefixed with scheme.
+//
+// If tlsConfig is not nil, the server's connections will use TLS.
+func newTCPListenerStreamServer(ctx context.Context, scheme, netType string, tcpAddr *net.TCPAddr,
+	tlsConfig *tls.Config) *listenerStreamServer {
 
 	return &listenerStreamServer{
 		Context: ctx,
-		address: fmt.Sprintf("%s:%s", netType, tcpAddr.String()),
+		address: fmt.Sprintf("%s:%s", scheme, tcpAddr.String()),
 		gen: func() (net.Listener, string, error) {
-			l, err := net.ListenTCP(netType, tcpAddr)
+			tl, err := net.ListenTCP(netType, tcpAddr)
 			if err != nil {
 				return nil, "", errors.Annotate(err).Reason("failed to listen to %(net)q address %(addr)q").
 					D("net", netType).
@@ -79,11 +173,54 @@ func newTCPServerImpl(ctx context.Context, netType, spec string, loopback net.IP
 					Err()
 			}
 
-			addr := fmt.Sprintf("%s:%s", netType, l.Addr().String())
+			l := net.Listener(tl)
+			if tlsConfig != nil {
+				l = tls.NewListener(l, tlsConfig)
+			}
+
+			addr := fmt.Sprintf("%s:%s", scheme, l.Addr().String())
 			log.Fields{
 				"addr": addr,
-			}.Debugf(ctx, "Listening on %q stream server...", netType)
+			}.Debugf(ctx, "Listening on %q stream server...", scheme)
 			return l, addr, nil
 		},
+	}
+}
+
+// generateCertificate generates a self-signed TLS certificate for ip.
+//
+// Clients identify the certificate by its fingerprint rather than by a chain
+// of trust, so its validity period is generous.
+func generateCertificate(ctx context.Context, ip net.IP) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now(ctx)
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: "LogDog Butler stream server",
+		},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(certValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses: []net.IP{ip},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
 	}, nil
 }
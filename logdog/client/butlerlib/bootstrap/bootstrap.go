@@ -5,14 +5,19 @@
 package bootstrap
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/luci/luci-go/client/environ"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamclient"
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
 	"github.com/luci/luci-go/logdog/common/viewer"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+	"github.com/luci/luci-go/lucictx"
+
+	"golang.org/x/net/context"
 )
 
 // ErrNotBootstrapped is returned by Get when the current process is not
@@ -42,7 +47,12 @@ type Bootstrap struct {
 	Client streamclient.Client
 }
 
-func getFromEnv(env environ.Environment, reg *streamclient.Registry) (*Bootstrap, error) {
+// getFromEnv loads a Bootstrap from env.
+//
+// ld is the LUCI_CONTEXT "logdog" section, or nil if there is none. If the
+// environment does not include stream server credentials, they are taken from
+// ld when it advertises the same stream server.
+func getFromEnv(env environ.Environment, reg *streamclient.Registry, ld *lucictx.LogDog) (*Bootstrap, error) {
 	// Detect Butler by looking for EnvStreamPrefix in the envrironent.
 	prefix, ok := env[EnvStreamPrefix]
 	if !ok {
@@ -63,7 +73,11 @@ func getFromEnv(env environ.Environment, reg *streamclient.Registry) (*Bootstrap
 
 	// If we have a stream server attached; instantiate a stream Client.
 	if p, ok := env[EnvStreamServerPath]; ok {
-		if err := bs.initializeClient(p, reg); err != nil {
+		creds, err := getCredentials(env, p, ld)
+		if err != nil {
+			return nil, fmt.Errorf("bootstrap: failed to load stream server credentials: %s", err)
+		}
+		if err := bs.initializeClient(p, reg, creds); err != nil {
 			return nil, fmt.Errorf("bootstrap: failed to create stream client [%s]: %s", p, err)
 		}
 	}
@@ -71,8 +85,31 @@ func getFromEnv(env environ.Environment, reg *streamclient.Registry) (*Bootstrap
 	return bs, nil
 }
 
-func (bs *Bootstrap) initializeClient(v string, reg *streamclient.Registry) error {
-	c, err := reg.NewClient(v)
+// getCredentials returns the credentials for the stream server at path, or nil
+// if none are available.
+func getCredentials(env environ.Environment, path string, ld *lucictx.LogDog) (*streamproto.Credentials, error) {
+	if v, ok := env[EnvStreamServerToken]; ok {
+		token, err := hex.DecodeString(v)
+		if err != nil {
+			return nil, errors.Annotate(err).Reason("invalid token in %(key)s").D("key", EnvStreamServerToken).Err()
+		}
+		return &streamproto.Credentials{
+			Token:           token,
+			CertFingerprint: env[EnvStreamServerCertFingerprint],
+		}, nil
+	}
+
+	if ld != nil && ld.StreamServer == path {
+		return &streamproto.Credentials{
+			Token:           ld.Token,
+			CertFingerprint: ld.CertFingerprint,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (bs *Bootstrap) initializeClient(v string, reg *streamclient.Registry, creds *streamproto.Credentials) error {
+	c, err := reg.NewAuthClient(v, creds)
 	if err != nil {
 		return errors.Annotate(err).Reason("bootstrap: failed to create stream client [%(config)s]").D("config", v).Err()
 	}
@@ -83,8 +120,11 @@ func (bs *Bootstrap) initializeClient(v string, reg *streamclient.Registry) erro
 // Get loads a Bootstrap instance from the environment. It will return an error
 // if the bootstrap data is invalid, and will return ErrNotBootstrapped if the
 // current process is not bootstrapped.
+//
+// Credentials for an authenticated stream server are loaded from the
+// environment or, failing that, from the process's LUCI_CONTEXT.
 func Get() (*Bootstrap, error) {
-	return getFromEnv(environ.Get(), streamclient.GetDefaultRegistry())
+	return getFromEnv(environ.Get(), streamclient.GetDefaultRegistry(), lucictx.GetLogDog(context.Background()))
 }
 
 // GetViewerURL returns a log stream viewer URL to the aggregate set of supplied
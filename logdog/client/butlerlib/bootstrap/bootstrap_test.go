@@ -13,6 +13,7 @@ import (
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamclient"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/lucictx"
 
 	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
@@ -43,13 +44,18 @@ func TestBootstrap(t *testing.T) {
 			regSpec = spec
 			return &sentinelClient{}, regErr
 		})
+		var regCreds *streamproto.Credentials
+		reg.RegisterAuth("test+auth", func(spec string, creds *streamproto.Credentials) (streamclient.Client, error) {
+			regSpec, regCreds = spec, creds
+			return &sentinelClient{}, regErr
+		})
 
 		env := environ.Environment{
 			"IRRELEVANT": "VALUE",
 		}
 
 		Convey(`With no Butler values will return ErrNotBootstrapped.`, func() {
-			_, err := getFromEnv(env, reg)
+			_, err := getFromEnv(env, reg, nil)
 			So(err, ShouldEqual, ErrNotBootstrapped)
 		})
 
@@ -58,7 +64,7 @@ func TestBootstrap(t *testing.T) {
 			env[EnvStreamPrefix] = "butler/prefix"
 
 			Convey(`Yields a Bootstrap with a Project, Prefix, and no Client.`, func() {
-				bs, err := getFromEnv(env, reg)
+				bs, err := getFromEnv(env, reg, nil)
 				So(err, ShouldBeNil)
 
 				So(bs, ShouldResemble, &Bootstrap{
@@ -72,7 +78,7 @@ func TestBootstrap(t *testing.T) {
 				env[EnvCoordinatorHost] = "example.appspot.com"
 
 				Convey(`Yields a fully-populated Bootstrap.`, func() {
-					bs, err := getFromEnv(env, reg)
+					bs, err := getFromEnv(env, reg, nil)
 					So(err, ShouldBeNil)
 
 					// Check that the client is populated, so we can test the remaining
@@ -90,26 +96,72 @@ func TestBootstrap(t *testing.T) {
 
 				Convey(`If Client creation fails, will fail.`, func() {
 					regErr = errors.New("testing error")
-					_, err := getFromEnv(env, reg)
+					_, err := getFromEnv(env, reg, nil)
 					So(err, ShouldErrLike, "failed to create stream client")
 				})
 			})
 
+			Convey(`With an authenticated stream server`, func() {
+				env[EnvStreamServerPath] = "test+auth:params"
+				ld := &lucictx.LogDog{
+					StreamServer:    "test+auth:params",
+					Token:           []byte("context token"),
+					CertFingerprint: "context fingerprint",
+				}
+
+				Convey(`Loads credentials from the environment.`, func() {
+					env[EnvStreamServerToken] = "1234"
+					env[EnvStreamServerCertFingerprint] = "fingerprint"
+
+					_, err := getFromEnv(env, reg, ld)
+					So(err, ShouldBeNil)
+					So(regSpec, ShouldEqual, "params")
+					So(regCreds, ShouldResemble, &streamproto.Credentials{
+						Token:           []byte{0x12, 0x34},
+						CertFingerprint: "fingerprint",
+					})
+				})
+
+				Convey(`Fails if the environment token is invalid.`, func() {
+					env[EnvStreamServerToken] = "not hex"
+
+					_, err := getFromEnv(env, reg, ld)
+					So(err, ShouldErrLike, "invalid token")
+				})
+
+				Convey(`Loads credentials from LUCI_CONTEXT for the same stream server.`, func() {
+					_, err := getFromEnv(env, reg, ld)
+					So(err, ShouldBeNil)
+					So(regCreds, ShouldResemble, &streamproto.Credentials{
+						Token:           []byte("context token"),
+						CertFingerprint: "context fingerprint",
+					})
+				})
+
+				Convey(`Ignores LUCI_CONTEXT credentials for a different stream server.`, func() {
+					ld.StreamServer = "test+auth:other"
+
+					_, err := getFromEnv(env, reg, ld)
+					So(err, ShouldBeNil)
+					So(regCreds, ShouldBeNil)
+				})
+			})
+
 			Convey(`With an invalid Butler prefix, will fail.`, func() {
 				env[EnvStreamPrefix] = "_notavaildprefix"
-				_, err := getFromEnv(env, reg)
+				_, err := getFromEnv(env, reg, nil)
 				So(err, ShouldErrLike, "failed to validate prefix")
 			})
 
 			Convey(`With an missing Butler project, will fail.`, func() {
 				delete(env, EnvStreamProject)
-				_, err := getFromEnv(env, reg)
+				_, err := getFromEnv(env, reg, nil)
 				So(err, ShouldErrLike, "failed to validate project")
 			})
 
 			Convey(`With an invalid Butler project, will fail.`, func() {
 				env[EnvStreamProject] = "_notavaildproject"
-				_, err := getFromEnv(env, reg)
+				_, err := getFromEnv(env, reg, nil)
 				So(err, ShouldErrLike, "failed to validate project")
 			})
 		})
@@ -166,7 +218,7 @@ func TestBootstrapURLGeneration(t *testing.T) {
 				return &sentinelClient{}, nil
 			})
 
-			So(bs.initializeClient("test:", &reg), ShouldBeNil)
+			So(bs.initializeClient("test:", &reg, nil), ShouldBeNil)
 			So(bs.Client, ShouldHaveSameTypeAs, &sentinelClient{})
 
 			Convey(`Can generate viewer URLs for streams.`, func() {
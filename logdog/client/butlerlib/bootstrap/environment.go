@@ -14,6 +14,18 @@ const (
 	// processes.
 	EnvStreamServerPath = "LOGDOG_STREAM_SERVER_PATH"
 
+	// EnvStreamServerToken is the hex-encoded secret token that the stream
+	// server at EnvStreamServerPath requires its clients to present.
+	//
+	// This is only set for authenticated stream servers (e.g., "tcp4+tls").
+	EnvStreamServerToken = "LOGDOG_STREAM_SERVER_TOKEN"
+
+	// EnvStreamServerCertFingerprint is the fingerprint of the TLS certificate
+	// of the stream server at EnvStreamServerPath.
+	//
+	// This is only set for TLS stream servers (e.g., "tcp4+tls").
+	EnvStreamServerCertFingerprint = "LOGDOG_STREAM_SERVER_CERT_FINGERPRINT"
+
 	// EnvStreamProject is the environment variable set to the configured stream
 	// project name.
 	EnvStreamProject = "LOGDOG_STREAM_PROJECT"
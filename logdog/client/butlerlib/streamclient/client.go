@@ -29,6 +29,10 @@ type streamFactory func() (io.WriteCloser, error)
 type clientImpl struct {
 	// network is the connection path to the stream server.
 	factory streamFactory
+
+	// token, if not empty, is the secret token to present to the stream server
+	// during the handshake.
+	token []byte
 }
 
 // New instantiates a new Client instance. This type of instance will be parsed
@@ -38,6 +42,11 @@ type clientImpl struct {
 // Supported protocols and their respective specs are:
 //   - unix:/path/to/socket describes a stream server listening on UNIX domain
 //     socket at "/path/to/socket".
+//   - tcp4:addr:port and tcp6:addr:port describe a stream server listening on
+//     a TCP port.
+//
+// Stream servers that require credentials ("tcp4+tls" and "tcp6+tls") must be
+// connected to using a Registry's NewAuthClient.
 //
 // Windows-only:
 //   - net.pipe:name describes a stream server listening on Windows named pipe
@@ -69,7 +78,7 @@ func (c *clientImpl) NewStream(f streamproto.Flags) (Stream, error) {
 		return nil, fmt.Errorf("failed to marshal properties JSON: %s", err)
 	}
 
-	// Perform the handshake: magic + size(data) + data.
+	// Perform the handshake: magic + [size(token) + token] + size(data) + data.
 	s := &BaseStream{
 		WriteCloser: client,
 		P:           p,
@@ -77,6 +86,11 @@ func (c *clientImpl) NewStream(f streamproto.Flags) (Stream, error) {
 	if _, err := s.writeRaw(streamproto.ProtocolFrameHeaderMagic); err != nil {
 		return nil, fmt.Errorf("failed to write magic number: %s", err)
 	}
+	if len(c.token) > 0 {
+		if err := s.writeRecord(c.token); err != nil {
+			return nil, fmt.Errorf("failed to write token: %s", err)
+		}
+	}
 	if err := s.writeRecord(data); err != nil {
 		return nil, fmt.Errorf("failed to write properties: %s", err)
 	}
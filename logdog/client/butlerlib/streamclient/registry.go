@@ -8,12 +8,20 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+
+	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 )
 
 // ClientFactory is a generator function that is invoked by the Registry when a
 // new Client is requested for its protocol.
 type ClientFactory func(string) (Client, error)
 
+// AuthClientFactory is a generator function that is invoked by the Registry
+// when a new Client is requested for its protocol. Unlike ClientFactory, it is
+// also supplied the Credentials to present to the stream server, which may be
+// nil if none were provided.
+type AuthClientFactory func(string, *streamproto.Credentials) (Client, error)
+
 // Registry maps protocol prefix strings to their Client generator functions.
 //
 // This allows multiple Butler stream protocols (e.g., "unix:", "net.pipe:",
@@ -24,7 +32,7 @@ type Registry struct {
 
 	// protocols is the set of registered protocols. Each client should register
 	// via registerProtocol in its init() method.
-	protocols map[string]ClientFactory
+	protocols map[string]AuthClientFactory
 }
 
 // Register registers a new protocol and its ClientFactory.
@@ -32,6 +40,16 @@ type Registry struct {
 // This can be invoked by calling NewClient with a path spec referencing that
 // protocol.
 func (r *Registry) Register(name string, f ClientFactory) {
+	r.RegisterAuth(name, func(spec string, _ *streamproto.Credentials) (Client, error) {
+		return f(spec)
+	})
+}
+
+// RegisterAuth registers a new protocol and its AuthClientFactory.
+//
+// This can be invoked by calling NewClient or NewAuthClient with a path spec
+// referencing that protocol.
+func (r *Registry) RegisterAuth(name string, f AuthClientFactory) {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
@@ -39,7 +57,7 @@ func (r *Registry) Register(name string, f ClientFactory) {
 		panic(fmt.Errorf("streamclient: protocol already registered for [%s]", name))
 	}
 	if r.protocols == nil {
-		r.protocols = make(map[string]ClientFactory)
+		r.protocols = make(map[string]AuthClientFactory)
 	}
 	r.protocols[name] = f
 }
@@ -47,6 +65,12 @@ func (r *Registry) Register(name string, f ClientFactory) {
 // NewClient invokes the protocol ClientFactory generator for the
 // supplied protocol/address string, returning the generated Client.
 func (r *Registry) NewClient(path string) (Client, error) {
+	return r.NewAuthClient(path, nil)
+}
+
+// NewAuthClient is like NewClient, but also supplies the Credentials that the
+// generated Client should present to its stream server. creds may be nil.
+func (r *Registry) NewAuthClient(path string, creds *streamproto.Credentials) (Client, error) {
 	parts := strings.SplitN(path, ":", 2)
 	value := ""
 	if len(parts) == 2 {
@@ -57,7 +81,7 @@ func (r *Registry) NewClient(path string) (Client, error) {
 	defer r.lock.Unlock()
 
 	if f, ok := r.protocols[parts[0]]; ok {
-		return f(value)
+		return f(value, creds)
 	}
 	return nil, fmt.Errorf("streamclient: no protocol registered for [%s]", parts[0])
 }
@@ -87,4 +111,6 @@ func RegisterDefaultProtocols(r *Registry) {
 	// Register common protocols.
 	r.Register("tcp4", tcpProtocolClientFactory("tcp4"))
 	r.Register("tcp6", tcpProtocolClientFactory("tcp6"))
+	r.RegisterAuth("tcp4+tls", tlsProtocolClientFactory("tcp4"))
+	r.RegisterAuth("tcp6+tls", tlsProtocolClientFactory("tcp6"))
 }
@@ -0,0 +1,36 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamproto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// MaxTokenSize is the maximum size of an authentication token.
+const MaxTokenSize = 1024
+
+// Credentials are the secrets that a client presents to an authenticated
+// stream server.
+//
+// When a stream server requires a token, the client writes it as a recordio
+// frame immediately after ProtocolFrameHeaderMagic, before the stream's JSON
+// description.
+type Credentials struct {
+	// Token is the secret token that the client presents during the handshake.
+	Token []byte
+
+	// CertFingerprint is the stream server's TLS certificate fingerprint, as
+	// returned by CertFingerprint. A client will refuse to connect to (and send
+	// Token to) a TLS stream server that presents a different certificate.
+	CertFingerprint string
+}
+
+// CertFingerprint returns the hex-encoded SHA256 fingerprint of a DER-encoded
+// certificate.
+func CertFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
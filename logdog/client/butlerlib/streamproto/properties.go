@@ -37,6 +37,18 @@ type Properties struct {
 	// Note that this value is best-effort, as it is subject to the constraints
 	// of the underlying transport medium.
 	Deadline time.Duration
+
+	// ByteLimit, if > 0, is the maximum number of bytes of data that the Butler
+	// will accept for this stream. Data beyond it is discarded.
+	//
+	// The Butler may also impose its own, stricter limit.
+	ByteLimit int64
+	// RateLimit, if > 0, is the maximum rate, in bytes per second, at which the
+	// Butler will accept data for this stream. Data that arrives faster is
+	// discarded.
+	//
+	// The Butler may also impose its own, stricter limit.
+	RateLimit int64
 }
 
 // Validate validates that the configured Properties are valid and sufficient to
@@ -72,6 +84,9 @@ type Flags struct {
 	Tee      TeeType            `json:"tee,omitempty"`
 	Timeout  clockflag.Duration `json:"timeout,omitempty"`
 	Deadline clockflag.Duration `json:"deadline,omitempty"`
+
+	ByteLimit int64 `json:"byteLimit,omitempty"`
+	RateLimit int64 `json:"rateLimit,omitempty"`
 }
 
 // Properties converts the Flags to a standard Properties structure.
@@ -95,6 +110,9 @@ func (f *Flags) Properties() *Properties {
 		Tee:      f.Tee,
 		Timeout:  time.Duration(f.Timeout),
 		Deadline: time.Duration(f.Deadline),
+
+		ByteLimit: f.ByteLimit,
+		RateLimit: f.RateLimit,
 	}
 	return p
 }
@@ -5,11 +5,12 @@
 package cli
 
 import (
-	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/client/coordinator"
@@ -24,6 +25,10 @@ const (
 	defaultBytes = 1024 * 1024 * 1 // 1 MB
 
 	noStreamDelay = 5 * time.Second
+
+	// transientErrorDelay is the amount of time to wait before retrying after a
+	// transient error, when following a log stream.
+	transientErrorDelay = 5 * time.Second
 )
 
 // coordinatorSource is a fetcher.Source implementation that uses the
@@ -35,6 +40,10 @@ type coordinatorSource struct {
 	tidx      types.MessageIndex
 	tailFirst bool
 
+	// follow, if true, causes transient errors to be retried indefinitely
+	// rather than returned.
+	follow bool
+
 	streamState *coordinator.LogStream
 }
 
@@ -64,6 +73,10 @@ func (s *coordinatorSource) LogEntries(c context.Context, req *fetcher.LogReques
 			if reqStream {
 				s.streamState = &streamState
 				s.tidx = streamState.State.TerminalIndex
+
+				if t := streamState.State.Tombstone; t != nil {
+					return nil, 0, &streamPurgedError{t}
+				}
 			}
 			return logs, s.tidx, nil
 
@@ -76,13 +89,28 @@ func (s *coordinatorSource) LogEntries(c context.Context, req *fetcher.LogReques
 			}
 
 		default:
-			if err != nil {
+			if !(s.follow && errors.IsTransient(err)) {
 				return nil, 0, err
 			}
+			log.WithError(err).Warningf(c, "Transient error fetching logs. Retrying.")
+
+			if tr := <-clock.After(c, transientErrorDelay); tr.Incomplete() {
+				return nil, 0, tr.Err
+			}
 		}
 	}
 }
 
+// streamPurgedError is returned by coordinatorSource when the log stream's log
+// data has been purged.
+type streamPurgedError struct {
+	*coordinator.Tombstone
+}
+
+func (e *streamPurgedError) Error() string {
+	return fmt.Sprintf("log stream was purged at %s: %s", e.Purged.Format(time.RFC3339), e.Reason)
+}
+
 func (s *coordinatorSource) descriptor() (*logpb.LogStreamDescriptor, error) {
 	if s.streamState != nil {
 		return &s.streamState.Desc, nil
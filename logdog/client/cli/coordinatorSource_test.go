@@ -0,0 +1,98 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/coordinator"
+	"github.com/luci/luci-go/logdog/common/fetcher"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testLogsClient implements the Get endpoint, instrumented for testing.
+type testLogsClient struct {
+	logdog.LogsClient
+
+	getCalls int
+	GH       func(*logdog.GetRequest) (*logdog.GetResponse, error)
+}
+
+func (s *testLogsClient) Get(c context.Context, req *logdog.GetRequest, o ...grpc.CallOption) (*logdog.GetResponse, error) {
+	s.getCalls++
+	return s.GH(req)
+}
+
+func TestCoordinatorSource(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A coordinatorSource bound to a testing Coordinator`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+
+		svc := testLogsClient{}
+		coord := coordinator.Client{C: &svc}
+
+		src := coordinatorSource{
+			stream: coord.Stream("proj", "test/+/a"),
+		}
+		src.tidx = -1
+
+		// The Coordinator is unavailable once, and then succeeds. This is the error
+		// that the pRPC client returns once it is done retrying.
+		svc.GH = func(*logdog.GetRequest) (*logdog.GetResponse, error) {
+			if svc.getCalls == 1 {
+				return nil, grpcutil.Unavailable
+			}
+			return &logdog.GetResponse{
+				State: &logdog.LogStreamState{
+					Created:       google.NewTimestamp(testclock.TestTimeUTC),
+					TerminalIndex: 0,
+				},
+				Desc: &logpb.LogStreamDescriptor{
+					Prefix:     "test",
+					Name:       "a",
+					StreamType: logpb.StreamType_TEXT,
+				},
+				Logs: []*logpb.LogEntry{{StreamIndex: 0}},
+			}, nil
+		}
+
+		delays := 0
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			delays++
+			tc.Add(d)
+		})
+
+		req := fetcher.LogRequest{Count: 1}
+
+		Convey(`When following, retries the transient error.`, func() {
+			src.follow = true
+
+			logs, tidx, err := src.LogEntries(c, &req)
+			So(err, ShouldBeNil)
+			So(logs, ShouldHaveLength, 1)
+			So(tidx, ShouldEqual, 0)
+			So(svc.getCalls, ShouldEqual, 2)
+			So(delays, ShouldEqual, 1)
+		})
+
+		Convey(`When not following, returns the transient error.`, func() {
+			_, _, err := src.LogEntries(c, &req)
+			So(err, ShouldNotBeNil)
+			So(svc.getCalls, ShouldEqual, 1)
+		})
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/luci/luci-go/common/errors"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/logging/gologger"
+	_ "github.com/luci/luci-go/common/logging/structlogger" // Register the structured log formats.
 	"github.com/luci/luci-go/grpc/prpc"
 	"github.com/luci/luci-go/logdog/client/coordinator"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -161,7 +162,9 @@ func Main(ctx context.Context, params Parameters) int {
 				newCatCommand(),
 				newQueryCommand(),
 				newListCommand(),
+				newSearchCommand(),
 				newLatestCommand(),
+				newArchiveCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login", false),
 				authcli.SubcommandLogout(authOptions, "auth-logout", false),
 				authcli.SubcommandInfo(authOptions, "auth-info", false),
@@ -0,0 +1,756 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/archive"
+	"github.com/luci/luci-go/logdog/common/renderer"
+	"github.com/luci/luci-go/logdog/common/storage"
+	archiveStorage "github.com/luci/luci-go/logdog/common/storage/archive"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+)
+
+const (
+	// archiveIndexPath and archiveLogPath are the paths at which
+	// localArchiveClient serves the index and log stream files.
+	archiveIndexPath = gs.Path("gs://local/logstream.index")
+	archiveLogPath   = gs.Path("gs://local/logstream.entries")
+
+	// archiveFetchCount is the number of log entries that archiveSource fetches
+	// at a time.
+	archiveFetchCount = 256
+)
+
+// newArchiveCommand returns the "archive" command group, whose subcommands
+// inspect local LogDog archive files.
+func newArchiveCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "archive <subcommand> [options...]",
+		ShortDesc: "Inspect local LogDog archive files.",
+		LongDesc: "Inspects the files of an archived log stream: its log stream (-log-file), its index " +
+			"(-index-file), and its data (-data-file). These do not need a Coordinator.\n\n" +
+			"Run \"logdog archive help\" for a list of subcommands.",
+		CommandRun: func() subcommands.CommandRun { return &archiveCommandRun{} },
+	}
+}
+
+type archiveCommandRun struct {
+	subcommands.CommandRunBase
+}
+
+func (cmd *archiveCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	app := cli.Application{
+		Name:    "logdog archive",
+		Title:   "LogDog archive file inspector",
+		Context: func(context.Context) context.Context { return a },
+
+		Commands: []*subcommands.Command{
+			subcommands.CmdHelp,
+			newArchiveVerifyCommand(),
+			newArchiveDescribeCommand(),
+			newArchiveCatCommand(),
+			newArchiveReindexCommand(),
+		},
+	}
+	return subcommands.Run(&app, args)
+}
+
+// archiveFiles are the flags that name an archived log stream's files.
+type archiveFiles struct {
+	logPath   string
+	indexPath string
+}
+
+func (f *archiveFiles) addToFlagSet(fs *flag.FlagSet) {
+	fs.StringVar(&f.logPath, "log-file", "", "Path to the archived log stream file.")
+	fs.StringVar(&f.indexPath, "index-file", "", "Path to the archived log stream's index file.")
+}
+
+// openLog opens the log stream file and detects its compression.
+func (f *archiveFiles) openLog() (*os.File, logpb.LogIndex_Compression, error) {
+	if f.logPath == "" {
+		return nil, 0, errors.New("a log stream file must be specified (-log-file)")
+	}
+
+	fd, err := os.Open(f.logPath)
+	if err != nil {
+		return nil, 0, errors.Annotate(err).Reason("failed to open log stream file").Err()
+	}
+
+	head := make([]byte, 16)
+	n, err := io.ReadFull(fd, head)
+	if err == nil || err == io.ErrUnexpectedEOF || err == io.EOF {
+		_, err = fd.Seek(0, 0)
+	}
+	if err != nil {
+		fd.Close()
+		return nil, 0, errors.Annotate(err).Reason("failed to read log stream file").Err()
+	}
+	return fd, archive.DetectCompression(head[:n]), nil
+}
+
+// loadIndex loads the index file, returning its raw data and its contents.
+func (f *archiveFiles) loadIndex() ([]byte, *logpb.LogIndex, error) {
+	d, err := ioutil.ReadFile(f.indexPath)
+	if err != nil {
+		return nil, nil, errors.Annotate(err).Reason("failed to read index file").Err()
+	}
+
+	var index logpb.LogIndex
+	if err := proto.Unmarshal(d, &index); err != nil {
+		return nil, nil, errors.Annotate(err).Reason("failed to unmarshal index").Err()
+	}
+	return d, &index, nil
+}
+
+// localArchiveClient is a read-only gs.Client that serves an archived log
+// stream's local files to an archive Storage.
+type localArchiveClient struct {
+	index   []byte
+	logPath string
+}
+
+var _ gs.Client = (*localArchiveClient)(nil)
+
+func (c *localArchiveClient) Close() error { return nil }
+
+func (c *localArchiveClient) NewReader(p gs.Path, offset, length int64) (io.ReadCloser, error) {
+	var r io.ReadCloser
+	switch p {
+	case archiveIndexPath:
+		d := c.index
+		if offset > int64(len(d)) {
+			offset = int64(len(d))
+		}
+		r = ioutil.NopCloser(bytes.NewReader(d[offset:]))
+
+	case archiveLogPath:
+		fd, err := os.Open(c.logPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fd.Seek(offset, 0); err != nil {
+			fd.Close()
+			return nil, err
+		}
+		r = fd
+
+	default:
+		return nil, os.ErrNotExist
+	}
+
+	if length >= 0 {
+		r = struct {
+			io.Reader
+			io.Closer
+		}{io.LimitReader(r, length), r}
+	}
+	return r, nil
+}
+
+func (c *localArchiveClient) NewWriter(gs.Path) (gs.Writer, error) {
+	return nil, errors.New("archive files are read-only")
+}
+
+func (c *localArchiveClient) Delete(gs.Path) error {
+	return errors.New("archive files are read-only")
+}
+
+func (c *localArchiveClient) Rename(gs.Path, gs.Path) error {
+	return errors.New("archive files are read-only")
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Subcommand: verify
+////////////////////////////////////////////////////////////////////////////////
+
+type archiveVerifyCommandRun struct {
+	subcommands.CommandRunBase
+	archiveFiles
+
+	dataPath string
+}
+
+func newArchiveVerifyCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "verify -log-file PATH [-index-file PATH] [-data-file PATH]",
+		ShortDesc: "Validate archive files.",
+		LongDesc: "Validates the framing and the log entries of an archived log stream. If an index is " +
+			"supplied, checks that it is consistent with the log stream. If a data file is supplied, " +
+			"checks that it matches the log stream's content.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &archiveVerifyCommandRun{}
+			cmd.archiveFiles.addToFlagSet(&cmd.Flags)
+			cmd.Flags.StringVar(&cmd.dataPath, "data-file", "", "Path to the archived log stream's data file.")
+			return cmd
+		},
+	}
+}
+
+func (cmd *archiveVerifyCommandRun) Run(scApp subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(scApp, cmd, env)
+
+	fd, codec, err := cmd.openLog()
+	if err != nil {
+		errors.Log(c, err)
+		return 1
+	}
+	defer fd.Close()
+
+	problems := 0
+	report := func(f string, args ...interface{}) {
+		fmt.Printf("FAIL: "+f+"\n", args...)
+		problems++
+	}
+
+	// Read the entire log stream. This validates its framing.
+	lr, err := archive.NewLogStreamReader(fd, codec)
+	if err != nil {
+		report("log stream: %s", err)
+		return 1
+	}
+	var (
+		count uint64
+		prev  *logpb.LogEntry
+		gaps  int
+	)
+	for {
+		rec, err := lr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			report("log stream: %s", err)
+			break
+		}
+		count++
+
+		le := rec.LogEntry
+		switch {
+		case prev == nil:
+			if le.StreamIndex != 0 {
+				gaps++
+			}
+		case le.StreamIndex <= prev.StreamIndex:
+			report("log stream: stream index %d follows %d", le.StreamIndex, prev.StreamIndex)
+		case le.PrefixIndex < prev.PrefixIndex:
+			report("log stream: prefix index %d (stream index %d) follows %d",
+				le.PrefixIndex, le.StreamIndex, prev.PrefixIndex)
+		case google.DurationFromProto(le.TimeOffset) < google.DurationFromProto(prev.TimeOffset):
+			report("log stream: time offset of stream index %d precedes the previous log entry's",
+				le.StreamIndex)
+		case le.StreamIndex != prev.StreamIndex+1:
+			gaps++
+		}
+		prev = le
+	}
+	fmt.Printf("Log stream %q: %s compression, %d log entries.\n", lr.Descriptor().Path(), codec, count)
+	if gaps > 0 {
+		fmt.Printf("WARNING: the log stream has %d gap(s) in its stream indices.\n", gaps)
+	}
+
+	if cmd.indexPath != "" {
+		_, index, err := cmd.loadIndex()
+		switch {
+		case err != nil:
+			report("index: %s", err)
+
+		case index.Compression != codec:
+			report("index: records %s compression, but the log stream has %s compression", index.Compression, codec)
+
+		default:
+			if _, err := fd.Seek(0, 0); err != nil {
+				errors.Log(c, errors.Annotate(err).Reason("failed to rewind log stream file").Err())
+				return 1
+			}
+
+			switch err := archive.VerifyIndex(index, fd).(type) {
+			case nil:
+				fmt.Printf("Index: %d index entries are consistent with the log stream.\n", len(index.Entries))
+			case errors.MultiError:
+				for _, ierr := range err {
+					report("index: %s", ierr)
+				}
+			default:
+				report("index: %s", err)
+			}
+		}
+	}
+
+	if cmd.dataPath != "" {
+		if err := cmd.verifyData(codec); err != nil {
+			report("data: %s", err)
+		} else {
+			fmt.Println("Data: matches the log stream's content.")
+		}
+	}
+
+	if problems > 0 {
+		fmt.Printf("Found %d problem(s).\n", problems)
+		return 1
+	}
+	fmt.Println("OK")
+	return 0
+}
+
+// verifyData checks that the data file matches the raw rendering of the log
+// stream.
+func (cmd *archiveVerifyCommandRun) verifyData(codec logpb.LogIndex_Compression) error {
+	fd, err := os.Open(cmd.dataPath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	data, err := archive.NewDecompressor(codec, fd)
+	if err != nil {
+		return errors.Annotate(err).Reason("failed to decompress data file").Err()
+	}
+
+	lfd, _, err := cmd.openLog()
+	if err != nil {
+		return err
+	}
+	defer lfd.Close()
+
+	lr, err := archive.NewLogStreamReader(lfd, codec)
+	if err != nil {
+		return err
+	}
+
+	cw := compareWriter{r: bufio.NewReader(data)}
+	if _, err := io.Copy(&cw, &renderer.Renderer{Source: logStreamSource{lr}, Raw: true}); err != nil {
+		return err
+	}
+	if _, err := cw.r.ReadByte(); err != io.EOF {
+		return fmt.Errorf("data file is longer than the log stream's content (%d byte(s))", cw.offset)
+	}
+	return nil
+}
+
+// logStreamSource is a renderer.Source that reads from a LogStreamReader.
+type logStreamSource struct {
+	*archive.LogStreamReader
+}
+
+func (s logStreamSource) NextLogEntry() (*logpb.LogEntry, error) {
+	rec, err := s.Next()
+	if err != nil {
+		return nil, err
+	}
+	return rec.LogEntry, nil
+}
+
+// compareWriter is an io.Writer that compares the data written to it with the
+// data read from a Reader.
+type compareWriter struct {
+	r      *bufio.Reader
+	offset int64
+}
+
+func (w *compareWriter) Write(d []byte) (int, error) {
+	for i, b := range d {
+		switch rb, err := w.r.ReadByte(); {
+		case err == io.EOF:
+			return i, fmt.Errorf("data file is shorter than the log stream's content (%d byte(s))", w.offset)
+		case err != nil:
+			return i, err
+		case rb != b:
+			return i, fmt.Errorf("data file differs from the log stream's content at offset %d", w.offset)
+		}
+		w.offset++
+	}
+	return len(d), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Subcommand: describe
+////////////////////////////////////////////////////////////////////////////////
+
+type archiveDescribeCommandRun struct {
+	subcommands.CommandRunBase
+	archiveFiles
+
+	showIndex bool
+}
+
+func newArchiveDescribeCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "describe [-log-file PATH] [-index-file PATH]",
+		ShortDesc: "Dump an archived log stream's descriptor.",
+		LongDesc: "Dumps the descriptor of an archived log stream, read from its log stream file or, " +
+			"failing that, its index. If an index is supplied, also summarizes it.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &archiveDescribeCommandRun{}
+			cmd.archiveFiles.addToFlagSet(&cmd.Flags)
+			cmd.Flags.BoolVar(&cmd.showIndex, "show-index", false, "Dump the index's entries too.")
+			return cmd
+		},
+	}
+}
+
+func (cmd *archiveDescribeCommandRun) Run(scApp subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(scApp, cmd, env)
+
+	if cmd.logPath == "" && cmd.indexPath == "" {
+		log.Errorf(c, "A log stream (-log-file) or index (-index-file) file must be specified.")
+		return 1
+	}
+
+	var index *logpb.LogIndex
+	if cmd.indexPath != "" {
+		var err error
+		if _, index, err = cmd.loadIndex(); err != nil {
+			errors.Log(c, err)
+			return 1
+		}
+	}
+
+	var desc *logpb.LogStreamDescriptor
+	if cmd.logPath != "" {
+		fd, codec, err := cmd.openLog()
+		if err != nil {
+			errors.Log(c, err)
+			return 1
+		}
+		defer fd.Close()
+
+		lr, err := archive.NewLogStreamReader(fd, codec)
+		if err != nil {
+			errors.Log(c, errors.Annotate(err).Reason("failed to read log stream").Err())
+			return 1
+		}
+		desc = lr.Descriptor()
+	} else {
+		desc = index.Desc
+	}
+
+	if err := proto.MarshalText(os.Stdout, desc); err != nil {
+		log.WithError(err).Errorf(c, "Failed to write descriptor.")
+		return 1
+	}
+
+	if index != nil {
+		fmt.Printf("\nIndex:\n"+
+			"  compression: %s\n"+
+			"  log entries: %d\n"+
+			"  last stream index: %d\n"+
+			"  last prefix index: %d\n"+
+			"  index entries: %d\n",
+			index.Compression, index.LogEntryCount, index.LastStreamIndex, index.LastPrefixIndex, len(index.Entries))
+
+		if cmd.showIndex {
+			for _, e := range index.Entries {
+				fmt.Printf("  - stream index %d (prefix index %d, sequence %d) at offset %d, +%s\n",
+					e.StreamIndex, e.PrefixIndex, e.Sequence, e.Offset, google.DurationFromProto(e.TimeOffset))
+			}
+		}
+	}
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Subcommand: cat
+////////////////////////////////////////////////////////////////////////////////
+
+type archiveCatCommandRun struct {
+	subcommands.CommandRunBase
+	archiveFiles
+
+	index     int64
+	count     int64
+	start     clockflag.Time
+	end       clockflag.Time
+	raw       bool
+	stripANSI bool
+
+	textPrefixFlags
+}
+
+func newArchiveCatCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "cat -log-file PATH [-index-file PATH]",
+		ShortDesc: "Write an archived log stream to STDOUT.",
+		LongDesc: "Renders an archived log stream's log entries, optionally constrained to a range of stream " +
+			"indices or of times. If no index is supplied, one is built from the log stream.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &archiveCatCommandRun{}
+			cmd.archiveFiles.addToFlagSet(&cmd.Flags)
+			cmd.Flags.Int64Var(&cmd.index, "index", 0, "Starting stream index.")
+			cmd.Flags.Int64Var(&cmd.count, "count", 0, "The number of log entries to render.")
+			cmd.Flags.Var(&cmd.start, "start", "Render log entries from this time (RFC3339).")
+			cmd.Flags.Var(&cmd.end, "end", "Render log entries up to this time (RFC3339).")
+			cmd.Flags.BoolVar(&cmd.raw, "raw", false,
+				"Reproduce original log stream, instead of attempting to render for humans.")
+			cmd.Flags.BoolVar(&cmd.stripANSI, "strip-ansi", false,
+				"When rendering text logs, remove their ANSI escape sequences (colors, cursor movement, etc.).")
+			cmd.textPrefixFlags.addToFlagSet(&cmd.Flags)
+			return cmd
+		},
+	}
+}
+
+func (cmd *archiveCatCommandRun) Run(scApp subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(scApp, cmd, env)
+
+	client := localArchiveClient{logPath: cmd.logPath}
+	var index *logpb.LogIndex
+	if cmd.indexPath != "" {
+		var err error
+		if client.index, index, err = cmd.loadIndex(); err != nil {
+			errors.Log(c, err)
+			return 1
+		}
+	} else {
+		// Build an index from the log stream, so that it can be read.
+		fd, codec, err := cmd.openLog()
+		if err != nil {
+			errors.Log(c, err)
+			return 1
+		}
+		index, err = archive.BuildIndex(fd, codec)
+		fd.Close()
+		if err != nil {
+			errors.Log(c, errors.Annotate(err).Reason("failed to build index").Err())
+			return 1
+		}
+		if client.index, err = proto.Marshal(index); err != nil {
+			log.WithError(err).Errorf(c, "Failed to marshal index.")
+			return 1
+		}
+	}
+
+	st, err := archiveStorage.New(c, archiveStorage.Options{
+		Index:  archiveIndexPath,
+		Stream: archiveLogPath,
+		Client: &client,
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create archive storage.")
+		return 1
+	}
+	defer st.Close()
+
+	desc := index.Desc
+	if desc == nil {
+		log.Errorf(c, "The index has no log stream descriptor.")
+		return 1
+	}
+
+	src := archiveSource{
+		st:         st,
+		next:       types.MessageIndex(cmd.index),
+		count:      cmd.count,
+		streamTime: google.TimeFromProto(desc.Timestamp),
+		start:      cmd.start.Time(),
+		end:        cmd.end.Time(),
+	}
+	src.seekTime(index)
+
+	rend := renderer.Renderer{
+		Source: &src,
+		Raw:    cmd.raw,
+		TextPrefix: func(le *logpb.LogEntry, line *logpb.Text_Line) string {
+			return cmd.getTextPrefix(desc, le)
+		},
+		DatagramWriter: getDatagramWriter(c, desc),
+	}
+	if cmd.stripANSI {
+		rend.TextMode = renderer.TextModeStrip
+	}
+	if _, err := io.Copy(os.Stdout, &rend); err != nil {
+		errors.Log(c, errors.Annotate(err).Reason("failed to render log stream").Err())
+		return 1
+	}
+	return 0
+}
+
+// archiveSource is a renderer.Source that reads log entries from an archive
+// Storage.
+type archiveSource struct {
+	st storage.Storage
+
+	// next is the stream index of the next log entry to fetch.
+	next types.MessageIndex
+	// count, if >0, is the maximum number of log entries to return.
+	count int64
+	// returned is the number of log entries that have been returned.
+	returned int64
+
+	// streamTime is the log stream's timestamp, to which log entries' time
+	// offsets are relative.
+	streamTime time.Time
+	// start and end, if not zero, bound the times of the returned log entries.
+	start time.Time
+	end   time.Time
+
+	buf  []*logpb.LogEntry
+	done bool
+}
+
+// seekTime advances next to the latest indexed log entry that precedes the
+// start time.
+func (s *archiveSource) seekTime(index *logpb.LogIndex) {
+	if s.start.IsZero() {
+		return
+	}
+
+	for _, e := range index.Entries {
+		if s.streamTime.Add(google.DurationFromProto(e.TimeOffset)).After(s.start) {
+			break
+		}
+		if idx := types.MessageIndex(e.StreamIndex); idx > s.next {
+			s.next = idx
+		}
+	}
+}
+
+func (s *archiveSource) NextLogEntry() (*logpb.LogEntry, error) {
+	for {
+		if s.done || (s.count > 0 && s.returned >= s.count) {
+			return nil, io.EOF
+		}
+
+		if len(s.buf) == 0 {
+			if err := s.fetch(); err != nil {
+				return nil, err
+			}
+			if len(s.buf) == 0 {
+				s.done = true
+				continue
+			}
+		}
+
+		le := s.buf[0]
+		s.buf = s.buf[1:]
+
+		ts := s.streamTime.Add(google.DurationFromProto(le.TimeOffset))
+		switch {
+		case !s.start.IsZero() && ts.Before(s.start):
+			continue
+		case !s.end.IsZero() && ts.After(s.end):
+			s.done = true
+			continue
+		}
+
+		s.returned++
+		return le, nil
+	}
+}
+
+func (s *archiveSource) fetch() error {
+	var ierr error
+	err := s.st.Get(storage.GetRequest{
+		Index: s.next,
+		Limit: archiveFetchCount,
+	}, func(e *storage.Entry) bool {
+		le, err := e.GetLogEntry()
+		if err != nil {
+			ierr = errors.Annotate(err).Reason("failed to unmarshal log entry").Err()
+			return false
+		}
+		s.buf = append(s.buf, le)
+		s.next = types.MessageIndex(le.StreamIndex) + 1
+		return len(s.buf) < archiveFetchCount
+	})
+	if err == nil {
+		err = ierr
+	}
+	return err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Subcommand: reindex
+////////////////////////////////////////////////////////////////////////////////
+
+type archiveReindexCommandRun struct {
+	subcommands.CommandRunBase
+	archiveFiles
+
+	force bool
+}
+
+func newArchiveReindexCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "reindex -log-file PATH -index-file PATH",
+		ShortDesc: "Rebuild an archived log stream's index.",
+		LongDesc: "Rebuilds the index of an archived log stream from its log stream file, and writes it to " +
+			"the index file.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &archiveReindexCommandRun{}
+			cmd.archiveFiles.addToFlagSet(&cmd.Flags)
+			cmd.Flags.BoolVar(&cmd.force, "force", false, "Overwrite an existing index file.")
+			return cmd
+		},
+	}
+}
+
+func (cmd *archiveReindexCommandRun) Run(scApp subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(scApp, cmd, env)
+
+	if cmd.indexPath == "" {
+		log.Errorf(c, "An index file to write must be specified (-index-file).")
+		return 1
+	}
+	if _, err := os.Stat(cmd.indexPath); err == nil && !cmd.force {
+		log.Fields{
+			"path": cmd.indexPath,
+		}.Errorf(c, "The index file already exists; use -force to overwrite it.")
+		return 1
+	}
+
+	fd, codec, err := cmd.openLog()
+	if err != nil {
+		errors.Log(c, err)
+		return 1
+	}
+	defer fd.Close()
+
+	index, err := archive.BuildIndex(fd, codec)
+	if err != nil {
+		errors.Log(c, errors.Annotate(err).Reason("failed to build index").Err())
+		return 1
+	}
+
+	d, err := proto.Marshal(index)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to marshal index.")
+		return 1
+	}
+	if err := ioutil.WriteFile(cmd.indexPath, d, 0644); err != nil {
+		log.WithError(err).Errorf(c, "Failed to write index file.")
+		return 1
+	}
+
+	log.Fields{
+		"path":         cmd.indexPath,
+		"compression":  codec,
+		"logEntries":   index.LogEntryCount,
+		"indexEntries": len(index.Entries),
+	}.Infof(c, "Wrote index.")
+	return 0
+}
@@ -5,12 +5,19 @@
 package cli
 
 import (
+	"bytes"
+	"flag"
+	"fmt"
 	"io"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/clockflag"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/flag/flagenum"
 	log "github.com/luci/luci-go/common/logging"
@@ -29,6 +36,19 @@ import (
 
 var errDatagramNotSupported = errors.New("datagram not supported")
 
+const (
+	// followDelay is the initial delay between polls of an idle log stream when
+	// following it.
+	followDelay = time.Second
+	// defaultFollowMaxDelay is the default maximum delay between polls of an
+	// idle log stream when following it.
+	defaultFollowMaxDelay = 30 * time.Second
+
+	// followQueryDelay is the delay between queries for new log streams when
+	// following a query.
+	followQueryDelay = 10 * time.Second
+)
+
 type timestampsFlag string
 
 const (
@@ -55,9 +75,13 @@ type catCommandRun struct {
 	fetchSize  int
 	fetchBytes int
 	raw        bool
+	stripANSI  bool
 
-	timestamps      timestampsFlag
-	showStreamIndex bool
+	textPrefixFlags
+
+	follow         bool
+	followMaxDelay clockflag.Duration
+	followQuery    string
 }
 
 func newCatCommand() *subcommands.Command {
@@ -65,14 +89,13 @@ func newCatCommand() *subcommands.Command {
 		UsageLine: "cat",
 		ShortDesc: "Write log stream to STDOUT.",
 		CommandRun: func() subcommands.CommandRun {
-			cmd := &catCommandRun{}
+			cmd := &catCommandRun{
+				followMaxDelay: clockflag.Duration(defaultFollowMaxDelay),
+			}
 
 			cmd.Flags.Int64Var(&cmd.index, "index", 0, "Starting index.")
 			cmd.Flags.Int64Var(&cmd.count, "count", 0, "The number of log entries to fetch.")
-			cmd.Flags.Var(&cmd.timestamps, "timestamps",
-				"When rendering text logs, prefix them with their timestamps. Options are: "+timestampFlagEnum.Choices())
-			cmd.Flags.BoolVar(&cmd.showStreamIndex, "show-stream-index", false,
-				"When rendering text logs, show their stream index.")
+			cmd.textPrefixFlags.addToFlagSet(&cmd.Flags)
 			cmd.Flags.IntVar(&cmd.buffer, "buffer", 64,
 				"The size of the read buffer. A smaller buffer will more responsive while streaming, whereas "+
 					"a larger buffer will have higher throughput.")
@@ -80,6 +103,17 @@ func newCatCommand() *subcommands.Command {
 			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
 			cmd.Flags.BoolVar(&cmd.raw, "raw", false,
 				"Reproduce original log stream, instead of attempting to render for humans.")
+			cmd.Flags.BoolVar(&cmd.stripANSI, "strip-ansi", false,
+				"When rendering text logs, remove their ANSI escape sequences (colors, cursor movement, etc.).")
+			cmd.Flags.BoolVar(&cmd.follow, "follow", false,
+				"Follow the log streams until they terminate, backing off while they are idle and retrying "+
+					"transient errors.")
+			cmd.Flags.Var(&cmd.followMaxDelay, "follow-max-delay",
+				"The maximum delay between polls of an idle log stream when following. "+clockflag.DurationHelp)
+			cmd.Flags.StringVar(&cmd.followQuery, "follow-query", "",
+				"Follow every text log stream matching this path query (may include globbing), including "+
+					"streams registered later, until interrupted. Implies -follow. Lines are prefixed with "+
+					"their stream path.")
 			return cmd
 		},
 	}
@@ -88,6 +122,15 @@ func newCatCommand() *subcommands.Command {
 func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
 	a := scApp.(*application)
 
+	if cmd.followQuery != "" {
+		cmd.follow = true
+		if len(args) > 0 {
+			log.Errorf(a, "Log paths cannot be supplied with -follow-query.")
+			return 1
+		}
+		return cmd.runFollowQuery(a)
+	}
+
 	if len(args) == 0 {
 		log.Errorf(a, "At least one log path must be supplied.")
 		return 1
@@ -153,7 +196,7 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 
 	tctx, _ := a.timeoutCtx(a)
 	for i, addr := range addrs {
-		if err := cmd.catPath(tctx, coords[addr.Host], addr); err != nil {
+		if err := cmd.catPath(tctx, coords[addr.Host], addr, os.Stdout, ""); err != nil {
 			log.Fields{
 				log.ErrorKey: err,
 				"project":    addr.Project,
@@ -171,20 +214,31 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 	return 0
 }
 
-func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client, addr *types.StreamAddr) error {
+// catPath renders a log stream to w.
+//
+// If prefix is not empty, it is written at the start of each text line.
+func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client, addr *types.StreamAddr,
+	w io.Writer, prefix string) error {
+
 	// Pull stream information.
 	src := coordinatorSource{
 		stream: coord.Stream(addr.Project, addr.Path),
+		follow: cmd.follow,
 	}
 	src.tidx = -1 // Must be set to probe for state.
 
-	f := fetcher.New(c, fetcher.Options{
+	fo := fetcher.Options{
 		Source:      &src,
 		Index:       types.MessageIndex(cmd.index),
 		Count:       cmd.count,
 		BufferCount: cmd.fetchSize,
 		BufferBytes: int64(cmd.fetchBytes),
-	})
+	}
+	if cmd.follow {
+		fo.Delay = followDelay
+		fo.MaxDelay = time.Duration(cmd.followMaxDelay)
+	}
+	f := fetcher.New(c, fo)
 
 	rend := renderer.Renderer{
 		Source: f,
@@ -195,7 +249,7 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 				log.WithError(err).Errorf(c, "Failed to get text prefix descriptor.")
 				return ""
 			}
-			return cmd.getTextPrefix(desc, le)
+			return prefix + cmd.getTextPrefix(desc, le)
 		},
 		DatagramWriter: func(w io.Writer, dg []byte) bool {
 			desc, err := src.descriptor()
@@ -206,18 +260,190 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 			return getDatagramWriter(c, desc)(w, dg)
 		},
 	}
-	if _, err := io.CopyBuffer(os.Stdout, &rend, make([]byte, cmd.buffer)); err != nil {
+	if cmd.stripANSI {
+		rend.TextMode = renderer.TextModeStrip
+	}
+	if _, err := io.CopyBuffer(w, &rend, make([]byte, cmd.buffer)); err != nil {
+		// Render a purged log stream's tombstone in place of its logs.
+		if pe, ok := err.(*streamPurgedError); ok {
+			_, err = fmt.Fprintf(w, "%s[%s]\n", prefix, pe.Error())
+		}
 		return err
 	}
 	return nil
 }
 
-func (cmd *catCommandRun) getTextPrefix(desc *logpb.LogStreamDescriptor, le *logpb.LogEntry) string {
+// runFollowQuery follows every text log stream matching the -follow-query
+// path, polling for newly-registered streams until it is interrupted.
+func (cmd *catCommandRun) runFollowQuery(a *application) int {
+	project, path, unified, err := a.splitPath(cmd.followQuery)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err).Reason("could not create Coordinator client").Err())
+		return 1
+	}
+
+	tctx, _ := a.timeoutCtx(a)
+
+	var (
+		outMu  sync.Mutex
+		wg     sync.WaitGroup
+		failed int32
+	)
+	seen := map[types.StreamPath]struct{}{}
+	follow := func(s *coordinator.LogStream) {
+		if _, ok := seen[s.Path]; ok {
+			return
+		}
+		seen[s.Path] = struct{}{}
+
+		name := string(s.Path)
+		if unified {
+			name = makeUnifiedPath(s.Project, s.Path)
+		}
+		log.Fields{
+			"path": name,
+		}.Infof(a, "Following log stream.")
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			lw := lineWriter{Writer: os.Stdout, mu: &outMu}
+			addr := types.StreamAddr{Project: s.Project, Path: s.Path}
+			err := cmd.catPath(tctx, coord, &addr, &lw, name+"| ")
+			if ferr := lw.flush(); err == nil {
+				err = ferr
+			}
+			if err != nil && tctx.Err() == nil {
+				log.Fields{
+					log.ErrorKey: err,
+					"path":       name,
+				}.Errorf(a, "Failed to follow log stream.")
+				atomic.StoreInt32(&failed, 1)
+			}
+		}()
+	}
+
+	// After the first query, only look for streams registered since the newest
+	// one that we've seen.
+	qo := coordinator.QueryOptions{
+		StreamType: coordinator.Text,
+		State:      true,
+	}
+	var queryErr error
+	for {
+		var found []*coordinator.LogStream
+		err := coord.Query(tctx, project, path, qo, func(s *coordinator.LogStream) bool {
+			found = append(found, s)
+			return true
+		})
+		switch {
+		case err == nil:
+			// Query results are newest-first; follow streams in registration
+			// order.
+			for i := len(found) - 1; i >= 0; i-- {
+				follow(found[i])
+				if created := found[i].State.Created; created.After(qo.After) {
+					qo.After = created
+				}
+			}
+
+		case tctx.Err() != nil:
+			// We've been interrupted.
+
+		case errors.IsTransient(err):
+			log.WithError(err).Warningf(a, "Transient error querying log streams. Retrying.")
+
+		default:
+			queryErr = err
+		}
+		if queryErr != nil {
+			break
+		}
+
+		if tr := <-clock.After(tctx, followQueryDelay); tr.Incomplete() {
+			break
+		}
+	}
+
+	wg.Wait()
+	switch {
+	case queryErr != nil:
+		log.WithError(queryErr).Errorf(a, "Failed to query log streams.")
+		return 1
+	case tctx.Err() == context.DeadlineExceeded:
+		return 2
+	case atomic.LoadInt32(&failed) != 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// lineWriter is an io.Writer that writes complete lines to an underlying
+// Writer shared with other lineWriters, so that their lines don't interleave.
+type lineWriter struct {
+	io.Writer
+
+	mu  *sync.Mutex
+	buf []byte
+}
+
+func (w *lineWriter) Write(d []byte) (int, error) {
+	w.buf = append(w.buf, d...)
+	if idx := bytes.LastIndexByte(w.buf, '\n'); idx >= 0 {
+		if err := w.write(w.buf[:idx+1]); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[idx+1:]...)
+	}
+	return len(d), nil
+}
+
+// flush writes any remaining partial line.
+func (w *lineWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.write(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+func (w *lineWriter) write(d []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.Writer.Write(d)
+	return err
+}
+
+// textPrefixFlags are the flags that control the prefix of rendered text log
+// lines.
+type textPrefixFlags struct {
+	timestamps      timestampsFlag
+	showStreamIndex bool
+}
+
+func (f *textPrefixFlags) addToFlagSet(fs *flag.FlagSet) {
+	fs.Var(&f.timestamps, "timestamps",
+		"When rendering text logs, prefix them with their timestamps. Options are: "+timestampFlagEnum.Choices())
+	fs.BoolVar(&f.showStreamIndex, "show-stream-index", false,
+		"When rendering text logs, show their stream index.")
+}
+
+func (f *textPrefixFlags) getTextPrefix(desc *logpb.LogStreamDescriptor, le *logpb.LogEntry) string {
 	var parts []string
-	if cmd.timestamps != timestampsOff {
+	if f.timestamps != timestampsOff {
 		ts := google.TimeFromProto(desc.Timestamp)
 		ts = ts.Add(google.DurationFromProto(le.TimeOffset))
-		switch cmd.timestamps {
+		switch f.timestamps {
 		case timestampsLocal:
 			parts = append(parts, ts.Local().Format(time.StampMilli))
 
@@ -226,7 +452,7 @@ func (cmd *catCommandRun) getTextPrefix(desc *logpb.LogStreamDescriptor, le *log
 		}
 	}
 
-	if cmd.showStreamIndex {
+	if f.showStreamIndex {
 		parts = append(parts, strconv.FormatUint(le.StreamIndex, 10))
 	}
 	if len(parts) == 0 {
@@ -0,0 +1,172 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/client/coordinator"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+)
+
+type searchCommandRun struct {
+	subcommands.CommandRunBase
+
+	path string
+	o    coordinator.SearchOptions
+}
+
+func newSearchCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "search [options] pattern",
+		ShortDesc: "Search the contents of terminated text log streams.",
+		LongDesc: "Search the contents of terminated text log streams, including archived ones, " +
+			"for lines matching a pattern. Matching lines are printed as PATH:LINE:TEXT, and " +
+			"context lines as PATH-LINE-TEXT.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &searchCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.path, "path", "", "Search logs matching this path (may include globbing).")
+			fs.BoolVar(&cmd.o.Regex, "regex", false, "Treat the pattern as a regular expression (RE2 syntax).")
+			fs.BoolVar(&cmd.o.IgnoreCase, "i", false, "Match the pattern without regard to case.")
+			fs.IntVar(&cmd.o.ContextLines, "C", 0, "Print this many lines of context around each match.")
+			fs.IntVar(&cmd.o.MaxMatches, "max-matches", 0,
+				"The maximum number of matches to print for each log stream. If 0, the service's limit applies.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *searchCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one search pattern must be supplied.")
+		return 1
+	}
+	pattern := args[0]
+
+	project, path, unified, err := a.splitPath(cmd.path)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err).Reason("could not create Coordinator client").Err())
+		return 1
+	}
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+
+	count := 0
+	log.Debugf(a, "Issuing search...")
+
+	tctx, _ := a.timeoutCtx(a)
+	ierr := error(nil)
+	err = coord.Search(tctx, project, path, pattern, cmd.o, func(sr *coordinator.SearchResult) bool {
+		name := string(sr.Path)
+		if unified {
+			name = makeUnifiedPath(sr.Project, sr.Path)
+		}
+
+		if ierr = writeSearchResult(bw, name, sr, cmd.o.ContextLines > 0); ierr != nil {
+			return false
+		}
+		if sr.Truncated {
+			log.Fields{
+				"path": name,
+			}.Warningf(a, "Log stream has more matches than were returned.")
+		}
+		count += len(sr.Matches)
+		return true
+	})
+	if err == nil {
+		// Propagate internal error.
+		err = ierr
+	}
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"count":      count,
+		}.Errorf(a, "Search failed.")
+
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+	log.Fields{
+		"count": count,
+	}.Infof(a, "Search completed.")
+	return 0
+}
+
+// writeSearchResult writes the matches of a log stream, grep-style.
+//
+// If separate is true, groups of lines that aren't adjacent are separated by
+// a "--" line.
+func writeSearchResult(bw *bufio.Writer, name string, sr *coordinator.SearchResult, separate bool) error {
+	// The context lines of nearby matches overlap, and may include other
+	// matches, so collect the distinct lines first.
+	lines := map[uint64]*logdog.SearchResponse_Line{}
+	matched := map[uint64]bool{}
+	for _, m := range sr.Matches {
+		for _, l := range m.Before {
+			lines[l.Index] = l
+		}
+		lines[m.Line.Index] = m.Line
+		matched[m.Line.Index] = true
+		for _, l := range m.After {
+			lines[l.Index] = l
+		}
+	}
+	indices := make([]uint64, 0, len(lines))
+	for idx := range lines {
+		indices = append(indices, idx)
+	}
+	sort.Sort(lineIndices(indices))
+
+	for i, idx := range indices {
+		if separate && i > 0 && idx != indices[i-1]+1 {
+			if _, err := bw.WriteString("--\n"); err != nil {
+				return err
+			}
+		}
+
+		sep := '-'
+		if matched[idx] {
+			sep = ':'
+		}
+		if _, err := fmt.Fprintf(bw, "%s%c%d%c%s\n", name, sep, idx, sep, lines[idx].Value); err != nil {
+			return err
+		}
+	}
+	if separate && len(indices) > 0 {
+		if _, err := bw.WriteString("--\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// lineIndices is a sortable slice of line indices.
+type lineIndices []uint64
+
+func (s lineIndices) Len() int           { return len(s) }
+func (s lineIndices) Less(i, j int) bool { return s[i] < s[j] }
+func (s lineIndices) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -27,10 +27,12 @@ import (
 	"github.com/luci/luci-go/common/flag/multiflag"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/logging/gologger"
+	_ "github.com/luci/luci-go/common/logging/structlogger" // Register the structured log formats.
 	"github.com/luci/luci-go/common/runtime/paniccatcher"
 	"github.com/luci/luci-go/common/runtime/profiling"
 	grpcLogging "github.com/luci/luci-go/grpc/logging"
 	"github.com/luci/luci-go/logdog/client/butler"
+	"github.com/luci/luci-go/logdog/client/butler/bundler"
 	"github.com/luci/luci-go/logdog/client/butler/output"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -73,6 +75,9 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	streamLimits bundler.Limits
+	globalLimits bundler.Limits
+
 	prof profiling.Profiler
 
 	client *http.Client
@@ -116,6 +121,15 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 			"of wire-format efficiency.")
 	fs.Var(&a.ioKeepAliveInterval, "io-keepalive-stderr",
 		"If supplied, periodically write messages to STDERR if data is received on any Butler stream.")
+	fs.Int64Var(&a.streamLimits.ByteLimit, "stream-byte-limit", 0,
+		"If > 0, the maximum number of bytes to accept for each stream. Streams are truncated beyond it.")
+	fs.Int64Var(&a.streamLimits.RateLimit, "stream-rate-limit", 0,
+		"If > 0, the maximum rate, in bytes per second, at which to accept data for each stream. Data "+
+			"that arrives faster is discarded.")
+	fs.Int64Var(&a.globalLimits.ByteLimit, "byte-limit", 0,
+		"If > 0, the maximum number of bytes to accept for all streams combined.")
+	fs.Int64Var(&a.globalLimits.RateLimit, "rate-limit", 0,
+		"If > 0, the maximum rate, in bytes per second, at which to accept data for all streams combined.")
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -159,6 +173,8 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 		TeeStderr:           os.Stderr,
 		IOKeepAliveInterval: time.Duration(a.ioKeepAliveInterval),
 		IOKeepAliveWriter:   os.Stderr,
+		StreamLimits:        a.streamLimits,
+		GlobalLimits:        a.globalLimits,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -0,0 +1,40 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/luci/luci-go/common/flag/multiflag"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+	localHTTPOutput "github.com/luci/luci-go/logdog/client/butler/output/localhttp"
+)
+
+func init() {
+	registerOutputFactory(&localHTTPOutputFactory{})
+}
+
+type localHTTPOutputFactory struct {
+	localHTTPOutput.Options
+}
+
+var _ outputFactory = (*localHTTPOutputFactory)(nil)
+
+func (f *localHTTPOutputFactory) option() multiflag.Option {
+	opt := newOutputOption("local-http",
+		"Debug output that serves stream data from a local HTTP viewer.", f)
+
+	flags := opt.Flags()
+	flags.StringVar(&f.Addr, "addr", "localhost:8080",
+		"The address that the viewer's HTTP server listens on.")
+	flags.IntVar(&f.MaxStreamEntries, "max-stream-entries", localHTTPOutput.DefaultMaxStreamEntries,
+		"The maximum number of log entries to retain for each stream.")
+
+	return opt
+}
+
+func (f *localHTTPOutputFactory) configOutput(a *application) (output.Output, error) {
+	return f.New(a)
+}
+
+func (f *localHTTPOutputFactory) scopes() []string { return nil }
@@ -36,6 +36,10 @@ func (s *streamConfig) addFlags(fs *flag.FlagSet) {
 		fmt.Sprintf("Tee the stream through the Butler's output. Options are: %s",
 			streamproto.TeeTypeFlagEnum.Choices()))
 	fs.Var(&s.Tags, "tag", "Add a key[=value] tag.")
+	fs.Int64Var(&s.ByteLimit, "byte-limit", 0,
+		"If > 0, the maximum number of bytes to accept for the stream.")
+	fs.Int64Var(&s.RateLimit, "rate-limit", 0,
+		"If > 0, the maximum rate, in bytes per second, at which to accept data for the stream.")
 }
 
 // Converts command-line parameters into a stream.Config.
@@ -20,6 +20,8 @@ type streamServerURI string
 var commonStreamServerExamples = []string{
 	"tcp4:[addr_v4][:port]",
 	"tcp6:[addr_v6][:port]",
+	"tcp4+tls:[addr_v4][:port]",
+	"tcp6+tls:[addr_v6][:port]",
 }
 
 func exampleStreamServerURIs() string {
@@ -82,6 +84,12 @@ func (u streamServerURI) resolve(ctx context.Context) (streamserver.StreamServer
 	case "tcp6":
 		return streamserver.NewTCP6Server(ctx, spec)
 
+	case "tcp4+tls":
+		return streamserver.NewTLSTCP4Server(ctx, spec)
+
+	case "tcp6+tls":
+		return streamserver.NewTLSTCP6Server(ctx, spec)
+
 	default:
 		return nil, errors.Reason("unknown stream server type: %(type)q").
 			D("type", typ).
@@ -20,6 +20,7 @@ import (
 	"github.com/luci/luci-go/logdog/client/butler"
 	"github.com/luci/luci-go/logdog/client/butler/bootstrap"
 	"github.com/luci/luci-go/logdog/client/butler/streamserver"
+	"github.com/luci/luci-go/lucictx"
 
 	"github.com/maruel/subcommands"
 	"golang.org/x/net/context"
@@ -197,12 +198,30 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string, _ subc
 		}()
 
 		bsEnv.StreamServerURI = streamServer.Address()
+		if as, ok := streamServer.(streamserver.AuthenticatedStreamServer); ok {
+			bsEnv.StreamServerCredentials = as.Credentials()
+		}
 	}
 
 	// Build our command enviornment.
 	env := environ.System()
 	bsEnv.Augment(env)
 
+	// Also advertise an authenticated stream server through LUCI_CONTEXT.
+	if creds := bsEnv.StreamServerCredentials; creds != nil {
+		exported, err := lucictx.Export(lucictx.SetLogDog(a, &lucictx.LogDog{
+			StreamServer:    bsEnv.StreamServerURI,
+			Token:           creds.Token,
+			CertFingerprint: creds.CertFingerprint,
+		}), "")
+		if err != nil {
+			log.WithError(err).Errorf(a, "Failed to export LUCI_CONTEXT.")
+			return runtimeErrorReturnCode
+		}
+		defer exported.Close()
+		exported.SetInEnviron(env)
+	}
+
 	// Construct and execute the command.
 	procCtx, procCancelFunc := context.WithCancel(a)
 	defer procCancelFunc()
@@ -5,7 +5,7 @@
 package coordinator
 
 import (
-	"errors"
+	"github.com/luci/luci-go/common/errors"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -32,6 +32,12 @@ func normalizeError(err error) error {
 	case codes.Unauthenticated, codes.PermissionDenied:
 		return ErrNoAccess
 
+	case codes.Internal, codes.Unavailable:
+		// The pRPC client retries transient errors itself, but returns the last
+		// one unwrapped. Mark it transient again so callers that keep retrying
+		// (e.g. when following a stream) can tell.
+		return errors.WrapTransient(err)
+
 	default:
 		return err
 	}
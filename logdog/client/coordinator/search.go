@@ -0,0 +1,93 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"golang.org/x/net/context"
+)
+
+// SearchResult is the set of matches found in a single log stream.
+type SearchResult struct {
+	// Project is the project that the log stream belongs to.
+	Project cfgtypes.ProjectName
+	// Path is the path of the log stream.
+	Path types.StreamPath
+
+	// Matches is the set of matching lines, in line order.
+	Matches []*logdog.SearchResponse_Match
+	// Truncated is true if the log stream has more matches than were returned.
+	Truncated bool
+}
+
+// SearchCallback is a callback method type that is used in search requests.
+//
+// If it returns false, additional callbacks and search requests will be
+// aborted.
+type SearchCallback func(*SearchResult) bool
+
+// SearchOptions is a set of search request options.
+type SearchOptions struct {
+	// Regex, if true, treats the pattern as a regular expression. Otherwise, it
+	// is matched literally.
+	Regex bool
+	// IgnoreCase, if true, matches the pattern without regard to case.
+	IgnoreCase bool
+
+	// ContextLines is the number of lines to return before and after each
+	// matching line.
+	ContextLines int
+	// MaxMatches, if > 0, is the maximum number of matches to return for each
+	// log stream.
+	MaxMatches int
+}
+
+// Search searches the terminated text log streams matching path for lines that
+// match pattern, invoking the supplied callback once for each log stream that
+// has matches.
+//
+// The path is a query path, as used by Query.
+func (c *Client) Search(ctx context.Context, project cfgtypes.ProjectName, path, pattern string, o SearchOptions,
+	cb SearchCallback) error {
+
+	req := logdog.SearchRequest{
+		Project:      string(project),
+		Path:         path,
+		Pattern:      pattern,
+		Regex:        o.Regex,
+		IgnoreCase:   o.IgnoreCase,
+		ContextLines: int32(o.ContextLines),
+		MaxMatches:   int32(o.MaxMatches),
+	}
+
+	// Iteratively search until either our search is done (Next is empty) or we
+	// are asked to stop via callback.
+	for {
+		resp, err := c.C.Search(ctx, &req)
+		if err != nil {
+			return normalizeError(err)
+		}
+
+		for _, s := range resp.Streams {
+			sr := SearchResult{
+				Project:   cfgtypes.ProjectName(resp.Project),
+				Path:      types.StreamPath(s.Path),
+				Matches:   s.Matches,
+				Truncated: s.Truncated,
+			}
+			if !cb(&sr) {
+				return nil
+			}
+		}
+
+		if resp.Next == "" {
+			return nil
+		}
+		req.Next = resp.Next
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/luci/luci-go/common/testing/prpctest"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testSearchLogsService struct {
+	testLogsServiceBase
+
+	LR logdog.SearchRequest
+	H  func(*logdog.SearchRequest) (*logdog.SearchResponse, error)
+}
+
+func (s *testSearchLogsService) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	s.LR = *req
+	if h := s.H; h != nil {
+		return s.H(req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestClientSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing Client`, t, func() {
+		c := context.Background()
+
+		ts := prpctest.Server{}
+		svc := testSearchLogsService{}
+		logdog.RegisterLogsServer(&ts, &svc)
+
+		// Create a testing server and client.
+		ts.Start(c)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		if err != nil {
+			panic(err)
+		}
+		client := Client{
+			C: logdog.NewLogsPRPCClient(prpcClient),
+		}
+
+		Convey(`When making a search request`, func() {
+			o := SearchOptions{
+				Regex:        true,
+				ContextLines: 2,
+			}
+
+			var paths []types.StreamPath
+			accumulate := func(sr *SearchResult) bool {
+				paths = append(paths, sr.Path)
+				return true
+			}
+
+			// This handler will return a single stream per request, as well as a
+			// non-empty Next pointer for the next search. It progresses
+			// "a" => "b" => "".
+			svc.H = func(req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+				r := logdog.SearchResponse{Project: req.Project}
+				match := &logdog.SearchResponse_Match{
+					Line: &logdog.SearchResponse_Line{Value: "FAILED"},
+				}
+
+				switch req.Next {
+				case "":
+					r.Streams = []*logdog.SearchResponse_Stream{{Path: "test/+/a", Matches: []*logdog.SearchResponse_Match{match}}}
+					r.Next = "b"
+				case "b":
+					r.Streams = []*logdog.SearchResponse_Stream{{Path: "test/+/b", Matches: []*logdog.SearchResponse_Match{match}}}
+				default:
+					return nil, errors.New("invalid cursor")
+				}
+				return &r, nil
+			}
+
+			Convey(`Can accumulate results across requests.`, func() {
+				So(client.Search(c, "myproj", "test/**", "FAIL.*", o, accumulate), ShouldBeNil)
+				So(paths, ShouldResemble, []types.StreamPath{"test/+/a", "test/+/b"})
+
+				So(svc.LR.Pattern, ShouldEqual, "FAIL.*")
+				So(svc.LR.Regex, ShouldBeTrue)
+				So(svc.LR.ContextLines, ShouldEqual, 2)
+			})
+
+			Convey(`Will stop invoking the callback if it returns false.`, func() {
+				accumulate = func(sr *SearchResult) bool {
+					paths = append(paths, sr.Path)
+					return false
+				}
+				So(client.Search(c, "myproj", "test/**", "FAIL.*", o, accumulate), ShouldBeNil)
+				So(paths, ShouldResemble, []types.StreamPath{"test/+/a"})
+			})
+		})
+	})
+}
@@ -51,6 +51,18 @@ type StreamState struct {
 	// Purged indicates the purged state of a log. A log that has been purged is
 	// only acknowledged to administrative clients.
 	Purged bool
+
+	// Tombstone, if not nil, indicates that the log stream's log data has been
+	// purged. A log stream with a tombstone has no log entries.
+	Tombstone *Tombstone
+}
+
+// Tombstone describes the purge of a log stream's log data.
+type Tombstone struct {
+	// Purged is the time when the log stream's log data was purged.
+	Purged time.Time
+	// Reason is the reason given for the purge.
+	Reason string
 }
 
 // LogStream is returned metadata about a log stream.
@@ -88,6 +100,13 @@ func loadLogStream(proj string, path types.StreamPath, s *logdog.LogStreamState,
 			ls.State.ArchiveStreamURL = a.StreamUrl
 			ls.State.ArchiveDataURL = a.DataUrl
 		}
+
+		if t := s.Tombstone; t != nil {
+			ls.State.Tombstone = &Tombstone{
+				Purged: google.TimeFromProto(t.Purged),
+				Reason: t.Reason,
+			}
+		}
 	}
 	return &ls
 }
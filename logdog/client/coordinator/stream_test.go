@@ -5,10 +5,10 @@
 package coordinator
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/common/testing/prpctest"
 	"github.com/luci/luci-go/grpc/grpcutil"
@@ -251,6 +251,15 @@ func TestStreamGet(t *testing.T) {
 					_, err := s.Get(c)
 					So(err, ShouldEqual, ErrNoAccess)
 				})
+
+				Convey(`Will return a transient error if the service is unavailable.`, func() {
+					svc.GH = func(*logdog.GetRequest) (*logdog.GetResponse, error) {
+						return nil, grpcutil.Unavailable
+					}
+
+					_, err := s.Get(c)
+					So(errors.IsTransient(err), ShouldBeTrue)
+				})
 			})
 
 			Convey(`Test State`, func() {
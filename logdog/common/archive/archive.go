@@ -35,6 +35,17 @@ type Manifest struct {
 	// DataWriter, if not nil, is the Writer to which reconstructed LogEntry data
 	// will be written.
 	DataWriter io.Writer
+	// HTMLWriter, if not nil, is the Writer to which an HTML rendering of a text
+	// log stream will be written. It is ignored for other stream types.
+	HTMLWriter io.Writer
+
+	// Compression is the compression codec to apply to the log stream and to the
+	// data stream. The index is not compressed, and records the codec.
+	//
+	// The log stream is compressed as a series of independent blocks, starting a
+	// new block at each indexed LogEntry, so that it can still be read from any
+	// index entry. The data stream is compressed as a single block.
+	Compression logpb.LogIndex_Compression
 
 	// StreamIndexRange, if >0, is the maximum number of log entry stream indices
 	// in between successive index entries.
@@ -65,6 +76,10 @@ func (m *Manifest) logger() logging.Logger {
 
 // Archive performs the log archival described in the supplied Manifest.
 func Archive(m Manifest) error {
+	if _, ok := logpb.LogIndex_Compression_name[int32(m.Compression)]; !ok {
+		return errors.Reason("unknown compression codec: %(codec)v").D("codec", m.Compression).Err()
+	}
+
 	// Wrap our log source in a safeLogEntrySource to protect our index order.
 	m.Source = &safeLogEntrySource{
 		Manifest: &m,
@@ -82,7 +97,8 @@ func Archive(m Manifest) error {
 		idx = &indexBuilder{
 			Manifest: &m,
 			index: logpb.LogIndex{
-				Desc: m.Desc,
+				Desc:        m.Desc,
+				Compression: m.Compression,
 			},
 			sizeFunc: m.sizeFunc,
 		}
@@ -94,7 +110,7 @@ func Archive(m Manifest) error {
 			logC = make(chan *logpb.LogEntry)
 
 			taskC <- func() error {
-				if err := archiveLogs(m.LogWriter, m.Desc, logC, idx); err != nil {
+				if err := archiveLogs(m.LogWriter, m.Compression, m.Desc, logC, idx); err != nil {
 					return err
 				}
 
@@ -112,7 +128,16 @@ func Archive(m Manifest) error {
 			dataC = make(chan *logpb.LogEntry)
 
 			taskC <- func() error {
-				return archiveData(m.DataWriter, dataC)
+				return archiveData(m.DataWriter, m.Compression, dataC)
+			}
+		}
+
+		var htmlC chan *logpb.LogEntry
+		if m.HTMLWriter != nil && m.Desc.StreamType == logpb.StreamType_TEXT {
+			htmlC = make(chan *logpb.LogEntry)
+
+			taskC <- func() error {
+				return archiveHTML(m.HTMLWriter, m.Desc, htmlC)
 			}
 		}
 
@@ -124,6 +149,9 @@ func Archive(m Manifest) error {
 			if dataC != nil {
 				defer close(dataC)
 			}
+			if htmlC != nil {
+				defer close(htmlC)
+			}
 
 			sendLog := func(le *logpb.LogEntry) {
 				if logC != nil {
@@ -132,6 +160,9 @@ func Archive(m Manifest) error {
 				if dataC != nil {
 					dataC <- le
 				}
+				if htmlC != nil {
+					htmlC <- le
+				}
 			}
 
 			for {
@@ -159,16 +190,17 @@ func Archive(m Manifest) error {
 	return err
 }
 
-func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
-	offset := int64(0)
+func archiveLogs(w io.Writer, c logpb.LogIndex_Compression, d *logpb.LogStreamDescriptor,
+	logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
+
+	bw := newBlockWriter(w, c)
 	out := func(pb proto.Message) error {
 		d, err := proto.Marshal(pb)
 		if err != nil {
 			return err
 		}
 
-		count, err := recordio.WriteFrame(w, d)
-		offset += int64(count)
+		_, err = recordio.WriteFrame(bw, d)
 		return err
 	}
 
@@ -180,11 +212,21 @@ func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.L
 			continue
 		}
 
-		// Add this LogEntry to our index, noting the current offset.
+		// Add this LogEntry to our index, noting the current offset. If it is
+		// indexed, start a new compressed block with it so that it can be read
+		// directly.
 		if idx != nil {
-			idx.addLogEntry(le, offset)
+			if e := idx.addLogEntry(le, bw.offset()); e != nil {
+				if err = bw.endBlock(); err != nil {
+					continue
+				}
+				e.Offset = uint64(bw.offset())
+			}
 		}
 		err = out(le)
 	}
+	if cerr := bw.Close(); err == nil {
+		err = cerr
+	}
 	return err
 }
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strconv"
 	"testing"
 	"time"
@@ -83,6 +84,15 @@ func (w *errWriter) Write(d []byte) (int, error) {
 	return w.Writer.Write(d)
 }
 
+// shouldReadAs asserts that an io.Reader's contents are the expected string.
+func shouldReadAs(actual interface{}, expected ...interface{}) string {
+	d, err := ioutil.ReadAll(actual.(io.Reader))
+	if err != nil {
+		return fmt.Sprintf("failed to read: %v", err)
+	}
+	return ShouldEqual(string(d), expected[0])
+}
+
 // indexParams umarshals an index from a byte stream and removes any entries.
 func indexParams(d []byte) logpb.LogIndex {
 	var index logpb.LogIndex
@@ -247,6 +257,77 @@ func TestArchive(t *testing.T) {
 			So(dataB.String(), ShouldEqual, "0\n1\n2\n3\n4\n5\n6\n")
 		})
 
+		Convey(`Will render an HTML document of a text stream.`, func() {
+			var htmlB bytes.Buffer
+			m.HTMLWriter = &htmlB
+			desc.Timestamp = google.NewTimestamp(time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC))
+
+			ts.add(0, 1)
+			So(Archive(m), ShouldBeNil)
+			So(htmlB.String(), ShouldStartWith, "<!DOCTYPE html>")
+			So(htmlB.String(), ShouldContainSubstring, "<title>test/+/foo</title>")
+			So(htmlB.String(), ShouldContainSubstring, `<div class="line" id="L1-0"><a class="anchor" href="#L1-0">1</a>`+
+				`<span class="time">2017-01-02T03:04:06.000Z</span>1</div>`)
+		})
+
+		for _, c := range []logpb.LogIndex_Compression{logpb.LogIndex_GZIP, logpb.LogIndex_SNAPPY} {
+			Convey(fmt.Sprintf(`With %s compression, can read the log stream from each index entry.`, c), func() {
+				m.Compression = c
+				m.StreamIndexRange = 3
+
+				ts.add(0, 1, 2, 3, 4, 5, 6, 7)
+				So(Archive(m), ShouldBeNil)
+
+				index := logpb.LogIndex{}
+				So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+				So(index.Compression, ShouldEqual, c)
+
+				var indices []uint64
+				for _, e := range index.Entries {
+					indices = append(indices, e.StreamIndex)
+
+					dr, err := NewDecompressor(c, bytes.NewReader(logB.Bytes()[e.Offset:]))
+					So(err, ShouldBeNil)
+					r := recordio.NewReader(dr, 1024*1024)
+					if e.Offset == 0 {
+						// The first block begins with the log stream descriptor.
+						_, err := r.ReadFrameAll()
+						So(err, ShouldBeNil)
+					}
+
+					var streamIndices []uint64
+					for {
+						d, err := r.ReadFrameAll()
+						if err == io.EOF {
+							break
+						}
+						So(err, ShouldBeNil)
+
+						le := logpb.LogEntry{}
+						So(proto.Unmarshal(d, &le), ShouldBeNil)
+						streamIndices = append(streamIndices, le.StreamIndex)
+					}
+					// The last index entry isn't a block boundary, so it is read from the
+					// start of its block.
+					So(streamIndices, ShouldContain, e.StreamIndex)
+					So(streamIndices[len(streamIndices)-1], ShouldEqual, 7)
+					if e.StreamIndex != 7 {
+						So(streamIndices[0], ShouldEqual, e.StreamIndex)
+					}
+				}
+				So(indices, ShouldResemble, []uint64{0, 3, 6, 7})
+
+				data, err := NewDecompressor(c, &dataB)
+				So(err, ShouldBeNil)
+				So(data, shouldReadAs, "0\n1\n2\n3\n4\n5\n6\n7\n")
+			})
+		}
+
+		Convey(`Will refuse an unknown compression codec.`, func() {
+			m.Compression = logpb.LogIndex_Compression(-1)
+			So(Archive(m), ShouldErrLike, "unknown compression codec")
+		})
+
 		Convey(`A sequence of non-contiguous logs will build a complete index.`, func() {
 			ts.add(0, 1, 3, 6)
 			So(Archive(m), ShouldBeNil)
@@ -0,0 +1,146 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/luci/luci-go/common/iotools"
+	"github.com/luci/luci-go/logdog/api/logpb"
+
+	"github.com/golang/snappy"
+)
+
+// CompressionExt returns the file extension conventionally used for files
+// compressed with the specified codec, including its leading ".". It is empty
+// if the codec applies no compression.
+func CompressionExt(c logpb.LogIndex_Compression) string {
+	switch c {
+	case logpb.LogIndex_GZIP:
+		return ".gz"
+	case logpb.LogIndex_SNAPPY:
+		return ".sz"
+	default:
+		return ""
+	}
+}
+
+// newCompressor returns a WriteCloser that compresses the data written to it
+// into w. Closing it flushes the compressed data, but does not close w.
+func newCompressor(c logpb.LogIndex_Compression, w io.Writer) (io.WriteCloser, error) {
+	switch c {
+	case logpb.LogIndex_NONE:
+		return nopWriteCloser{w}, nil
+	case logpb.LogIndex_GZIP:
+		return gzip.NewWriter(w), nil
+	case logpb.LogIndex_SNAPPY:
+		return snappy.NewBufferedWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %v", c)
+	}
+}
+
+// NewDecompressor returns a Reader that decompresses the data read from r,
+// which was compressed with the specified codec.
+//
+// r may hold a series of compressed blocks, such as a compressed log stream or
+// a part of it that begins at a block boundary. They are decompressed in
+// sequence.
+//
+// Decompressors may return no data at block boundaries, so the decompressed
+// data is buffered, and the returned Reader is also an io.ByteReader.
+func NewDecompressor(c logpb.LogIndex_Compression, r io.Reader) (io.Reader, error) {
+	switch c {
+	case logpb.LogIndex_NONE:
+		return r, nil
+
+	case logpb.LogIndex_GZIP:
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return bufio.NewReader(gr), nil
+
+	case logpb.LogIndex_SNAPPY:
+		return bufio.NewReader(snappy.NewReader(r)), nil
+
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %v", c)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// blockWriter writes data as a series of independently-compressed blocks.
+//
+// A block is started by the first write after the previous block has ended.
+// Each block can be decompressed on its own, or along with the blocks after
+// it, so readers may begin reading at any block's offset.
+type blockWriter struct {
+	codec logpb.LogIndex_Compression
+	out   iotools.CountingWriter
+
+	// block is the compressor for the current block, or nil if no block is
+	// open.
+	block io.WriteCloser
+	// blockOffset is the offset of the current block.
+	blockOffset int64
+}
+
+func newBlockWriter(w io.Writer, c logpb.LogIndex_Compression) *blockWriter {
+	return &blockWriter{
+		codec: c,
+		out:   iotools.CountingWriter{Writer: w},
+	}
+}
+
+func (w *blockWriter) Write(d []byte) (int, error) {
+	if w.codec == logpb.LogIndex_NONE {
+		return w.out.Write(d)
+	}
+
+	if w.block == nil {
+		block, err := newCompressor(w.codec, &w.out)
+		if err != nil {
+			return 0, err
+		}
+		w.block, w.blockOffset = block, w.out.Count
+	}
+	return w.block.Write(d)
+}
+
+// offset returns the offset at which a reader can begin reading in order to
+// read the next data written to w.
+//
+// Without compression, this is the current offset. Otherwise, it is the offset
+// of the current block, or of the next block if none is open.
+func (w *blockWriter) offset() int64 {
+	if w.block != nil {
+		return w.blockOffset
+	}
+	return w.out.Count
+}
+
+// endBlock ends the current block, if one is open. The next write will start
+// a new block.
+func (w *blockWriter) endBlock() error {
+	if w.block == nil {
+		return nil
+	}
+
+	err := w.block.Close()
+	w.block = nil
+	return err
+}
+
+// Close ends the current block. It does not close the underlying Writer.
+func (w *blockWriter) Close() error { return w.endBlock() }
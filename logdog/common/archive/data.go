@@ -7,6 +7,7 @@ package archive
 import (
 	"io"
 
+	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/common/renderer"
 )
@@ -27,12 +28,39 @@ func (cs channelRendererSource) NextLogEntry() (*logpb.LogEntry, error) {
 	return nil, io.EOF
 }
 
-func archiveData(w io.Writer, dataC <-chan *logpb.LogEntry) error {
-	entryC := make(chan *logpb.LogEntry)
-	r := renderer.Renderer{
-		Source: channelRendererSource(entryC),
-		Raw:    true,
+func archiveData(w io.Writer, c logpb.LogIndex_Compression, dataC <-chan *logpb.LogEntry) (err error) {
+	cw, err := newCompressor(c, w)
+	if err != nil {
+		return err
 	}
+	defer func() {
+		if cerr := cw.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	return renderEntries(cw, &renderer.Renderer{Raw: true}, dataC)
+}
+
+// archiveHTML renders a text log stream as an HTML document.
+func archiveHTML(w io.Writer, d *logpb.LogStreamDescriptor, dataC <-chan *logpb.LogEntry) error {
+	return renderEntries(w, &renderer.Renderer{
+		TextMode: renderer.TextModeHTML,
+		HTML: &renderer.HTMLOptions{
+			Document:   true,
+			Title:      string(d.Path()),
+			Anchors:    true,
+			Timestamps: true,
+			StreamTime: google.TimeFromProto(d.Timestamp),
+		},
+	}, dataC)
+}
+
+// renderEntries renders the log entries received from dataC using r, whose
+// Source is set by renderEntries.
+func renderEntries(w io.Writer, r *renderer.Renderer, dataC <-chan *logpb.LogEntry) error {
+	entryC := make(chan *logpb.LogEntry)
+	r.Source = channelRendererSource(entryC)
 
 	// Run our Renderer in a goroutine.
 	rendererFinishedC := make(chan error)
@@ -43,7 +71,7 @@ func archiveData(w io.Writer, dataC <-chan *logpb.LogEntry) error {
 		}()
 
 		// Render our stream.
-		_, err = io.Copy(w, &r)
+		_, err = io.Copy(w, r)
 
 		// If our Renderer encounters an error, it will stop reading from entryC
 		// prematurely. In order for the pipeline to complete, we need to manually
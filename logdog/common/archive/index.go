@@ -25,7 +25,11 @@ type indexBuilder struct {
 	sizeFunc func(proto.Message) int
 }
 
-func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
+// addLogEntry adds a LogEntry at the specified offset to the index.
+//
+// If the LogEntry is indexed, its index entry is returned. Otherwise, it
+// returns nil.
+func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) *logpb.LogIndex_Entry {
 	// Only calculate the size if we actually use it.
 	if i.ByteRange > 0 {
 		i.lastBytes += uint64(i.size(le))
@@ -51,7 +55,7 @@ func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
 			(i.ByteRange > 0 && i.lastBytes >= uint64(i.ByteRange))) {
 			// Not going to index this entry. Buffer it as a terminator.
 			i.latestBufferedEntry = &entry
-			return
+			return nil
 		}
 
 		i.lastBytes = 0
@@ -63,6 +67,7 @@ func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
 	// Update our counters.
 	i.lastStreamIndex = le.StreamIndex
 	i.lastPrefixIndex = le.PrefixIndex
+	return &entry
 }
 
 func (i *indexBuilder) emit(w io.Writer) error {
@@ -0,0 +1,419 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/luci/luci-go/common/data/recordio"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/iotools"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+)
+
+// MaxRecordSize is the maximum size of a record in an archived log stream.
+//
+// It is larger than the maximum log entry size, and protects readers from
+// corrupt or malicious log streams.
+const MaxRecordSize = 2 * types.MaxLogEntryDataSize
+
+// snappyStreamIdentifier is the chunk that begins each snappy-compressed block.
+var snappyStreamIdentifier = []byte("\xff\x06\x00\x00sNaPpY")
+
+// DetectCompression returns the compression codec that was applied to a log
+// stream, given its leading bytes.
+//
+// An uncompressed log stream begins with the frame holding its descriptor, so
+// it can't be mistaken for either of the compressed formats.
+func DetectCompression(head []byte) logpb.LogIndex_Compression {
+	switch {
+	case len(head) >= 2 && head[0] == 0x1f && head[1] == 0x8b:
+		return logpb.LogIndex_GZIP
+	case bytes.HasPrefix(head, snappyStreamIdentifier):
+		return logpb.LogIndex_SNAPPY
+	default:
+		return logpb.LogIndex_NONE
+	}
+}
+
+// LogStreamRecord is a LogEntry read by a LogStreamReader.
+type LogStreamRecord struct {
+	*logpb.LogEntry
+
+	// Offset is the offset in the log stream at which a reader can begin reading
+	// in order to read this LogEntry. If the log stream is compressed, this is
+	// the offset of the compressed block that holds it.
+	Offset int64
+	// Direct is true if this LogEntry is the first record that is read from
+	// Offset, so that it can be indexed.
+	Direct bool
+}
+
+// LogStreamReader reads the descriptor and log entries of an archived log
+// stream, such as one written to a Manifest's LogWriter.
+type LogStreamReader struct {
+	codec  logpb.LogIndex_Compression
+	blocks blockReader
+	desc   logpb.LogStreamDescriptor
+
+	// rio reads records from the current block, or is nil if the next block has
+	// not been started.
+	rio recordio.Reader
+	// block counts the decompressed data read from the current block.
+	block iotools.CountingReader
+	// blockOffset is the offset of the current block.
+	blockOffset int64
+	// blockRecords is the number of records read from the current block.
+	blockRecords int
+}
+
+// NewLogStreamReader returns a LogStreamReader that reads a log stream from r,
+// which was compressed with the specified codec. It reads the log stream's
+// descriptor immediately.
+func NewLogStreamReader(r io.Reader, c logpb.LogIndex_Compression) (*LogStreamReader, error) {
+	lr := LogStreamReader{codec: c}
+	switch c {
+	case logpb.LogIndex_NONE:
+		lr.blocks = &singleBlockReader{r: bufio.NewReader(r)}
+	case logpb.LogIndex_GZIP:
+		br := bufio.NewReader(r)
+		lr.blocks = &gzipBlockReader{br: br, r: iotools.CountingReader{Reader: br}}
+	case logpb.LogIndex_SNAPPY:
+		lr.blocks = &snappyBlockReader{br: bufio.NewReader(r)}
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %v", c)
+	}
+
+	d, _, err := lr.next()
+	switch {
+	case err == io.EOF:
+		return nil, errors.New("log stream is empty")
+	case err != nil:
+		return nil, errors.Annotate(err).Reason("failed to read descriptor").Err()
+	}
+	if err := proto.Unmarshal(d, &lr.desc); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to unmarshal descriptor").Err()
+	}
+	return &lr, nil
+}
+
+// Descriptor returns the log stream's descriptor.
+func (lr *LogStreamReader) Descriptor() *logpb.LogStreamDescriptor { return &lr.desc }
+
+// Next returns the next LogEntry in the log stream.
+//
+// It returns io.EOF when there are no more log entries.
+func (lr *LogStreamReader) Next() (*LogStreamRecord, error) {
+	d, offset, err := lr.next()
+	if err != nil {
+		return nil, err
+	}
+
+	rec := LogStreamRecord{
+		LogEntry: &logpb.LogEntry{},
+		Offset:   offset,
+		Direct:   lr.codec == logpb.LogIndex_NONE || lr.blockRecords == 1,
+	}
+	if err := proto.Unmarshal(d, rec.LogEntry); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to unmarshal log entry at offset %(offset)d").
+			D("offset", offset).Err()
+	}
+	return &rec, nil
+}
+
+// next reads the next record, returning its data and the offset at which a
+// reader can begin reading in order to read it.
+func (lr *LogStreamReader) next() ([]byte, int64, error) {
+	for {
+		if lr.rio == nil {
+			r, offset, err := lr.blocks.nextBlock()
+			if err != nil {
+				if err != io.EOF {
+					err = errors.Annotate(err).Reason("failed to read block at offset %(offset)d").
+						D("offset", offset).Err()
+				}
+				return nil, offset, err
+			}
+
+			lr.block = iotools.CountingReader{Reader: r}
+			lr.rio = recordio.NewReader(&lr.block, MaxRecordSize)
+			lr.blockOffset, lr.blockRecords = offset, 0
+		}
+
+		// Without compression, each record can be read from its own offset.
+		offset := lr.blockOffset
+		if lr.codec == logpb.LogIndex_NONE {
+			offset += lr.block.Count
+		}
+
+		start := lr.block.Count
+		d, err := lr.rio.ReadFrameAll()
+		if err == io.EOF && lr.block.Count != start {
+			// The block ended after a record's header, but before its data.
+			err = io.ErrUnexpectedEOF
+		}
+		switch err {
+		case nil:
+			lr.blockRecords++
+			return d, offset, nil
+
+		case io.EOF:
+			lr.rio = nil
+
+		case io.ErrUnexpectedEOF:
+			return nil, offset, errors.Annotate(err).Reason("truncated record at offset %(offset)d").
+				D("offset", offset).Err()
+
+		default:
+			return nil, offset, errors.Annotate(err).Reason("failed to read record at offset %(offset)d").
+				D("offset", offset).Err()
+		}
+	}
+}
+
+// blockReader splits a log stream into the blocks that it was written as.
+type blockReader interface {
+	// nextBlock returns a Reader for the next block's decompressed data, and the
+	// offset of the block. It returns io.EOF if there are no more blocks.
+	nextBlock() (io.Reader, int64, error)
+}
+
+// singleBlockReader is a blockReader for an uncompressed log stream, which is
+// a single block.
+type singleBlockReader struct {
+	r    io.Reader
+	done bool
+}
+
+func (b *singleBlockReader) nextBlock() (io.Reader, int64, error) {
+	if b.done {
+		return nil, 0, io.EOF
+	}
+	b.done = true
+	return b.r, 0, nil
+}
+
+// gzipBlockReader is a blockReader for a gzip-compressed log stream, in which
+// each block is a gzip member.
+type gzipBlockReader struct {
+	br *bufio.Reader
+	// r counts the compressed data that has been consumed from br. gzip reads
+	// through it byte-wise, so it doesn't read past the end of each member.
+	r iotools.CountingReader
+	z *gzip.Reader
+}
+
+func (b *gzipBlockReader) nextBlock() (io.Reader, int64, error) {
+	offset := b.r.Count
+	if _, err := b.br.Peek(1); err != nil {
+		return nil, offset, err
+	}
+
+	var err error
+	if b.z == nil {
+		b.z, err = gzip.NewReader(&b.r)
+	} else {
+		err = b.z.Reset(&b.r)
+	}
+	if err != nil {
+		return nil, offset, err
+	}
+	b.z.Multistream(false)
+	return b.z, offset, nil
+}
+
+// snappyBlockReader is a blockReader for a snappy-compressed log stream, in
+// which each block begins with a stream identifier chunk.
+type snappyBlockReader struct {
+	br *bufio.Reader
+	z  *snappy.Reader
+
+	// offset is the offset of the next byte that will be read from br.
+	offset int64
+	// chunkRemaining is the number of bytes remaining in the current chunk.
+	chunkRemaining int
+	// started is true if a chunk has been read from the current block.
+	started bool
+}
+
+func (b *snappyBlockReader) nextBlock() (io.Reader, int64, error) {
+	if _, err := b.br.Peek(1); err != nil {
+		return nil, b.offset, err
+	}
+
+	b.started = false
+	if b.z == nil {
+		b.z = snappy.NewReader(b)
+	} else {
+		b.z.Reset(b)
+	}
+	return b.z, b.offset, nil
+}
+
+// Read reads the current block's compressed data, returning io.EOF at the next
+// stream identifier chunk.
+func (b *snappyBlockReader) Read(d []byte) (int, error) {
+	if b.chunkRemaining == 0 {
+		hdr, err := b.br.Peek(4)
+		switch {
+		case len(hdr) == 0:
+			return 0, err
+		case len(hdr) < 4:
+			// Pass the truncated chunk header through, so that it is reported as
+			// corrupt.
+			b.chunkRemaining = len(hdr)
+		case hdr[0] == snappyStreamIdentifier[0] && b.started:
+			return 0, io.EOF
+		default:
+			b.chunkRemaining = 4 + (int(hdr[1]) | int(hdr[2])<<8 | int(hdr[3])<<16)
+			b.started = true
+		}
+	}
+
+	if len(d) > b.chunkRemaining {
+		d = d[:b.chunkRemaining]
+	}
+	n, err := b.br.Read(d)
+	b.chunkRemaining -= n
+	b.offset += int64(n)
+	return n, err
+}
+
+// BuildIndex reads an archived log stream from r, which was compressed with
+// the specified codec, and builds an index for it.
+//
+// Every LogEntry that can be read directly is indexed, along with the last
+// LogEntry. For a compressed log stream, this reproduces the index that Archive
+// built for it.
+func BuildIndex(r io.Reader, c logpb.LogIndex_Compression) (*logpb.LogIndex, error) {
+	lr, err := NewLogStreamReader(r, c)
+	if err != nil {
+		return nil, err
+	}
+
+	index := logpb.LogIndex{
+		Desc:        lr.Descriptor(),
+		Compression: c,
+	}
+	var last *logpb.LogIndex_Entry
+	for {
+		rec, err := lr.Next()
+		switch {
+		case err == io.EOF:
+			if last != nil {
+				index.Entries = append(index.Entries, last)
+			}
+			return &index, nil
+		case err != nil:
+			return nil, err
+		}
+
+		index.LastPrefixIndex = rec.PrefixIndex
+		index.LastStreamIndex = rec.StreamIndex
+		index.LogEntryCount++
+
+		last = &logpb.LogIndex_Entry{
+			Sequence:    rec.Sequence,
+			PrefixIndex: rec.PrefixIndex,
+			StreamIndex: rec.StreamIndex,
+			Offset:      uint64(rec.Offset),
+			TimeOffset:  rec.TimeOffset,
+		}
+		if rec.Direct {
+			index.Entries = append(index.Entries, last)
+			last = nil
+		}
+	}
+}
+
+// VerifyIndex reads an archived log stream from r and checks that index is
+// consistent with it.
+//
+// If the log stream can't be read, its error is returned. Otherwise, each
+// inconsistency is returned in an errors.MultiError.
+func VerifyIndex(index *logpb.LogIndex, r io.Reader) error {
+	lr, err := NewLogStreamReader(r, index.Compression)
+	if err != nil {
+		return err
+	}
+
+	var merr errors.MultiError
+	addErr := func(f string, args ...interface{}) {
+		merr = append(merr, fmt.Errorf(f, args...))
+	}
+
+	if index.Desc != nil && !proto.Equal(index.Desc, lr.Descriptor()) {
+		addErr("index descriptor does not match the log stream's descriptor")
+	}
+
+	var (
+		entries = index.Entries
+		last    *LogStreamRecord
+		count   uint64
+	)
+	for {
+		rec, err := lr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		count++
+		last = rec
+
+		for len(entries) > 0 && entries[0].StreamIndex == rec.StreamIndex {
+			e := entries[0]
+			entries = entries[1:]
+
+			switch {
+			case e.Offset != uint64(rec.Offset):
+				addErr("index entry for stream index %d has offset %d, but it is read from offset %d",
+					e.StreamIndex, e.Offset, rec.Offset)
+			case e.PrefixIndex != rec.PrefixIndex:
+				addErr("index entry for stream index %d has prefix index %d, but the log entry has %d",
+					e.StreamIndex, e.PrefixIndex, rec.PrefixIndex)
+			case e.Sequence != rec.Sequence:
+				addErr("index entry for stream index %d has sequence %d, but the log entry has %d",
+					e.StreamIndex, e.Sequence, rec.Sequence)
+			case google.DurationFromProto(e.TimeOffset) != google.DurationFromProto(rec.TimeOffset):
+				addErr("index entry for stream index %d has time offset %s, but the log entry has %s",
+					e.StreamIndex, google.DurationFromProto(e.TimeOffset), google.DurationFromProto(rec.TimeOffset))
+			}
+		}
+	}
+
+	for _, e := range entries {
+		addErr("index entry for stream index %d (offset %d) does not match a log entry in order",
+			e.StreamIndex, e.Offset)
+	}
+	if index.LogEntryCount != count {
+		addErr("index has %d log entries, but the log stream has %d", index.LogEntryCount, count)
+	}
+	if last != nil {
+		if index.LastStreamIndex != last.StreamIndex {
+			addErr("index's last stream index is %d, but the log stream's is %d",
+				index.LastStreamIndex, last.StreamIndex)
+		}
+		if index.LastPrefixIndex != last.PrefixIndex {
+			addErr("index's last prefix index is %d, but the log stream's is %d",
+				index.LastPrefixIndex, last.PrefixIndex)
+		}
+	}
+
+	if len(merr) > 0 {
+		return merr
+	}
+	return nil
+}
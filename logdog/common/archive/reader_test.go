@@ -0,0 +1,124 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/logdog/api/logpb"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLogStreamReader(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An archived log stream`, t, func() {
+		var logB, indexB bytes.Buffer
+		desc := &logpb.LogStreamDescriptor{
+			Prefix: "test",
+			Name:   "foo",
+		}
+		ts := testSource{}
+		m := Manifest{
+			Desc:        desc,
+			Source:      &ts,
+			LogWriter:   &logB,
+			IndexWriter: &indexB,
+		}
+
+		archive := func() *logpb.LogIndex {
+			So(Archive(m), ShouldBeNil)
+
+			var index logpb.LogIndex
+			So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+			return &index
+		}
+
+		for _, c := range []logpb.LogIndex_Compression{logpb.LogIndex_NONE, logpb.LogIndex_GZIP, logpb.LogIndex_SNAPPY} {
+			Convey(fmt.Sprintf(`With %s compression`, c), func() {
+				m.Compression = c
+				if c != logpb.LogIndex_NONE {
+					m.StreamIndexRange = 3
+				}
+				ts.add(0, 1, 2, 3, 4, 5, 6, 7)
+				index := archive()
+
+				Convey(`Can detect its compression.`, func() {
+					So(DetectCompression(logB.Bytes()), ShouldEqual, c)
+				})
+
+				Convey(`Can be read.`, func() {
+					lr, err := NewLogStreamReader(bytes.NewReader(logB.Bytes()), c)
+					So(err, ShouldBeNil)
+					So(lr.Descriptor(), ShouldResemble, desc)
+
+					var indices []uint64
+					for {
+						rec, err := lr.Next()
+						if err == io.EOF {
+							break
+						}
+						So(err, ShouldBeNil)
+						indices = append(indices, rec.StreamIndex)
+					}
+					So(indices, ShouldResemble, []uint64{0, 1, 2, 3, 4, 5, 6, 7})
+				})
+
+				Convey(`Can rebuild its index.`, func() {
+					rebuilt, err := BuildIndex(bytes.NewReader(logB.Bytes()), c)
+					So(err, ShouldBeNil)
+					So(rebuilt, ShouldResemble, index)
+				})
+
+				Convey(`Can verify its index.`, func() {
+					So(VerifyIndex(index, bytes.NewReader(logB.Bytes())), ShouldBeNil)
+
+					index.LogEntryCount = 3
+					index.Entries[1].Offset++
+					err := VerifyIndex(index, bytes.NewReader(logB.Bytes()))
+					So(err, ShouldHaveSameTypeAs, errors.MultiError(nil))
+
+					merr := err.(errors.MultiError)
+					So(merr, ShouldHaveLength, 2)
+					So(merr[0], ShouldErrLike, fmt.Sprintf("index entry for stream index %d has offset", index.Entries[1].StreamIndex))
+					So(merr[1], ShouldErrLike, "index has 3 log entries, but the log stream has 8")
+				})
+
+				Convey(`Will report a truncated log stream.`, func() {
+					lr, err := NewLogStreamReader(bytes.NewReader(logB.Bytes()[:logB.Len()-2]), c)
+					So(err, ShouldBeNil)
+					for err == nil {
+						_, err = lr.Next()
+					}
+					So(err, ShouldNotEqual, io.EOF)
+				})
+			})
+		}
+
+		Convey(`Can rebuild a sparse index for a compressed log stream.`, func() {
+			m.Compression = logpb.LogIndex_GZIP
+			m.ByteRange = 32
+			ts.add(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+			index := archive()
+			So(len(index.Entries), ShouldBeLessThan, 10)
+
+			rebuilt, err := BuildIndex(bytes.NewReader(logB.Bytes()), m.Compression)
+			So(err, ShouldBeNil)
+			So(rebuilt, ShouldResemble, index)
+		})
+
+		Convey(`Will refuse an empty log stream.`, func() {
+			_, err := NewLogStreamReader(bytes.NewReader(nil), logpb.LogIndex_NONE)
+			So(err, ShouldErrLike, "log stream is empty")
+		})
+	})
+}
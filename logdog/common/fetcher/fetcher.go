@@ -84,6 +84,10 @@ type Options struct {
 
 	// Delay is the amount of time to wait in between unsuccessful log requests.
 	Delay time.Duration
+	// MaxDelay, if greater than Delay, causes the Fetcher to back off while the
+	// log stream is idle: the delay doubles after each consecutive unsuccessful
+	// log request, up to MaxDelay. It is reset once logs are returned.
+	MaxDelay time.Duration
 
 	// sizeFunc is a function that calculates the byte size of a LogEntry
 	// protobuf.
@@ -117,6 +121,9 @@ func New(c context.Context, o Options) *Fetcher {
 	if o.Delay <= 0 {
 		o.Delay = DefaultDelay
 	}
+	if o.MaxDelay < o.Delay {
+		o.MaxDelay = o.Delay
+	}
 	if o.BufferBytes <= 0 && o.BufferCount <= 0 {
 		o.BufferBytes = DefaultBufferBytes
 	}
@@ -330,6 +337,7 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 		lreq.Bytes = 0
 	}
 
+	delay := f.o.Delay
 	for {
 		log.Fields{
 			"index": req.index,
@@ -362,13 +370,18 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 			// No logs this round. Sleep for more.
 			log.Fields{
 				"index": req.index,
-				"delay": f.o.Delay,
+				"delay": delay,
 			}.Infof(c, "No logs returned. Sleeping...")
-			if tr := clock.Sleep(c, f.o.Delay); tr.Incomplete() {
+			if tr := clock.Sleep(c, delay); tr.Incomplete() {
 				log.WithError(tr.Err).Warningf(c, "Context was canceled.")
 				resp.err = tr.Err
 				return
 			}
+
+			// Back off while the stream is idle.
+			if delay *= 2; delay > f.o.MaxDelay {
+				delay = f.o.MaxDelay
+			}
 		}
 	}
 }
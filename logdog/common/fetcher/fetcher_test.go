@@ -246,6 +246,31 @@ func TestFetcher(t *testing.T) {
 				So(delayed, ShouldBeTrue)
 			})
 
+			Convey(`Will back off while no log records are available.`, func() {
+				o.Delay = time.Second
+				o.MaxDelay = 5 * time.Second
+
+				var delays []time.Duration
+				tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+					delays = append(delays, d)
+					if len(delays) == 5 {
+						var cmd testSourceCommand
+						ts.send(cmd.logs(0).terminalIndex(0))
+					}
+					tc.Add(d)
+				})
+
+				f := newFetcher()
+				defer reap(f)
+
+				logs, err := loadLogs(f, 0)
+				So(err, ShouldEqual, io.EOF)
+				So(logs, ShouldResemble, []types.MessageIndex{0})
+				So(delays, ShouldResemble, []time.Duration{
+					time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+				})
+			})
+
 			Convey(`When an error is countered getting the terminal index, returns the error.`, func() {
 				var cmd testSourceCommand
 				ts.send(cmd.error(errors.New("test error"), false))
@@ -0,0 +1,322 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	ansiESC = '\x1b'
+	ansiBEL = '\x07'
+
+	// maxPendingANSI is the maximum length of an escape sequence that is held
+	// back until the rest of its line arrives. Longer ones are dropped.
+	maxPendingANSI = 256
+)
+
+// scanANSI splits s into text and ANSI escape sequences.
+//
+// text is called with each run of text between escape sequences. sgr, if not
+// nil, is called with the parameters of each SGR ("Select Graphic Rendition")
+// sequence. All other escape sequences are dropped.
+//
+// An escape sequence that is cut off at the end of s is returned as rest, so
+// that it can be completed by the text that follows s, if any.
+func scanANSI(s string, text func(string), sgr func([]int)) (rest string) {
+	for len(s) > 0 {
+		idx := strings.IndexByte(s, ansiESC)
+		if idx < 0 {
+			text(s)
+			return ""
+		}
+		if idx > 0 {
+			text(s[:idx])
+		}
+		seq := s[idx:]
+		s = s[idx+1:]
+		if len(s) == 0 {
+			return seq
+		}
+
+		switch s[0] {
+		case '[':
+			// CSI: parameter bytes, intermediate bytes, then a final byte.
+			i := 1
+			for i < len(s) && s[i] >= 0x30 && s[i] <= 0x3F {
+				i++
+			}
+			params := s[1:i]
+			j := i
+			for j < len(s) && s[j] >= 0x20 && s[j] <= 0x2F {
+				j++
+			}
+			if j >= len(s) {
+				return seq
+			}
+			if s[j] == 'm' && j == i && sgr != nil {
+				if p, ok := parseSGRParams(params); ok {
+					sgr(p)
+				}
+			}
+			s = s[j+1:]
+
+		case ']':
+			// OSC: terminated by BEL or ST (ESC \).
+			end := -1
+			for i := 1; i < len(s); i++ {
+				if s[i] == ansiBEL {
+					end = i + 1
+					break
+				}
+				if s[i] == ansiESC && i+1 < len(s) && s[i+1] == '\\' {
+					end = i + 2
+					break
+				}
+			}
+			if end < 0 {
+				return seq
+			}
+			s = s[end:]
+
+		default:
+			// Intermediate bytes, then a final byte.
+			i := 0
+			for i < len(s) && s[i] >= 0x20 && s[i] <= 0x2F {
+				i++
+			}
+			if i >= len(s) {
+				return seq
+			}
+			s = s[i+1:]
+		}
+	}
+	return ""
+}
+
+// pendingANSI holds an escape sequence that was cut off at the end of a line
+// with no delimiter, until the continuation of the line arrives in a later log
+// entry.
+type pendingANSI string
+
+// join returns s, prefixed with the pending escape sequence, and clears it.
+func (p *pendingANSI) join(s string) string {
+	s = string(*p) + s
+	*p = ""
+	return s
+}
+
+// hold keeps rest, the escape sequence cut off at the end of a line returned
+// by scanANSI, if the line is continued.
+func (p *pendingANSI) hold(rest string, continued bool) {
+	if continued && len(rest) <= maxPendingANSI {
+		*p = pendingANSI(rest)
+	}
+}
+
+// parseSGRParams parses the parameters of an SGR sequence. Empty parameters
+// are zero.
+func parseSGRParams(s string) ([]int, bool) {
+	if s == "" {
+		return []int{0}, true
+	}
+
+	parts := strings.Split(s, ";")
+	params := make([]int, len(parts))
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		v, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, false
+		}
+		params[i] = v
+	}
+	return params, true
+}
+
+// StripANSI returns s with its ANSI escape sequences removed.
+func StripANSI(s string) string {
+	if strings.IndexByte(s, ansiESC) < 0 {
+		return s
+	}
+
+	var b bytes.Buffer
+	scanANSI(s, func(t string) { b.WriteString(t) }, nil)
+	return b.String()
+}
+
+// ansiColor is a terminal color.
+type ansiColor struct {
+	// set is true if the color is not the default one.
+	set bool
+	// index, if rgb is false, is the index of the color in the 256-color
+	// palette.
+	index int
+	// rgb, if true, means that the color is the 24-bit color r, g, b.
+	rgb     bool
+	r, g, b uint8
+}
+
+// cssValue returns the CSS value of a color that has no class of its own.
+func (c ansiColor) cssValue() string {
+	r, g, b := c.r, c.g, c.b
+	if !c.rgb {
+		r, g, b = paletteColor(c.index)
+	}
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// paletteColor returns the RGB value of a color of the 256-color palette,
+// beyond the 16 basic colors.
+func paletteColor(index int) (r, g, b uint8) {
+	if index >= 232 {
+		v := uint8(8 + 10*(index-232))
+		return v, v, v
+	}
+
+	levels := [...]uint8{0, 95, 135, 175, 215, 255}
+	index -= 16
+	return levels[(index/36)%6], levels[(index/6)%6], levels[index%6]
+}
+
+// sgrState is the text style set by SGR sequences.
+type sgrState struct {
+	bold      bool
+	faint     bool
+	italic    bool
+	underline bool
+	inverse   bool
+	strike    bool
+
+	fg ansiColor
+	bg ansiColor
+}
+
+// apply updates the state with the parameters of an SGR sequence.
+func (s *sgrState) apply(params []int) {
+	for i := 0; i < len(params); i++ {
+		switch p := params[i]; {
+		case p == 0:
+			*s = sgrState{}
+		case p == 1:
+			s.bold = true
+		case p == 2:
+			s.faint = true
+		case p == 3:
+			s.italic = true
+		case p == 4:
+			s.underline = true
+		case p == 7:
+			s.inverse = true
+		case p == 9:
+			s.strike = true
+		case p == 22:
+			s.bold, s.faint = false, false
+		case p == 23:
+			s.italic = false
+		case p == 24:
+			s.underline = false
+		case p == 27:
+			s.inverse = false
+		case p == 29:
+			s.strike = false
+
+		case p >= 30 && p <= 37:
+			s.fg = ansiColor{set: true, index: p - 30}
+		case p == 38:
+			s.fg, i = parseExtendedColor(params, i)
+		case p == 39:
+			s.fg = ansiColor{}
+		case p >= 40 && p <= 47:
+			s.bg = ansiColor{set: true, index: p - 40}
+		case p == 48:
+			s.bg, i = parseExtendedColor(params, i)
+		case p == 49:
+			s.bg = ansiColor{}
+		case p >= 90 && p <= 97:
+			s.fg = ansiColor{set: true, index: p - 90 + 8}
+		case p >= 100 && p <= 107:
+			s.bg = ansiColor{set: true, index: p - 100 + 8}
+		}
+	}
+}
+
+// parseExtendedColor parses a "38;5;n" or "38;2;r;g;b" color whose first
+// parameter is at params[i]. It returns the color and the index of its last
+// parameter.
+//
+// An invalid color resets to the default color.
+func parseExtendedColor(params []int, i int) (ansiColor, int) {
+	if i+1 >= len(params) {
+		return ansiColor{}, len(params)
+	}
+
+	switch params[i+1] {
+	case 5:
+		if i+2 < len(params) && params[i+2] >= 0 && params[i+2] <= 255 {
+			return ansiColor{set: true, index: params[i+2]}, i + 2
+		}
+		return ansiColor{}, i + 2
+
+	case 2:
+		if i+4 >= len(params) {
+			return ansiColor{}, len(params)
+		}
+		c := ansiColor{set: true, rgb: true}
+		for j, v := range []*uint8{&c.r, &c.g, &c.b} {
+			p := params[i+2+j]
+			if p < 0 || p > 255 {
+				return ansiColor{}, i + 4
+			}
+			*v = uint8(p)
+		}
+		return c, i + 4
+
+	default:
+		return ansiColor{}, i + 1
+	}
+}
+
+// isDefault returns true if no style is set.
+func (s *sgrState) isDefault() bool { return *s == sgrState{} }
+
+// htmlAttrs returns the class and style attributes of an HTML element with the
+// state's style.
+func (s *sgrState) htmlAttrs() (classes, style []string) {
+	flags := []struct {
+		set   bool
+		class string
+	}{
+		{s.bold, "ansi-bold"},
+		{s.faint, "ansi-faint"},
+		{s.italic, "ansi-italic"},
+		{s.underline, "ansi-underline"},
+		{s.strike, "ansi-strike"},
+		{s.inverse, "ansi-inverse"},
+	}
+	for _, f := range flags {
+		if f.set {
+			classes = append(classes, f.class)
+		}
+	}
+
+	color := func(c ansiColor, kind, property string) {
+		switch {
+		case !c.set:
+		case !c.rgb && c.index < 16:
+			classes = append(classes, fmt.Sprintf("ansi-%s-%d", kind, c.index))
+		default:
+			style = append(style, property+":"+c.cssValue())
+		}
+	}
+	color(s.fg, "fg", "color")
+	color(s.bg, "bg", "background-color")
+	return
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package renderer
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestANSI(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Stripping ANSI escape sequences`, t, func() {
+		for _, tc := range []struct {
+			in, out string
+		}{
+			{"plain text", "plain text"},
+			{"\x1b[1;31mred\x1b[0m text", "red text"},
+			{"\x1b[2Kclear\x1b[10;20H", "clear"},
+			{"\x1b]0;title\x07after", "after"},
+			{"\x1b]8;;http://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+			{"\x1b(Bcharset", "charset"},
+			{"cut off\x1b[1;3", "cut off"},
+			{"trailing\x1b", "trailing"},
+		} {
+			Convey(tc.in, func() {
+				So(StripANSI(tc.in), ShouldEqual, tc.out)
+			})
+		}
+	})
+
+	Convey(`Scanning ANSI escape sequences returns the cut off one`, t, func() {
+		for _, tc := range []struct {
+			in, rest string
+		}{
+			{"complete\x1b[1m", ""},
+			{"cut off\x1b[1;3", "\x1b[1;3"},
+			{"trailing\x1b", "\x1b"},
+			{"title\x1b]0;foo", "\x1b]0;foo"},
+			{"title\x1b]0;foo\x1b", "\x1b]0;foo\x1b"},
+			{"charset\x1b(", "\x1b("},
+		} {
+			Convey(tc.in, func() {
+				So(scanANSI(tc.in, func(string) {}, nil), ShouldEqual, tc.rest)
+			})
+		}
+	})
+
+	Convey(`An SGR state`, t, func() {
+		var s sgrState
+		attrs := func(params ...int) ([]string, []string) {
+			s.apply(params)
+			return s.htmlAttrs()
+		}
+
+		Convey(`Starts with the default style.`, func() {
+			So(s.isDefault(), ShouldBeTrue)
+		})
+
+		Convey(`Sets and resets attributes.`, func() {
+			classes, style := attrs(1, 4, 32, 41)
+			So(classes, ShouldResemble, []string{"ansi-bold", "ansi-underline", "ansi-fg-2", "ansi-bg-1"})
+			So(style, ShouldBeNil)
+
+			classes, _ = attrs(22, 39)
+			So(classes, ShouldResemble, []string{"ansi-underline", "ansi-bg-1"})
+
+			attrs(0)
+			So(s.isDefault(), ShouldBeTrue)
+		})
+
+		Convey(`Renders bright colors as classes.`, func() {
+			classes, _ := attrs(93, 104)
+			So(classes, ShouldResemble, []string{"ansi-fg-11", "ansi-bg-12"})
+		})
+
+		Convey(`Renders extended colors as styles.`, func() {
+			classes, style := attrs(38, 5, 196, 48, 2, 1, 2, 3, 3)
+			So(classes, ShouldResemble, []string{"ansi-italic"})
+			So(style, ShouldResemble, []string{"color:#ff0000", "background-color:#010203"})
+
+			_, style = attrs(38, 5, 244)
+			So(style[0], ShouldEqual, "color:#808080")
+
+			classes, style = attrs(38, 5, 3)
+			So(classes, ShouldResemble, []string{"ansi-italic", "ansi-fg-3"})
+			So(style, ShouldResemble, []string{"background-color:#010203"})
+		})
+
+		Convey(`Resets invalid extended colors.`, func() {
+			attrs(31)
+			_, style := attrs(38, 2, 1000, 0, 0, 1)
+			So(style, ShouldBeNil)
+			So(s.bold, ShouldBeTrue)
+		})
+	})
+}
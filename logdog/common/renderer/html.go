@@ -0,0 +1,170 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/api/logpb"
+)
+
+// HTMLStyle is the stylesheet for the classes used by TextModeHTML.
+//
+// Colors 0-15 of the terminal palette have an "ansi-fg-N" and an "ansi-bg-N"
+// class. Other colors are rendered as inline styles.
+const HTMLStyle = `
+.line { white-space: pre-wrap; font-family: monospace; }
+.line .anchor { color: #999; text-decoration: none; margin-right: 0.5em; }
+.line .time { color: #999; margin-right: 0.5em; }
+.ansi-bold { font-weight: bold; }
+.ansi-faint { opacity: 0.6; }
+.ansi-italic { font-style: italic; }
+.ansi-underline { text-decoration: underline; }
+.ansi-strike { text-decoration: line-through; }
+.ansi-inverse { filter: invert(100%); }
+.ansi-fg-0 { color: #000000; } .ansi-bg-0 { background-color: #000000; }
+.ansi-fg-1 { color: #cd0000; } .ansi-bg-1 { background-color: #cd0000; }
+.ansi-fg-2 { color: #00cd00; } .ansi-bg-2 { background-color: #00cd00; }
+.ansi-fg-3 { color: #cdcd00; } .ansi-bg-3 { background-color: #cdcd00; }
+.ansi-fg-4 { color: #0000ee; } .ansi-bg-4 { background-color: #0000ee; }
+.ansi-fg-5 { color: #cd00cd; } .ansi-bg-5 { background-color: #cd00cd; }
+.ansi-fg-6 { color: #00cdcd; } .ansi-bg-6 { background-color: #00cdcd; }
+.ansi-fg-7 { color: #e5e5e5; } .ansi-bg-7 { background-color: #e5e5e5; }
+.ansi-fg-8 { color: #7f7f7f; } .ansi-bg-8 { background-color: #7f7f7f; }
+.ansi-fg-9 { color: #ff0000; } .ansi-bg-9 { background-color: #ff0000; }
+.ansi-fg-10 { color: #00ff00; } .ansi-bg-10 { background-color: #00ff00; }
+.ansi-fg-11 { color: #ffff00; } .ansi-bg-11 { background-color: #ffff00; }
+.ansi-fg-12 { color: #5c5cff; } .ansi-bg-12 { background-color: #5c5cff; }
+.ansi-fg-13 { color: #ff00ff; } .ansi-bg-13 { background-color: #ff00ff; }
+.ansi-fg-14 { color: #00ffff; } .ansi-bg-14 { background-color: #00ffff; }
+.ansi-fg-15 { color: #ffffff; } .ansi-bg-15 { background-color: #ffffff; }
+`
+
+// htmlTimeFormat is the format of rendered log entry timestamps.
+const htmlTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// HTMLOptions is the set of options used to render text streams as HTML.
+type HTMLOptions struct {
+	// Document, if true, renders a complete HTML document, including HTMLStyle.
+	// Otherwise, only the lines are rendered.
+	Document bool
+	// Title is the title of the HTML document.
+	Title string
+
+	// Anchors, if true, prefixes each line with a link to itself. The anchor of
+	// a line is "L<stream index>-<n>", where n is the position of the line in
+	// its log entry.
+	Anchors bool
+
+	// Timestamps, if true, prefixes each line with the time of its log entry.
+	Timestamps bool
+	// StreamTime is the time of the log stream, to which log entry time offsets
+	// are added.
+	StreamTime time.Time
+}
+
+// htmlRenderer is the state of a Renderer that renders text as HTML.
+type htmlRenderer struct {
+	*HTMLOptions
+
+	// sgr is the current ANSI style. It persists across lines.
+	sgr sgrState
+	// started is true if the document header was written.
+	started bool
+	// lineOpen is true if the last line had no delimiter, and is continued by
+	// the next one.
+	lineOpen bool
+	// pending is the escape sequence cut off at the end of the open line.
+	pending pendingANSI
+}
+
+// start writes the document header, if needed.
+func (h *htmlRenderer) start(buf *bytes.Buffer) {
+	if h.started {
+		return
+	}
+	h.started = true
+
+	if h.Document {
+		fmt.Fprintf(buf, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n"+
+			"<title>%s</title>\n<style>%s</style>\n</head>\n<body>\n", html.EscapeString(h.Title), HTMLStyle)
+	}
+}
+
+// finish closes the current line, and writes the document footer if needed.
+func (h *htmlRenderer) finish(buf *bytes.Buffer) {
+	h.start(buf)
+	if h.lineOpen {
+		buf.WriteString("</div>\n")
+		h.lineOpen = false
+	}
+	if h.Document {
+		buf.WriteString("</body>\n</html>\n")
+	}
+}
+
+// writeLine renders a text line of a log entry. n is the position of the line
+// in the log entry.
+func (h *htmlRenderer) writeLine(buf *bytes.Buffer, le *logpb.LogEntry, n int, prefix, value string, delimited bool) {
+	h.start(buf)
+
+	if !h.lineOpen {
+		id := fmt.Sprintf("L%d-%d", le.StreamIndex, n)
+		fmt.Fprintf(buf, `<div class="line" id="%s">`, id)
+		if h.Anchors {
+			fmt.Fprintf(buf, `<a class="anchor" href="#%s">%d</a>`, id, le.StreamIndex)
+		}
+		if h.Timestamps {
+			ts := h.StreamTime.Add(google.DurationFromProto(le.TimeOffset))
+			fmt.Fprintf(buf, `<span class="time">%s</span>`, ts.UTC().Format(htmlTimeFormat))
+		}
+		buf.WriteString(html.EscapeString(prefix))
+	}
+
+	spanOpen := false
+	openSpan := func() {
+		if h.sgr.isDefault() {
+			return
+		}
+		classes, style := h.sgr.htmlAttrs()
+		buf.WriteString("<span")
+		if len(classes) > 0 {
+			fmt.Fprintf(buf, ` class="%s"`, strings.Join(classes, " "))
+		}
+		if len(style) > 0 {
+			fmt.Fprintf(buf, ` style="%s"`, strings.Join(style, ";"))
+		}
+		buf.WriteString(">")
+		spanOpen = true
+	}
+	closeSpan := func() {
+		if spanOpen {
+			buf.WriteString("</span>")
+			spanOpen = false
+		}
+	}
+
+	rest := scanANSI(h.pending.join(value), func(t string) {
+		if !spanOpen {
+			openSpan()
+		}
+		buf.WriteString(html.EscapeString(t))
+	}, func(params []int) {
+		closeSpan()
+		h.sgr.apply(params)
+	})
+	closeSpan()
+	h.pending.hold(rest, !delimited)
+
+	h.lineOpen = !delimited
+	if delimited {
+		buf.WriteString("</div>\n")
+	}
+}
@@ -9,6 +9,9 @@
 //     order.
 //   - Binary streams are rendered by emitting the sequential binary data
 //     verbatim.
+//
+// Text streams can also have their ANSI escape sequences stripped, or be
+// rendered as HTML, translating ANSI styles into styled HTML spans.
 package renderer
 
 import (
@@ -27,6 +30,19 @@ import (
 // be.
 type DatagramWriter func(io.Writer, []byte) bool
 
+// TextMode is the way that a Renderer renders the lines of text streams.
+type TextMode int
+
+const (
+	// TextModeDefault renders text lines verbatim.
+	TextModeDefault TextMode = iota
+	// TextModeStrip renders text lines with their ANSI escape sequences removed.
+	TextModeStrip
+	// TextModeHTML renders text lines as HTML. ANSI SGR sequences are rendered
+	// as styled spans, and other escape sequences are removed.
+	TextModeHTML
+)
+
 // Renderer is a stateful instance that provides an io.Reader interface to a
 // log stream.
 type Renderer struct {
@@ -46,6 +62,12 @@ type Renderer struct {
 	// resulting string is prepended to that text line on render.
 	TextPrefix func(le *logpb.LogEntry, line *logpb.Text_Line) string
 
+	// TextMode is the way that text lines are rendered. It is ignored if Raw is
+	// true.
+	TextMode TextMode
+	// HTML, if not nil, is the set of options used by TextModeHTML.
+	HTML *HTMLOptions
+
 	// DatagramWriter is a function to call to render a complete datagram stream.
 	// If it returns false, or if nil, a hex dump renderer will be used to
 	// render the datagram.
@@ -61,6 +83,12 @@ type Renderer struct {
 
 	// dgBuf is a buffer used for partial datagrams.
 	dgBuf bytes.Buffer
+
+	// html is the state of TextModeHTML rendering.
+	html *htmlRenderer
+	// ansi is the escape sequence cut off at the end of the last line, if it
+	// had no delimiter. It is used by TextModeStrip.
+	ansi pendingANSI
 }
 
 var _ io.Reader = (*Renderer)(nil)
@@ -76,6 +104,10 @@ func (r *Renderer) Read(b []byte) (int, error) {
 
 	count := copy(b, buffered)
 	r.bufPos += count
+	if r.bufPos < r.buf.Len() {
+		// Don't return our error until our buffer is drained.
+		return count, nil
+	}
 	return count, r.err
 }
 
@@ -91,16 +123,30 @@ func (r *Renderer) bufferNext() error {
 	if le != nil {
 		switch {
 		case le.GetText() != nil:
-			for _, line := range le.GetText().Lines {
+			for i, line := range le.GetText().Lines {
+				prefix := ""
 				if r.TextPrefix != nil {
-					r.buf.WriteString(r.TextPrefix(le, line))
+					prefix = r.TextPrefix(le, line)
 				}
 
-				r.buf.WriteString(line.Value)
-				if !r.Raw {
-					r.buf.WriteRune('\n')
-				} else {
+				switch {
+				case r.Raw:
+					r.buf.WriteString(prefix)
+					r.buf.WriteString(line.Value)
 					r.buf.WriteString(line.Delimiter)
+
+				case r.TextMode == TextModeHTML:
+					r.htmlRenderer().writeLine(&r.buf, le, i, prefix, line.Value, line.Delimiter != "")
+
+				default:
+					r.buf.WriteString(prefix)
+					if r.TextMode == TextModeStrip {
+						rest := scanANSI(r.ansi.join(line.Value), func(t string) { r.buf.WriteString(t) }, nil)
+						r.ansi.hold(rest, line.Delimiter == "")
+					} else {
+						r.buf.WriteString(line.Value)
+					}
+					r.buf.WriteRune('\n')
 				}
 			}
 
@@ -145,9 +191,25 @@ func (r *Renderer) bufferNext() error {
 		}
 	}
 
+	if err != nil && !r.Raw && r.TextMode == TextModeHTML {
+		r.htmlRenderer().finish(&r.buf)
+	}
 	return err
 }
 
+// htmlRenderer returns the Renderer's HTML rendering state, creating it if
+// needed.
+func (r *Renderer) htmlRenderer() *htmlRenderer {
+	if r.html == nil {
+		opts := r.HTML
+		if opts == nil {
+			opts = &HTMLOptions{}
+		}
+		r.html = &htmlRenderer{HTMLOptions: opts}
+	}
+	return r.html
+}
+
 func dumpHex(w io.Writer, data []byte) (err error) {
 	// Hex dump.
 	d := hex.Dumper(w)
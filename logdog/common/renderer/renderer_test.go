@@ -8,7 +8,9 @@ import (
 	"bytes"
 	"io"
 	"testing"
+	"time"
 
+	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/logpb"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -111,6 +113,121 @@ func TestRenderer(t *testing.T) {
 			})
 		})
 
+		Convey(`With TEXT log entries containing ANSI escape sequences`, func() {
+			ts.loadText("\x1b[1;31mred <b>\x1b[0m plain", "\n")
+			ts.loadLogEntry(&logpb.LogEntry{
+				StreamIndex: 1,
+				TimeOffset:  google.NewDuration(1500 * time.Millisecond),
+				Content: &logpb.LogEntry_Text{
+					Text: &logpb.Text{
+						Lines: []*logpb.Text_Line{
+							{Value: "\x1b[32mgreen", Delimiter: "\n"},
+							{Value: "still green, ", Delimiter: ""},
+						},
+					},
+				},
+			})
+			ts.loadLogEntry(&logpb.LogEntry{
+				StreamIndex: 2,
+				TimeOffset:  google.NewDuration(2 * time.Second),
+				Content: &logpb.LogEntry_Text{
+					Text: &logpb.Text{
+						Lines: []*logpb.Text_Line{
+							{Value: "continued\x1b[m", Delimiter: "\n"},
+						},
+					},
+				},
+			})
+
+			Convey(`When configured to strip, renders the text without escape sequences.`, func() {
+				r.TextMode = TextModeStrip
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, "red <b> plain\ngreen\nstill green, \ncontinued\n")
+			})
+
+			Convey(`When configured to render raw, ignores the text mode.`, func() {
+				r.Raw = true
+				r.TextMode = TextModeStrip
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual,
+					"\x1b[1;31mred <b>\x1b[0m plain\n\x1b[32mgreen\nstill green, continued\x1b[m\n")
+			})
+
+			Convey(`When configured to render HTML, renders styled lines.`, func() {
+				r.TextMode = TextModeHTML
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, ""+
+					`<div class="line" id="L0-0"><span class="ansi-bold ansi-fg-1">red &lt;b&gt;</span> plain</div>`+"\n"+
+					`<div class="line" id="L1-0"><span class="ansi-fg-2">green</span></div>`+"\n"+
+					`<div class="line" id="L1-1"><span class="ansi-fg-2">still green, </span>`+
+					`<span class="ansi-fg-2">continued</span></div>`+"\n")
+			})
+
+			Convey(`When configured to render an HTML document with anchors and timestamps.`, func() {
+				r.TextMode = TextModeHTML
+				r.TextPrefix = func(*logpb.LogEntry, *logpb.Text_Line) string { return "<p>" }
+				r.HTML = &HTMLOptions{
+					Document:   true,
+					Title:      "a & b",
+					Anchors:    true,
+					Timestamps: true,
+					StreamTime: time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+				}
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldStartWith, "<!DOCTYPE html>\n")
+				So(b.String(), ShouldContainSubstring, "<title>a &amp; b</title>")
+				So(b.String(), ShouldContainSubstring, ""+
+					`<div class="line" id="L1-0"><a class="anchor" href="#L1-0">1</a>`+
+					`<span class="time">2017-01-02T03:04:06.500Z</span>&lt;p&gt;<span class="ansi-fg-2">green</span></div>`)
+				So(b.String(), ShouldEndWith, "continued</span></div>\n</body>\n</html>\n")
+			})
+		})
+
+		Convey(`With an escape sequence split across partial lines`, func() {
+			ts.loadText("plain \x1b[3", "")
+			ts.loadText("1mred\x1b", "")
+			ts.loadText("[0m plain", "\n")
+			ts.loadText("\x1b[1", "\n")
+			ts.loadText("m", "\n")
+
+			Convey(`When configured to strip, completes it with the continuation.`, func() {
+				r.TextMode = TextModeStrip
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, "plain \nred\n plain\n\nm\n")
+			})
+
+			Convey(`When configured to render HTML, completes it with the continuation.`, func() {
+				r.TextMode = TextModeHTML
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, ""+
+					`<div class="line" id="L0-0">plain <span class="ansi-fg-1">red</span> plain</div>`+"\n"+
+					`<div class="line" id="L0-0"></div>`+"\n"+
+					`<div class="line" id="L0-0">m</div>`+"\n")
+			})
+		})
+
+		Convey(`With no log data, renders an empty HTML document.`, func() {
+			r.TextMode = TextModeHTML
+			r.HTML = &HTMLOptions{Document: true}
+
+			_, err := b.ReadFrom(r)
+			So(err, ShouldBeNil)
+			So(b.String(), ShouldStartWith, "<!DOCTYPE html>\n")
+			So(b.String(), ShouldEndWith, "<body>\n</body>\n</html>\n")
+		})
+
 		Convey(`With BINARY log entries {{0x00}, {0x01, 0x02}, {}, {0x03}}`, func() {
 			ts.loadBinary([]byte{0x00})
 			ts.loadBinary([]byte{0x01, 0x02})
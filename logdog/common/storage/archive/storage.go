@@ -26,6 +26,7 @@ import (
 	"github.com/luci/luci-go/common/iotools"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	logArchive "github.com/luci/luci-go/logdog/common/archive"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/caching"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -52,6 +53,9 @@ const (
 // Google Storage URLs.
 type Options struct {
 	// Index is the Google Storage URL for the stream's index.
+	//
+	// The index records the compression applied to the stream's entries, so a
+	// compressed stream cannot be read without it.
 	Index gs.Path
 	// Stream is the Google Storage URL for the stream's entries.
 	Stream gs.Path
@@ -94,6 +98,10 @@ func (s *storageImpl) Close() {}
 func (s *storageImpl) Config(storage.Config) error  { return storage.ErrReadOnly }
 func (s *storageImpl) Put(storage.PutRequest) error { return storage.ErrReadOnly }
 
+func (s *storageImpl) Purge(cfgtypes.ProjectName, types.StreamPath) error {
+	return storage.ErrReadOnly
+}
+
 func (s *storageImpl) Get(req storage.GetRequest, cb storage.GetCallback) error {
 	idx, err := s.getIndex()
 	if err != nil {
@@ -147,8 +155,16 @@ func (s *storageImpl) getLogEntriesIter(st *getStrategy, cb storage.GetCallback)
 		}
 	}()
 
-	// Count how many bytes we've read.
-	cr := iotools.CountingReader{Reader: storageReader}
+	// Decompress the log stream, if needed. The range always begins at the
+	// start of a compressed block.
+	sr, err := logArchive.NewDecompressor(st.compression, storageReader)
+	if err != nil {
+		log.WithError(err).Errorf(s, "Failed to create stream decompressor.")
+		return errors.Annotate(err).Reason("failed to create stream decompressor").Err()
+	}
+
+	// Count how many (decompressed) bytes we've read.
+	cr := iotools.CountingReader{Reader: sr}
 
 	// Iteratively update our strategy's start offset each time we read a complete
 	// frame.
@@ -156,6 +172,7 @@ func (s *storageImpl) getLogEntriesIter(st *getStrategy, cb storage.GetCallback)
 		rio       = recordio.NewReader(&cr, maxStreamRecordSize)
 		buf       bytes.Buffer
 		remaining = st.count
+		frames    = 0
 	)
 	for {
 		// Reset the count so we know how much we read for this frame.
@@ -185,8 +202,9 @@ func (s *storageImpl) getLogEntriesIter(st *getStrategy, cb storage.GetCallback)
 
 		// If we read from offset 0, the first frame will be the log stream's
 		// descriptor, which we can discard.
-		discardFrame := (offset == 0)
+		discardFrame := (st.startOffset == 0 && frames == 0)
 		offset += uint64(cr.Count)
+		frames++
 		if discardFrame {
 			continue
 		}
@@ -370,6 +388,9 @@ type getStrategy struct {
 	// count is the number of log entries that will be fetched. If 0, no upper
 	// bound was calculated.
 	count uint64
+
+	// compression is the compression codec applied to the log entry stream.
+	compression logpb.LogIndex_Compression
 }
 
 func (gs *getStrategy) length() int64 {
@@ -389,7 +410,8 @@ func (gs *getStrategy) setCount(v uint64) {
 
 func buildGetStrategy(req *storage.GetRequest, idx *logpb.LogIndex) *getStrategy {
 	st := getStrategy{
-		startIndex: req.Index,
+		startIndex:  req.Index,
+		compression: idx.Compression,
 	}
 
 	// If the user has requested an index past the end of the stream, return no
@@ -447,6 +469,16 @@ func buildGetStrategy(req *storage.GetRequest, idx *logpb.LogIndex) *getStrategy
 			}
 		}
 
+		// In a compressed stream, the last index entry may not begin a block of
+		// its own, sharing the block of the index entry before it. Our upper bound
+		// must be the beginning of a later block.
+		if idx.Compression != logpb.LogIndex_NONE {
+			for endIndexEntry > 0 && endIndexEntry < len(idx.Entries) &&
+				idx.Entries[endIndexEntry].Offset == idx.Entries[endIndexEntry-1].Offset {
+				endIndexEntry++
+			}
+		}
+
 		// If we're pointing to a valid index entry, set our upper bound.
 		if endIndexEntry < len(idx.Entries) {
 			st.endOffset = idx.Entries[endIndexEntry].Offset
@@ -33,7 +33,9 @@ const (
 )
 
 type logStreamGenerator struct {
-	lines []string
+	lines       []string
+	compression logpb.LogIndex_Compression
+	indexRange  int
 
 	indexBuf  bytes.Buffer
 	streamBuf bytes.Buffer
@@ -80,6 +82,9 @@ func (g *logStreamGenerator) generate(lines ...string) {
 		Source:      &src,
 		LogWriter:   &g.streamBuf,
 		IndexWriter: &g.indexBuf,
+		Compression: g.compression,
+
+		StreamIndexRange: g.indexRange,
 	})
 	if err != nil {
 		panic(err)
@@ -241,78 +246,97 @@ func testArchiveStorage(t *testing.T, limit int64) {
 			So(st.Put(storage.PutRequest{}), ShouldEqual, storage.ErrReadOnly)
 		})
 
-		Convey(`Given a stream with 5 log entries`, func() {
-			gen.generate("foo", "bar", "baz", "qux", "quux")
-
-			// Basic test cases.
-			for _, tc := range []struct {
-				title string
-				mod   func()
-			}{
-				{`Complete index`, func() {}},
-				{`Empty index protobuf`, func() { gen.sparseIndex() }},
-				{`No index provided`, func() { stImpl.Index = "" }},
-				{`Invalid index path`, func() { stImpl.Index = "does-not-exist" }},
-				{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }},
-				{`Sparse index with a terminal entry`, func() { gen.sparseIndex(1, 3, 4) }},
-				{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }},
-			} {
-				Convey(fmt.Sprintf(`Test Case: %q`, tc.title), func() {
-					tc.mod()
-
-					// Run through per-testcase variant set.
-					for _, variant := range []struct {
-						title string
-						mod   func()
-					}{
-						{"with hints", func() {}},
-						{"without hints", func() { gen.pruneIndexHints() }},
-					} {
-						Convey(variant.title, func() {
-							variant.mod()
-							client.load(&gen)
-
-							var entries []string
-							collect := func(e *storage.Entry) bool {
-								entries = append(entries, gen.lineFromEntry(e))
-								return true
-							}
-
-							Convey(`Can Get [0..]`, func() {
-								So(st.Get(storage.GetRequest{}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines)
-							})
-
-							Convey(`Can Get [1..].`, func() {
-								So(st.Get(storage.GetRequest{Index: 1}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines[1:])
-							})
-
-							Convey(`Can Get [1..2].`, func() {
-								So(st.Get(storage.GetRequest{Index: 1, Limit: 2}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines[1:3])
-							})
-
-							Convey(`Can Get [5..].`, func() {
-								So(st.Get(storage.GetRequest{Index: 5}, collect), ShouldBeNil)
-								So(entries, ShouldHaveLength, 0)
-							})
-
-							Convey(`Can Get [4].`, func() {
-								So(st.Get(storage.GetRequest{Index: 4, Limit: 1}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines[4:])
-							})
+		for _, compression := range []logpb.LogIndex_Compression{logpb.LogIndex_NONE, logpb.LogIndex_GZIP, logpb.LogIndex_SNAPPY} {
+			Convey(fmt.Sprintf(`Given a stream with 5 log entries, compressed with %v`, compression), func() {
+				gen.compression = compression
+				gen.generate("foo", "bar", "baz", "qux", "quux")
+
+				// Basic test cases.
+				for _, tc := range []struct {
+					title   string
+					mod     func()
+					noIndex bool
+				}{
+					{`Complete index`, func() {}, false},
+					{`Empty index protobuf`, func() { gen.sparseIndex() }, false},
+					{`No index provided`, func() { stImpl.Index = "" }, true},
+					{`Invalid index path`, func() { stImpl.Index = "does-not-exist" }, true},
+					{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }, false},
+					{`Sparse index with a terminal entry`, func() { gen.sparseIndex(1, 3, 4) }, false},
+					{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }, false},
+					{`Index with a stream index range`, func() {
+						gen.indexRange = 3
+						gen.generate(gen.lines...)
+					}, false},
+				} {
+					if tc.noIndex && compression != logpb.LogIndex_NONE {
+						// The index records the stream's compression, so compressed
+						// streams cannot be read without it.
+						continue
+					}
 
-							Convey(`Can tail.`, func() {
-								e, err := st.Tail("", "")
-								So(err, ShouldBeNil)
-								So(gen.lineFromEntry(e), ShouldEqual, gen.lines[len(gen.lines)-1])
+					Convey(fmt.Sprintf(`Test Case: %q`, tc.title), func() {
+						tc.mod()
+
+						// Run through per-testcase variant set.
+						for _, variant := range []struct {
+							title string
+							mod   func()
+						}{
+							{"with hints", func() {}},
+							{"without hints", func() { gen.pruneIndexHints() }},
+						} {
+							Convey(variant.title, func() {
+								variant.mod()
+								client.load(&gen)
+
+								var entries []string
+								collect := func(e *storage.Entry) bool {
+									entries = append(entries, gen.lineFromEntry(e))
+									return true
+								}
+
+								Convey(`Can Get [0..]`, func() {
+									So(st.Get(storage.GetRequest{}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines)
+								})
+
+								Convey(`Can Get [1..].`, func() {
+									So(st.Get(storage.GetRequest{Index: 1}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[1:])
+								})
+
+								Convey(`Can Get [1..2].`, func() {
+									So(st.Get(storage.GetRequest{Index: 1, Limit: 2}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[1:3])
+								})
+
+								Convey(`Can Get [1..3].`, func() {
+									So(st.Get(storage.GetRequest{Index: 1, Limit: 3}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[1:4])
+								})
+
+								Convey(`Can Get [5..].`, func() {
+									So(st.Get(storage.GetRequest{Index: 5}, collect), ShouldBeNil)
+									So(entries, ShouldHaveLength, 0)
+								})
+
+								Convey(`Can Get [4].`, func() {
+									So(st.Get(storage.GetRequest{Index: 4, Limit: 1}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[4:])
+								})
+
+								Convey(`Can tail.`, func() {
+									e, err := st.Tail("", "")
+									So(err, ShouldBeNil)
+									So(gen.lineFromEntry(e), ShouldEqual, gen.lines[len(gen.lines)-1])
+								})
 							})
-						})
-					}
-				})
-			}
-		})
+						}
+					})
+				}
+			})
+		}
 
 		// Individual error test cases.
 		for _, tc := range []struct {
@@ -61,6 +61,10 @@ type btTable interface {
 	// If keysOnly is true, then the callback will return nil row data.
 	getLogData(c context.Context, rk *rowKey, limit int, keysOnly bool, cb btGetCallback) error
 
+	// deleteLogData deletes all of the rows belonging to the stream of the
+	// supplied row key.
+	deleteLogData(context.Context, *rowKey) error
+
 	// setMaxLogAge updates the maximum log age policy for the log family.
 	setMaxLogAge(context.Context, time.Duration) error
 }
@@ -133,6 +137,28 @@ func (t *btTableProd) getLogData(c context.Context, rk *rowKey, limit int, keysO
 	return nil
 }
 
+func (t *btTableProd) deleteLogData(c context.Context, rk *rowKey) error {
+	// Collect the keys of all of the stream's rows.
+	var keys []string
+	rng := bigtable.NewRange(rk.pathPrefix(), rk.pathPrefixUpperBound())
+	err := t.base.logTable.ReadRows(c, rng, func(row bigtable.Row) bool {
+		keys = append(keys, row.Key())
+		return true
+	}, bigtable.RowFilter(bigtable.StripValueFilter()))
+	if err != nil {
+		return grpcutil.WrapIfTransient(err)
+	}
+
+	for _, key := range keys {
+		m := bigtable.NewMutation()
+		m.DeleteRow()
+		if err := t.base.logTable.Apply(c, key, m); err != nil {
+			return wrapIfTransientForApply(err)
+		}
+	}
+	return nil
+}
+
 func (t *btTableProd) setMaxLogAge(c context.Context, d time.Duration) error {
 	var logGCPolicy bigtable.GCPolicy
 	if d > 0 {
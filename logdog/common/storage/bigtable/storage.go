@@ -302,6 +302,20 @@ func (s *btStorage) Get(r storage.GetRequest, cb storage.GetCallback) error {
 	}
 }
 
+func (s *btStorage) Purge(project cfgtypes.ProjectName, path types.StreamPath) error {
+	ctx := log.SetFields(s, log.Fields{
+		"project": project,
+		"path":    path,
+	})
+
+	rk := newRowKey(string(project), string(path), 0, 0)
+	if err := s.raw.deleteLogData(ctx, rk); err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to delete log stream rows.")
+		return err
+	}
+	return nil
+}
+
 func (s *btStorage) Tail(project cfgtypes.ProjectName, path types.StreamPath) (*storage.Entry, error) {
 	ctx := log.SetFields(s, log.Fields{
 		"project": project,
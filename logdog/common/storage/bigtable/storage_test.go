@@ -12,6 +12,7 @@ import (
 	"github.com/luci/luci-go/common/data/recordio"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/memory"
+	"github.com/luci/luci-go/logdog/common/storage/storagetest"
 	"github.com/luci/luci-go/logdog/common/types"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
 
@@ -257,3 +258,11 @@ func TestStorage(t *testing.T) {
 		})
 	})
 }
+
+func TestStorageConformance(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Conformance(t, storagetest.Options{GetMissingIsEmpty: true}, func() storage.Storage {
+		return NewMemoryInstance(context.Background(), Options{})
+	})
+}
@@ -170,6 +170,28 @@ func (t *btTableTest) getLogData(c context.Context, rk *rowKey, limit int, keysO
 	return ierr
 }
 
+func (t *btTableTest) deleteLogData(c context.Context, rk *rowKey) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	prefix := rk.pathPrefix()
+	var items []*storageItem
+	t.forEachItem([]byte(prefix), func(k, v []byte) bool {
+		if !bytes.HasPrefix(k, []byte(prefix)) {
+			return false
+		}
+		items = append(items, &storageItem{k, nil})
+		return true
+	})
+
+	coll := t.collection()
+	for _, itm := range items {
+		coll.Delete(itm)
+	}
+	return nil
+}
+
 func (t *btTableTest) setMaxLogAge(c context.Context, d time.Duration) error {
 	if t.err != nil {
 		return t.err
@@ -0,0 +1,291 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package localfs implements the Storage interface on top of a local
+// filesystem directory.
+//
+// It is meant for LogDog deployments that run on a single machine.
+//
+// Layout
+//
+// Each log stream is stored in its own directory:
+//
+//   <Root>/<project>/HEX(SHA256(<stream path>))/
+//
+// As with BigTable, the stream path is hashed so that arbitrarily long paths
+// map to a fixed-size directory name.
+//
+// A stream directory holds append-only segment files, named after their
+// sequence number ("00000000000000000001.seg", ...). Each Put appends its log
+// entries to the last segment, starting a new one once it exceeds the segment
+// size. A segment is a RecordIO stream in which each frame holds a log entry:
+// its stream index, as a big-endian uint64, followed by its data.
+//
+// Streams are loaded lazily, by scanning their segments, the first time they
+// are accessed. A frame that was partially written when the process died is
+// discarded at that time.
+//
+// Expiration
+//
+// Entries are expired one segment at a time: a segment whose last write is
+// older than the configured maximum log age is deleted, along with its
+// entries.
+package localfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+)
+
+// DefaultSegmentSize is the default size after which a new segment file is
+// started.
+const DefaultSegmentSize = 16 * 1024 * 1024
+
+// Options is a set of configuration options for the local filesystem storage.
+type Options struct {
+	// Root is the directory that logs are stored under. It is created if it
+	// doesn't exist.
+	Root string
+
+	// SegmentSize is the size after which a new segment file is started. If
+	// <= 0, DefaultSegmentSize will be used.
+	SegmentSize int64
+
+	// Clock is the clock used to track the age of segments. If nil, the system
+	// clock will be used.
+	Clock clock.Clock
+}
+
+// Storage is an implementation of the storage.Storage interface that stores
+// logs in a local directory.
+//
+// Only one Storage instance (and process) may use a given directory at a time.
+type Storage struct {
+	opts Options
+
+	mu        sync.Mutex
+	maxLogAge time.Duration
+	streams   map[streamKey]*stream
+	closed    bool
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+type streamKey struct {
+	project cfgtypes.ProjectName
+	path    types.StreamPath
+}
+
+// New returns a new Storage instance storing logs under opts.Root.
+func New(opts Options) (*Storage, error) {
+	if opts.Root == "" {
+		return nil, errors.New("localfs: a root directory is required")
+	}
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSegmentSize
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.GetSystemClock()
+	}
+	if err := os.MkdirAll(opts.Root, 0755); err != nil {
+		return nil, err
+	}
+	return &Storage{
+		opts:    opts,
+		streams: map[streamKey]*stream{},
+	}, nil
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.streams = nil
+}
+
+// Config implements storage.Storage.
+//
+// The new maximum log age is applied to all of the streams right away.
+func (s *Storage) Config(cfg storage.Config) error {
+	return s.run(func() error {
+		s.maxLogAge = cfg.MaxLogAge
+		return s.pruneLocked()
+	})
+}
+
+// Prune deletes the segments of all streams that exceed the maximum log age.
+//
+// Expired segments are also deleted whenever their stream is accessed, so
+// Prune only needs to be called periodically to reclaim the space used by
+// streams that are no longer accessed.
+func (s *Storage) Prune() error {
+	return s.run(s.pruneLocked)
+}
+
+// Put implements storage.Storage.
+func (s *Storage) Put(req storage.PutRequest) error {
+	return s.run(func() error {
+		st, err := s.getStreamLocked(req.Project, req.Path, true)
+		if err != nil {
+			return err
+		}
+		return st.put(req.Index, req.Values, s.opts.SegmentSize, s.opts.Clock.Now())
+	})
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(req storage.GetRequest, cb storage.GetCallback) error {
+	var recs []*storage.Entry
+	err := s.run(func() error {
+		st, err := s.getStreamLocked(req.Project, req.Path, false)
+		if err != nil {
+			return err
+		}
+		if st == nil {
+			return storage.ErrDoesNotExist
+		}
+		recs, err = st.get(req.Index, req.Limit, req.KeysOnly)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range recs {
+		if !cb(r) {
+			break
+		}
+	}
+	return nil
+}
+
+// Tail implements storage.Storage.
+//
+// Like the BigTable implementation, it returns the last entry of the
+// contiguous range of entries starting at index 0.
+func (s *Storage) Tail(project cfgtypes.ProjectName, path types.StreamPath) (e *storage.Entry, err error) {
+	err = s.run(func() error {
+		st, err := s.getStreamLocked(project, path, false)
+		if err != nil {
+			return err
+		}
+		if st == nil {
+			return storage.ErrDoesNotExist
+		}
+		e, err = st.tail()
+		return err
+	})
+	return
+}
+
+// Purge implements storage.Storage.
+func (s *Storage) Purge(project cfgtypes.ProjectName, path types.StreamPath) error {
+	return s.run(func() error {
+		if err := project.Validate(); err != nil {
+			return err
+		}
+
+		delete(s.streams, streamKey{project, path})
+		return removeSegmentFiles(s.streamDir(project, path))
+	})
+}
+
+func (s *Storage) run(f func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errors.New("storage is closed")
+	}
+	return f()
+}
+
+// streamDir returns the directory of a stream.
+func (s *Storage) streamDir(project cfgtypes.ProjectName, path types.StreamPath) string {
+	hash := sha256.Sum256([]byte(path))
+	return filepath.Join(s.opts.Root, string(project), hex.EncodeToString(hash[:]))
+}
+
+// getStreamLocked returns the stream with the given project and path, loading
+// it from disk if needed, with its expired segments removed.
+//
+// If the stream doesn't exist and create is false, it returns nil.
+func (s *Storage) getStreamLocked(project cfgtypes.ProjectName, path types.StreamPath, create bool) (*stream, error) {
+	if err := project.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := streamKey{project, path}
+	st := s.streams[key]
+	if st == nil {
+		var err error
+		if st, err = loadStream(s.streamDir(project, path)); err != nil {
+			return nil, err
+		}
+		if st.empty() && !create {
+			// Don't keep track of streams that are only looked up.
+			return nil, nil
+		}
+		s.streams[key] = st
+	}
+	if err := s.expireLocked(st); err != nil {
+		return nil, err
+	}
+
+	if st.empty() && !create {
+		return nil, nil
+	}
+	return st, nil
+}
+
+// expireLocked removes the expired segments of a stream.
+func (s *Storage) expireLocked(st *stream) error {
+	if s.maxLogAge <= 0 {
+		return nil
+	}
+	return st.expire(s.opts.Clock.Now().Add(-s.maxLogAge))
+}
+
+// pruneLocked removes the expired segments of all of the streams on disk.
+func (s *Storage) pruneLocked() error {
+	if s.maxLogAge <= 0 {
+		return nil
+	}
+
+	dirs, err := filepath.Glob(filepath.Join(s.opts.Root, "*", "*"))
+	if err != nil {
+		return err
+	}
+	loaded := make(map[string]*stream, len(s.streams))
+	for _, st := range s.streams {
+		loaded[st.dir] = st
+	}
+	for _, dir := range dirs {
+		st := loaded[dir]
+		if st == nil {
+			// Streams that aren't loaded don't need to be indexed: look at their
+			// segment files only.
+			if err := expireSegmentFiles(dir, s.opts.Clock.Now().Add(-s.maxLogAge)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := s.expireLocked(st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localfs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/storage/storagetest"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStorageConformance(t *testing.T) {
+	t.Parallel()
+
+	var dirs []string
+	defer func() {
+		for _, d := range dirs {
+			os.RemoveAll(d)
+		}
+	}()
+
+	storagetest.Conformance(t, storagetest.Options{}, func() storage.Storage {
+		dir, err := ioutil.TempDir("", "localfs")
+		if err != nil {
+			panic(err)
+		}
+		dirs = append(dirs, dir)
+
+		s, err := New(Options{Root: dir})
+		if err != nil {
+			panic(err)
+		}
+		return s
+	})
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local filesystem storage instance`, t, func() {
+		dir, err := ioutil.TempDir("", "localfs")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		_, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeLocal)
+		opts := Options{
+			Root:        dir,
+			SegmentSize: 32,
+			Clock:       tc,
+		}
+		s, err := New(opts)
+		So(err, ShouldBeNil)
+		defer func() { s.Close() }()
+
+		put := func(path string, index int, d ...string) error {
+			data := make([][]byte, len(d))
+			for i, v := range d {
+				data[i] = []byte(v)
+			}
+			return s.Put(storage.PutRequest{
+				Project: "test",
+				Path:    types.StreamPath(path),
+				Index:   types.MessageIndex(index),
+				Values:  data,
+			})
+		}
+		get := func(path string) ([]string, error) {
+			got := []string{}
+			err := s.Get(storage.GetRequest{Project: "test", Path: types.StreamPath(path)}, func(e *storage.Entry) bool {
+				got = append(got, string(e.D))
+				return true
+			})
+			return got, err
+		}
+		segments := func(path string) []string {
+			paths, err := filepath.Glob(filepath.Join(s.streamDir("test", types.StreamPath(path)), "*"+segmentExt))
+			So(err, ShouldBeNil)
+			for i := range paths {
+				paths[i] = filepath.Base(paths[i])
+			}
+			return paths
+		}
+		reopen := func() {
+			s.Close()
+			s, err = New(opts)
+			So(err, ShouldBeNil)
+		}
+
+		Convey(`Starts new segments when they are full.`, func() {
+			So(put("a/+/b", 0, "0123456789", "0123456789"), ShouldBeNil)
+			So(put("a/+/b", 2, "0123456789"), ShouldBeNil)
+			So(segments("a/+/b"), ShouldResemble, []string{
+				"00000000000000000001.seg",
+				"00000000000000000002.seg",
+			})
+
+			got, err := get("a/+/b")
+			So(err, ShouldBeNil)
+			So(got, ShouldHaveLength, 3)
+		})
+
+		Convey(`Survives restarts.`, func() {
+			So(put("a/+/b", 0, "0", "1"), ShouldBeNil)
+			So(put("a/+/b", 3, "3"), ShouldBeNil)
+			reopen()
+
+			got, err := get("a/+/b")
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, []string{"0", "1", "3"})
+			So(put("a/+/b", 1, "1"), ShouldEqual, storage.ErrExists)
+
+			So(put("a/+/b", 2, "2"), ShouldBeNil)
+			e, err := s.Tail("test", "a/+/b")
+			So(err, ShouldBeNil)
+			So(string(e.D), ShouldEqual, "3")
+		})
+
+		Convey(`Discards partially written frames.`, func() {
+			So(put("a/+/b", 0, "0", "1"), ShouldBeNil)
+			seg := filepath.Join(s.streamDir("test", "a/+/b"), "00000000000000000001.seg")
+			f, err := os.OpenFile(seg, os.O_WRONLY|os.O_APPEND, 0)
+			So(err, ShouldBeNil)
+			_, err = f.Write([]byte{20, 0, 0, 0})
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+			reopen()
+
+			So(put("a/+/b", 2, "2"), ShouldBeNil)
+			reopen()
+			got, err := get("a/+/b")
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, []string{"0", "1", "2"})
+		})
+
+		Convey(`Expires old segments.`, func() {
+			So(put("a/+/b", 0, "0123456789", "0123456789"), ShouldBeNil)
+			tc.Add(time.Hour)
+			So(put("a/+/b", 2, "2"), ShouldBeNil)
+			So(put("a/+/c", 0, "0"), ShouldBeNil)
+			tc.Add(time.Hour)
+
+			So(s.Config(storage.Config{MaxLogAge: 90 * time.Minute}), ShouldBeNil)
+			So(segments("a/+/b"), ShouldResemble, []string{"00000000000000000002.seg"})
+			So(segments("a/+/c"), ShouldResemble, []string{"00000000000000000001.seg"})
+			got, err := get("a/+/b")
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, []string{"2"})
+
+			Convey(`Including streams that aren't loaded.`, func() {
+				reopen()
+				tc.Add(time.Hour)
+				So(s.Config(storage.Config{MaxLogAge: 90 * time.Minute}), ShouldBeNil)
+				So(segments("a/+/b"), ShouldBeEmpty)
+				So(segments("a/+/c"), ShouldBeEmpty)
+
+				_, err := get("a/+/b")
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+			})
+
+			Convey(`When streams are accessed.`, func() {
+				tc.Add(time.Hour)
+				_, err := s.Tail("test", "a/+/c")
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+				So(segments("a/+/c"), ShouldBeEmpty)
+
+				So(put("a/+/c", 0, "new"), ShouldBeNil)
+				got, err := get("a/+/c")
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"new"})
+			})
+		})
+
+		Convey(`Rejects invalid projects.`, func() {
+			So(s.Put(storage.PutRequest{Project: "../x", Path: "a/+/b", Values: [][]byte{nil}}), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,385 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localfs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/data/recordio"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+)
+
+const (
+	// segmentExt is the extension of segment files.
+	segmentExt = ".seg"
+	// indexSize is the size of the stream index that prefixes each frame.
+	indexSize = 8
+)
+
+// segment is a segment file of a stream.
+type segment struct {
+	path    string
+	seq     int64
+	size    int64
+	modTime time.Time
+	indices []types.MessageIndex // Indices of the entries stored in the segment.
+}
+
+// location is the location of an entry's data.
+type location struct {
+	seg    *segment
+	offset int64
+	size   int64
+}
+
+// stream is the in-memory index of a stream's segments.
+type stream struct {
+	dir      string
+	segments []*segment
+	entries  map[types.MessageIndex]*location
+	indices  []types.MessageIndex // Sorted indices of entries.
+}
+
+// loadStream loads the stream stored in dir. If dir doesn't exist, the stream
+// is empty.
+func loadStream(dir string) (*stream, error) {
+	st := &stream{
+		dir:     dir,
+		entries: map[types.MessageIndex]*location{},
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		seq, err := strconv.ParseInt(strings.TrimSuffix(filepath.Base(path), segmentExt), 10, 64)
+		if err != nil {
+			// Not one of our segments.
+			continue
+		}
+		if err := st.loadSegment(path, seq); err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// loadSegment scans a segment file and indexes its entries.
+//
+// A partially written frame at the end of the segment is truncated.
+func (st *stream) loadSegment(path string, seq int64) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	seg := &segment{
+		path:    path,
+		seq:     seq,
+		modTime: fi.ModTime(),
+	}
+	st.segments = append(st.segments, seg)
+
+	br := bufio.NewReader(f)
+	var hdr [binary.MaxVarintLen64]byte
+	var index [indexSize]byte
+	for {
+		size, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err == nil && size >= indexSize && size <= uint64(fi.Size()-seg.size) {
+			if _, err = io.ReadFull(br, index[:]); err == nil {
+				_, err = br.Discard(int(size - indexSize))
+			}
+			if err == nil {
+				n := int64(binary.PutUvarint(hdr[:], size))
+				st.add(types.MessageIndex(binary.BigEndian.Uint64(index[:])), &location{
+					seg:    seg,
+					offset: seg.size + n + indexSize,
+					size:   int64(size) - indexSize,
+				})
+				seg.size += n + int64(size)
+				continue
+			}
+		}
+
+		// The rest of the segment is a partially written frame. Drop it, keeping
+		// the segment's age.
+		if err := f.Truncate(seg.size); err != nil {
+			return err
+		}
+		return os.Chtimes(path, seg.modTime, seg.modTime)
+	}
+}
+
+// add indexes an entry.
+func (st *stream) add(idx types.MessageIndex, loc *location) {
+	if _, ok := st.entries[idx]; !ok {
+		i := sort.Search(len(st.indices), func(i int) bool { return st.indices[i] >= idx })
+		st.indices = append(st.indices, 0)
+		copy(st.indices[i+1:], st.indices[i:])
+		st.indices[i] = idx
+	}
+	st.entries[idx] = loc
+	loc.seg.indices = append(loc.seg.indices, idx)
+}
+
+func (st *stream) empty() bool { return len(st.indices) == 0 }
+
+// put appends entries to the stream, starting a new segment if the last one
+// has reached segmentSize.
+func (st *stream) put(index types.MessageIndex, values [][]byte, segmentSize int64, now time.Time) error {
+	for i := range values {
+		if _, ok := st.entries[index+types.MessageIndex(i)]; ok {
+			return storage.ErrExists
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	var seg *segment
+	newSegment := false
+	if n := len(st.segments); n > 0 && st.segments[n-1].size < segmentSize {
+		seg = st.segments[n-1]
+	} else {
+		seq := int64(1)
+		if n > 0 {
+			seq = st.segments[n-1].seq + 1
+		}
+		if err := os.MkdirAll(st.dir, 0755); err != nil {
+			return err
+		}
+		seg = &segment{
+			path: filepath.Join(st.dir, fmt.Sprintf("%020d%s", seq, segmentExt)),
+			seq:  seq,
+		}
+		newSegment = true
+	}
+
+	buf := bytes.Buffer{}
+	locs := make([]*location, len(values))
+	for i, v := range values {
+		frame := make([]byte, indexSize+len(v))
+		binary.BigEndian.PutUint64(frame, uint64(index)+uint64(i))
+		copy(frame[indexSize:], v)
+
+		recordio.WriteFrame(&buf, frame)
+		locs[i] = &location{
+			seg:    seg,
+			offset: seg.size + int64(buf.Len()-len(v)),
+			size:   int64(len(v)),
+		}
+	}
+
+	if err := appendFile(seg.path, buf.Bytes(), seg.size); err != nil {
+		return err
+	}
+	if err := os.Chtimes(seg.path, now, now); err != nil {
+		return err
+	}
+	if newSegment {
+		st.segments = append(st.segments, seg)
+	}
+	seg.size += int64(buf.Len())
+	seg.modTime = now
+
+	for i, loc := range locs {
+		st.add(index+types.MessageIndex(i), loc)
+	}
+	return nil
+}
+
+// appendFile appends data to the file at path, whose current size is size. On
+// failure, the file is truncated back to its original size.
+func appendFile(path string, data []byte, size int64) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Truncate(path, size)
+	}
+	return err
+}
+
+// get returns up to limit entries, starting at index.
+func (st *stream) get(index types.MessageIndex, limit int, keysOnly bool) ([]*storage.Entry, error) {
+	i := sort.Search(len(st.indices), func(i int) bool { return st.indices[i] >= index })
+	indices := st.indices[i:]
+	if limit > 0 && limit < len(indices) {
+		indices = indices[:limit]
+	}
+
+	r := segmentReader{}
+	defer r.close()
+
+	entries := make([]*storage.Entry, len(indices))
+	for i, idx := range indices {
+		var data []byte
+		if !keysOnly {
+			var err error
+			if data, err = r.read(st.entries[idx]); err != nil {
+				return nil, err
+			}
+		}
+		entries[i] = storage.MakeEntry(data, idx)
+	}
+	return entries, nil
+}
+
+// tail returns the last entry of the contiguous range of entries starting at
+// index 0.
+func (st *stream) tail() (*storage.Entry, error) {
+	// Since indices are sorted and unique, indices[i] == i for a prefix of
+	// indices.
+	i := sort.Search(len(st.indices), func(i int) bool { return st.indices[i] != types.MessageIndex(i) }) - 1
+	if i < 0 {
+		return nil, storage.ErrDoesNotExist
+	}
+
+	r := segmentReader{}
+	defer r.close()
+	data, err := r.read(st.entries[types.MessageIndex(i)])
+	if err != nil {
+		return nil, err
+	}
+	return storage.MakeEntry(data, types.MessageIndex(i)), nil
+}
+
+// expire deletes the segments that haven't been written to since before
+// cutoff, along with their entries.
+func (st *stream) expire(cutoff time.Time) error {
+	expired := false
+	kept := st.segments[:0]
+	for _, seg := range st.segments {
+		if !seg.modTime.Before(cutoff) {
+			kept = append(kept, seg)
+			continue
+		}
+		if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		expired = true
+		for _, idx := range seg.indices {
+			if loc := st.entries[idx]; loc != nil && loc.seg == seg {
+				delete(st.entries, idx)
+			}
+		}
+	}
+	st.segments = kept
+	if !expired {
+		return nil
+	}
+
+	indices := st.indices[:0]
+	for _, idx := range st.indices {
+		if _, ok := st.entries[idx]; ok {
+			indices = append(indices, idx)
+		}
+	}
+	st.indices = indices
+	if len(st.segments) == 0 {
+		// Remove the stream directory, which is empty unless it holds files that
+		// aren't ours.
+		os.Remove(st.dir)
+	}
+	return nil
+}
+
+// expireSegmentFiles deletes the segment files in dir that haven't been
+// written to since before cutoff.
+func expireSegmentFiles(dir string, cutoff time.Time) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if fi.ModTime().Before(cutoff) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	os.Remove(dir)
+	return nil
+}
+
+// removeSegmentFiles deletes all of the segment files in dir.
+func removeSegmentFiles(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	os.Remove(dir)
+	return nil
+}
+
+// segmentReader reads entry data, keeping segment files open until it is
+// closed.
+type segmentReader struct {
+	files map[*segment]*os.File
+}
+
+func (r *segmentReader) read(loc *location) ([]byte, error) {
+	f := r.files[loc.seg]
+	if f == nil {
+		var err error
+		if f, err = os.Open(loc.seg.path); err != nil {
+			return nil, err
+		}
+		if r.files == nil {
+			r.files = map[*segment]*os.File{}
+		}
+		r.files[loc.seg] = f
+	}
+
+	data := make([]byte, loc.size)
+	if n, err := f.ReadAt(data, loc.offset); n != len(data) {
+		if err == nil || err == io.EOF {
+			err = storage.ErrBadData
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *segmentReader) close() {
+	for _, f := range r.files {
+		f.Close()
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/storage/storagetest"
 	"github.com/luci/luci-go/logdog/common/types"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
 
@@ -222,3 +223,9 @@ func TestBigTable(t *testing.T) {
 		})
 	})
 }
+
+func TestStorageConformance(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Conformance(t, storagetest.Options{}, func() storage.Storage { return &Storage{} })
+}
@@ -119,6 +119,12 @@ type Storage interface {
 	// index.
 	Tail(cfgtypes.ProjectName, types.StreamPath) (*Entry, error)
 
+	// Purge deletes all of the log entries of the specified stream.
+	//
+	// Purging a stream that has no log entries succeeds. Read-only Storage
+	// instances will return ErrReadOnly.
+	Purge(cfgtypes.ProjectName, types.StreamPath) error
+
 	// Config installs the supplied configuration parameters into the storage
 	// instance.
 	Config(Config) error
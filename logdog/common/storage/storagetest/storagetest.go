@@ -0,0 +1,213 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package storagetest implements conformance tests that all storage.Storage
+// implementations must pass.
+package storagetest
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// Options adapts the conformance tests to a Storage implementation.
+type Options struct {
+	// GetMissingIsEmpty is true if the Storage can't tell a missing stream from
+	// an empty one without additional lookups, and so returns no records instead
+	// of ErrDoesNotExist when getting a missing stream.
+	GetMissingIsEmpty bool
+}
+
+// Conformance runs the conformance tests against Storage instances returned by
+// newStorage. Every test uses a new, empty instance, which is closed at the
+// end of the test.
+func Conformance(t *testing.T, o Options, newStorage func() storage.Storage) {
+	Convey(`A Storage instance`, t, func() {
+		s := newStorage()
+		defer s.Close()
+
+		get := func(project, path string, index int, limit int, keysOnly bool) ([]string, error) {
+			req := storage.GetRequest{
+				Project:  cfgtypes.ProjectName(project),
+				Path:     types.StreamPath(path),
+				Index:    types.MessageIndex(index),
+				Limit:    limit,
+				KeysOnly: keysOnly,
+			}
+			got := []string{}
+			err := s.Get(req, func(e *storage.Entry) bool {
+				if keysOnly {
+					idx, err := e.GetStreamIndex()
+					So(err, ShouldBeNil)
+					got = append(got, strconv.Itoa(int(idx)))
+				} else {
+					got = append(got, string(e.D))
+				}
+				return true
+			})
+			return got, err
+		}
+
+		put := func(project, path string, index int, d ...string) error {
+			data := make([][]byte, len(d))
+			for i, v := range d {
+				data[i] = []byte(v)
+			}
+			return s.Put(storage.PutRequest{
+				Project: cfgtypes.ProjectName(project),
+				Path:    types.StreamPath(path),
+				Index:   types.MessageIndex(index),
+				Values:  data,
+			})
+		}
+
+		// getMissing asserts that getting a missing stream fails, or returns no
+		// records if the Storage doesn't tell missing streams apart.
+		getMissing := func(project, path string) {
+			got, err := get(project, path, 0, 0, false)
+			if o.GetMissingIsEmpty {
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{})
+			} else {
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+			}
+		}
+
+		tail := func(project, path string) (string, error) {
+			e, err := s.Tail(cfgtypes.ProjectName(project), types.StreamPath(path))
+			if err != nil {
+				return "", err
+			}
+			return string(e.D), nil
+		}
+
+		Convey(`Accepts a configuration.`, func() {
+			So(s.Config(storage.Config{MaxLogAge: 24 * time.Hour}), ShouldBeNil)
+		})
+
+		Convey(`With data: A{0, 1, 2, 3, 4}, B{10, 12, 13}, other-project A{0}`, func() {
+			So(put("test", "A", 0, "0", "1", "2"), ShouldBeNil)
+			So(put("test", "A", 3, "3", "4"), ShouldBeNil)
+			So(put("test", "B", 10, "10"), ShouldBeNil)
+			So(put("test", "B", 12, "12", "13"), ShouldBeNil)
+			So(put("other", "A", 0, "other"), ShouldBeNil)
+
+			Convey(`Refuses to overwrite existing records.`, func() {
+				So(put("test", "A", 3, "3", "4"), ShouldEqual, storage.ErrExists)
+
+				got, err := get("test", "A", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
+			})
+
+			Convey(`Can fetch a full stream.`, func() {
+				got, err := get("test", "A", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
+			})
+
+			Convey(`Can fetch from an index.`, func() {
+				got, err := get("test", "A", 1, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"1", "2", "3", "4"})
+			})
+
+			Convey(`Applies the limit.`, func() {
+				got, err := get("test", "A", 1, 2, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"1", "2"})
+			})
+
+			Convey(`Can fetch keys only.`, func() {
+				got, err := get("test", "A", 1, 0, true)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"1", "2", "3", "4"})
+			})
+
+			Convey(`Stops when the callback returns false.`, func() {
+				var got []string
+				err := s.Get(storage.GetRequest{Project: "test", Path: "A"}, func(e *storage.Entry) bool {
+					got = append(got, string(e.D))
+					return len(got) < 2
+				})
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"0", "1"})
+			})
+
+			Convey(`Returns non-contiguous records in order.`, func() {
+				got, err := get("test", "B", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"10", "12", "13"})
+
+				got, err = get("test", "B", 11, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"12", "13"})
+			})
+
+			Convey(`Keeps projects apart.`, func() {
+				got, err := get("other", "A", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"other"})
+			})
+
+			Convey(`Fails to get a missing stream.`, func() {
+				getMissing("test", "INVALID")
+			})
+
+			Convey(`Can tail a contiguous stream.`, func() {
+				got, err := tail("test", "A")
+				So(err, ShouldBeNil)
+				So(got, ShouldEqual, "4")
+
+				So(put("test", "A", 5, "5"), ShouldBeNil)
+				got, err = tail("test", "A")
+				So(err, ShouldBeNil)
+				So(got, ShouldEqual, "5")
+			})
+
+			Convey(`Fails to tail a missing stream.`, func() {
+				_, err := tail("test", "INVALID")
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+			})
+
+			Convey(`Can purge a stream.`, func() {
+				So(s.Purge("test", "A"), ShouldBeNil)
+
+				getMissing("test", "A")
+
+				_, err := tail("test", "A")
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+				Convey(`Leaves other streams alone.`, func() {
+					got, err := get("test", "B", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"10", "12", "13"})
+
+					got, err = get("other", "A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"other"})
+				})
+
+				Convey(`Accepts new records afterwards.`, func() {
+					So(put("test", "A", 0, "new"), ShouldBeNil)
+
+					got, err := get("test", "A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"new"})
+				})
+			})
+
+			Convey(`Can purge a missing stream.`, func() {
+				So(s.Purge("test", "INVALID"), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -36,6 +36,7 @@ const (
 	tsEntriesField = "entries"
 	tsIndexField   = "index"
 	tsDataField    = "data"
+	tsHTMLField    = "html"
 
 	// If the archive dispatch is within this range of the current time, we will
 	// avoid archival.
@@ -53,7 +54,7 @@ var (
 	// tsSize tracks the archive binary file size distribution of completed
 	// archives.
 	//
-	// The "archive" field is the specific type of archive (entries, index, data)
+	// The "archive" field is the specific type of archive (entries, index, data, html)
 	// that is being tracked.
 	//
 	// The "stream" field is the type of log stream that is being archived.
@@ -67,7 +68,7 @@ var (
 	// tsTotalBytes tracks the cumulative total number of bytes that have
 	// been archived by this instance.
 	//
-	// The "archive" field is the specific type of archive (entries, index, data)
+	// The "archive" field is the specific type of archive (entries, index, data, html)
 	// that is being tracked.
 	//
 	// The "stream" field is the type of log stream that is being archived.
@@ -138,6 +139,9 @@ type Settings struct {
 	// regardless of whether a specific binary file extension has been supplied
 	// with the log stream.
 	AlwaysRender bool
+	// RenderHTML, if true, means that text streams are also archived as an HTML
+	// document. See archive.Manifest for more information.
+	RenderHTML bool
 
 	// IndexStreamRange is the maximum number of stream indexes in between index
 	// entries. See archive.Manifest for more information.
@@ -148,6 +152,10 @@ type Settings struct {
 	// IndexByteRange is the maximum number of stream data bytes in between index
 	// entries. See archive.Manifest for more information.
 	IndexByteRange int
+
+	// Compression is the compression codec to apply to the archived log and
+	// data streams. See archive.Manifest for more information.
+	Compression logpb.LogIndex_Compression
 }
 
 // SettingsLoader returns archival Settings for a given project.
@@ -373,6 +381,22 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 			return err
 		}
 
+		// The log stream may have been purged while we were staging it. If so,
+		// discard the staged archival rather than finalizing it.
+		switch ls, err := a.Service.LoadStream(c, &logdog.LoadStreamRequest{
+			Project: at.Project,
+			Id:      at.Id,
+		}); {
+		case err != nil:
+			log.WithError(err).Errorf(c, "Failed to reload log stream before finalizing.")
+			return err
+
+		case ls.State != nil && ls.State.Purged:
+			log.Warningf(c, "Log stream was purged during archival. Discarding archival request.")
+			task.Consume()
+			return statusErr(errors.New("log stream is purged"))
+		}
+
 		// Finalize the archival.
 		if err := staged.finalize(c, a.GSClient, &ar); err != nil {
 			log.WithError(err).Errorf(c, "Failed to finalize archival.")
@@ -385,6 +409,7 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 		staged.stream.addMetrics(c, tsEntriesField, streamType)
 		staged.index.addMetrics(c, tsIndexField, streamType)
 		staged.data.addMetrics(c, tsDataField, streamType)
+		staged.html.addMetrics(c, tsHTMLField, streamType)
 
 		tsLogEntries.Add(c, float64(staged.logEntryCount), streamType)
 		tsTotalLogEntries.Add(c, staged.logEntryCount, streamType)
@@ -481,7 +506,12 @@ func (a *Archivist) makeStagedArchival(c context.Context, project cfgtypes.Proje
 			bext = "bin"
 		}
 
-		sa.data = sa.makeStagingPaths(fmt.Sprintf("data.%s", bext), uid)
+		sa.data = sa.makeStagingPaths(fmt.Sprintf("data.%s%s", bext, archive.CompressionExt(sa.Compression)), uid)
+	}
+
+	// If we're emitting an HTML rendering of text streams, construct that too.
+	if sa.RenderHTML && sa.desc.StreamType == logpb.StreamType_TEXT {
+		sa.html = sa.makeStagingPaths("data.html", uid)
 	}
 	return &sa, nil
 }
@@ -497,6 +527,7 @@ type stagedArchival struct {
 	stream stagingPaths
 	index  stagingPaths
 	data   stagingPaths
+	html   stagingPaths
 
 	finalized     bool
 	terminalIndex types.MessageIndex
@@ -619,7 +650,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		return w, nil
 	}
 
-	var streamWriter, indexWriter, dataWriter gs.Writer
+	var streamWriter, indexWriter, dataWriter, htmlWriter gs.Writer
 	if streamWriter, err = createWriter(sa.stream.staged); err != nil {
 		return
 	}
@@ -638,6 +669,13 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		defer closeWriter(dataWriter, sa.data.staged)
 	}
 
+	if sa.html.enabled() {
+		if htmlWriter, err = createWriter(sa.html.staged); err != nil {
+			return err
+		}
+		defer closeWriter(htmlWriter, sa.html.staged)
+	}
+
 	// Read our log entries from intermediate storage.
 	ss := storageSource{
 		Context:       c,
@@ -654,9 +692,11 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		LogWriter:        streamWriter,
 		IndexWriter:      indexWriter,
 		DataWriter:       dataWriter,
+		HTMLWriter:       htmlWriter,
 		StreamIndexRange: sa.IndexStreamRange,
 		PrefixIndexRange: sa.IndexPrefixRange,
 		ByteRange:        sa.IndexByteRange,
+		Compression:      sa.Compression,
 
 		Logger: log.Get(c),
 	}
@@ -686,6 +726,9 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	if dataWriter != nil {
 		sa.data.bytesWritten = dataWriter.Count()
 	}
+	if htmlWriter != nil {
+		sa.html.bytesWritten = htmlWriter.Count()
+	}
 	return
 }
 
@@ -769,6 +812,7 @@ func (sa *stagedArchival) getStagingPaths() []*stagingPaths {
 		&sa.stream,
 		&sa.index,
 		&sa.data,
+		&sa.html,
 	}
 }
 
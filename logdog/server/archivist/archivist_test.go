@@ -468,6 +468,23 @@ func TestHandleArchive(t *testing.T) {
 				})
 			})
 
+			Convey(`Will consume task and discard the staged archival if the stream is purged during archival.`, func() {
+				addTestEntry(project, 0, 1, 2, 3, 4)
+
+				loads := 0
+				sc.lsCallback = func(*logdog.LoadStreamRequest) (*logdog.LoadStreamResponse, error) {
+					if loads++; loads > 1 {
+						stream.State.Purged = true
+					}
+					return &stream, nil
+				}
+
+				So(ar.archiveTaskImpl(c, task), ShouldErrLike, "log stream is purged")
+				So(task.consumed, ShouldBeTrue)
+				So(archiveRequest, ShouldBeNil)
+				So(gsc.objs, ShouldBeEmpty)
+			})
+
 			Convey(`When a transient archival error occurs, will not consume the task.`, func() {
 				addTestEntry(project, 0, 1, 2, 3, 4)
 				gsc.newWriterErr = func(*testGSWriter) error { return errors.WrapTransient(errors.New("test error")) }
@@ -643,6 +660,33 @@ func TestHandleArchive(t *testing.T) {
 			})
 		})
 
+		Convey(`When configured to render HTML`, func() {
+			stBase.RenderHTML = true
+
+			addTestEntry(project, 0, 1, 2, 3, 4)
+			stream.State.TerminalIndex = 4
+
+			Convey(`Will emit an HTML rendering of a text stream.`, func() {
+				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
+				So(task.consumed, ShouldBeTrue)
+
+				So(hasStreams(true, true, true), ShouldBeTrue)
+				So(gsc.objs[gs.Path(gsURL(project, "data.html"))], ShouldBeGreaterThan, 0)
+			})
+
+			Convey(`Will not emit an HTML rendering of a binary stream.`, func() {
+				desc.StreamType = logpb.StreamType_BINARY
+				reloadDesc()
+
+				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
+				So(task.consumed, ShouldBeTrue)
+
+				So(hasStreams(true, true, true), ShouldBeTrue)
+				_, ok := gsc.objs[gs.Path(gsURL(project, "data.html"))]
+				So(ok, ShouldBeFalse)
+			})
+		})
+
 		Convey(`With an empty project name, will fail and consume the task.`, func() {
 			archiveTask.Project = ""
 
@@ -20,6 +20,7 @@ import (
 	"github.com/luci/luci-go/common/tsmon/types"
 	"github.com/luci/luci-go/grpc/grpcutil"
 	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/server/archivist"
 	"github.com/luci/luci-go/logdog/server/service"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
@@ -240,6 +241,12 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 			return 0
 		}
 
+		// The project's compression codec, if specified, overrides the service's.
+		compression := archiveCompression(pcfg.ArchiveCompression)
+		if pcfg.ArchiveCompression == svcconfig.ArchiveCompression_DEFAULT {
+			compression = archiveCompression(acfg.ArchiveCompression)
+		}
+
 		// Load our base settings.
 		//
 		// Archival bases are:
@@ -252,7 +259,9 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 			IndexStreamRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.StreamRange }),
 			IndexPrefixRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.PrefixRange }),
 			IndexByteRange:   indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.ByteRange }),
+			Compression:      compression,
 			AlwaysRender:     (acfg.RenderAllStreams || pcfg.RenderAllStreams),
+			RenderHTML:       (acfg.RenderHtml || pcfg.RenderHtml),
 		}
 
 		// Fold project settings into loaded ones.
@@ -260,6 +269,18 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 	}
 }
 
+// archiveCompression returns the archive compression codec configured by c.
+func archiveCompression(c svcconfig.ArchiveCompression) logpb.LogIndex_Compression {
+	switch c {
+	case svcconfig.ArchiveCompression_GZIP:
+		return logpb.LogIndex_GZIP
+	case svcconfig.ArchiveCompression_SNAPPY:
+		return logpb.LogIndex_SNAPPY
+	default:
+		return logpb.LogIndex_NONE
+	}
+}
+
 // Entry point.
 func main() {
 	mathrand.SeedRandomly()
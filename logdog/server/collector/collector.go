@@ -433,6 +433,9 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 					ID:            state.ID,
 					Secret:        state.Secret,
 					TerminalIndex: types.MessageIndex(h.be.TerminalIndex),
+
+					QuotaDroppedBytes: h.be.QuotaDroppedBytes,
+					RateDroppedBytes:  h.be.RateDroppedBytes,
 				}
 
 				log.Fields{
@@ -97,6 +97,27 @@ func testCollectorImpl(t *testing.T, caching bool) {
 			So(terminateCalled, ShouldBeTrue)
 		})
 
+		Convey(`Will report the data dropped by the Butler when terminating a stream.`, func() {
+			var treq cc.TerminateRequest
+			tcc.terminateCallback = func(tr cc.TerminateRequest) error {
+				treq = tr
+				return nil
+			}
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			be := bb.genBundleEntry("foo/+/bar", 2, 2)
+			be.QuotaDroppedBytes = 10
+			be.RateDroppedBytes = 20
+			bb.addBundleEntry(be)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			So(treq.TerminalIndex, ShouldEqual, 2)
+			So(treq.QuotaDroppedBytes, ShouldEqual, 10)
+			So(treq.RateDroppedBytes, ShouldEqual, 20)
+		})
+
 		Convey(`Will return a transient error if a transient error happened while terminating.`, func() {
 			tcc.terminateCallback = func(cc.TerminateRequest) error { return errors.WrapTransient(errors.New("test error")) }
 
@@ -70,6 +70,13 @@ type TerminateRequest struct {
 	TerminalIndex types.MessageIndex
 	// Secret is the log stream's prefix secret.
 	Secret types.PrefixSecret
+
+	// QuotaDroppedBytes is the number of bytes of stream data that the Butler
+	// discarded because the stream exceeded a byte quota.
+	QuotaDroppedBytes uint64
+	// RateDroppedBytes is the number of bytes of stream data that the Butler
+	// discarded because the stream exceeded a rate limit.
+	RateDroppedBytes uint64
 }
 
 type coordinatorImpl struct {
@@ -135,10 +142,12 @@ func (c *coordinatorImpl) TerminateStream(ctx context.Context, r *TerminateReque
 	}
 
 	req := logdog.TerminateStreamRequest{
-		Project:       string(r.Project),
-		Id:            r.ID,
-		Secret:        []byte(r.Secret),
-		TerminalIndex: int64(r.TerminalIndex),
+		Project:           string(r.Project),
+		Id:                r.ID,
+		Secret:            []byte(r.Secret),
+		TerminalIndex:     int64(r.TerminalIndex),
+		QuotaDroppedBytes: r.QuotaDroppedBytes,
+		RateDroppedBytes:  r.RateDroppedBytes,
 	}
 
 	if _, err := c.c.TerminateStream(ctx, &req); err != nil {
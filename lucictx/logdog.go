@@ -0,0 +1,49 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package lucictx
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+)
+
+// LogDog is a struct that may be used with the "logdog" section of
+// LUCI_CONTEXT.
+//
+// It advertises a LogDog Butler stream server, and the credentials needed to
+// connect to it.
+type LogDog struct {
+	// StreamServer is the stream server's address (e.g., "tcp4+tls:...").
+	StreamServer string `json:"stream_server"`
+	// Token is the secret token that the stream server requires.
+	Token []byte `json:"token,omitempty"`
+	// CertFingerprint is the fingerprint of the stream server's TLS
+	// certificate.
+	CertFingerprint string `json:"cert_fingerprint,omitempty"`
+}
+
+// GetLogDog calls Lookup and returns the current LogDog from LUCI_CONTEXT if it
+// was present. If no LogDog is in the context, this returns nil.
+func GetLogDog(ctx context.Context) *LogDog {
+	ret := LogDog{}
+	ok, err := Lookup(ctx, "logdog", &ret)
+	if err != nil {
+		panic(err)
+	}
+	if !ok {
+		return nil
+	}
+	return &ret
+}
+
+// SetLogDog Sets the LogDog in the LUCI_CONTEXT.
+func SetLogDog(ctx context.Context, ld *LogDog) context.Context {
+	ctx, err := Set(ctx, "logdog", ld)
+	if err != nil {
+		panic(fmt.Errorf("impossible: %s", err))
+	}
+	return ctx
+}
@@ -39,5 +39,17 @@ func TestPredefinedTypes(t *testing.T) {
 
 			So(GetSwarming(c), ShouldResemble, &Swarming{[]byte("foo")})
 		})
+
+		Convey("logdog", func() {
+			So(GetLogDog(c), ShouldBeNil)
+
+			c = SetLogDog(c, &LogDog{"tcp4+tls:127.0.0.1:1234", []byte("foo"), "abcd"})
+			rawJSON := json.RawMessage{}
+			Get(c, "logdog", &rawJSON)
+			So(string(rawJSON), ShouldEqual,
+				`{"stream_server":"tcp4+tls:127.0.0.1:1234","token":"Zm9v","cert_fingerprint":"abcd"}`)
+
+			So(GetLogDog(c), ShouldResemble, &LogDog{"tcp4+tls:127.0.0.1:1234", []byte("foo"), "abcd"})
+		})
 	})
 }
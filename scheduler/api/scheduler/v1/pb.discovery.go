@@ -12,29 +12,251 @@ func init() {
 			"scheduler.Scheduler",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 76, 144, 63, 143, 218, 64,
-			16, 197, 109, 108, 89, 48, 161, 193, 101, 170, 167, 212, 137, 1,
-			71, 74, 149, 198, 33, 20, 68, 40, 32, 1, 233, 237, 245, 224,
-			93, 201, 241, 90, 251, 7, 37, 159, 226, 190, 198, 233, 250, 251,
-			126, 39, 124, 58, 142, 102, 164, 223, 232, 189, 209, 188, 71, 15,
-			17, 253, 104, 148, 147, 190, 202, 132, 254, 59, 111, 189, 80, 195,
-			248, 210, 232, 185, 21, 146, 107, 223, 178, 153, 151, 189, 186, 163,
-			203, 242, 29, 178, 222, 104, 167, 211, 201, 109, 145, 127, 160, 201,
-			225, 13, 126, 61, 142, 40, 73, 227, 56, 152, 134, 244, 28, 82,
-			56, 77, 163, 56, 72, 243, 167, 16, 43, 221, 255, 55, 170, 145,
-			14, 249, 98, 249, 13, 71, 201, 216, 158, 86, 27, 20, 222, 73,
-			109, 108, 134, 162, 109, 49, 8, 44, 12, 91, 54, 23, 174, 51,
-			194, 201, 50, 244, 25, 78, 42, 11, 171, 189, 17, 12, 161, 107,
-			134, 178, 104, 244, 133, 77, 199, 53, 124, 87, 179, 129, 147, 140,
-			162, 47, 197, 245, 176, 18, 220, 89, 254, 140, 63, 108, 172, 210,
-			29, 242, 108, 65, 112, 178, 116, 16, 101, 135, 138, 113, 214, 190,
-			171, 161, 186, 193, 181, 221, 172, 214, 191, 15, 107, 156, 85, 203,
-			25, 209, 152, 194, 81, 26, 37, 227, 25, 237, 105, 148, 4, 105,
-			76, 193, 52, 252, 248, 29, 183, 144, 224, 127, 189, 182, 108, 7,
-			115, 239, 171, 86, 9, 20, 251, 205, 235, 159, 124, 167, 187, 166,
-			80, 130, 51, 250, 148, 224, 184, 251, 185, 35, 34, 138, 146, 32,
-			76, 35, 26, 207, 170, 100, 104, 242, 235, 75, 0, 0, 0, 255,
-			255, 219, 117, 112, 209, 146, 1, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 164, 89, 203, 115, 27, 71,
+			122, 71, 119, 15, 6, 131, 166, 196, 71, 19, 164, 160, 145, 181,
+			105, 194, 187, 122, 164, 96, 80, 166, 151, 230, 198, 218, 148, 45,
+			81, 12, 45, 201, 145, 20, 80, 148, 42, 177, 107, 153, 193, 160,
+			1, 140, 52, 152, 134, 231, 1, 18, 174, 221, 202, 166, 106, 93,
+			73, 109, 101, 203, 155, 67, 14, 121, 108, 82, 149, 83, 106, 171,
+			114, 220, 92, 82, 149, 83, 174, 249, 67, 242, 31, 228, 176, 135,
+			212, 215, 211, 61, 24, 210, 146, 45, 105, 47, 18, 126, 253, 248,
+			158, 191, 175, 251, 235, 33, 253, 47, 68, 47, 13, 165, 28, 134,
+			98, 115, 18, 203, 84, 246, 178, 193, 166, 24, 79, 210, 89, 71,
+			65, 182, 148, 79, 118, 204, 100, 171, 70, 171, 123, 48, 127, 251,
+			39, 116, 213, 151, 227, 206, 153, 249, 219, 84, 205, 62, 2, 248,
+			8, 253, 153, 153, 30, 202, 208, 139, 134, 29, 25, 15, 231, 106,
+			210, 217, 68, 36, 155, 207, 35, 121, 28, 229, 42, 39, 189, 255,
+			67, 232, 95, 49, 217, 127, 116, 251, 215, 248, 59, 251, 249, 206,
+			71, 122, 121, 231, 169, 8, 195, 251, 176, 248, 49, 236, 235, 217,
+			74, 206, 123, 244, 127, 111, 210, 219, 195, 32, 29, 101, 189, 142,
+			47, 199, 155, 97, 230, 7, 234, 159, 119, 134, 114, 51, 241, 71,
+			162, 159, 133, 34, 222, 244, 38, 65, 9, 77, 223, 157, 3, 237,
+			103, 189, 24, 112, 191, 41, 30, 173, 207, 232, 194, 61, 217, 75,
+			186, 226, 243, 76, 36, 41, 107, 210, 218, 36, 150, 207, 132, 159,
+			54, 17, 71, 215, 234, 93, 3, 217, 58, 181, 253, 44, 78, 100,
+			220, 196, 106, 66, 35, 118, 137, 214, 39, 222, 80, 28, 37, 193,
+			23, 162, 73, 56, 186, 86, 237, 58, 48, 112, 16, 124, 33, 90,
+			143, 104, 61, 151, 62, 9, 103, 172, 69, 173, 103, 178, 151, 52,
+			17, 39, 215, 22, 182, 22, 59, 115, 147, 239, 201, 94, 87, 205,
+			177, 223, 163, 11, 145, 56, 73, 143, 78, 169, 162, 48, 180, 171,
+			70, 90, 25, 101, 119, 163, 169, 244, 189, 52, 144, 81, 97, 246,
+			239, 211, 218, 51, 217, 59, 138, 197, 64, 153, 189, 176, 181, 114,
+			70, 186, 24, 116, 237, 103, 234, 255, 55, 115, 36, 164, 203, 167,
+			212, 130, 63, 59, 116, 33, 152, 143, 105, 183, 214, 74, 138, 231,
+			59, 186, 229, 149, 223, 238, 228, 15, 233, 210, 227, 56, 24, 14,
+			69, 12, 145, 81, 202, 174, 211, 229, 185, 136, 163, 72, 70, 190,
+			80, 174, 146, 238, 210, 124, 252, 1, 12, 183, 190, 79, 109, 181,
+			109, 240, 13, 217, 92, 166, 228, 153, 236, 105, 213, 240, 179, 245,
+			231, 244, 124, 201, 94, 49, 120, 173, 152, 190, 77, 207, 151, 172,
+			11, 250, 74, 48, 233, 158, 155, 15, 222, 237, 183, 126, 137, 40,
+			185, 39, 123, 175, 37, 216, 165, 142, 153, 211, 198, 22, 152, 93,
+			167, 213, 36, 245, 210, 60, 89, 11, 91, 171, 167, 165, 28, 192,
+			84, 55, 95, 1, 57, 159, 120, 89, 34, 250, 77, 139, 163, 107,
+			78, 87, 163, 214, 85, 234, 152, 165, 144, 255, 44, 56, 130, 245,
+			89, 162, 195, 229, 100, 1, 136, 201, 146, 214, 255, 96, 74, 231,
+			225, 97, 31, 210, 197, 146, 191, 115, 79, 154, 37, 27, 78, 69,
+			179, 91, 138, 15, 4, 236, 50, 165, 73, 234, 197, 169, 232, 31,
+			165, 137, 142, 86, 93, 143, 60, 86, 12, 25, 4, 81, 144, 140,
+			242, 121, 162, 230, 169, 25, 122, 156, 176, 13, 122, 46, 205, 25,
+			34, 250, 71, 189, 153, 114, 171, 222, 93, 40, 198, 110, 207, 192,
+			103, 237, 76, 85, 77, 106, 196, 26, 180, 58, 8, 34, 47, 108,
+			218, 42, 20, 57, 96, 87, 233, 146, 47, 163, 65, 48, 60, 138,
+			197, 52, 72, 2, 25, 53, 107, 106, 219, 98, 62, 220, 213, 163,
+			236, 34, 117, 166, 129, 56, 62, 202, 226, 176, 233, 168, 21, 53,
+			192, 135, 113, 248, 66, 142, 214, 95, 200, 209, 173, 223, 18, 90,
+			63, 48, 161, 98, 59, 180, 182, 47, 82, 56, 41, 216, 250, 233,
+			44, 154, 10, 119, 27, 95, 27, 135, 170, 248, 132, 46, 238, 139,
+			116, 30, 233, 132, 93, 126, 97, 6, 10, 49, 151, 94, 54, 13,
+			210, 62, 162, 231, 79, 73, 99, 47, 77, 167, 251, 226, 50, 103,
+			55, 41, 157, 23, 46, 251, 58, 175, 93, 183, 52, 116, 182, 196,
+			183, 169, 243, 8, 104, 249, 146, 173, 235, 103, 175, 166, 142, 186,
+			153, 216, 251, 180, 222, 21, 73, 54, 126, 221, 125, 219, 212, 185,
+			213, 147, 113, 250, 154, 219, 118, 233, 146, 218, 246, 74, 97, 122,
+			137, 144, 123, 191, 125, 135, 214, 88, 213, 170, 252, 10, 33, 250,
+			27, 68, 209, 57, 70, 172, 10, 219, 250, 53, 226, 187, 114, 50,
+			139, 131, 225, 40, 229, 91, 55, 222, 125, 159, 63, 30, 9, 254,
+			201, 225, 238, 93, 126, 43, 75, 71, 50, 78, 58, 252, 86, 24,
+			114, 181, 32, 225, 177, 72, 68, 60, 21, 253, 14, 229, 135, 137,
+			224, 114, 192, 211, 81, 144, 240, 68, 102, 177, 47, 184, 47, 251,
+			130, 7, 9, 31, 202, 169, 136, 35, 209, 231, 89, 212, 23, 49,
+			79, 71, 130, 223, 154, 120, 62, 8, 14, 124, 17, 37, 162, 205,
+			159, 136, 24, 24, 207, 183, 58, 55, 40, 79, 71, 94, 202, 125,
+			47, 226, 61, 193, 7, 50, 139, 250, 60, 136, 212, 174, 79, 238,
+			238, 238, 61, 56, 216, 227, 131, 32, 20, 29, 74, 29, 138, 48,
+			35, 118, 133, 209, 58, 197, 164, 194, 136, 83, 249, 30, 253, 152,
+			98, 187, 194, 172, 115, 149, 247, 144, 251, 67, 94, 48, 156, 139,
+			147, 137, 76, 68, 162, 228, 76, 178, 94, 24, 248, 252, 214, 163,
+			187, 185, 201, 162, 180, 14, 28, 10, 124, 16, 79, 41, 177, 43,
+			136, 145, 115, 206, 10, 221, 167, 150, 93, 193, 21, 70, 22, 241,
+			166, 251, 1, 215, 181, 194, 7, 34, 245, 71, 34, 225, 94, 24,
+			114, 17, 121, 189, 80, 244, 57, 220, 162, 60, 241, 210, 32, 25,
+			204, 130, 104, 200, 75, 53, 212, 161, 244, 28, 173, 130, 32, 196,
+			200, 162, 125, 222, 32, 204, 200, 226, 226, 69, 131, 8, 35, 139,
+			223, 125, 135, 62, 86, 42, 17, 35, 43, 248, 142, 187, 207, 79,
+			87, 89, 161, 121, 94, 214, 9, 248, 226, 241, 97, 48, 21, 17,
+			24, 209, 230, 99, 153, 164, 60, 22, 190, 136, 82, 62, 8, 226,
+			146, 126, 4, 98, 237, 134, 65, 152, 145, 149, 181, 171, 6, 17,
+			70, 86, 182, 110, 211, 63, 80, 250, 49, 35, 13, 252, 3, 183,
+			125, 90, 255, 220, 113, 158, 4, 209, 48, 20, 37, 59, 10, 37,
+			24, 49, 210, 176, 87, 13, 2, 73, 141, 13, 131, 8, 35, 141,
+			246, 251, 244, 87, 72, 105, 33, 140, 184, 248, 61, 247, 43, 196,
+			231, 37, 201, 67, 47, 139, 180, 146, 72, 28, 151, 52, 228, 142,
+			62, 147, 189, 156, 134, 60, 146, 199, 109, 158, 69, 161, 72, 242,
+			244, 194, 76, 144, 80, 238, 133, 177, 240, 250, 51, 30, 103, 81,
+			20, 68, 195, 14, 165, 28, 18, 17, 196, 154, 6, 190, 23, 2,
+			51, 82, 9, 68, 243, 34, 46, 143, 35, 17, 27, 70, 60, 147,
+			189, 194, 19, 130, 24, 113, 237, 101, 131, 48, 35, 238, 202, 5,
+			131, 192, 246, 214, 187, 244, 31, 177, 242, 196, 98, 100, 3, 239,
+			184, 191, 192, 220, 156, 36, 252, 56, 8, 67, 62, 137, 197, 20,
+			242, 224, 101, 169, 28, 123, 105, 224, 115, 125, 83, 0, 69, 140,
+			63, 29, 254, 199, 94, 148, 121, 97, 121, 46, 201, 252, 17, 229,
+			30, 88, 28, 203, 108, 56, 202, 43, 12, 168, 11, 133, 150, 130,
+			108, 47, 12, 229, 177, 232, 119, 248, 173, 104, 198, 39, 34, 234,
+			195, 62, 25, 27, 191, 75, 145, 131, 160, 196, 66, 111, 19, 39,
+			194, 207, 82, 216, 87, 152, 218, 151, 34, 225, 145, 76, 71, 32,
+			33, 24, 232, 72, 22, 129, 204, 239, 236, 55, 143, 163, 133, 24,
+			217, 176, 23, 13, 194, 140, 108, 44, 173, 25, 68, 24, 217, 224,
+			219, 244, 231, 57, 35, 170, 140, 92, 193, 63, 112, 127, 194, 139,
+			147, 21, 14, 155, 108, 44, 18, 109, 5, 216, 214, 41, 205, 190,
+			196, 244, 72, 166, 191, 179, 217, 85, 196, 200, 21, 123, 201, 32,
+			204, 200, 149, 229, 117, 131, 8, 35, 87, 54, 222, 167, 255, 150,
+			155, 109, 51, 210, 198, 59, 238, 63, 32, 110, 78, 118, 48, 91,
+			164, 115, 98, 166, 146, 155, 179, 186, 207, 161, 33, 16, 109, 238,
+			193, 90, 8, 185, 199, 253, 44, 142, 69, 148, 134, 69, 34, 233,
+			139, 51, 9, 46, 122, 209, 236, 205, 157, 178, 17, 35, 237, 34,
+			23, 54, 102, 164, 93, 228, 194, 38, 140, 180, 249, 54, 253, 239,
+			220, 169, 26, 35, 91, 120, 207, 253, 15, 237, 84, 249, 24, 144,
+			177, 47, 6, 89, 24, 206, 248, 88, 78, 181, 9, 37, 35, 83,
+			201, 7, 94, 80, 120, 218, 225, 119, 83, 149, 40, 90, 206, 212,
+			153, 61, 37, 190, 5, 17, 79, 228, 88, 112, 213, 32, 105, 17,
+			111, 236, 112, 13, 49, 178, 101, 27, 23, 107, 152, 145, 173, 245,
+			183, 13, 34, 140, 108, 117, 118, 41, 165, 216, 170, 48, 107, 187,
+			242, 17, 162, 148, 18, 11, 142, 255, 109, 103, 21, 142, 127, 75,
+			29, 255, 59, 120, 205, 253, 128, 223, 29, 128, 253, 60, 153, 8,
+			63, 24, 4, 162, 15, 41, 106, 181, 218, 234, 26, 208, 253, 125,
+			114, 21, 148, 39, 170, 220, 98, 145, 102, 112, 255, 229, 166, 128,
+			160, 42, 35, 59, 216, 49, 8, 49, 178, 83, 95, 54, 136, 48,
+			178, 179, 218, 160, 63, 69, 74, 39, 98, 228, 38, 110, 184, 9,
+			16, 35, 145, 113, 27, 2, 166, 142, 248, 54, 20, 38, 4, 96,
+			234, 133, 153, 186, 122, 139, 166, 172, 83, 122, 219, 240, 65, 44,
+			199, 220, 83, 231, 79, 32, 179, 132, 42, 134, 180, 33, 94, 234,
+			12, 87, 97, 130, 245, 28, 158, 94, 32, 6, 202, 44, 76, 147,
+			194, 88, 84, 5, 19, 140, 177, 112, 115, 220, 172, 47, 25, 68,
+			24, 185, 201, 86, 233, 151, 185, 177, 152, 145, 15, 241, 186, 123,
+			194, 139, 103, 156, 49, 114, 236, 157, 4, 227, 108, 204, 163, 108,
+			220, 203, 249, 168, 162, 147, 74, 29, 156, 14, 196, 244, 70, 155,
+			123, 188, 47, 6, 94, 22, 166, 176, 100, 251, 6, 133, 253, 80,
+			237, 29, 254, 4, 220, 76, 160, 84, 166, 130, 111, 223, 184, 161,
+			34, 235, 123, 147, 137, 232, 115, 47, 133, 145, 194, 98, 92, 5,
+			59, 106, 6, 33, 70, 62, 116, 86, 12, 34, 140, 124, 216, 88,
+			83, 153, 70, 204, 186, 93, 249, 56, 207, 52, 248, 117, 219, 89,
+			161, 11, 212, 178, 16, 100, 122, 23, 55, 213, 22, 132, 43, 22,
+			32, 106, 144, 205, 200, 238, 194, 162, 65, 136, 145, 221, 165, 85,
+			131, 8, 35, 187, 235, 23, 232, 231, 74, 8, 98, 100, 31, 95,
+			116, 251, 188, 148, 14, 149, 63, 224, 142, 250, 60, 209, 54, 93,
+			206, 196, 75, 18, 161, 218, 156, 114, 183, 160, 51, 104, 82, 69,
+			191, 45, 87, 72, 229, 106, 95, 231, 10, 97, 240, 105, 191, 222,
+			48, 136, 48, 178, 127, 161, 169, 60, 199, 204, 186, 87, 121, 156,
+			123, 14, 215, 244, 61, 199, 85, 158, 99, 240, 252, 62, 86, 69,
+			98, 97, 92, 177, 1, 57, 6, 33, 70, 238, 107, 158, 98, 213,
+			166, 220, 95, 109, 208, 191, 130, 212, 171, 32, 63, 196, 13, 247,
+			139, 87, 224, 233, 252, 252, 120, 9, 93, 105, 137, 175, 175, 73,
+			87, 172, 66, 240, 176, 176, 25, 66, 240, 80, 211, 21, 171, 214,
+			230, 33, 91, 165, 127, 147, 219, 140, 25, 57, 192, 235, 238, 95,
+			188, 10, 93, 231, 7, 212, 55, 177, 150, 194, 210, 237, 27, 111,
+			192, 90, 172, 88, 123, 160, 89, 139, 85, 64, 15, 52, 107, 177,
+			98, 237, 129, 102, 45, 97, 214, 147, 202, 103, 121, 238, 160, 49,
+			121, 226, 52, 85, 238, 8, 228, 238, 41, 190, 162, 182, 16, 197,
+			218, 167, 154, 181, 68, 101, 242, 233, 194, 154, 65, 136, 145, 167,
+			235, 27, 6, 17, 70, 158, 126, 247, 123, 244, 68, 9, 65, 140,
+			124, 138, 47, 186, 207, 95, 139, 181, 167, 82, 122, 134, 188, 244,
+			213, 50, 71, 84, 230, 62, 213, 153, 35, 138, 188, 159, 106, 242,
+			18, 149, 185, 79, 53, 121, 45, 102, 253, 168, 210, 203, 3, 0,
+			29, 197, 143, 156, 11, 244, 159, 33, 163, 22, 68, 192, 195, 151,
+			221, 95, 162, 82, 190, 242, 103, 48, 15, 250, 34, 74, 225, 180,
+			206, 51, 28, 231, 207, 81, 200, 165, 122, 244, 195, 213, 49, 223,
+			163, 46, 42, 104, 33, 125, 57, 129, 243, 61, 136, 82, 89, 114,
+			178, 243, 53, 233, 250, 190, 201, 93, 58, 125, 83, 95, 75, 174,
+			107, 23, 45, 117, 240, 123, 58, 199, 150, 58, 248, 61, 167, 105,
+			16, 97, 196, 187, 244, 22, 221, 164, 216, 170, 50, 75, 84, 70,
+			200, 125, 27, 94, 15, 93, 49, 224, 89, 20, 124, 158, 137, 112,
+			86, 118, 195, 211, 215, 27, 196, 161, 138, 24, 17, 206, 162, 34,
+			66, 21, 194, 48, 208, 69, 92, 85, 58, 7, 58, 172, 85, 165,
+			115, 160, 139, 184, 170, 138, 120, 176, 218, 208, 219, 16, 35, 67,
+			188, 162, 183, 65, 54, 134, 197, 54, 200, 198, 176, 126, 206, 32,
+			194, 200, 112, 105, 153, 238, 83, 108, 217, 204, 122, 94, 249, 28,
+			185, 55, 75, 241, 121, 169, 197, 209, 139, 186, 120, 237, 2, 52,
+			36, 207, 157, 53, 101, 139, 13, 46, 132, 218, 5, 91, 177, 55,
+			212, 182, 216, 202, 133, 80, 187, 96, 43, 23, 194, 213, 6, 253,
+			79, 96, 128, 13, 101, 51, 193, 174, 251, 239, 167, 24, 16, 244,
+			161, 34, 61, 109, 19, 15, 162, 84, 12, 69, 204, 189, 177, 132,
+			158, 43, 12, 75, 107, 19, 232, 105, 202, 207, 168, 14, 229, 31,
+			203, 99, 49, 21, 113, 27, 82, 12, 229, 43, 179, 176, 15, 167,
+			118, 121, 215, 113, 144, 230, 135, 83, 226, 141, 203, 51, 71, 65,
+			159, 247, 178, 148, 242, 158, 8, 101, 52, 4, 106, 164, 146, 247,
+			131, 193, 64, 64, 159, 7, 26, 76, 5, 216, 170, 2, 38, 154,
+			30, 182, 138, 249, 196, 49, 49, 128, 10, 152, 52, 47, 210, 235,
+			20, 91, 53, 102, 165, 149, 25, 114, 47, 3, 61, 120, 95, 36,
+			126, 28, 244, 202, 175, 177, 121, 84, 161, 235, 73, 157, 5, 21,
+			213, 26, 68, 53, 211, 81, 173, 169, 168, 102, 58, 170, 53, 21,
+			213, 76, 71, 181, 166, 162, 154, 105, 98, 212, 32, 168, 83, 188,
+			174, 167, 192, 200, 105, 177, 13, 140, 156, 214, 87, 12, 34, 140,
+			76, 27, 107, 122, 27, 102, 228, 184, 208, 134, 109, 64, 212, 32,
+			196, 200, 241, 130, 209, 6, 199, 219, 113, 161, 141, 48, 114, 130,
+			153, 158, 34, 85, 64, 182, 65, 136, 145, 147, 218, 121, 131, 96,
+			229, 242, 10, 253, 62, 197, 150, 195, 170, 63, 174, 252, 37, 66,
+			238, 85, 110, 190, 39, 150, 2, 163, 187, 106, 152, 42, 218, 72,
+			136, 142, 131, 24, 249, 177, 179, 172, 26, 93, 203, 193, 21, 102,
+			253, 20, 225, 11, 238, 111, 16, 47, 62, 68, 154, 43, 65, 109,
+			43, 245, 149, 87, 19, 61, 52, 246, 252, 81, 16, 9, 126, 77,
+			116, 134, 29, 222, 58, 216, 253, 120, 239, 206, 225, 39, 123, 119,
+			90, 109, 202, 91, 127, 114, 184, 119, 8, 63, 121, 171, 123, 248,
+			224, 193, 221, 7, 251, 240, 243, 225, 147, 189, 110, 247, 240, 65,
+			235, 122, 91, 53, 142, 143, 110, 29, 30, 236, 221, 105, 153, 86,
+			88, 63, 92, 244, 43, 199, 139, 250, 148, 143, 60, 120, 149, 125,
+			203, 211, 174, 67, 233, 121, 90, 5, 47, 170, 202, 13, 199, 64,
+			4, 176, 206, 12, 36, 0, 215, 214, 233, 30, 197, 86, 157, 217,
+			63, 67, 240, 13, 200, 221, 41, 149, 111, 41, 112, 147, 88, 78,
+			68, 156, 66, 241, 202, 1, 151, 81, 110, 94, 254, 114, 204, 223,
+			250, 11, 148, 88, 117, 196, 172, 159, 33, 7, 114, 102, 89, 117,
+			8, 227, 151, 8, 191, 173, 20, 214, 129, 102, 214, 151, 8, 47,
+			25, 136, 96, 118, 249, 59, 6, 18, 128, 27, 45, 250, 135, 106,
+			43, 98, 214, 95, 67, 6, 54, 249, 252, 227, 44, 100, 32, 139,
+			130, 19, 158, 6, 99, 145, 164, 222, 120, 2, 141, 210, 56, 240,
+			99, 153, 8, 95, 70, 125, 227, 122, 29, 10, 8, 246, 215, 12,
+			84, 226, 28, 102, 32, 1, 184, 182, 78, 255, 84, 233, 194, 204,
+			250, 57, 194, 77, 247, 62, 47, 125, 233, 125, 5, 101, 252, 64,
+			164, 92, 70, 112, 180, 13, 96, 171, 23, 194, 166, 52, 206, 68,
+			97, 7, 174, 42, 217, 198, 14, 140, 0, 58, 171, 6, 18, 128,
+			235, 23, 232, 191, 0, 237, 234, 152, 48, 235, 23, 8, 187, 112,
+			109, 233, 135, 191, 250, 164, 12, 82, 225, 86, 82, 231, 103, 58,
+			227, 215, 90, 207, 131, 168, 255, 129, 234, 163, 90, 215, 243, 175,
+			100, 65, 82, 126, 133, 104, 155, 210, 145, 160, 37, 102, 240, 99,
+			47, 153, 11, 230, 99, 245, 137, 33, 156, 241, 216, 131, 243, 12,
+			228, 68, 188, 55, 203, 15, 175, 252, 227, 23, 15, 210, 68, 132,
+			131, 194, 29, 82, 85, 22, 58, 6, 34, 128, 245, 53, 3, 149,
+			253, 205, 139, 244, 129, 242, 198, 98, 214, 87, 8, 55, 220, 143,
+			248, 233, 2, 10, 189, 84, 36, 169, 25, 212, 133, 52, 183, 178,
+			205, 243, 250, 49, 117, 82, 40, 135, 203, 240, 171, 185, 114, 11,
+			1, 172, 27, 58, 89, 4, 32, 91, 165, 93, 165, 188, 202, 172,
+			191, 69, 120, 197, 189, 3, 157, 25, 164, 164, 125, 246, 121, 89,
+			98, 52, 188, 32, 242, 252, 121, 81, 159, 31, 203, 232, 106, 10,
+			199, 186, 63, 242, 162, 161, 122, 173, 229, 42, 170, 185, 80, 91,
+			107, 172, 34, 128, 181, 115, 6, 18, 128, 75, 203, 116, 162, 12,
+			176, 153, 245, 119, 8, 95, 118, 123, 252, 204, 199, 124, 62, 9,
+			162, 196, 188, 14, 55, 161, 138, 242, 5, 124, 170, 63, 125, 122,
+			190, 47, 227, 190, 190, 32, 142, 71, 129, 106, 153, 160, 7, 41,
+			25, 15, 153, 244, 99, 225, 165, 37, 243, 236, 170, 82, 105, 226,
+			99, 35, 128, 245, 166, 129, 4, 224, 165, 183, 232, 103, 202, 188,
+			26, 179, 254, 30, 225, 117, 247, 1, 55, 127, 66, 224, 19, 25,
+			68, 240, 125, 66, 242, 81, 54, 246, 34, 14, 223, 204, 224, 75,
+			166, 234, 138, 79, 221, 134, 37, 67, 130, 1, 229, 222, 212, 11,
+			66, 88, 89, 152, 82, 171, 42, 241, 198, 148, 26, 2, 88, 95,
+			49, 144, 0, 108, 172, 193, 151, 77, 171, 142, 29, 102, 253, 19,
+			68, 234, 143, 248, 215, 186, 169, 151, 245, 106, 192, 120, 125, 46,
+			156, 73, 107, 97, 130, 83, 85, 98, 77, 229, 57, 8, 160, 99,
+			162, 225, 16, 128, 151, 222, 234, 217, 147, 88, 166, 242, 189, 255,
+			31, 0, 18, 247, 85, 45, 173, 30, 0, 0},
 	)
 }
 
@@ -9,6 +9,16 @@ It is generated from these files:
 	github.com/luci/luci-go/scheduler/api/scheduler/v1/scheduler.proto
 
 It has these top-level messages:
+	JobsRequest
+	JobsReply
+	InvocationsRequest
+	InvocationsReply
+	TriggerJobReply
+	JobRef
+	InvocationRef
+	Job
+	JobState
+	Invocation
 */
 package scheduler
 
@@ -17,6 +27,7 @@ import prpc "github.com/luci/luci-go/grpc/prpc"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/empty"
 
 import (
 	context "golang.org/x/net/context"
@@ -34,6 +45,368 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type JobsRequest struct {
+	// If not specified or "", all projects' jobs are returned.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// cursor, if given, is the value of JobsReply.next_cursor from a previous
+	// call, to fetch the next page of results.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+	// page_size is the maximum number of jobs to return. If 0, a default of 50
+	// is used. Values above 500 are capped at 500.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+}
+
+func (m *JobsRequest) Reset()                    { *m = JobsRequest{} }
+func (m *JobsRequest) String() string            { return proto.CompactTextString(m) }
+func (*JobsRequest) ProtoMessage()               {}
+func (*JobsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *JobsRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *JobsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *JobsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type JobsReply struct {
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty"`
+	// next_cursor, if not empty, can be passed in JobsRequest.cursor to fetch
+	// the next page of results.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+}
+
+func (m *JobsReply) Reset()                    { *m = JobsReply{} }
+func (m *JobsReply) String() string            { return proto.CompactTextString(m) }
+func (*JobsReply) ProtoMessage()               {}
+func (*JobsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *JobsReply) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *JobsReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type InvocationsRequest struct {
+	JobRef *JobRef `protobuf:"bytes,1,opt,name=job_ref,json=jobRef" json:"job_ref,omitempty"`
+	// cursor, if given, is the value of InvocationsReply.next_cursor from
+	// a previous call, to fetch the next page of results.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+	// page_size is the maximum number of invocations to return. If 0, a default
+	// of 50 is used. Values above 500 are capped at 500.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+}
+
+func (m *InvocationsRequest) Reset()                    { *m = InvocationsRequest{} }
+func (m *InvocationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*InvocationsRequest) ProtoMessage()               {}
+func (*InvocationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *InvocationsRequest) GetJobRef() *JobRef {
+	if m != nil {
+		return m.JobRef
+	}
+	return nil
+}
+
+func (m *InvocationsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *InvocationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type InvocationsReply struct {
+	Invocations []*Invocation `protobuf:"bytes,1,rep,name=invocations" json:"invocations,omitempty"`
+	// next_cursor, if not empty, can be passed in InvocationsRequest.cursor to
+	// fetch the next page of results.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+}
+
+func (m *InvocationsReply) Reset()                    { *m = InvocationsReply{} }
+func (m *InvocationsReply) String() string            { return proto.CompactTextString(m) }
+func (*InvocationsReply) ProtoMessage()               {}
+func (*InvocationsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *InvocationsReply) GetInvocations() []*Invocation {
+	if m != nil {
+		return m.Invocations
+	}
+	return nil
+}
+
+func (m *InvocationsReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type TriggerJobReply struct {
+	// invocation_nonce identifies the request to start an invocation. It is
+	// copied into Invocation.invocation_nonce of the resulting invocation(s).
+	InvocationNonce int64 `protobuf:"varint,1,opt,name=invocation_nonce,json=invocationNonce" json:"invocation_nonce,omitempty"`
+}
+
+func (m *TriggerJobReply) Reset()                    { *m = TriggerJobReply{} }
+func (m *TriggerJobReply) String() string            { return proto.CompactTextString(m) }
+func (*TriggerJobReply) ProtoMessage()               {}
+func (*TriggerJobReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *TriggerJobReply) GetInvocationNonce() int64 {
+	if m != nil {
+		return m.InvocationNonce
+	}
+	return 0
+}
+
+// JobRef uniquely identifies a job.
+type JobRef struct {
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	Job     string `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+}
+
+func (m *JobRef) Reset()                    { *m = JobRef{} }
+func (m *JobRef) String() string            { return proto.CompactTextString(m) }
+func (*JobRef) ProtoMessage()               {}
+func (*JobRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *JobRef) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *JobRef) GetJob() string {
+	if m != nil {
+		return m.Job
+	}
+	return ""
+}
+
+// InvocationRef uniquely identifies an invocation of a job.
+type InvocationRef struct {
+	JobRef *JobRef `protobuf:"bytes,1,opt,name=job_ref,json=jobRef" json:"job_ref,omitempty"`
+	// invocation_id is a unique integer among all invocations for a given job.
+	// However, there could be invocations with the same invocation_id but
+	// belonging to different jobs.
+	InvocationId int64 `protobuf:"varint,2,opt,name=invocation_id,json=invocationId" json:"invocation_id,omitempty"`
+}
+
+func (m *InvocationRef) Reset()                    { *m = InvocationRef{} }
+func (m *InvocationRef) String() string            { return proto.CompactTextString(m) }
+func (*InvocationRef) ProtoMessage()               {}
+func (*InvocationRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *InvocationRef) GetJobRef() *JobRef {
+	if m != nil {
+		return m.JobRef
+	}
+	return nil
+}
+
+func (m *InvocationRef) GetInvocationId() int64 {
+	if m != nil {
+		return m.InvocationId
+	}
+	return 0
+}
+
+// Job describes a single job.
+type Job struct {
+	JobRef   *JobRef   `protobuf:"bytes,1,opt,name=job_ref,json=jobRef" json:"job_ref,omitempty"`
+	Schedule string    `protobuf:"bytes,2,opt,name=schedule" json:"schedule,omitempty"`
+	State    *JobState `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+	Paused   bool      `protobuf:"varint,4,opt,name=paused" json:"paused,omitempty"`
+}
+
+func (m *Job) Reset()                    { *m = Job{} }
+func (m *Job) String() string            { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()               {}
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *Job) GetJobRef() *JobRef {
+	if m != nil {
+		return m.JobRef
+	}
+	return nil
+}
+
+func (m *Job) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *Job) GetState() *JobState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *Job) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// JobState describes current Job state.
+type JobState struct {
+	// ui_status is the state of the job's state machine (e.g. "SCHEDULED",
+	// "QUEUED", "RUNNING", "OVERRUN"), or "PAUSED" if the job is paused and
+	// has no pending or running invocations.
+	UiStatus string `protobuf:"bytes,1,opt,name=ui_status,json=uiStatus" json:"ui_status,omitempty"`
+}
+
+func (m *JobState) Reset()                    { *m = JobState{} }
+func (m *JobState) String() string            { return proto.CompactTextString(m) }
+func (*JobState) ProtoMessage()               {}
+func (*JobState) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *JobState) GetUiStatus() string {
+	if m != nil {
+		return m.UiStatus
+	}
+	return ""
+}
+
+// Invocation describes properties of one job execution.
+type Invocation struct {
+	InvocationRef *InvocationRef `protobuf:"bytes,1,opt,name=invocation_ref,json=invocationRef" json:"invocation_ref,omitempty"`
+	// started_ts is unix timestamp in microseconds.
+	StartedTs int64 `protobuf:"varint,2,opt,name=started_ts,json=startedTs" json:"started_ts,omitempty"`
+	// finished_ts is unix timestamp in microseconds. Set only if final is true.
+	FinishedTs int64 `protobuf:"varint,3,opt,name=finished_ts,json=finishedTs" json:"finished_ts,omitempty"`
+	// triggered_by is an identity ("kind:value") that is specified only if the
+	// invocation was triggered manually rather than by the service itself.
+	TriggeredBy string `protobuf:"bytes,4,opt,name=triggered_by,json=triggeredBy" json:"triggered_by,omitempty"`
+	// status is the latest status of the invocation, e.g. "RUNNING".
+	Status string `protobuf:"bytes,5,opt,name=status" json:"status,omitempty"`
+	// If true, the invocation properties are final and won't be changed.
+	Final bool `protobuf:"varint,6,opt,name=final" json:"final,omitempty"`
+	// config_revision pins project/job config version according to which this
+	// invocation was created.
+	ConfigRevision string `protobuf:"bytes,7,opt,name=config_revision,json=configRevision" json:"config_revision,omitempty"`
+	// view_url points to human readable page for a given invocation if
+	// available.
+	ViewUrl string `protobuf:"bytes,8,opt,name=view_url,json=viewUrl" json:"view_url,omitempty"`
+	// invocation_nonce identifies the request that started the invocation.
+	InvocationNonce int64 `protobuf:"varint,9,opt,name=invocation_nonce,json=invocationNonce" json:"invocation_nonce,omitempty"`
+}
+
+func (m *Invocation) Reset()                    { *m = Invocation{} }
+func (m *Invocation) String() string            { return proto.CompactTextString(m) }
+func (*Invocation) ProtoMessage()               {}
+func (*Invocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Invocation) GetInvocationRef() *InvocationRef {
+	if m != nil {
+		return m.InvocationRef
+	}
+	return nil
+}
+
+func (m *Invocation) GetStartedTs() int64 {
+	if m != nil {
+		return m.StartedTs
+	}
+	return 0
+}
+
+func (m *Invocation) GetFinishedTs() int64 {
+	if m != nil {
+		return m.FinishedTs
+	}
+	return 0
+}
+
+func (m *Invocation) GetTriggeredBy() string {
+	if m != nil {
+		return m.TriggeredBy
+	}
+	return ""
+}
+
+func (m *Invocation) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Invocation) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *Invocation) GetConfigRevision() string {
+	if m != nil {
+		return m.ConfigRevision
+	}
+	return ""
+}
+
+func (m *Invocation) GetViewUrl() string {
+	if m != nil {
+		return m.ViewUrl
+	}
+	return ""
+}
+
+func (m *Invocation) GetInvocationNonce() int64 {
+	if m != nil {
+		return m.InvocationNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*JobsRequest)(nil), "scheduler.JobsRequest")
+	proto.RegisterType((*JobsReply)(nil), "scheduler.JobsReply")
+	proto.RegisterType((*InvocationsRequest)(nil), "scheduler.InvocationsRequest")
+	proto.RegisterType((*InvocationsReply)(nil), "scheduler.InvocationsReply")
+	proto.RegisterType((*TriggerJobReply)(nil), "scheduler.TriggerJobReply")
+	proto.RegisterType((*JobRef)(nil), "scheduler.JobRef")
+	proto.RegisterType((*InvocationRef)(nil), "scheduler.InvocationRef")
+	proto.RegisterType((*Job)(nil), "scheduler.Job")
+	proto.RegisterType((*JobState)(nil), "scheduler.JobState")
+	proto.RegisterType((*Invocation)(nil), "scheduler.Invocation")
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -45,6 +418,37 @@ const _ = grpc.SupportPackageIsVersion4
 // Client API for Scheduler service
 
 type SchedulerClient interface {
+	// GetJobs fetches all enabled jobs satisfying JobsRequest.
+	GetJobs(ctx context.Context, in *JobsRequest, opts ...grpc.CallOption) (*JobsReply, error)
+	// GetInvocations fetches invocations of a given job, most recent first.
+	GetInvocations(ctx context.Context, in *InvocationsRequest, opts ...grpc.CallOption) (*InvocationsReply, error)
+	// GetInvocation fetches a single invocation.
+	GetInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*Invocation, error)
+	// TriggerJob launches a new invocation of a job right now, unless the job is
+	// already running.
+	//
+	// Requires the caller to be an owner of the job.
+	TriggerJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*TriggerJobReply, error)
+	// PauseJob will prevent automatic triggering of a job. Manual triggering such
+	// as through this API is still allowed. Any pending or running invocations
+	// are still executed. PauseJob does nothing if job is already paused.
+	//
+	// Requires the caller to be an owner of the job.
+	PauseJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ResumeJob resumes paused job. ResumeJob does nothing if job is not paused.
+	//
+	// Requires the caller to be an owner of the job.
+	ResumeJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// AbortJob resets the job to scheduled state, aborting a currently pending
+	// or running invocation if any.
+	//
+	// Requires the caller to be an owner of the job.
+	AbortJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// AbortInvocation forcefully moves the invocation to failed state. It does
+	// nothing if the invocation is already in some final state.
+	//
+	// Requires the caller to be an owner of the job.
+	AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 type schedulerPRPCClient struct {
 	client *prpc.Client
@@ -54,6 +458,78 @@ func NewSchedulerPRPCClient(client *prpc.Client) SchedulerClient {
 	return &schedulerPRPCClient{client}
 }
 
+func (c *schedulerPRPCClient) GetJobs(ctx context.Context, in *JobsRequest, opts ...grpc.CallOption) (*JobsReply, error) {
+	out := new(JobsReply)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) GetInvocations(ctx context.Context, in *InvocationsRequest, opts ...grpc.CallOption) (*InvocationsReply, error) {
+	out := new(InvocationsReply)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "GetInvocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) GetInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*Invocation, error) {
+	out := new(Invocation)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "GetInvocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) TriggerJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*TriggerJobReply, error) {
+	out := new(TriggerJobReply)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "TriggerJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) PauseJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "PauseJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) ResumeJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "ResumeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) AbortJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "AbortJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "AbortInvocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type schedulerClient struct {
 	cc *grpc.ClientConn
 }
@@ -62,21 +538,301 @@ func NewSchedulerClient(cc *grpc.ClientConn) SchedulerClient {
 	return &schedulerClient{cc}
 }
 
+func (c *schedulerClient) GetJobs(ctx context.Context, in *JobsRequest, opts ...grpc.CallOption) (*JobsReply, error) {
+	out := new(JobsReply)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/GetJobs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetInvocations(ctx context.Context, in *InvocationsRequest, opts ...grpc.CallOption) (*InvocationsReply, error) {
+	out := new(InvocationsReply)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/GetInvocations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*Invocation, error) {
+	out := new(Invocation)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/GetInvocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) TriggerJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*TriggerJobReply, error) {
+	out := new(TriggerJobReply)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/TriggerJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) PauseJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/PauseJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ResumeJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/ResumeJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) AbortJob(ctx context.Context, in *JobRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/AbortJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/AbortInvocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Scheduler service
 
 type SchedulerServer interface {
+	// GetJobs fetches all enabled jobs satisfying JobsRequest.
+	GetJobs(context.Context, *JobsRequest) (*JobsReply, error)
+	// GetInvocations fetches invocations of a given job, most recent first.
+	GetInvocations(context.Context, *InvocationsRequest) (*InvocationsReply, error)
+	// GetInvocation fetches a single invocation.
+	GetInvocation(context.Context, *InvocationRef) (*Invocation, error)
+	// TriggerJob launches a new invocation of a job right now, unless the job is
+	// already running.
+	//
+	// Requires the caller to be an owner of the job.
+	TriggerJob(context.Context, *JobRef) (*TriggerJobReply, error)
+	// PauseJob will prevent automatic triggering of a job. Manual triggering such
+	// as through this API is still allowed. Any pending or running invocations
+	// are still executed. PauseJob does nothing if job is already paused.
+	//
+	// Requires the caller to be an owner of the job.
+	PauseJob(context.Context, *JobRef) (*google_protobuf.Empty, error)
+	// ResumeJob resumes paused job. ResumeJob does nothing if job is not paused.
+	//
+	// Requires the caller to be an owner of the job.
+	ResumeJob(context.Context, *JobRef) (*google_protobuf.Empty, error)
+	// AbortJob resets the job to scheduled state, aborting a currently pending
+	// or running invocation if any.
+	//
+	// Requires the caller to be an owner of the job.
+	AbortJob(context.Context, *JobRef) (*google_protobuf.Empty, error)
+	// AbortInvocation forcefully moves the invocation to failed state. It does
+	// nothing if the invocation is already in some final state.
+	//
+	// Requires the caller to be an owner of the job.
+	AbortInvocation(context.Context, *InvocationRef) (*google_protobuf.Empty, error)
 }
 
 func RegisterSchedulerServer(s prpc.Registrar, srv SchedulerServer) {
 	s.RegisterService(&_Scheduler_serviceDesc, srv)
 }
 
+func _Scheduler_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetJobs(ctx, req.(*JobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetInvocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetInvocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/GetInvocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetInvocations(ctx, req.(*InvocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvocationRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/GetInvocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetInvocation(ctx, req.(*InvocationRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/TriggerJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).TriggerJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/PauseJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PauseJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/ResumeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ResumeJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_AbortJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).AbortJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/AbortJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).AbortJob(ctx, req.(*JobRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_AbortInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvocationRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).AbortInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/AbortInvocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).AbortInvocation(ctx, req.(*InvocationRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Scheduler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "scheduler.Scheduler",
 	HandlerType: (*SchedulerServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "github.com/luci/luci-go/scheduler/api/scheduler/v1/scheduler.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetJobs",
+			Handler:    _Scheduler_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetInvocations",
+			Handler:    _Scheduler_GetInvocations_Handler,
+		},
+		{
+			MethodName: "GetInvocation",
+			Handler:    _Scheduler_GetInvocation_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _Scheduler_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _Scheduler_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _Scheduler_ResumeJob_Handler,
+		},
+		{
+			MethodName: "AbortJob",
+			Handler:    _Scheduler_AbortJob_Handler,
+		},
+		{
+			MethodName: "AbortInvocation",
+			Handler:    _Scheduler_AbortInvocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/luci/luci-go/scheduler/api/scheduler/v1/scheduler.proto",
 }
 
 func init() {
@@ -84,11 +840,49 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 87 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x72, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29, 0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9,
-	0xfa, 0xc5, 0xc9, 0x19, 0xa9, 0x29, 0xa5, 0x39, 0xa9, 0x45, 0xfa, 0x89, 0x05, 0x99, 0x48, 0xbc,
-	0x32, 0x43, 0x04, 0x47, 0xaf, 0xa0, 0x28, 0xbf, 0x24, 0x5f, 0x88, 0x13, 0x2e, 0x60, 0xc4, 0xcd,
-	0xc5, 0x19, 0x0c, 0xe3, 0x24, 0xb1, 0x81, 0xa5, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x52,
-	0x17, 0x2a, 0x0f, 0x64, 0x00, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0x56, 0x97, 0xb5, 0x4d, 0x4e, 0xb7, 0x76, 0x3f, 0xff, 0xc6, 0x14, 0x3a, 0x4d, 0x94, 0x70,
+	0xb1, 0x0e, 0x89, 0x56, 0x14, 0xc6, 0x2e, 0x40, 0x02, 0x36, 0xa1, 0x69, 0x13, 0x42, 0x93, 0x3b,
+	0xee, 0x90, 0x42, 0x93, 0x3a, 0x99, 0xab, 0x2c, 0x2e, 0xb1, 0x53, 0xe8, 0x9e, 0x82, 0x17, 0xe3,
+	0x8d, 0xb8, 0x40, 0x76, 0x92, 0x26, 0xdd, 0x5a, 0xa0, 0xdc, 0xb4, 0xf9, 0xbe, 0xe3, 0x73, 0x7c,
+	0xbe, 0xf3, 0xc7, 0x70, 0xec, 0x53, 0x71, 0x15, 0x3b, 0x1d, 0x97, 0x5d, 0x77, 0x83, 0xd8, 0xa5,
+	0xea, 0xe7, 0x89, 0xcf, 0xba, 0xdc, 0xbd, 0x22, 0xc3, 0x38, 0x20, 0x51, 0x77, 0x30, 0xa6, 0x05,
+	0x34, 0x79, 0x9a, 0x83, 0xce, 0x38, 0x62, 0x82, 0x21, 0x63, 0x46, 0x34, 0x77, 0x7d, 0xc6, 0xfc,
+	0x80, 0x74, 0x95, 0xc1, 0x89, 0xbd, 0x2e, 0xb9, 0x1e, 0x8b, 0x69, 0x72, 0xce, 0xfa, 0x04, 0xb5,
+	0x73, 0xe6, 0x70, 0x4c, 0xbe, 0xc4, 0x84, 0x0b, 0x64, 0x42, 0x75, 0x1c, 0xb1, 0x11, 0x71, 0x85,
+	0x59, 0x6a, 0x95, 0xda, 0x06, 0xce, 0x20, 0xda, 0x81, 0x8a, 0x1b, 0x47, 0x9c, 0x45, 0xe6, 0x9a,
+	0x32, 0xa4, 0x08, 0xed, 0x82, 0x31, 0x1e, 0xf8, 0xc4, 0xe6, 0xf4, 0x86, 0x98, 0x5a, 0xab, 0xd4,
+	0x2e, 0x63, 0x5d, 0x12, 0x7d, 0x7a, 0x43, 0xac, 0x0b, 0x30, 0x92, 0xe8, 0xe3, 0x60, 0x8a, 0x2c,
+	0x58, 0x1f, 0x31, 0x87, 0x9b, 0xa5, 0x96, 0xd6, 0xae, 0xf5, 0xea, 0x9d, 0x3c, 0xe5, 0x73, 0xe6,
+	0x60, 0x65, 0x43, 0x0f, 0xa0, 0x16, 0x92, 0x6f, 0xc2, 0x9e, 0xbb, 0x0a, 0x24, 0x75, 0xa2, 0x18,
+	0x2b, 0x06, 0x74, 0x16, 0x4e, 0x98, 0x3b, 0x10, 0x94, 0x85, 0xb3, 0xb4, 0x1f, 0x43, 0x75, 0xc4,
+	0x1c, 0x3b, 0x22, 0x9e, 0x4a, 0xbb, 0xd6, 0xfb, 0xef, 0x56, 0x74, 0xe2, 0xe1, 0xca, 0x48, 0xfd,
+	0xff, 0x9b, 0x90, 0x00, 0xb6, 0xe6, 0xae, 0x95, 0x7a, 0x8e, 0xa0, 0x46, 0x73, 0x2e, 0x95, 0x75,
+	0xaf, 0x70, 0x71, 0xee, 0x81, 0x8b, 0x27, 0xff, 0x2c, 0xf2, 0x15, 0x34, 0x2e, 0x23, 0xea, 0xfb,
+	0x24, 0x52, 0xb9, 0xcb, 0xcb, 0x0e, 0x60, 0x2b, 0x0f, 0x61, 0x87, 0x2c, 0x74, 0x89, 0x92, 0xaa,
+	0xe1, 0x46, 0xce, 0x7f, 0x90, 0xb4, 0xf5, 0x1c, 0x2a, 0x89, 0xe4, 0xdf, 0x74, 0x73, 0x0b, 0xb4,
+	0x11, 0x73, 0xd2, 0xab, 0xe5, 0xa7, 0xf5, 0x19, 0x36, 0x0b, 0xf9, 0x12, 0x6f, 0xa5, 0x9a, 0x3e,
+	0x82, 0xcd, 0x42, 0x76, 0x74, 0xa8, 0x02, 0x6b, 0x78, 0x23, 0x27, 0xcf, 0x86, 0xd6, 0xf7, 0x12,
+	0x68, 0xe7, 0xcc, 0x59, 0x29, 0x70, 0x13, 0xf4, 0xcc, 0x96, 0x26, 0x3b, 0xc3, 0xe8, 0x00, 0xca,
+	0x5c, 0x0c, 0x44, 0xd2, 0xac, 0x5a, 0xef, 0xff, 0xf9, 0x28, 0x7d, 0x69, 0xc2, 0xc9, 0x09, 0xd9,
+	0xf3, 0xf1, 0x20, 0xe6, 0x64, 0x68, 0xae, 0xb7, 0x4a, 0x6d, 0x1d, 0xa7, 0xc8, 0xda, 0x07, 0x3d,
+	0x3b, 0x2a, 0xfb, 0x1f, 0x53, 0x5b, 0x9e, 0x8f, 0x79, 0x5a, 0x2e, 0x3d, 0xa6, 0x7d, 0x85, 0xad,
+	0x1f, 0x6b, 0x00, 0x79, 0x79, 0xd0, 0x6b, 0xa8, 0x17, 0xf4, 0xe6, 0x4a, 0xcc, 0xc5, 0xdd, 0x27,
+	0x1e, 0x2e, 0xd4, 0x47, 0xea, 0xda, 0x03, 0xe0, 0x62, 0x10, 0x09, 0x32, 0xb4, 0x05, 0x4f, 0xab,
+	0x65, 0xa4, 0xcc, 0xa5, 0x9a, 0x10, 0x8f, 0x86, 0x94, 0x5f, 0x25, 0x76, 0x4d, 0xd9, 0x21, 0xa3,
+	0x2e, 0x39, 0x7a, 0x08, 0x1b, 0x22, 0x99, 0x10, 0x32, 0xb4, 0x9d, 0xa9, 0x92, 0x65, 0xe0, 0xda,
+	0x8c, 0x3b, 0x9e, 0x4a, 0xcd, 0xa9, 0x98, 0x72, 0x32, 0xe7, 0x09, 0x42, 0xdb, 0x50, 0xf6, 0x68,
+	0x38, 0x08, 0xcc, 0x8a, 0x2a, 0x45, 0x02, 0xd0, 0x3e, 0x34, 0x5c, 0x16, 0x7a, 0xd4, 0xb7, 0x23,
+	0x32, 0xa1, 0x9c, 0xb2, 0xd0, 0xac, 0x2a, 0xb7, 0x7a, 0x42, 0xe3, 0x94, 0x45, 0xf7, 0x41, 0x9f,
+	0x50, 0xf2, 0xd5, 0x8e, 0xa3, 0xc0, 0xd4, 0x93, 0xa1, 0x92, 0xf8, 0x63, 0x14, 0x2c, 0x9c, 0x51,
+	0x63, 0xe1, 0x8c, 0xf6, 0x7e, 0x6a, 0x60, 0xf4, 0xb3, 0x52, 0xa1, 0x23, 0xa8, 0x9e, 0x12, 0x21,
+	0x5f, 0x0a, 0xb4, 0x33, 0xdf, 0xc5, 0x6c, 0xc3, 0x9b, 0xdb, 0x77, 0x78, 0xb9, 0x15, 0xef, 0xa1,
+	0x7e, 0x4a, 0x44, 0x61, 0x33, 0xd1, 0xde, 0xc2, 0x0e, 0xcc, 0xc2, 0xec, 0x2e, 0x33, 0xcb, 0x68,
+	0x6f, 0x60, 0x73, 0x2e, 0x1a, 0x5a, 0xda, 0xce, 0xe6, 0xe2, 0x35, 0x47, 0x2f, 0x01, 0xf2, 0xc5,
+	0x45, 0x77, 0xe7, 0xba, 0xd9, 0x2c, 0x50, 0xb7, 0x57, 0xfc, 0x10, 0xf4, 0x0b, 0x39, 0x96, 0x4b,
+	0x5c, 0x77, 0x3a, 0xc9, 0x3b, 0xde, 0xc9, 0xde, 0xf1, 0xce, 0x3b, 0xf9, 0x8e, 0xa3, 0x17, 0x60,
+	0x60, 0xc2, 0xe3, 0xeb, 0x55, 0xfd, 0x0e, 0x41, 0x7f, 0xeb, 0xb0, 0x48, 0xac, 0xe8, 0x76, 0x02,
+	0x0d, 0xe5, 0xf6, 0x57, 0x65, 0x5a, 0x12, 0xc4, 0xa9, 0x28, 0xfc, 0xec, 0xd7, 0x00, 0x9c, 0xcf,
+	0x09, 0xf6, 0xea, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,19 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package acl implements access checks shared by the UI and the API servers.
+package acl
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/server/auth"
+)
+
+// IsJobOwner returns true if the caller is allowed to modify the given job,
+// e.g. to trigger, pause, resume or abort it.
+func IsJobOwner(c context.Context, projectID, jobID string) (bool, error) {
+	// TODO(vadimsh): Do real ACLs.
+	return auth.IsMember(c, "administrators")
+}
@@ -5,11 +5,32 @@
 package apiservers
 
 import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/server/auth"
+
 	"github.com/luci/luci-go/scheduler/api/scheduler/v1"
+	"github.com/luci/luci-go/scheduler/appengine/acl"
 	"github.com/luci/luci-go/scheduler/appengine/catalog"
 	"github.com/luci/luci-go/scheduler/appengine/engine"
 )
 
+const (
+	// defaultPageSize is used when the request doesn't specify a page size.
+	defaultPageSize = 50
+	// maxPageSize is an upper bound on the page size requested by callers.
+	maxPageSize = 500
+)
+
 // SchedulerServer implements scheduler.Scheduler API.
 type SchedulerServer struct {
 	Engine  engine.Engine
@@ -17,3 +38,256 @@ type SchedulerServer struct {
 }
 
 var _ scheduler.SchedulerServer = (*SchedulerServer)(nil)
+
+// GetJobs fetches all enabled jobs satisfying JobsRequest.
+//
+// Jobs are returned ordered by their full ID. The cursor is the full ID of the
+// last job returned.
+func (s SchedulerServer) GetJobs(c context.Context, in *scheduler.JobsRequest) (*scheduler.JobsReply, error) {
+	var jobs []*engine.Job
+	var err error
+	if in.Project == "" {
+		jobs, err = s.Engine.GetAllJobs(c)
+	} else {
+		jobs, err = s.Engine.GetProjectJobs(c, in.Project)
+	}
+	if err != nil {
+		return nil, internalError(c, err)
+	}
+
+	// The engine returns jobs in no particular order. Sort them to be able to
+	// page through them.
+	sort.Sort(jobsByID(jobs))
+	if in.Cursor != "" {
+		idx := sort.Search(len(jobs), func(i int) bool { return jobs[i].JobID > in.Cursor })
+		jobs = jobs[idx:]
+	}
+
+	out := &scheduler.JobsReply{}
+	if pageSize := clampPageSize(in.PageSize); len(jobs) > pageSize {
+		jobs = jobs[:pageSize]
+		out.NextCursor = jobs[pageSize-1].JobID
+	}
+	out.Jobs = make([]*scheduler.Job, len(jobs))
+	for i, job := range jobs {
+		out.Jobs[i] = jobToProto(job)
+	}
+	return out, nil
+}
+
+// GetInvocations fetches invocations of a given job, most recent first.
+func (s SchedulerServer) GetInvocations(c context.Context, in *scheduler.InvocationsRequest) (*scheduler.InvocationsReply, error) {
+	job, err := s.getJob(c, in.JobRef)
+	if err != nil {
+		return nil, err
+	}
+	invs, cursor, err := s.Engine.ListInvocations(c, job.JobID, clampPageSize(in.PageSize), in.Cursor)
+	switch {
+	case errors.IsTransient(err):
+		return nil, internalError(c, err)
+	case err != nil:
+		return nil, grpcutil.Errf(codes.InvalidArgument, "bad cursor - %s", err)
+	}
+	out := &scheduler.InvocationsReply{
+		Invocations: make([]*scheduler.Invocation, len(invs)),
+		NextCursor:  cursor,
+	}
+	for i, inv := range invs {
+		out.Invocations[i] = invocationToProto(in.JobRef, inv)
+	}
+	return out, nil
+}
+
+// GetInvocation fetches a single invocation.
+func (s SchedulerServer) GetInvocation(c context.Context, in *scheduler.InvocationRef) (*scheduler.Invocation, error) {
+	job, err := s.getJob(c, in.JobRef)
+	if err != nil {
+		return nil, err
+	}
+	switch inv, err := s.Engine.GetInvocation(c, job.JobID, in.InvocationId); {
+	case err != nil:
+		return nil, internalError(c, err)
+	case inv == nil:
+		return nil, grpcutil.Errf(codes.NotFound, "no such invocation")
+	default:
+		return invocationToProto(in.JobRef, inv), nil
+	}
+}
+
+// TriggerJob launches a new invocation of a job right now, unless the job is
+// already running.
+func (s SchedulerServer) TriggerJob(c context.Context, in *scheduler.JobRef) (*scheduler.TriggerJobReply, error) {
+	var nonce int64
+	err := s.jobAction(c, in, func(jobID string) (err error) {
+		nonce, err = s.Engine.TriggerInvocation(c, jobID, auth.CurrentIdentity(c))
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &scheduler.TriggerJobReply{InvocationNonce: nonce}, nil
+}
+
+// PauseJob prevents automatic triggering of a job.
+func (s SchedulerServer) PauseJob(c context.Context, in *scheduler.JobRef) (*empty.Empty, error) {
+	err := s.jobAction(c, in, func(jobID string) error {
+		return s.Engine.PauseJob(c, jobID, auth.CurrentIdentity(c))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// ResumeJob resumes a paused job.
+func (s SchedulerServer) ResumeJob(c context.Context, in *scheduler.JobRef) (*empty.Empty, error) {
+	err := s.jobAction(c, in, func(jobID string) error {
+		return s.Engine.ResumeJob(c, jobID, auth.CurrentIdentity(c))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// AbortJob aborts a currently pending or running invocation of a job, if any.
+func (s SchedulerServer) AbortJob(c context.Context, in *scheduler.JobRef) (*empty.Empty, error) {
+	err := s.jobAction(c, in, func(jobID string) error {
+		return s.Engine.AbortJob(c, jobID, auth.CurrentIdentity(c))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// AbortInvocation forcefully moves the invocation to failed state.
+func (s SchedulerServer) AbortInvocation(c context.Context, in *scheduler.InvocationRef) (*empty.Empty, error) {
+	err := s.jobAction(c, in.JobRef, func(jobID string) error {
+		switch inv, err := s.Engine.GetInvocation(c, jobID, in.InvocationId); {
+		case err != nil:
+			return err
+		case inv == nil:
+			return grpcutil.Errf(codes.NotFound, "no such invocation")
+		}
+		return s.Engine.AbortInvocation(c, jobID, in.InvocationId, auth.CurrentIdentity(c))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// getJob fetches an enabled job given its reference.
+//
+// Returns gRPC errors.
+func (s SchedulerServer) getJob(c context.Context, ref *scheduler.JobRef) (*engine.Job, error) {
+	if ref == nil || ref.Project == "" || ref.Job == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "project and job are required")
+	}
+	switch job, err := s.Engine.GetJob(c, ref.Project+"/"+ref.Job); {
+	case err != nil:
+		return nil, internalError(c, err)
+	case job == nil || !job.Enabled:
+		return nil, grpcutil.Errf(codes.NotFound, "no such job")
+	default:
+		return job, nil
+	}
+}
+
+// jobAction checks that the caller owns the job and then calls cb with the
+// full job ID.
+//
+// Errors returned by cb that are not already gRPC errors are converted to
+// grpc.Internal. Returns gRPC errors.
+func (s SchedulerServer) jobAction(c context.Context, ref *scheduler.JobRef, cb func(jobID string) error) error {
+	if ref == nil || ref.Project == "" || ref.Job == "" {
+		return grpcutil.Errf(codes.InvalidArgument, "project and job are required")
+	}
+	switch ok, err := acl.IsJobOwner(c, ref.Project, ref.Job); {
+	case err != nil:
+		return internalError(c, err)
+	case !ok:
+		return grpcutil.Errf(codes.PermissionDenied, "no permission to modify the job")
+	}
+	job, err := s.getJob(c, ref)
+	if err != nil {
+		return err
+	}
+	if err := cb(job.JobID); err != nil {
+		if grpcutil.Code(err) != codes.Unknown {
+			return err
+		}
+		return internalError(c, err)
+	}
+	return nil
+}
+
+// internalError logs err and returns an opaque grpc.Internal error.
+func internalError(c context.Context, err error) error {
+	logging.WithError(err).Errorf(c, "Internal error")
+	return grpcutil.Internal
+}
+
+// clampPageSize returns the page size to use given the requested one.
+func clampPageSize(size int32) int {
+	switch {
+	case size <= 0:
+		return defaultPageSize
+	case size > maxPageSize:
+		return maxPageSize
+	default:
+		return int(size)
+	}
+}
+
+func jobToProto(job *engine.Job) *scheduler.Job {
+	status := string(job.State.State)
+	if job.Paused && (job.State.State == engine.JobStateScheduled || job.State.State == engine.JobStateSuspended) {
+		status = "PAUSED"
+	}
+	return &scheduler.Job{
+		JobRef: &scheduler.JobRef{
+			Project: job.ProjectID,
+			Job:     strings.TrimPrefix(job.JobID, job.ProjectID+"/"),
+		},
+		Schedule: job.Schedule,
+		State:    &scheduler.JobState{UiStatus: status},
+		Paused:   job.Paused,
+	}
+}
+
+func invocationToProto(ref *scheduler.JobRef, inv *engine.Invocation) *scheduler.Invocation {
+	out := &scheduler.Invocation{
+		InvocationRef: &scheduler.InvocationRef{
+			JobRef:       ref,
+			InvocationId: inv.ID,
+		},
+		StartedTs:       toMicros(inv.Started),
+		TriggeredBy:     string(inv.TriggeredBy),
+		Status:          string(inv.Status),
+		Final:           inv.Status.Final(),
+		ConfigRevision:  inv.Revision,
+		ViewUrl:         inv.ViewURL,
+		InvocationNonce: inv.InvocationNonce,
+	}
+	if out.Final {
+		out.FinishedTs = toMicros(inv.Finished)
+	}
+	return out
+}
+
+// toMicros converts t to a unix timestamp in microseconds, or 0 if t is zero.
+func toMicros(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / 1000
+}
+
+// jobsByID sorts jobs by their full ID.
+type jobsByID []*engine.Job
+
+func (s jobsByID) Len() int           { return len(s) }
+func (s jobsByID) Less(i, j int) bool { return s[i].JobID < s[j].JobID }
+func (s jobsByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,272 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package apiservers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/authtest"
+	"github.com/luci/luci-go/server/auth/identity"
+
+	"github.com/luci/luci-go/scheduler/api/scheduler/v1"
+	"github.com/luci/luci-go/scheduler/appengine/engine"
+	"github.com/luci/luci-go/scheduler/appengine/task"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeEngine implements the subset of engine.Engine used by SchedulerServer.
+type fakeEngine struct {
+	engine.Engine
+
+	jobs    []*engine.Job
+	invs    map[string][]*engine.Invocation
+	actions []string
+}
+
+func (f *fakeEngine) GetAllJobs(c context.Context) ([]*engine.Job, error) {
+	return f.GetProjectJobs(c, "")
+}
+
+func (f *fakeEngine) GetProjectJobs(c context.Context, projectID string) ([]*engine.Job, error) {
+	var out []*engine.Job
+	for _, j := range f.jobs {
+		if j.Enabled && (projectID == "" || j.ProjectID == projectID) {
+			out = append(out, j)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEngine) GetJob(c context.Context, jobID string) (*engine.Job, error) {
+	for _, j := range f.jobs {
+		if j.JobID == jobID {
+			return j, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeEngine) ListInvocations(c context.Context, jobID string, pageSize int, cursor string) ([]*engine.Invocation, string, error) {
+	invs := f.invs[jobID]
+	start := 0
+	if cursor != "" {
+		if _, err := fmt.Sscanf(cursor, "%d", &start); err != nil {
+			return nil, "", err
+		}
+	}
+	if start > len(invs) {
+		start = len(invs)
+	}
+	invs = invs[start:]
+	next := ""
+	if len(invs) > pageSize {
+		invs = invs[:pageSize]
+		next = fmt.Sprintf("%d", start+pageSize)
+	}
+	return invs, next, nil
+}
+
+func (f *fakeEngine) GetInvocation(c context.Context, jobID string, invID int64) (*engine.Invocation, error) {
+	for _, inv := range f.invs[jobID] {
+		if inv.ID == invID {
+			return inv, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeEngine) TriggerInvocation(c context.Context, jobID string, triggeredBy identity.Identity) (int64, error) {
+	f.actions = append(f.actions, fmt.Sprintf("trigger %s by %s", jobID, triggeredBy))
+	return 123, nil
+}
+
+func (f *fakeEngine) PauseJob(c context.Context, jobID string, who identity.Identity) error {
+	f.actions = append(f.actions, fmt.Sprintf("pause %s by %s", jobID, who))
+	return nil
+}
+
+func (f *fakeEngine) ResumeJob(c context.Context, jobID string, who identity.Identity) error {
+	f.actions = append(f.actions, fmt.Sprintf("resume %s by %s", jobID, who))
+	return nil
+}
+
+func (f *fakeEngine) AbortJob(c context.Context, jobID string, who identity.Identity) error {
+	f.actions = append(f.actions, fmt.Sprintf("abort %s by %s", jobID, who))
+	return nil
+}
+
+func (f *fakeEngine) AbortInvocation(c context.Context, jobID string, invID int64, who identity.Identity) error {
+	f.actions = append(f.actions, fmt.Sprintf("abort %s/%d by %s", jobID, invID, who))
+	return nil
+}
+
+func TestSchedulerServer(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a fake engine", t, func() {
+		c := context.Background()
+		epoch := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		fe := &fakeEngine{
+			jobs: []*engine.Job{
+				{JobID: "def/2", ProjectID: "def", Enabled: true, Schedule: "triggered"},
+				{JobID: "abc/1", ProjectID: "abc", Enabled: true, Schedule: "with 1m interval"},
+				{JobID: "abc/2", ProjectID: "abc", Enabled: true, Paused: true,
+					State: engine.JobState{State: engine.JobStateSuspended}},
+				{JobID: "abc/3", ProjectID: "abc", Enabled: false},
+			},
+			invs: map[string][]*engine.Invocation{
+				"abc/1": {
+					{ID: 3, Started: epoch.Add(2 * time.Minute), Status: task.StatusRunning, InvocationNonce: 7},
+					{ID: 2, Started: epoch.Add(time.Minute), Finished: epoch.Add(90 * time.Second),
+						Status: task.StatusSucceeded, TriggeredBy: "user:someone@example.com"},
+					{ID: 1, Started: epoch, Status: task.StatusFailed},
+				},
+			},
+		}
+		ss := SchedulerServer{Engine: fe}
+		abc1 := &scheduler.JobRef{Project: "abc", Job: "1"}
+
+		Convey("GetJobs pages through all jobs", func() {
+			reply, err := ss.GetJobs(c, &scheduler.JobsRequest{PageSize: 2})
+			So(err, ShouldBeNil)
+			So(reply.NextCursor, ShouldEqual, "abc/2")
+			So(reply.Jobs, ShouldResemble, []*scheduler.Job{
+				{
+					JobRef:   &scheduler.JobRef{Project: "abc", Job: "1"},
+					Schedule: "with 1m interval",
+					State:    &scheduler.JobState{},
+				},
+				{
+					JobRef: &scheduler.JobRef{Project: "abc", Job: "2"},
+					State:  &scheduler.JobState{UiStatus: "PAUSED"},
+					Paused: true,
+				},
+			})
+
+			reply, err = ss.GetJobs(c, &scheduler.JobsRequest{PageSize: 2, Cursor: reply.NextCursor})
+			So(err, ShouldBeNil)
+			So(reply.NextCursor, ShouldEqual, "")
+			So(len(reply.Jobs), ShouldEqual, 1)
+			So(reply.Jobs[0].JobRef, ShouldResemble, &scheduler.JobRef{Project: "def", Job: "2"})
+		})
+
+		Convey("GetJobs filters by project", func() {
+			reply, err := ss.GetJobs(c, &scheduler.JobsRequest{Project: "def"})
+			So(err, ShouldBeNil)
+			So(len(reply.Jobs), ShouldEqual, 1)
+			So(reply.Jobs[0].Schedule, ShouldEqual, "triggered")
+		})
+
+		Convey("GetInvocations works", func() {
+			reply, err := ss.GetInvocations(c, &scheduler.InvocationsRequest{JobRef: abc1, PageSize: 2})
+			So(err, ShouldBeNil)
+			So(reply.NextCursor, ShouldEqual, "2")
+			So(reply.Invocations, ShouldResemble, []*scheduler.Invocation{
+				{
+					InvocationRef:   &scheduler.InvocationRef{JobRef: abc1, InvocationId: 3},
+					StartedTs:       epoch.Add(2*time.Minute).UnixNano() / 1000,
+					Status:          "RUNNING",
+					InvocationNonce: 7,
+				},
+				{
+					InvocationRef: &scheduler.InvocationRef{JobRef: abc1, InvocationId: 2},
+					StartedTs:     epoch.Add(time.Minute).UnixNano() / 1000,
+					FinishedTs:    epoch.Add(90*time.Second).UnixNano() / 1000,
+					TriggeredBy:   "user:someone@example.com",
+					Status:        "SUCCEEDED",
+					Final:         true,
+				},
+			})
+
+			reply, err = ss.GetInvocations(c, &scheduler.InvocationsRequest{JobRef: abc1, Cursor: reply.NextCursor})
+			So(err, ShouldBeNil)
+			So(len(reply.Invocations), ShouldEqual, 1)
+			So(reply.NextCursor, ShouldEqual, "")
+		})
+
+		Convey("GetInvocations with a bad cursor", func() {
+			_, err := ss.GetInvocations(c, &scheduler.InvocationsRequest{JobRef: abc1, Cursor: "bad"})
+			So(grpcutil.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("GetInvocation works", func() {
+			inv, err := ss.GetInvocation(c, &scheduler.InvocationRef{JobRef: abc1, InvocationId: 1})
+			So(err, ShouldBeNil)
+			So(inv.Status, ShouldEqual, "FAILED")
+			So(inv.Final, ShouldBeTrue)
+			So(inv.FinishedTs, ShouldEqual, 0)
+
+			_, err = ss.GetInvocation(c, &scheduler.InvocationRef{JobRef: abc1, InvocationId: 4})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+		})
+
+		Convey("Unknown and disabled jobs are not found", func() {
+			_, err := ss.GetInvocations(c, &scheduler.InvocationsRequest{
+				JobRef: &scheduler.JobRef{Project: "abc", Job: "missing"},
+			})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+			_, err = ss.GetInvocations(c, &scheduler.InvocationsRequest{
+				JobRef: &scheduler.JobRef{Project: "abc", Job: "3"},
+			})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+			_, err = ss.GetInvocations(c, &scheduler.InvocationsRequest{})
+			So(grpcutil.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Actions by an owner", func() {
+			c = auth.WithState(c, &authtest.FakeState{
+				Identity:       "user:admin@example.com",
+				IdentityGroups: []string{"administrators"},
+			})
+
+			reply, err := ss.TriggerJob(c, abc1)
+			So(err, ShouldBeNil)
+			So(reply.InvocationNonce, ShouldEqual, 123)
+			_, err = ss.PauseJob(c, abc1)
+			So(err, ShouldBeNil)
+			_, err = ss.ResumeJob(c, abc1)
+			So(err, ShouldBeNil)
+			_, err = ss.AbortJob(c, abc1)
+			So(err, ShouldBeNil)
+			_, err = ss.AbortInvocation(c, &scheduler.InvocationRef{JobRef: abc1, InvocationId: 3})
+			So(err, ShouldBeNil)
+			So(fe.actions, ShouldResemble, []string{
+				"trigger abc/1 by user:admin@example.com",
+				"pause abc/1 by user:admin@example.com",
+				"resume abc/1 by user:admin@example.com",
+				"abort abc/1 by user:admin@example.com",
+				"abort abc/1/3 by user:admin@example.com",
+			})
+
+			_, err = ss.AbortInvocation(c, &scheduler.InvocationRef{JobRef: abc1, InvocationId: 4})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+			_, err = ss.PauseJob(c, &scheduler.JobRef{Project: "abc", Job: "missing"})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+		})
+
+		Convey("Actions by a non-owner", func() {
+			c = auth.WithState(c, &authtest.FakeState{
+				Identity: "user:someone@example.com",
+			})
+
+			_, err := ss.TriggerJob(c, abc1)
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+			_, err = ss.PauseJob(c, abc1)
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+			_, err = ss.AbortInvocation(c, &scheduler.InvocationRef{JobRef: abc1, InvocationId: 3})
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+			So(fe.actions, ShouldBeEmpty)
+		})
+	})
+}
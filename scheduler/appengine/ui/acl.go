@@ -7,12 +7,11 @@ package ui
 import (
 	"golang.org/x/net/context"
 
-	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/scheduler/appengine/acl"
 )
 
 func isJobOwner(c context.Context, projectID, jobID string) bool {
-	// TODO(vadimsh): Do real ACLs.
-	ok, err := auth.IsMember(c, "administrators")
+	ok, err := acl.IsJobOwner(c, projectID, jobID)
 	if err != nil {
 		panic(err)
 	}